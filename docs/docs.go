@@ -52,7 +52,7 @@ const docTemplate = `{
                     "202": {
                         "description": "Login successful",
                         "schema": {
-                            "$ref": "#/definitions/dto.TokenResponse"
+                            "$ref": "#/definitions/dto.TokenSuccessResponse"
                         }
                     },
                     "400": {
@@ -70,6 +70,37 @@ const docTemplate = `{
                 }
             }
         },
+        "/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes the access token of the request and its refresh token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "User logout",
+                "responses": {
+                    "200": {
+                        "description": "Logged out",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GenericSuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/me": {
             "get": {
                 "security": [
@@ -107,6 +138,189 @@ const docTemplate = `{
                 }
             }
         },
+        "/me/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the notifications of the authenticated user, newest first by default: task assignments, status changes of assigned tasks, due date reminders and project membership changes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get my notifications",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only list unread notifications",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, ignored when cursor is set",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort as field:asc or field:desc (fields: id, created_at; default id:desc)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notifications found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationSliceSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notifications/read-all": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks every unread notification of the authenticated user as read",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Mark all my notifications as read",
+                "responses": {
+                    "200": {
+                        "description": "Notifications marked as read",
+                        "schema": {
+                            "$ref": "#/definitions/dto.MarkNotificationsReadSuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notifications/unread-count": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Counts the unread notifications of the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Count my unread notifications",
+                "responses": {
+                    "200": {
+                        "description": "Unread notifications counted",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnreadNotificationCountSuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notifications/{notificationId}/read": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks a notification of the authenticated user as read; marking a read notification again keeps its read time",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Mark a notification as read",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Notification ID",
+                        "name": "notificationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notification marked as read",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid notification ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Notification not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/projects": {
             "get": {
                 "description": "Retrieves projects based on optional query parameters (id, name, status, managerid, startdate, enddate)",
@@ -158,6 +372,30 @@ const docTemplate = `{
                         "description": "End date before (format: YYYY-MM-DD)",
                         "name": "enddate",
                         "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, ignored when cursor is set",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort as field:asc or field:desc (fields: id, name, status, start_date, created_at, updated_at)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -396,24 +634,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{projectId}/members": {
-            "post": {
+        "/projects/{projectId}/activity": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Adds one or more users to a project as team members",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves who changed what in a project, its sprints and its tasks, newest first by default; available to any project member",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Projects"
                 ],
-                "summary": "Add team members to a project",
+                "summary": "Get project activity",
                 "parameters": [
                     {
                         "type": "integer",
@@ -423,36 +658,51 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "List of user IDs to add",
-                        "name": "members",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.AddTeamMembersRequest"
-                        }
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, ignored when cursor is set",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort as field:asc or field:desc (fields: id, created_at; default id:desc)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Team members added successfully",
+                        "description": "Project activity found",
                         "schema": {
-                            "$ref": "#/definitions/dto.IntSuccessResponse"
+                            "$ref": "#/definitions/dto.ActivitySliceSuccessResponse"
                         }
                     },
-                    "207": {
-                        "description": "Some team members added successfully",
+                    "400": {
+                        "description": "Bad request - Invalid project ID or query parameters",
                         "schema": {
-                            "$ref": "#/definitions/dto.AddTeamMembersPartialSuccessResponse"
+                            "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request - Invalid input or project ID",
+                    "403": {
+                        "description": "Forbidden - User is not a project member",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - User not authorized",
+                    "404": {
+                        "description": "Not found - Project not found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -466,21 +716,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{projectId}/tasks": {
+        "/projects/{projectId}/backlog": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves all tasks associated with a specific project",
+                "description": "Retrieves all tasks of a specific project that do not belong to any sprint",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Tasks"
                 ],
-                "summary": "Get tasks by project ID",
+                "summary": "Get project backlog",
                 "parameters": [
                     {
                         "type": "integer",
@@ -488,11 +738,35 @@ const docTemplate = `{
                         "name": "projectId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, ignored when cursor is set",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort as field:asc or field:desc (fields: id, title, status, priority, created_at, updated_at)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Tasks found",
+                    "200": {
+                        "description": "Backlog tasks found",
                         "schema": {
                             "$ref": "#/definitions/dto.TaskSliceSuccessResponse"
                         }
@@ -524,57 +798,63 @@ const docTemplate = `{
                 }
             }
         },
-        "/sprints": {
+        "/projects/{projectId}/events": {
             "get": {
-                "description": "Retrieves sprints based on optional query parameters (id, name, projectid, startdate, enddate)",
-                "produces": [
-                    "application/json"
-                ],
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Streams the task events of a project (task.created, task.updated, task.status_changed, task.assigned, task.deleted) as Server-Sent Events while they happen, on any instance of the API; available to any project member. Each message carries the event type as its SSE event name and the event as JSON data, the same payload webhooks receive. Browsers' EventSource cannot set headers, so the access token may be passed as the access_token query parameter instead. The stream ends when the access token expires, the access token is revoked or the user is no longer a project member, the last two checked on every heartbeat. A client that reconnects should reload the board, since events sent while it was disconnected are not replayed.",
+                "produces": [
+                    "text/event-stream"
+                ],
                 "tags": [
-                    "Sprints"
+                    "Projects"
                 ],
-                "summary": "Find sprints with filters",
+                "summary": "Stream project board events",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Sprint ID",
-                        "name": "id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Sprint name",
-                        "name": "name",
-                        "in": "query"
-                    },
                     {
                         "type": "integer",
                         "description": "Project ID",
-                        "name": "projectid",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Start date after (format: YYYY-MM-DD)",
-                        "name": "startdate",
-                        "in": "query"
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "End date before (format: YYYY-MM-DD)",
-                        "name": "enddate",
+                        "description": "Access token, when the Authorization header cannot be set",
+                        "name": "access_token",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Sprints found",
+                        "description": "Stream of task events",
                         "schema": {
-                            "$ref": "#/definitions/dto.SprintSliceSuccessResponse"
+                            "type": "string"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid query parameters",
+                        "description": "Bad request - Invalid project ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User is not a project member",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Project not found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -586,50 +866,47 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/projects/{projectId}/labels": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new sprint for a specific project",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves the labels of a project ordered by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sprints"
+                    "Labels"
                 ],
-                "summary": "Create a new sprint",
+                "summary": "Get labels of a project",
                 "parameters": [
                     {
-                        "description": "Sprint creation request",
-                        "name": "sprint",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateSprintRequest"
-                        }
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Sprint created successfully",
+                    "200": {
+                        "description": "Labels found",
                         "schema": {
-                            "$ref": "#/definitions/dto.SprintSuccessResponse"
+                            "$ref": "#/definitions/dto.LabelSliceSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid input or dates",
+                        "description": "Bad request - Invalid project ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "403": {
-                        "description": "Forbidden - User not authorized",
+                        "description": "Forbidden - User not part of the project",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -647,41 +924,51 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/sprints/{sprintId}": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves details of a specific sprint",
+                "description": "Creates a label with a name unique in the project and a hex color",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sprints"
+                    "Labels"
                 ],
-                "summary": "Get a sprint by ID",
+                "summary": "Create a label",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Sprint ID",
-                        "name": "sprintId",
+                        "description": "Project ID",
+                        "name": "projectId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Label creation request",
+                        "name": "label",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateLabelRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Sprint found",
+                    "201": {
+                        "description": "Label created successfully",
                         "schema": {
-                            "$ref": "#/definitions/dto.SprintSuccessResponse"
+                            "$ref": "#/definitions/dto.LabelSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid sprint ID",
+                        "description": "Bad request - Invalid input",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -693,7 +980,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Not found - Sprint not found",
+                        "description": "Not found - Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Label name already used in the project",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -705,14 +998,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/projects/{projectId}/labels/{labelId}": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates the details of an existing sprint",
+                "description": "Renames or recolors a label of the project",
                 "consumes": [
                     "application/json"
                 ],
@@ -720,36 +1015,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Sprints"
+                    "Labels"
                 ],
-                "summary": "Update a sprint",
+                "summary": "Update a label",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Sprint ID",
-                        "name": "sprintId",
+                        "description": "Project ID",
+                        "name": "projectId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Sprint update request",
-                        "name": "sprint",
+                        "type": "integer",
+                        "description": "Label ID",
+                        "name": "labelId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Label update request",
+                        "name": "label",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateSprintRequest"
+                            "$ref": "#/definitions/dto.UpdateLabelRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Sprint updated",
+                        "description": "Label updated successfully",
                         "schema": {
-                            "$ref": "#/definitions/dto.SprintSuccessResponse"
+                            "$ref": "#/definitions/dto.LabelSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid input or sprint ID",
+                        "description": "Bad request - Invalid input",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -760,6 +1062,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not found - Project or label not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Label name already used in the project",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -774,32 +1088,39 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a specific sprint",
+                "description": "Deletes a label of the project and removes it from every task",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sprints"
+                    "Labels"
                 ],
-                "summary": "Delete a sprint",
+                "summary": "Delete a label",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Sprint ID",
-                        "name": "sprintId",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Label ID",
+                        "name": "labelId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Sprint deleted successfully",
+                        "description": "Label deleted successfully",
                         "schema": {
                             "$ref": "#/definitions/dto.GenericSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid sprint ID",
+                        "description": "Bad request - Invalid ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -811,7 +1132,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Not found - Sprint not found",
+                        "description": "Not found - Project or label not found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -825,67 +1146,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks": {
+        "/projects/{projectId}/members": {
             "get": {
-                "description": "Retrieves tasks based on optional query parameters (id, title, status, priority, due_date_before)",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves all members of a project with their project role; available to any project member",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Projects"
                 ],
-                "summary": "Find tasks with filters",
+                "summary": "List project members",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Task ID",
-                        "name": "id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Task title",
-                        "name": "title",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "OPEN",
-                            "IN_PROGRESS",
-                            "DONE"
-                        ],
-                        "type": "string",
-                        "description": "Task status",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "LOW",
-                            "MEDIUM",
-                            "HIGH"
-                        ],
-                        "type": "string",
-                        "description": "Task priority",
-                        "name": "priority",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Due date before (format: YYYY-MM-DD)",
-                        "name": "due_date_before",
-                        "in": "query"
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Tasks found",
+                    "200": {
+                        "description": "Project members found",
                         "schema": {
-                            "$ref": "#/definitions/dto.TaskSliceSuccessResponse"
+                            "$ref": "#/definitions/dto.ProjectMemberSliceSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid query parameters",
+                        "description": "Bad request - Invalid project ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User is not a project member",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Project not found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -904,7 +1209,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new task with the provided details for a specific sprint",
+                "description": "Adds one or more users to a project with the given project role (MEMBER by default)",
                 "consumes": [
                     "application/json"
                 ],
@@ -912,29 +1217,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Projects"
                 ],
-                "summary": "Create a new task",
+                "summary": "Add team members to a project",
                 "parameters": [
                     {
-                        "description": "Task creation request",
-                        "name": "task",
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "List of user IDs to add",
+                        "name": "members",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateTaskRequest"
+                            "$ref": "#/definitions/dto.AddTeamMembersRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Task created successfully",
+                    "200": {
+                        "description": "Team members added successfully",
                         "schema": {
-                            "$ref": "#/definitions/dto.TaskSuccessResponse"
+                            "$ref": "#/definitions/dto.IntSuccessResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Some team members added successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AddTeamMembersPartialSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid input",
+                        "description": "Bad request - Invalid input or project ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -946,7 +1264,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Not found - Sprint not found",
+                        "description": "Not found - Project not found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -960,39 +1278,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks/{taskId}": {
-            "get": {
+        "/projects/{projectId}/members/{userId}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves details of a specific task",
+                "description": "Removes a user from a project; the project manager cannot be removed",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Projects"
                 ],
-                "summary": "Get a task by ID",
+                "summary": "Remove a project member",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Task ID",
-                        "name": "taskId",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Task found",
+                    "200": {
+                        "description": "Member removed successfully",
                         "schema": {
-                            "$ref": "#/definitions/dto.TaskSuccessResponse"
+                            "$ref": "#/definitions/dto.GenericSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid task ID",
+                        "description": "Bad request - Invalid IDs or user is the project manager",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1004,7 +1329,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Not found - Task not found",
+                        "description": "Not found - Project not found or user is not a member",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1016,51 +1341,65 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/projects/{projectId}/tasks": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates the details of an existing task",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves all tasks associated with a specific project",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Tasks"
                 ],
-                "summary": "Update a task",
+                "summary": "Get tasks by project ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Task ID",
-                        "name": "taskId",
+                        "description": "Project ID",
+                        "name": "projectId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Task update request",
-                        "name": "task",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdateTaskRequest"
-                        }
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, ignored when cursor is set",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort as field:asc or field:desc (fields: id, title, status, priority, created_at, updated_at)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "202": {
-                        "description": "Task updated",
+                        "description": "Tasks found",
                         "schema": {
-                            "$ref": "#/definitions/dto.TaskSuccessResponse"
+                            "$ref": "#/definitions/dto.TaskSliceSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid input or task ID",
+                        "description": "Bad request - Invalid project ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1072,7 +1411,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Not found - Task not found",
+                        "description": "Not found - Project not found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1084,39 +1423,41 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/projects/{projectId}/velocity": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a specific task",
+                "description": "Retrieves the tasks committed and completed in every closed sprint of the project, in completion order",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Metrics"
                 ],
-                "summary": "Delete a task",
+                "summary": "Get project velocity",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Task ID",
-                        "name": "taskId",
+                        "description": "Project ID",
+                        "name": "projectId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Task deleted successfully",
+                    "200": {
+                        "description": "Velocity computed",
                         "schema": {
-                            "$ref": "#/definitions/dto.GenericSuccessResponse"
+                            "$ref": "#/definitions/dto.VelocitySuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid task ID",
+                        "description": "Bad request - Invalid project ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1128,7 +1469,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Not found - Task not found",
+                        "description": "Not found - Project not found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1142,46 +1483,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks/{taskId}/user/{userId}": {
-            "post": {
+        "/projects/{projectId}/webhooks": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Assigns a specific task to a user",
+                "description": "Retrieves the webhook subscriptions of a project; secrets are not returned",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Webhooks"
                 ],
-                "summary": "Assign task to user",
+                "summary": "Get webhooks of a project",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Task ID",
-                        "name": "taskId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "User ID",
-                        "name": "userId",
+                        "description": "Project ID",
+                        "name": "projectId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Task assigned successfully",
+                    "200": {
+                        "description": "Webhooks found",
                         "schema": {
-                            "$ref": "#/definitions/dto.GenericSuccessResponse"
+                            "$ref": "#/definitions/dto.WebhookSliceSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid IDs or user not in project",
+                        "description": "Bad request - Invalid project ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1193,7 +1527,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Not found - Task or user not found",
+                        "description": "Not found - Project not found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1205,35 +1539,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users": {
-            "get": {
-                "description": "Retrieves a list of all users",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Users"
-                ],
-                "summary": "Get all users",
-                "responses": {
-                    "200": {
-                        "description": "Users found",
-                        "schema": {
-                            "$ref": "#/definitions/dto.UserSliceSuccessResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request - Database error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    }
-                }
             },
             "post": {
-                "description": "Creates a new user with the provided details",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Subscribes a URL to events of a project. Events are POSTed as JSON, signed in the X-Webhook-Signature-256 header as \"sha256=\" followed by the hex HMAC-SHA256 of the body keyed with the secret, which is only returned by this call. Failed deliveries are retried with exponential backoff",
                 "consumes": [
                     "application/json"
                 ],
@@ -1241,29 +1554,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Webhooks"
                 ],
-                "summary": "Create a new user",
+                "summary": "Create a webhook",
                 "parameters": [
                     {
-                        "description": "User creation request",
-                        "name": "user",
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook creation request",
+                        "name": "webhook",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateUserRequest"
+                            "$ref": "#/definitions/dto.CreateWebhookRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "User created successfully",
+                        "description": "Webhook created successfully",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserSuccessResponse"
+                            "$ref": "#/definitions/dto.WebhookSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid input or validation failure",
+                        "description": "Bad request - Invalid input or URL not pointing to a public host",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Project not found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1277,40 +1609,70 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}": {
-            "get": {
-                "description": "Retrieves a user based on the provided ID",
+        "/projects/{projectId}/webhooks/{webhookId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the URL or the events of a webhook, or pauses and resumes its deliveries",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Webhooks"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Update a webhook",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
-                        "name": "userId",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Webhook update request",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateWebhookRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "302": {
-                        "description": "User found",
+                    "200": {
+                        "description": "Webhook updated successfully",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserSuccessResponse"
+                            "$ref": "#/definitions/dto.WebhookSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid ID",
+                        "description": "Bad request - Invalid input or URL not pointing to a public host",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Not found - Project or webhook not found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1323,84 +1685,146 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Updates an existing user's details",
-                "consumes": [
-                    "application/json"
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Deletes a webhook together with its delivery log",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Webhooks"
                 ],
-                "summary": "Update a user",
+                "summary": "Delete a webhook",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
-                        "name": "userId",
+                        "description": "Project ID",
+                        "name": "projectId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "User update request",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdateUserRequest"
-                        }
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "User updated",
+                    "200": {
+                        "description": "Webhook deleted successfully",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserSuccessResponse"
+                            "$ref": "#/definitions/dto.GenericSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid input or ID",
+                        "description": "Bad request - Invalid ID",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Forbidden - User not authorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
-                    }
+                    },
+                    "404": {
+                        "description": "Not found - Project or webhook not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
                 }
-            },
-            "delete": {
-                "description": "Deletes a user based on the provided ID",
+            }
+        },
+        "/projects/{projectId}/webhooks/{webhookId}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the deliveries of a webhook with the outcome of their last attempt, newest first by default",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Webhooks"
                 ],
-                "summary": "Delete a user",
+                "summary": "Get deliveries of a webhook",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
-                        "name": "userId",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, ignored when cursor is set",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort as field:asc or field:desc (fields: id, created_at; default id:desc)",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User deleted",
+                        "description": "Webhook deliveries found",
                         "schema": {
-                            "$ref": "#/definitions/dto.GenericSuccessResponse"
+                            "$ref": "#/definitions/dto.WebhookDeliverySliceSuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid ID or user not found",
+                        "description": "Bad request - Invalid ID or query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Project or webhook not found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1414,40 +1838,65 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{userId}/tasks": {
-            "get": {
-                "description": "Retrieves all tasks assigned to a specific user",
+        "/projects/{projectId}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queues the payload of a past delivery again as a new delivery with the same event ID, whatever the outcome of the original",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Webhooks"
                 ],
-                "summary": "Get tasks by user ID",
+                "summary": "Redeliver a webhook delivery",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User ID",
-                        "name": "userId",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Delivery ID",
+                        "name": "deliveryId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "202": {
-                        "description": "Tasks found",
+                        "description": "Redelivery queued",
                         "schema": {
-                            "$ref": "#/definitions/dto.TaskSliceSuccessResponse"
+                            "$ref": "#/definitions/dto.WebhookDeliverySuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid user ID",
+                        "description": "Bad request - Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Not found - User not found",
+                        "description": "Not found - Project, webhook or delivery not found",
                         "schema": {
                             "$ref": "#/definitions/dto.ErrorResponse"
                         }
@@ -1460,136 +1909,6677 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.AddTeamMembersPartialSuccessResponse": {
-            "type": "object",
-            "properties": {
-                "details": {
-                    "type": "string",
-                    "example": "User with ID 3 not found"
-                },
-                "message": {
-                    "type": "string",
-                    "example": "Some users could not be added to the project"
-                },
-                "updated_count": {
-                    "type": "integer",
-                    "example": 2
-                }
-            }
-        },
-        "dto.AddTeamMembersRequest": {
-            "type": "object"
         },
-        "dto.CreateProjectRequest": {
-            "type": "object",
-            "required": [
-                "name",
-                "start_date"
-            ],
-            "properties": {
-                "description": {
-                    "description": "Description is an optional detailed description of the project.",
-                    "type": "string",
-                    "maxLength": 65535,
-                    "example": "Redesign the company website to improve UX."
-                },
-                "end_date": {
-                    "description": "EndDate is the optional date when the project is expected to end.",
-                    "type": "string",
-                    "example": "2025-06-15T00:00:00Z"
-                },
-                "name": {
-                    "description": "Name is the name of the project.",
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 2,
-                    "example": "Website Redesign"
-                },
-                "start_date": {
-                    "description": "StartDate is the date when the project begins.",
-                    "type": "string",
-                    "example": "2025-04-10T00:00:00Z"
-                },
-                "status": {
-                    "description": "Status is the current status of the project.",
-                    "enum": [
-                        "ACTIVE",
-                        "ON_HOLD",
-                        "COMPLETED",
-                        "CANCELLED"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.ProjectStatus"
+        "/projects/{projectId}/wip-limits": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves how many tasks each board column of a project may hold, in total and per assignee; statuses without a limit are unlimited. Available to any project member",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WIP Limits"
+                ],
+                "summary": "Get project WIP limits",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "WIP limits found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WipLimitsSuccessResponse"
                         }
-                    ],
-                    "example": "ACTIVE"
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid project ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User is not a project member",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
                 }
-            }
-        },
-        "dto.CreateSprintRequest": {
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the WIP limits of a project, at most one per status; an empty list lifts every limit. Status changes and assignments past a limit are then rejected unless a project manager overrides them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WIP Limits"
+                ],
+                "summary": "Update project WIP limits",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "WIP limits update request",
+                        "name": "limits",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateWipLimitsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "WIP limits updated",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WipLimitsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/workflow": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the status transitions allowed in a project and the roles that may make them; available to any project member",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workflows"
+                ],
+                "summary": "Get project workflow",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Workflow found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorkflowSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid project ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User is not a project member",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the status transitions allowed in a project; an empty list restores the default workflow",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Workflows"
+                ],
+                "summary": "Update project workflow",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Workflow update request",
+                        "name": "workflow",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateWorkflowRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Workflow updated",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorkflowSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/worklogs/totals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the time logged on a project per user between two days, both included",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Worklogs"
+                ],
+                "summary": "Get worklog totals of a project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (format: YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (format: YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Totals computed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProjectWorklogTotalsSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid project ID or date range",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Full-text search over task titles and descriptions and project names and descriptions. Results are ranked by relevance, carry highlighted snippets and only include the projects the user is a member of and their tasks, plus the tasks assigned to the user. The query accepts web search syntax: quoted phrases, OR and -word",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search tasks and projects",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search text",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "all",
+                            "tasks",
+                            "projects"
+                        ],
+                        "type": "string",
+                        "description": "Kinds of items to search (default all)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum results of each kind (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Search results",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SearchSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sprints": {
+            "get": {
+                "description": "Retrieves sprints based on optional query parameters (id, name, projectid, startdate, enddate)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Find sprints with filters",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sprint ID",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sprint name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "projectid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date after (format: YYYY-MM-DD)",
+                        "name": "startdate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date before (format: YYYY-MM-DD)",
+                        "name": "enddate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, ignored when cursor is set",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort as field:asc or field:desc (fields: id, name, start_date, end_date, created_at, updated_at)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sprints found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SprintSliceSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new sprint for a specific project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Create a new sprint",
+                "parameters": [
+                    {
+                        "description": "Sprint creation request",
+                        "name": "sprint",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateSprintRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Sprint created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SprintSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input or dates",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sprints/{sprintId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves details of a specific sprint",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Get a sprint by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sprint found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SprintSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid sprint ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Sprint not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates the details of an existing sprint",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Update a sprint",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Sprint update request",
+                        "name": "sprint",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateSprintRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sprint updated",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SprintSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input or sprint ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a specific sprint",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Delete a sprint",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sprint deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GenericSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid sprint ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Sprint not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sprints/{sprintId}/board": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the tasks of a sprint grouped by status into the columns TO_DO, IN_PROGRESS, REVIEW, BLOCKED and DONE, each in rank order; available to any project member",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Get a sprint board",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sprint board found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SprintBoardSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid sprint ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User is not a project member",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Sprint not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sprints/{sprintId}/burndown": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the number of unfinished tasks at the end of every sprint day, replayed from the task status history, together with the ideal line",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Metrics"
+                ],
+                "summary": "Get sprint burndown",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Burndown computed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BurndownSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid sprint ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Sprint not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sprints/{sprintId}/complete": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Closes the active sprint and moves its unfinished tasks to the next sprint or to the backlog, within the WIP limits there unless override_wip_limit is set. The outcome is recorded in the sprint report.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Complete a sprint",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Where unfinished tasks go",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CompleteSprintRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sprint completed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SprintReportSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input or next sprint",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Sprint not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Sprint is not active or WIP limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sprints/{sprintId}/report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the report written when the sprint was completed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Get a sprint report",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sprint report found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SprintReportSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid sprint ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Sprint or report not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sprints/{sprintId}/start": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Makes a planned sprint the active sprint of its project. Only one sprint per project can be active.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Start a sprint",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sprint started",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SprintSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid sprint ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Sprint not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Sprint is not planned or another sprint is active",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves tasks based on optional query parameters (id, title, status, priority, due_date_before, story points, labels)\nand on a filter expression such as ` + "`" + `status = TO_DO and (priority \u003e= HIGH or due \u003c 2025-01-01) and assignee = me` + "`" + `.\nFields: id, title, description, status, priority, due, created, updated, assignee, project, sprint, parent, story_points, label.\nOperators: = != \u003c \u003c= \u003e \u003e= ~ (contains) in (...) not in (...) is empty, is not empty; combined with and, or, not and parentheses.\nSearches the tasks of the projects the requestor is a member of.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Find tasks with filters",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task title",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "OPEN",
+                            "IN_PROGRESS",
+                            "DONE"
+                        ],
+                        "type": "string",
+                        "description": "Task status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "LOW",
+                            "MEDIUM",
+                            "HIGH"
+                        ],
+                        "type": "string",
+                        "description": "Task priority",
+                        "name": "priority",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Due date before (format: YYYY-MM-DD)",
+                        "name": "due_date_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum story points",
+                        "name": "story_points_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum story points",
+                        "name": "story_points_max",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only tasks with (true) or without (false) story points",
+                        "name": "estimated",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated label IDs, e.g. 1,4",
+                        "name": "labels",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "Whether tasks carry any (default) or all of the labels",
+                        "name": "labels_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression, e.g. status in (TO_DO, IN_PROGRESS) and label = 4",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Saved view ID; its filter is combined with the other parameters",
+                        "name": "view",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, ignored when cursor is set",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort as field:asc or field:desc (fields: id, title, status, priority, created_at, updated_at)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Tasks found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskSliceSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Saved view of a project the user is not part of",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Saved view not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new task in a sprint, or in the project backlog when no sprint is given, optionally as a subtask of another task. The board column of its status must be within its WIP limit unless override_wip_limit is set",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Create a new task",
+                "parameters": [
+                    {
+                        "description": "Task creation request",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTaskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Task created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input or task hierarchy",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Project, sprint or parent task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - WIP limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves details of a specific task, including its nested subtasks and its links to other tasks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Get a task by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Task found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid task ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates the details of an existing task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Update a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task update request",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateTaskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Task updated",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input, task ID or task hierarchy",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task or parent task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Status transition not allowed by the project workflow, task blocked by open tasks or WIP limit reached",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "details": {
+                                            "$ref": "#/definitions/dto.StatusTransitionErrorDetails"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a specific task together with all of its subtasks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Delete a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Task deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GenericSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid task ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/attachments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the metadata of the files attached to a task, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachments"
+                ],
+                "summary": "Get attachments of a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attachments found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AttachmentSliceSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid task ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Uploads a file as multipart form data and attaches it to the task; project viewers may not upload. The size and MIME type of the file are limited by the server configuration",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachments"
+                ],
+                "summary": "Upload an attachment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File to attach",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Attachment uploaded successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AttachmentSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid task ID or missing file",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request entity too large - File exceeds the size limit",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported media type - File type not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/attachments/{attachmentId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Streams the content of an attached file",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Attachments"
+                ],
+                "summary": "Download an attachment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Attachment ID",
+                        "name": "attachmentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attachment content",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task or attachment not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes an attachment and its file. Only the uploader or a manager of the project may delete it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attachments"
+                ],
+                "summary": "Delete an attachment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Attachment ID",
+                        "name": "attachmentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attachment deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GenericSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task or attachment not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/comments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the top-level comments of a task, each one with its thread of replies",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Get comments of a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, ignored when cursor is set",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort as field:asc or field:desc (fields: id, created_at, updated_at)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comments found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CommentSliceSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid task ID or query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a comment, or a reply to another comment, on a task. Users mentioned as @email are recorded",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Comment on a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment creation request",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Comment created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CommentSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input, parent comment or reply nested too deep",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/comments/{commentId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the body of a comment, keeping the previous body in its edit history. Only the author may edit a comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Edit a comment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment update request",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comment updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CommentSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task or comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a comment together with all of its replies. Only the author or a project manager may delete a comment",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Delete a comment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comment deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GenericSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task or comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/comments/{commentId}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the previous bodies of a comment, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Get edit history of a comment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Comment edits found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CommentEditSliceSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task or comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves who changed what in a task and when, newest first by default",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Get task history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, ignored when cursor is set",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort as field:asc or field:desc (fields: id, created_at; default id:desc)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task history found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ActivitySliceSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid task ID or query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/labels": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the labels of a task with labels of its project; an empty list removes every label",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Set labels of a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task labels request",
+                        "name": "labels",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SetTaskLabelsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task labels updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input or label of another project",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task or label not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/links": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the links of a task to other tasks, in both directions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task Links"
+                ],
+                "summary": "Get links of a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task links found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskLinkSliceSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid task ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Links the task to a target task of the same project; the task blocks, relates to or duplicates the target. Blocking links cannot form cycles",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task Links"
+                ],
+                "summary": "Link a task to another task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task link creation request",
+                        "name": "link",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTaskLinkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Task link created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskLinkSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input, self link or tasks of different projects",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Tasks already linked or blocking cycle",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/links/{linkId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a link the task is the source or the target of",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task Links"
+                ],
+                "summary": "Delete a task link",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Link ID",
+                        "name": "linkId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task link deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GenericSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task or link not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/move": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a task to the column of a status and to a position within it, in one step: right after after_task_id and/or right before before_task_id, or at the end of the column without either. Like a status change, any project member may do it when the project workflow allows the transition for their role and the WIP limit of the status is not reached. Project managers may override the WIP limit with override_wip_limit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Move task on the board",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Move request",
+                        "name": "move",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MoveTaskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task moved",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input or task hierarchy",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized or not allowed to override the WIP limit",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Status transition not allowed, task blocked by open tasks, WIP limit reached, or neighbour tasks not in the target column",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "details": {
+                                            "$ref": "#/definitions/dto.StatusTransitionErrorDetails"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/sprint": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a top-level task together with all of its subtasks from its sprint, unless the board columns of the backlog cannot take them within their WIP limits",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Move task to backlog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Move past the WIP limits of the backlog",
+                        "name": "override_wip_limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task moved",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid task ID or task is a subtask",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - WIP limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/sprint/{sprintId}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a top-level task together with all of its subtasks into a sprint of the same project, unless the board columns of the sprint cannot take them within their WIP limits",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Move task to sprint",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Move past the WIP limits of the sprint",
+                        "name": "override_wip_limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task moved",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid IDs, sprint of another project or task is a subtask",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task or sprint not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - WIP limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/status": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a task to another status; any project member may do it when the project workflow allows the transition for their role and the WIP limit of the status is not reached. Project managers may override the WIP limit with override_wip_limit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Change task status",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status change request",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChangeTaskStatusRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task status changed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input or task hierarchy",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized or not allowed to override the WIP limit",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - Status transition not allowed by the project workflow, task blocked by open tasks or WIP limit reached",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dto.ErrorResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "details": {
+                                            "$ref": "#/definitions/dto.StatusTransitionErrorDetails"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/user/{userId}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Assigns a specific task to a user, unless the user already holds as many tasks of the task's status as the WIP limit per assignee allows",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Assign task to user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Assign past the WIP limit per assignee",
+                        "name": "override_wip_limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Task assigned successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GenericSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid IDs, user not in project or project viewer",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - WIP limit per assignee reached",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/worklogs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the time logged on a task",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Worklogs"
+                ],
+                "summary": "Get worklogs of a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, ignored when cursor is set",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort as field:asc or field:desc (fields: id, started_at, created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Worklogs found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorklogSliceSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid task ID or query parameters",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Logs time spent on a task. Assignees may log time on their own tasks and project managers on any task of the project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Worklogs"
+                ],
+                "summary": "Log time on a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Worklog creation request",
+                        "name": "worklog",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateWorklogRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Worklog created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorklogSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{taskId}/worklogs/{worklogId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates the start, duration or note of a worklog. Only the author may edit a worklog",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Worklogs"
+                ],
+                "summary": "Edit a worklog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Worklog ID",
+                        "name": "worklogId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Worklog update request",
+                        "name": "worklog",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateWorklogRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Worklog updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WorklogSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task or worklog not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a worklog. Only the author or a project manager may delete a worklog",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Worklogs"
+                ],
+                "summary": "Delete a worklog",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Worklog ID",
+                        "name": "worklogId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Worklog deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GenericSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not authorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Task or worklog not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/token/refresh": {
+            "post": {
+                "description": "Rotates the refresh token and returns a new access and refresh token. Reusing an already exchanged refresh token revokes every token of the user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Refresh tokens",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "refresh",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Token refreshed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TokenSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Refresh token is invalid, expired or reused",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "description": "Retrieves a list of all users",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get all users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, ignored when cursor is set",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort as field:asc or field:desc (fields: id, email, first_name, last_name, role, created_at)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Users found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserSliceSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid query parameters or database error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a new user with the provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "User creation request",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input or validation failure",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}": {
+            "get": {
+                "description": "Retrieves a user based on the provided ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get user by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "User found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates an existing user's details, including which notification emails the user receives",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User update request",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "User updated",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input or ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Owner or admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a user based on the provided ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User deleted",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GenericSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid ID or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/password": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the password of a user after checking the current one; every token issued to the user before the change stops working",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Change password",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Password change request",
+                        "name": "password",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password changed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GenericSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input, ID or current password",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Owner or admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/tasks": {
+            "get": {
+                "description": "Retrieves all tasks assigned to a specific user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Get tasks by user ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, ignored when cursor is set",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort as field:asc or field:desc (fields: id, title, status, priority, created_at, updated_at)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Tasks found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskSliceSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - User not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{userId}/timesheet": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the time a user logged between two days, both included, with daily totals. Available to the user and admins",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Worklogs"
+                ],
+                "summary": "Get timesheet of a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (format: YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (format: YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Timesheet found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TimesheetSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid user ID or date range",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Owner or admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/views": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the views saved by the current user and the views shared to the user's projects, ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Views"
+                ],
+                "summary": "Get saved views",
+                "responses": {
+                    "200": {
+                        "description": "Saved views found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SavedViewSliceSuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Saves a named task filter expression; with a project ID the view is shared to the members of the project and lists only its tasks. Run it with GET /tasks?view={id}",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Views"
+                ],
+                "summary": "Save a view",
+                "parameters": [
+                    {
+                        "description": "Saved view creation request",
+                        "name": "view",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateSavedViewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Saved view created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SavedViewSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input or filter expression",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not part of the project",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/views/{viewId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves a view of the current user or a view shared to one of the user's projects",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Views"
+                ],
+                "summary": "Get a saved view",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Saved view ID",
+                        "name": "viewId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Saved view found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SavedViewSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid view ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not part of the project of the view",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Saved view not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renames a view of the current user, changes its filter expression or shares it to another project; project ID 0 makes it personal again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Views"
+                ],
+                "summary": "Update a saved view",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Saved view ID",
+                        "name": "viewId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Saved view update request",
+                        "name": "view",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateSavedViewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Saved view updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SavedViewSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid input or filter expression",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not the owner of the view",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Saved view not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a view of the current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Views"
+                ],
+                "summary": "Delete a saved view",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Saved view ID",
+                        "name": "viewId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Saved view deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GenericSuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid view ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - User not the owner of the view",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - Saved view not found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.ActivityChangeResponse": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "description": "Field is the column that changed.",
+                    "type": "string",
+                    "example": "status"
+                },
+                "new_value": {
+                    "description": "NewValue is the value after the change; omitted for removed values.",
+                    "type": "string",
+                    "example": "IN_PROGRESS"
+                },
+                "old_value": {
+                    "description": "OldValue is the value before the change; omitted for created values.",
+                    "type": "string",
+                    "example": "TO_DO"
+                }
+            }
+        },
+        "dto.ActivityResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action is what was done to the entity.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ActivityAction"
+                        }
+                    ],
+                    "example": "UPDATE"
+                },
+                "actor_first_name": {
+                    "description": "ActorFirstName is the first name of the actor.",
+                    "type": "string",
+                    "example": "John"
+                },
+                "actor_id": {
+                    "description": "ActorID is the ID of the user who made the change.",
+                    "type": "integer",
+                    "example": 7
+                },
+                "actor_last_name": {
+                    "description": "ActorLastName is the last name of the actor.",
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "changes": {
+                    "description": "Changes lists the fields that changed.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ActivityChangeResponse"
+                    }
+                },
+                "created_at": {
+                    "description": "CreatedAt is the time of the change.",
+                    "type": "string",
+                    "example": "2025-04-20T10:00:00Z"
+                },
+                "entity_id": {
+                    "description": "EntityID is the ID of the entity that changed.",
+                    "type": "integer",
+                    "example": 101
+                },
+                "entity_type": {
+                    "description": "EntityType is the kind of entity that changed.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ActivityEntityType"
+                        }
+                    ],
+                    "example": "TASK"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the activity.",
+                    "type": "integer",
+                    "example": 55
+                },
+                "project_id": {
+                    "description": "ProjectID is the ID of the project the changed entity belongs to.",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "dto.ActivitySliceSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ActivityResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Items found successfully"
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "dto.AddTeamMembersPartialSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "details": {
+                    "type": "string",
+                    "example": "User with ID 3 not found"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Some users could not be added to the project"
+                },
+                "updated_count": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "dto.AddTeamMembersRequest": {
+            "type": "object"
+        },
+        "dto.AttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "description": "ContentType is the MIME type of the file.",
+                    "type": "string",
+                    "example": "image/png"
+                },
+                "created_at": {
+                    "description": "CreatedAt is the time the file was uploaded.",
+                    "type": "string",
+                    "example": "2025-04-10T09:00:00Z"
+                },
+                "file_name": {
+                    "description": "FileName is the original name of the uploaded file.",
+                    "type": "string",
+                    "example": "login-mockup.png"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the attachment.",
+                    "type": "integer",
+                    "example": 3
+                },
+                "size": {
+                    "description": "Size is the size of the file in bytes.",
+                    "type": "integer",
+                    "example": 48213
+                },
+                "task_id": {
+                    "description": "TaskID is the ID of the task the file is attached to.",
+                    "type": "integer",
+                    "example": 101
+                },
+                "uploaded_by_id": {
+                    "description": "UploadedByID is the ID of the user who uploaded the file.",
+                    "type": "integer",
+                    "example": 5
+                },
+                "uploader_first_name": {
+                    "description": "UploaderFirstName is the first name of the uploader.",
+                    "type": "string",
+                    "example": "John"
+                },
+                "uploader_last_name": {
+                    "description": "UploaderLastName is the last name of the uploader.",
+                    "type": "string",
+                    "example": "Doe"
+                }
+            }
+        },
+        "dto.AttachmentSliceSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AttachmentResponse"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Items found successfully"
+                }
+            }
+        },
+        "dto.AttachmentSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.AttachmentResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.BoardColumnResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "description": "Status is the status of the tasks in the column.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskStatus"
+                        }
+                    ],
+                    "example": "IN_PROGRESS"
+                },
+                "tasks": {
+                    "description": "Tasks lists the tasks of the column in rank order.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskInSliceResponse"
+                    }
+                }
+            }
+        },
+        "dto.BurndownPoint": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "Date is the day the point describes.",
+                    "type": "string",
+                    "example": "2025-04-16T00:00:00Z"
+                },
+                "ideal_points": {
+                    "description": "IdealPoints is the remaining story points on a steady pace to zero.",
+                    "type": "number",
+                    "example": 24.5
+                },
+                "ideal_tasks": {
+                    "description": "IdealTasks is the remaining number of tasks on a steady pace to zero.",
+                    "type": "number",
+                    "example": 7.5
+                },
+                "remaining_points": {
+                    "description": "RemainingPoints is the sum of the story points of the tasks not done; empty for days still to come.",
+                    "type": "integer",
+                    "example": 21
+                },
+                "remaining_tasks": {
+                    "description": "RemainingTasks is the number of tasks not done at the end of the day; empty for days still to come.",
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "dto.BurndownResponse": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "description": "EndDate is the last day of the sprint.",
+                    "type": "string",
+                    "example": "2025-04-30T00:00:00Z"
+                },
+                "points": {
+                    "description": "Points is the daily time series, one point per sprint day.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BurndownPoint"
+                    }
+                },
+                "sprint_id": {
+                    "description": "SprintID is the ID of the sprint.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "start_date": {
+                    "description": "StartDate is the first day of the sprint.",
+                    "type": "string",
+                    "example": "2025-04-15T00:00:00Z"
+                },
+                "status": {
+                    "description": "Status is the lifecycle state of the sprint.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.SprintStatus"
+                        }
+                    ],
+                    "example": "ACTIVE"
+                },
+                "total_points": {
+                    "description": "TotalPoints is the sum of the story points of the sprint scope.",
+                    "type": "integer",
+                    "example": 34
+                },
+                "total_tasks": {
+                    "description": "TotalTasks is the number of tasks in the sprint scope.",
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "dto.BurndownSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.BurndownResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.ChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "current_password",
+                "new_password"
+            ],
+            "properties": {
+                "current_password": {
+                    "description": "CurrentPassword is the password the user currently logs in with.",
+                    "type": "string",
+                    "example": "securepassword123"
+                },
+                "new_password": {
+                    "description": "NewPassword is the password that replaces the current one.",
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "evenmoresecure456"
+                }
+            }
+        },
+        "dto.ChangeTaskStatusRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "override_wip_limit": {
+                    "description": "OverrideWipLimit lets a project manager move the task past the WIP limit of the status.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "status": {
+                    "description": "Status is the new status of the task.",
+                    "enum": [
+                        "TO_DO",
+                        "IN_PROGRESS",
+                        "REVIEW",
+                        "DONE",
+                        "BLOCKED"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskStatus"
+                        }
+                    ],
+                    "example": "IN_PROGRESS"
+                }
+            }
+        },
+        "dto.CommentEditResponse": {
+            "type": "object",
+            "properties": {
+                "edited_at": {
+                    "description": "EditedAt is the time of the edit.",
+                    "type": "string",
+                    "example": "2025-04-21T10:00:00Z"
+                },
+                "editor_id": {
+                    "description": "EditorID is the ID of the user who made the edit.",
+                    "type": "integer",
+                    "example": 7
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the edit.",
+                    "type": "integer",
+                    "example": 3
+                },
+                "previous_body": {
+                    "description": "PreviousBody is the body the comment had before the edit.",
+                    "type": "string",
+                    "example": "Looks good"
+                }
+            }
+        },
+        "dto.CommentEditSliceSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CommentEditResponse"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Items found successfully"
+                }
+            }
+        },
+        "dto.CommentMentionResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "Email is the email of the mentioned user.",
+                    "type": "string",
+                    "example": "jane@example.com"
+                },
+                "user_id": {
+                    "description": "UserID is the ID of the mentioned user.",
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "dto.CommentResponse": {
+            "type": "object",
+            "properties": {
+                "author_first_name": {
+                    "description": "AuthorFirstName is the first name of the author.",
+                    "type": "string",
+                    "example": "John"
+                },
+                "author_id": {
+                    "description": "AuthorID is the ID of the user who wrote the comment.",
+                    "type": "integer",
+                    "example": 7
+                },
+                "author_last_name": {
+                    "description": "AuthorLastName is the last name of the author.",
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "body": {
+                    "description": "Body is the text of the comment.",
+                    "type": "string",
+                    "example": "Looks good, @jane@example.com please review"
+                },
+                "created_at": {
+                    "description": "CreatedAt is the time the comment was posted.",
+                    "type": "string",
+                    "example": "2025-04-20T10:00:00Z"
+                },
+                "edited_at": {
+                    "description": "EditedAt is the time of the last edit; omitted for unedited comments.",
+                    "type": "string",
+                    "example": "2025-04-21T10:00:00Z"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the comment.",
+                    "type": "integer",
+                    "example": 12
+                },
+                "mentions": {
+                    "description": "Mentions lists the users mentioned in the comment.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CommentMentionResponse"
+                    }
+                },
+                "parent_comment_id": {
+                    "description": "ParentCommentID is the ID of the comment this one replies to; omitted for top-level comments.",
+                    "type": "integer",
+                    "example": 10
+                },
+                "replies": {
+                    "description": "Replies is the thread of replies to this comment.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CommentResponse"
+                    }
+                },
+                "task_id": {
+                    "description": "TaskID is the ID of the commented task.",
+                    "type": "integer",
+                    "example": 101
+                }
+            }
+        },
+        "dto.CommentSliceSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CommentResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Items found successfully"
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "dto.CommentSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.CommentResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.CompleteSprintRequest": {
+            "type": "object",
+            "required": [
+                "carry_over"
+            ],
+            "properties": {
+                "carry_over": {
+                    "description": "CarryOver tells where unfinished tasks go: the next sprint or the backlog.",
+                    "enum": [
+                        "NEXT_SPRINT",
+                        "BACKLOG"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CarryOverTarget"
+                        }
+                    ],
+                    "example": "NEXT_SPRINT"
+                },
+                "next_sprint_id": {
+                    "description": "NextSprintID is the optional sprint to carry tasks over to; defaults to the next planned sprint.",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 2
+                },
+                "override_wip_limit": {
+                    "description": "OverrideWipLimit carries the tasks over past the WIP limits of the next sprint or of the backlog.",
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "dto.CreateCommentRequest": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "description": "Body is the text of the comment; users are mentioned with @email.",
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "Looks good, @jane@example.com please review"
+                },
+                "parent_comment_id": {
+                    "description": "ParentCommentID is the optional ID of the comment this one replies to.",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 12
+                }
+            }
+        },
+        "dto.CreateLabelRequest": {
+            "type": "object",
+            "required": [
+                "color",
+                "name"
+            ],
+            "properties": {
+                "color": {
+                    "description": "Color is the hex color of the label.",
+                    "type": "string",
+                    "maxLength": 7,
+                    "example": "#1E90FF"
+                },
+                "name": {
+                    "description": "Name is the name of the label, unique in the project.",
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1,
+                    "example": "frontend"
+                }
+            }
+        },
+        "dto.CreateProjectRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "start_date"
+            ],
+            "properties": {
+                "description": {
+                    "description": "Description is an optional detailed description of the project.",
+                    "type": "string",
+                    "maxLength": 65535,
+                    "example": "Redesign the company website to improve UX."
+                },
+                "end_date": {
+                    "description": "EndDate is the optional date when the project is expected to end.",
+                    "type": "string",
+                    "example": "2025-06-15T00:00:00Z"
+                },
+                "name": {
+                    "description": "Name is the name of the project.",
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 2,
+                    "example": "Website Redesign"
+                },
+                "start_date": {
+                    "description": "StartDate is the date when the project begins.",
+                    "type": "string",
+                    "example": "2025-04-10T00:00:00Z"
+                },
+                "status": {
+                    "description": "Status is the current status of the project.",
+                    "enum": [
+                        "ACTIVE",
+                        "ON_HOLD",
+                        "COMPLETED",
+                        "CANCELLED"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ProjectStatus"
+                        }
+                    ],
+                    "example": "ACTIVE"
+                }
+            }
+        },
+        "dto.CreateSavedViewRequest": {
+            "type": "object",
+            "required": [
+                "filter",
+                "name"
+            ],
+            "properties": {
+                "filter": {
+                    "description": "Filter is the filter expression of the view, as accepted by the filter parameter of GET /tasks.",
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 1,
+                    "example": "assignee = me and status != DONE and priority = CRITICAL"
+                },
+                "name": {
+                    "description": "Name is the name of the view.",
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "My open critical tasks"
+                },
+                "project_id": {
+                    "description": "ProjectID optionally shares the view to the members of a project and limits it to the project's tasks.",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                }
+            }
+        },
+        "dto.CreateSprintRequest": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "goal",
+                "name",
+                "project_id",
+                "start_date"
+            ],
+            "properties": {
+                "end_date": {
+                    "description": "EndDate is the date when the sprint ends.",
+                    "type": "string",
+                    "example": "2025-04-30T00:00:00Z"
+                },
+                "goal": {
+                    "description": "Goal is the objective or goal of the sprint.",
+                    "type": "string",
+                    "minLength": 5,
+                    "example": "Complete initial UI design"
+                },
+                "name": {
+                    "description": "Name is the name of the sprint.",
+                    "type": "string",
+                    "minLength": 2,
+                    "example": "Sprint 1"
+                },
+                "project_id": {
+                    "description": "ProjectID is the ID of the project this sprint belongs to.",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "start_date": {
+                    "description": "StartDate is the date when the sprint begins.",
+                    "type": "string",
+                    "example": "2025-04-15T00:00:00Z"
+                }
+            }
+        },
+        "dto.CreateTaskLinkRequest": {
+            "type": "object",
+            "required": [
+                "target_task_id",
+                "type"
+            ],
+            "properties": {
+                "target_task_id": {
+                    "description": "TargetTaskID is the ID of the linked task; the task of the path blocks, relates to or duplicates it.",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 102
+                },
+                "type": {
+                    "description": "Type is the type of the link.",
+                    "enum": [
+                        "BLOCKS",
+                        "RELATES_TO",
+                        "DUPLICATES"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskLinkType"
+                        }
+                    ],
+                    "example": "BLOCKS"
+                }
+            }
+        },
+        "dto.CreateTaskRequest": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "description": "Description is an optional detailed description of the task.",
+                    "type": "string",
+                    "maxLength": 65535,
+                    "example": "Create a RESTful endpoint for user authentication."
+                },
+                "due_date": {
+                    "description": "DueDate is the optional due date of the task.",
+                    "type": "string",
+                    "example": "2025-04-20T00:00:00Z"
+                },
+                "original_estimate_minutes": {
+                    "description": "OriginalEstimateMinutes is the optional time estimate in minutes.",
+                    "type": "integer",
+                    "maximum": 100000,
+                    "minimum": 0,
+                    "example": 480
+                },
+                "override_wip_limit": {
+                    "description": "OverrideWipLimit lets a project manager create the task past the WIP limit of its status.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "parent_task_id": {
+                    "description": "ParentTaskID is the optional ID of the task this task is a subtask of.",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 100
+                },
+                "priority": {
+                    "description": "Priority is the priority level of the task.",
+                    "enum": [
+                        "HIGH",
+                        "MEDIUM",
+                        "LOW",
+                        "CRITICAL"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskPriority"
+                        }
+                    ],
+                    "example": "HIGH"
+                },
+                "project_id": {
+                    "description": "ProjectID is the ID of the project this task belongs to. Required when\nneither a sprint nor a parent task is given, i.e. for backlog tasks.",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "remaining_estimate_minutes": {
+                    "description": "RemainingEstimateMinutes is the optional remaining time in minutes; defaults to the original estimate.",
+                    "type": "integer",
+                    "maximum": 100000,
+                    "minimum": 0,
+                    "example": 480
+                },
+                "sprint_id": {
+                    "description": "SprintID is the optional ID of the sprint this task belongs to; omit it to put the task in the backlog.",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                },
+                "status": {
+                    "description": "Status is the current status of the task.",
+                    "enum": [
+                        "TO_DO",
+                        "IN_PROGRESS",
+                        "REVIEW",
+                        "DONE",
+                        "BLOCKED"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskStatus"
+                        }
+                    ],
+                    "example": "TO_DO"
+                },
+                "story_points": {
+                    "description": "StoryPoints is the optional relative size of the task.",
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 5
+                },
+                "title": {
+                    "description": "Title is the title of the task.",
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 2,
+                    "example": "Implement login API"
+                }
+            }
+        },
+        "dto.CreateUserRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "first_name",
+                "last_name",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "description": "Email is the user's email address.",
+                    "type": "string",
+                    "example": "john.doe@example.com"
+                },
+                "first_name": {
+                    "description": "FirstName is the user's first name.",
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2,
+                    "example": "John"
+                },
+                "last_name": {
+                    "description": "LastName is the user's last name.",
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2,
+                    "example": "Doe"
+                },
+                "password": {
+                    "description": "Password is the user's password.",
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "securepassword123"
+                },
+                "role": {
+                    "description": "Role is the user's role in the system.",
+                    "enum": [
+                        "TEAM_MEMBER",
+                        "PROJECT_MANAGER",
+                        "ADMIN"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.UserRole"
+                        }
+                    ],
+                    "example": "TEAM_MEMBER"
+                }
+            }
+        },
+        "dto.CreateWebhookRequest": {
+            "type": "object",
+            "required": [
+                "events",
+                "url"
+            ],
+            "properties": {
+                "events": {
+                    "description": "Events lists the event types to deliver.",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "task.created",
+                        "task.status_changed"
+                    ]
+                },
+                "secret": {
+                    "description": "Secret optionally sets the key of the payload signatures; one is generated when it is empty.",
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 16,
+                    "example": "3f1b0c9e2d7a4e6f8a1b2c3d4e5f6a7b"
+                },
+                "url": {
+                    "description": "URL receives the events as signed JSON POST requests.",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "https://ci.example.com/hooks/tasks"
+                }
+            }
+        },
+        "dto.CreateWorklogRequest": {
+            "type": "object",
+            "required": [
+                "duration_minutes",
+                "started_at"
+            ],
+            "properties": {
+                "duration_minutes": {
+                    "description": "DurationMinutes is the time spent, in minutes.",
+                    "type": "integer",
+                    "maximum": 1440,
+                    "minimum": 1,
+                    "example": 90
+                },
+                "note": {
+                    "description": "Note is an optional description of the work done.",
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "Wrote handler tests"
+                },
+                "started_at": {
+                    "description": "StartedAt is when the work started.",
+                    "type": "string",
+                    "example": "2025-04-21T09:00:00Z"
+                }
+            }
+        },
+        "dto.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "details": {},
+                "message": {
+                    "type": "string",
+                    "example": "An error occurred"
+                }
+            }
+        },
+        "dto.GenericSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.IntSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.LabelResponse": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "description": "Color is the hex color of the label.",
+                    "type": "string",
+                    "example": "#1E90FF"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the label.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "description": "Name is the name of the label.",
+                    "type": "string",
+                    "example": "frontend"
+                },
+                "project_id": {
+                    "description": "ProjectID is the ID of the project the label belongs to.",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "dto.LabelSliceSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LabelResponse"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Items found successfully"
+                }
+            }
+        },
+        "dto.LabelSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.LabelResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "description": "Email is the user's email address.",
+                    "type": "string",
+                    "example": "john.doe@example.com"
+                },
+                "password": {
+                    "description": "Password is the user's password.",
+                    "type": "string",
+                    "minLength": 8,
+                    "example": "securepassword123"
+                }
+            }
+        },
+        "dto.MarkNotificationsReadResponse": {
+            "type": "object",
+            "properties": {
+                "marked": {
+                    "description": "Marked is the number of notifications that were unread.",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "dto.MarkNotificationsReadSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.MarkNotificationsReadResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.MoveTaskRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "after_task_id": {
+                    "description": "AfterTaskID is the optional ID of the task the moved task follows.",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 12
+                },
+                "before_task_id": {
+                    "description": "BeforeTaskID is the optional ID of the task the moved task precedes.",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 15
+                },
+                "override_wip_limit": {
+                    "description": "OverrideWipLimit lets a project manager move the task past the WIP limit of the status.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "status": {
+                    "description": "Status is the status of the column the task is moved to.",
+                    "enum": [
+                        "TO_DO",
+                        "IN_PROGRESS",
+                        "REVIEW",
+                        "DONE",
+                        "BLOCKED"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskStatus"
+                        }
+                    ],
+                    "example": "IN_PROGRESS"
+                }
+            }
+        },
+        "dto.NotificationResponse": {
+            "type": "object",
+            "properties": {
+                "actor_first_name": {
+                    "description": "ActorFirstName is the first name of the actor.",
+                    "type": "string",
+                    "example": "John"
+                },
+                "actor_id": {
+                    "description": "ActorID is the user who caused the notification; reminders have none.",
+                    "type": "integer",
+                    "example": 5
+                },
+                "actor_last_name": {
+                    "description": "ActorLastName is the last name of the actor.",
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "created_at": {
+                    "description": "CreatedAt is the time of the notification.",
+                    "type": "string",
+                    "example": "2025-04-10T09:00:00Z"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the notification.",
+                    "type": "integer",
+                    "example": 12
+                },
+                "message": {
+                    "description": "Message describes what happened.",
+                    "type": "string",
+                    "example": "You were assigned to \"Design login page\""
+                },
+                "project_id": {
+                    "description": "ProjectID is the project the notification is about.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "project_name": {
+                    "description": "ProjectName is the name of the project.",
+                    "type": "string",
+                    "example": "Website redesign"
+                },
+                "read": {
+                    "description": "Read tells whether the notification was read.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "read_at": {
+                    "description": "ReadAt is the time the notification was read.",
+                    "type": "string",
+                    "example": "2025-04-10T10:00:00Z"
+                },
+                "task_id": {
+                    "description": "TaskID is the task the notification is about, if any.",
+                    "type": "integer",
+                    "example": 101
+                },
+                "task_title": {
+                    "description": "TaskTitle is the title of the task.",
+                    "type": "string",
+                    "example": "Design login page"
+                },
+                "type": {
+                    "description": "Type is the kind of notification.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NotificationType"
+                        }
+                    ],
+                    "example": "TASK_ASSIGNED"
+                }
+            }
+        },
+        "dto.NotificationSliceSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.NotificationResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Items found successfully"
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "dto.NotificationSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.NotificationResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.ProjectMemberResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "Email is the email address of the member.",
+                    "type": "string",
+                    "example": "john.doe@example.com"
+                },
+                "first_name": {
+                    "description": "FirstName is the first name of the member.",
+                    "type": "string",
+                    "example": "John"
+                },
+                "joined_at": {
+                    "description": "JoinedAt is the time the user became a member of the project.",
+                    "type": "string",
+                    "example": "2025-04-10T00:00:00Z"
+                },
+                "last_name": {
+                    "description": "LastName is the last name of the member.",
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "role": {
+                    "description": "Role is the role of the member in the project.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ProjectMemberRole"
+                        }
+                    ],
+                    "example": "MEMBER"
+                },
+                "user_id": {
+                    "description": "UserID is the ID of the member.",
+                    "type": "integer",
+                    "example": 101
+                }
+            }
+        },
+        "dto.ProjectMemberSliceSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProjectMemberResponse"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Items found successfully"
+                }
+            }
+        },
+        "dto.ProjectResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Description is the detailed description of the project.",
+                    "type": "string",
+                    "example": "Redesign the company website to improve UX."
+                },
+                "end_date": {
+                    "description": "EndDate is the date when the project is expected to end (optional).",
+                    "type": "string",
+                    "example": "2025-06-15T00:00:00Z"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the project.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "manager_id": {
+                    "description": "ManagerID is the ID of the project manager.",
+                    "type": "integer",
+                    "example": 42
+                },
+                "name": {
+                    "description": "Name is the name of the project.",
+                    "type": "string",
+                    "example": "Website Redesign"
+                },
+                "start_date": {
+                    "description": "StartDate is the date when the project started.",
+                    "type": "string",
+                    "example": "2025-04-10T00:00:00Z"
+                },
+                "status": {
+                    "description": "Status is the current status of the project.",
+                    "type": "string",
+                    "example": "ACTIVE"
+                },
+                "team_member_count": {
+                    "description": "TeamMemberCount is the total number of team members (optional).",
+                    "type": "integer",
+                    "example": 3
+                },
+                "team_members": {
+                    "description": "TeamMembers is the list of team members assigned to the project (optional).",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TeamMember"
+                    }
+                }
+            }
+        },
+        "dto.ProjectSearchHitResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID is the unique identifier of the project.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "description": "Name is the name of the project.",
+                    "type": "string",
+                    "example": "Website redesign"
+                },
+                "rank": {
+                    "description": "Rank is the relevance of the project; higher is better.",
+                    "type": "number",
+                    "example": 0.304
+                },
+                "snippet": {
+                    "description": "Snippet is an HTML escaped excerpt of the name and description with matches wrapped in \u003cmark\u003e tags.",
+                    "type": "string",
+                    "example": "New \u003cmark\u003elogin\u003c/mark\u003e flow for the website"
+                },
+                "status": {
+                    "description": "Status is the status of the project.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ProjectStatus"
+                        }
+                    ],
+                    "example": "ACTIVE"
+                }
+            }
+        },
+        "dto.ProjectSliceSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProjectResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Items found successfully"
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "dto.ProjectSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.ProjectResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.ProjectWorklogTotalsResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "From is the first day of the range.",
+                    "type": "string",
+                    "example": "2025-04-01T00:00:00Z"
+                },
+                "project_id": {
+                    "description": "ProjectID is the ID of the project.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "to": {
+                    "description": "To is the last day of the range.",
+                    "type": "string",
+                    "example": "2025-04-30T00:00:00Z"
+                },
+                "total_minutes": {
+                    "description": "TotalMinutes is the time logged on the project, in minutes.",
+                    "type": "integer",
+                    "example": 5400
+                },
+                "users": {
+                    "description": "Users lists the time logged per user.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WorklogUserTotal"
+                    }
+                }
+            }
+        },
+        "dto.ProjectWorklogTotalsSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.ProjectWorklogTotalsResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.RefreshTokenRequest": {
+            "type": "object",
+            "required": [
+                "refresh_token"
+            ],
+            "properties": {
+                "refresh_token": {
+                    "description": "RefreshToken is the refresh token received from the last login or refresh.",
+                    "type": "string",
+                    "example": "random-refresh-token"
+                }
+            }
+        },
+        "dto.SavedViewResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt is the time the view was saved.",
+                    "type": "string",
+                    "example": "2025-04-10T09:00:00Z"
+                },
+                "filter": {
+                    "description": "Filter is the filter expression of the view.",
+                    "type": "string",
+                    "example": "assignee = me and status != DONE and priority = CRITICAL"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the view, passed as the view parameter of GET /tasks.",
+                    "type": "integer",
+                    "example": 4
+                },
+                "name": {
+                    "description": "Name is the name of the view.",
+                    "type": "string",
+                    "example": "My open critical tasks"
+                },
+                "owner_first_name": {
+                    "description": "OwnerFirstName is the first name of the owner.",
+                    "type": "string",
+                    "example": "John"
+                },
+                "owner_id": {
+                    "description": "OwnerID is the ID of the user who saved the view.",
+                    "type": "integer",
+                    "example": 5
+                },
+                "owner_last_name": {
+                    "description": "OwnerLastName is the last name of the owner.",
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "project_id": {
+                    "description": "ProjectID is the project the view is shared to, if any.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "updated_at": {
+                    "description": "UpdatedAt is the time the view was last changed.",
+                    "type": "string",
+                    "example": "2025-04-12T15:30:00Z"
+                }
+            }
+        },
+        "dto.SavedViewSliceSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SavedViewResponse"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Items found successfully"
+                }
+            }
+        },
+        "dto.SavedViewSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.SavedViewResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.SearchResponse": {
+            "type": "object",
+            "properties": {
+                "projects": {
+                    "description": "Projects lists the matching projects; empty when projects are not searched.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ProjectSearchHitResponse"
+                    }
+                },
+                "query": {
+                    "description": "Query is the searched text.",
+                    "type": "string",
+                    "example": "login"
+                },
+                "tasks": {
+                    "description": "Tasks lists the matching tasks; empty when tasks are not searched.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskSearchHitResponse"
+                    }
+                }
+            }
+        },
+        "dto.SearchSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.SearchResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.SetTaskLabelsRequest": {
+            "type": "object",
+            "properties": {
+                "label_ids": {
+                    "description": "LabelIDs are the IDs of the labels the task carries from now on; an empty list removes every label.",
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        1,
+                        4
+                    ]
+                }
+            }
+        },
+        "dto.SprintBoardResponse": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "description": "Columns lists one column per status, in board order.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BoardColumnResponse"
+                    }
+                },
+                "sprint_id": {
+                    "description": "SprintID is the ID of the sprint.",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "dto.SprintBoardSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.SprintBoardResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.SprintReportResponse": {
+            "type": "object",
+            "properties": {
+                "carried_over_count": {
+                    "description": "CarriedOverCount is the number of tasks carried over.",
+                    "type": "integer",
+                    "example": 2
+                },
+                "carry_over": {
+                    "description": "CarryOver tells where unfinished tasks went.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.CarryOverTarget"
+                        }
+                    ],
+                    "example": "NEXT_SPRINT"
+                },
+                "completed_at": {
+                    "description": "CompletedAt is the time the sprint was completed.",
+                    "type": "string",
+                    "example": "2025-04-30T17:00:00Z"
+                },
+                "completed_by_first_name": {
+                    "description": "CompletedByFirstName is the first name of the user who completed the sprint.",
+                    "type": "string",
+                    "example": "John"
+                },
+                "completed_by_id": {
+                    "description": "CompletedByID is the ID of the user who completed the sprint.",
+                    "type": "integer",
+                    "example": 7
+                },
+                "completed_by_last_name": {
+                    "description": "CompletedByLastName is the last name of the user who completed the sprint.",
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "completed_count": {
+                    "description": "CompletedCount is the number of tasks completed in the sprint.",
+                    "type": "integer",
+                    "example": 8
+                },
+                "next_sprint_id": {
+                    "description": "NextSprintID is the sprint unfinished tasks were moved to, if any.",
+                    "type": "integer",
+                    "example": 2
+                },
+                "project_id": {
+                    "description": "ProjectID is the ID of the project the sprint belongs to.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "sprint_id": {
+                    "description": "SprintID is the ID of the completed sprint.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "tasks": {
+                    "description": "Tasks lists every task of the sprint with its outcome.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SprintReportTaskResponse"
+                    }
+                }
+            }
+        },
+        "dto.SprintReportSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.SprintReportResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.SprintReportTaskResponse": {
+            "type": "object",
+            "properties": {
+                "outcome": {
+                    "description": "Outcome tells whether the task was completed or carried over.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.SprintTaskOutcome"
+                        }
+                    ],
+                    "example": "CARRIED_OVER"
+                },
+                "status": {
+                    "description": "Status is the status of the task when the sprint ended.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskStatus"
+                        }
+                    ],
+                    "example": "IN_PROGRESS"
+                },
+                "story_points": {
+                    "description": "StoryPoints is the size of the task when the sprint ended.",
+                    "type": "integer",
+                    "example": 5
+                },
+                "task_id": {
+                    "description": "TaskID is the ID of the task.",
+                    "type": "integer",
+                    "example": 101
+                },
+                "title": {
+                    "description": "Title is the title of the task when the sprint ended.",
+                    "type": "string",
+                    "example": "Design homepage layout"
+                }
+            }
+        },
+        "dto.SprintResponse": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "description": "CompletedAt is the time the sprint was completed, if it was.",
+                    "type": "string",
+                    "example": "2025-04-30T17:00:00Z"
+                },
+                "completed_story_points": {
+                    "description": "CompletedStoryPoints is the sum of the story points of the done sprint tasks.",
+                    "type": "integer",
+                    "example": 8
+                },
+                "end_date": {
+                    "description": "EndDate is the date when the sprint ends.",
+                    "type": "string",
+                    "example": "2025-04-30T00:00:00Z"
+                },
+                "goal": {
+                    "description": "Goal is the objective or goal of the sprint.",
+                    "type": "string",
+                    "example": "Complete initial UI design"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the sprint.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "description": "Name is the name of the sprint.",
+                    "type": "string",
+                    "example": "Sprint 1"
+                },
+                "original_estimate_minutes": {
+                    "description": "OriginalEstimateMinutes is the sum of the original estimates of the sprint tasks.",
+                    "type": "integer",
+                    "example": 2400
+                },
+                "project_id": {
+                    "description": "ProjectID is the ID of the project this sprint belongs to.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "project_name": {
+                    "description": "ProjectName is the optional name of the associated project.",
+                    "type": "string",
+                    "example": "Website Redesign"
+                },
+                "remaining_estimate_minutes": {
+                    "description": "RemainingEstimateMinutes is the sum of the remaining estimates of the sprint tasks.",
+                    "type": "integer",
+                    "example": 960
+                },
+                "start_date": {
+                    "description": "StartDate is the date when the sprint started.",
+                    "type": "string",
+                    "example": "2025-04-15T00:00:00Z"
+                },
+                "started_at": {
+                    "description": "StartedAt is the time the sprint was started, if it was.",
+                    "type": "string",
+                    "example": "2025-04-15T09:00:00Z"
+                },
+                "status": {
+                    "description": "Status is the lifecycle state of the sprint.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.SprintStatus"
+                        }
+                    ],
+                    "example": "ACTIVE"
+                },
+                "story_points": {
+                    "description": "StoryPoints is the sum of the story points of the sprint tasks.",
+                    "type": "integer",
+                    "example": 21
+                },
+                "task_count": {
+                    "description": "TaskCount is the total number of tasks in the sprint (optional).",
+                    "type": "integer",
+                    "example": 3
+                },
+                "tasks": {
+                    "description": "Tasks is the list of tasks within the sprint (optional).",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskInSprintResponse"
+                    }
+                }
+            }
+        },
+        "dto.SprintSliceSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SprintResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Items found successfully"
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "dto.SprintSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.SprintResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.StatusTransitionErrorDetails": {
+            "type": "object",
+            "properties": {
+                "allowed_statuses": {
+                    "description": "AllowedStatuses lists the statuses the task may move to instead.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TaskStatus"
+                    },
+                    "example": [
+                        "IN_PROGRESS",
+                        "BLOCKED"
+                    ]
+                },
+                "error": {
+                    "description": "Error is the human readable reason.",
+                    "type": "string",
+                    "example": "status transition is not allowed by the project workflow: cannot move task from TO_DO to DONE, allowed next statuses: IN_PROGRESS, BLOCKED"
+                },
+                "from": {
+                    "description": "From is the current status of the task.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskStatus"
+                        }
+                    ],
+                    "example": "TO_DO"
+                },
+                "to": {
+                    "description": "To is the rejected status.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskStatus"
+                        }
+                    ],
+                    "example": "DONE"
+                }
+            }
+        },
+        "dto.TaskInSliceResponse": {
+            "type": "object",
+            "properties": {
+                "assignee_first_name": {
+                    "description": "AssigneeFirstName is the optional first name of the assignee.",
+                    "type": "string",
+                    "example": "John"
+                },
+                "assignee_id": {
+                    "description": "AssigneeID is the optional ID of the user assigned to the task.",
+                    "type": "integer",
+                    "example": 42
+                },
+                "assignee_last_name": {
+                    "description": "AssigneeLastName is the optional last name of the assignee.",
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "description": {
+                    "description": "Description is the detailed description of the task.",
+                    "type": "string",
+                    "example": "Create a RESTful endpoint for user authentication."
+                },
+                "due_date": {
+                    "description": "DueDate is the optional due date of the task.",
+                    "type": "string",
+                    "example": "2025-04-20T00:00:00Z"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the task.",
+                    "type": "integer",
+                    "example": 101
+                },
+                "labels": {
+                    "description": "Labels lists the labels of the task (optional).",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LabelResponse"
+                    }
+                },
+                "parent_task_id": {
+                    "description": "ParentTaskID is the optional ID of the parent task.",
+                    "type": "integer",
+                    "example": 100
+                },
+                "priority": {
+                    "description": "Priority is the priority level of the task.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskPriority"
+                        }
+                    ],
+                    "example": "HIGH"
+                },
+                "project_id": {
+                    "description": "ProjectID is the ID of the project this task belongs to.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "rank": {
+                    "description": "Rank orders the task within its board column.",
+                    "type": "string",
+                    "example": "i"
+                },
+                "remaining_estimate_minutes": {
+                    "description": "RemainingEstimateMinutes is the optional remaining time in minutes.",
+                    "type": "integer",
+                    "example": 120
+                },
+                "sprint_id": {
+                    "description": "SprintID is the ID of the sprint this task belongs to; omitted for backlog tasks.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "status": {
+                    "description": "Status is the current status of the task.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskStatus"
+                        }
+                    ],
+                    "example": "IN_PROGRESS"
+                },
+                "story_points": {
+                    "description": "StoryPoints is the optional relative size of the task.",
+                    "type": "integer",
+                    "example": 5
+                },
+                "title": {
+                    "description": "Title is the title of the task.",
+                    "type": "string",
+                    "example": "Implement login API"
+                }
+            }
+        },
+        "dto.TaskInSprintResponse": {
+            "type": "object",
+            "properties": {
+                "due_date": {
+                    "description": "DueDate is the optional due date of the task.",
+                    "type": "string",
+                    "example": "2025-04-25T00:00:00Z"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the task.",
+                    "type": "integer",
+                    "example": 101
+                },
+                "priority": {
+                    "description": "Priority is the priority level of the task.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskPriority"
+                        }
+                    ],
+                    "example": "HIGH"
+                },
+                "status": {
+                    "description": "Status is the current status of the task.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskStatus"
+                        }
+                    ],
+                    "example": "IN_PROGRESS"
+                },
+                "story_points": {
+                    "description": "StoryPoints is the optional relative size of the task.",
+                    "type": "integer",
+                    "example": 5
+                },
+                "task": {
+                    "description": "Title is the title or description of the task.",
+                    "type": "string",
+                    "example": "Design homepage layout"
+                }
+            }
+        },
+        "dto.TaskLinkResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID is the unique identifier of the link.",
+                    "type": "integer",
+                    "example": 7
+                },
+                "relation": {
+                    "description": "Relation reads the link from the task: blocks, is blocked by, relates to, duplicates or is duplicated by.",
+                    "type": "string",
+                    "example": "is blocked by"
+                },
+                "task_id": {
+                    "description": "TaskID is the ID of the other task of the link.",
+                    "type": "integer",
+                    "example": 102
+                },
+                "task_status": {
+                    "description": "TaskStatus is the status of the other task.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskStatus"
+                        }
+                    ],
+                    "example": "IN_PROGRESS"
+                },
+                "task_title": {
+                    "description": "TaskTitle is the title of the other task.",
+                    "type": "string",
+                    "example": "Design login page"
+                },
+                "type": {
+                    "description": "Type is the type of the link.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskLinkType"
+                        }
+                    ],
+                    "example": "BLOCKS"
+                }
+            }
+        },
+        "dto.TaskLinkSliceSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskLinkResponse"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Items found successfully"
+                }
+            }
+        },
+        "dto.TaskLinkSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.TaskLinkResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.TaskResponse": {
+            "type": "object",
+            "properties": {
+                "assignee_first_name": {
+                    "description": "AssigneeFirstName is the optional first name of the assignee.",
+                    "type": "string",
+                    "example": "John"
+                },
+                "assignee_id": {
+                    "description": "AssigneeID is the optional ID of the user assigned to the task.",
+                    "type": "integer",
+                    "example": 42
+                },
+                "assignee_last_name": {
+                    "description": "AssigneeLastName is the optional last name of the assignee.",
+                    "type": "string",
+                    "example": "Doe"
+                },
+                "completed_subtask_count": {
+                    "description": "CompletedSubtaskCount is the number of direct subtasks that are done.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "description": {
+                    "description": "Description is the detailed description of the task.",
+                    "type": "string",
+                    "example": "Create a RESTful endpoint for user authentication."
+                },
+                "due_date": {
+                    "description": "DueDate is the optional due date of the task.",
+                    "type": "string",
+                    "example": "2025-04-20T00:00:00Z"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the task.",
+                    "type": "integer",
+                    "example": 101
+                },
+                "labels": {
+                    "description": "Labels lists the labels of the task (optional).",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LabelResponse"
+                    }
+                },
+                "links": {
+                    "description": "Links lists the links of the task to other tasks (optional).",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskLinkResponse"
+                    }
+                },
+                "original_estimate_minutes": {
+                    "description": "OriginalEstimateMinutes is the optional time estimate in minutes.",
+                    "type": "integer",
+                    "example": 480
+                },
+                "parent_task_id": {
+                    "description": "ParentTaskID is the optional ID of the parent task.",
+                    "type": "integer",
+                    "example": 100
+                },
+                "priority": {
+                    "description": "Priority is the priority level of the task.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskPriority"
+                        }
+                    ],
+                    "example": "HIGH"
+                },
+                "project_id": {
+                    "description": "ProjectID is the ID of the project this task belongs to.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "project_name": {
+                    "description": "ProjectName is the name of the project this task belongs to.",
+                    "type": "string",
+                    "example": "Website Redesign"
+                },
+                "rank": {
+                    "description": "Rank orders the task within its board column.",
+                    "type": "string",
+                    "example": "i"
+                },
+                "remaining_estimate_minutes": {
+                    "description": "RemainingEstimateMinutes is the optional remaining time in minutes.",
+                    "type": "integer",
+                    "example": 120
+                },
+                "sprint_id": {
+                    "description": "SprintID is the ID of the sprint this task belongs to; omitted for backlog tasks.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "sprint_name": {
+                    "description": "SprintName is the name of the sprint this task belongs to; omitted for backlog tasks.",
+                    "type": "string",
+                    "example": "Sprint 1"
+                },
+                "status": {
+                    "description": "Status is the current status of the task.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskStatus"
+                        }
+                    ],
+                    "example": "IN_PROGRESS"
+                },
+                "story_points": {
+                    "description": "StoryPoints is the optional relative size of the task.",
+                    "type": "integer",
+                    "example": 5
+                },
+                "subtask_count": {
+                    "description": "SubtaskCount is the number of direct subtasks.",
+                    "type": "integer",
+                    "example": 3
+                },
+                "subtasks": {
+                    "description": "Subtasks is the tree of subtasks below this task (optional).",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskResponse"
+                    }
+                },
+                "title": {
+                    "description": "Title is the title of the task.",
+                    "type": "string",
+                    "example": "Implement login API"
+                }
+            }
+        },
+        "dto.TaskSearchHitResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID is the unique identifier of the task.",
+                    "type": "integer",
+                    "example": 101
+                },
+                "project_id": {
+                    "description": "ProjectID is the ID of the project of the task.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "project_name": {
+                    "description": "ProjectName is the name of the project of the task.",
+                    "type": "string",
+                    "example": "Website redesign"
+                },
+                "rank": {
+                    "description": "Rank is the relevance of the task; higher is better.",
+                    "type": "number",
+                    "example": 0.6079
+                },
+                "snippet": {
+                    "description": "Snippet is an HTML escaped excerpt of the title and description with matches wrapped in \u003cmark\u003e tags.",
+                    "type": "string",
+                    "example": "Design \u003cmark\u003elogin\u003c/mark\u003e page"
+                },
+                "status": {
+                    "description": "Status is the status of the task.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskStatus"
+                        }
+                    ],
+                    "example": "IN_PROGRESS"
+                },
+                "title": {
+                    "description": "Title is the title of the task.",
+                    "type": "string",
+                    "example": "Design login page"
+                }
+            }
+        },
+        "dto.TaskSliceSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Items found successfully"
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "dto.TaskSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.TaskResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.TeamMember": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "Email is the email address of the team member.",
+                    "type": "string",
+                    "example": "john.doe@example.com"
+                },
+                "first_name": {
+                    "description": "FirstName is the first name of the team member.",
+                    "type": "string",
+                    "example": "John"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the team member.",
+                    "type": "integer",
+                    "example": 101
+                },
+                "last_name": {
+                    "description": "LastName is the last name of the team member.",
+                    "type": "string",
+                    "example": "Doe"
+                }
+            }
+        },
+        "dto.TimesheetDay": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "Date is the day, at midnight UTC.",
+                    "type": "string",
+                    "example": "2025-04-21T00:00:00Z"
+                },
+                "total_minutes": {
+                    "description": "TotalMinutes is the time logged on that day, in minutes.",
+                    "type": "integer",
+                    "example": 450
+                }
+            }
+        },
+        "dto.TimesheetEntry": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "CreatedAt is the time the worklog was created.",
+                    "type": "string",
+                    "example": "2025-04-21T10:35:00Z"
+                },
+                "duration_minutes": {
+                    "description": "DurationMinutes is the time spent, in minutes.",
+                    "type": "integer",
+                    "example": 90
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the worklog.",
+                    "type": "integer",
+                    "example": 5
+                },
+                "note": {
+                    "description": "Note is the description of the work done.",
+                    "type": "string",
+                    "example": "Wrote handler tests"
+                },
+                "project_id": {
+                    "description": "ProjectID is the ID of the project of the task.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "started_at": {
+                    "description": "StartedAt is when the work started.",
+                    "type": "string",
+                    "example": "2025-04-21T09:00:00Z"
+                },
+                "task_id": {
+                    "description": "TaskID is the ID of the task the time was logged on.",
+                    "type": "integer",
+                    "example": 101
+                },
+                "task_title": {
+                    "description": "TaskTitle is the title of the task.",
+                    "type": "string",
+                    "example": "Implement login API"
+                },
+                "user_first_name": {
+                    "description": "UserFirstName is the first name of the user.",
+                    "type": "string",
+                    "example": "John"
+                },
+                "user_id": {
+                    "description": "UserID is the ID of the user who logged the time.",
+                    "type": "integer",
+                    "example": 7
+                },
+                "user_last_name": {
+                    "description": "UserLastName is the last name of the user.",
+                    "type": "string",
+                    "example": "Doe"
+                }
+            }
+        },
+        "dto.TimesheetResponse": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "description": "Days lists the days with logged time, in date order.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TimesheetDay"
+                    }
+                },
+                "from": {
+                    "description": "From is the first day of the range.",
+                    "type": "string",
+                    "example": "2025-04-21T00:00:00Z"
+                },
+                "to": {
+                    "description": "To is the last day of the range.",
+                    "type": "string",
+                    "example": "2025-04-25T00:00:00Z"
+                },
+                "total_minutes": {
+                    "description": "TotalMinutes is the time logged over the range, in minutes.",
+                    "type": "integer",
+                    "example": 2250
+                },
+                "user_id": {
+                    "description": "UserID is the ID of the user.",
+                    "type": "integer",
+                    "example": 7
+                },
+                "worklogs": {
+                    "description": "Worklogs lists the worklogs of the range, oldest first.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TimesheetEntry"
+                    }
+                }
+            }
+        },
+        "dto.TimesheetSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.TimesheetResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.TokenResponse": {
+            "type": "object",
+            "properties": {
+                "expires_in": {
+                    "description": "ExpiresIn is the lifetime of the access token in seconds.",
+                    "type": "integer",
+                    "example": 3600
+                },
+                "refresh_token": {
+                    "description": "RefreshToken is exchanged at /token/refresh for a new token pair.",
+                    "type": "string",
+                    "example": "random-refresh-token"
+                },
+                "token": {
+                    "description": "Token is the access token sent as the Bearer credential.",
+                    "type": "string",
+                    "example": "random-token"
+                },
+                "token_type": {
+                    "description": "TokenType is the authorization scheme of the access token.",
+                    "type": "string",
+                    "example": "Bearer"
+                }
+            }
+        },
+        "dto.TokenSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.TokenResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.UnreadNotificationCountResponse": {
+            "type": "object",
+            "properties": {
+                "unread": {
+                    "description": "Unread is the number of unread notifications.",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "dto.UnreadNotificationCountSuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.UnreadNotificationCountResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
+                }
+            }
+        },
+        "dto.UpdateCommentRequest": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "description": "Body is the new text of the comment.",
+                    "type": "string",
+                    "maxLength": 5000,
+                    "example": "Looks good, @john@example.com please review"
+                }
+            }
+        },
+        "dto.UpdateLabelRequest": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "description": "Color is the optional new hex color of the label.",
+                    "type": "string",
+                    "maxLength": 7,
+                    "example": "#FF8800"
+                },
+                "name": {
+                    "description": "Name is the optional new name of the label.",
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1,
+                    "example": "backend"
+                }
+            }
+        },
+        "dto.UpdateProjectRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Description is the optional new description of the project.",
+                    "type": "string",
+                    "maxLength": 65535,
+                    "example": "Updated redesign with new features."
+                },
+                "end_date": {
+                    "description": "EndDate is the optional new end date of the project.",
+                    "type": "string",
+                    "example": "2025-07-01T00:00:00Z"
+                },
+                "name": {
+                    "description": "Name is the optional new name of the project.",
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 2,
+                    "example": "Website Redesign v2"
+                },
+                "status": {
+                    "description": "Status is the optional new status of the project.",
+                    "enum": [
+                        "ACTIVE",
+                        "ON_HOLD",
+                        "COMPLETED",
+                        "CANCELLED"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ProjectStatus"
+                        }
+                    ],
+                    "example": "ON_HOLD"
+                }
+            }
+        },
+        "dto.UpdateSavedViewRequest": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "description": "Filter is the optional new filter expression of the view.",
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 1,
+                    "example": "assignee = me and status != DONE"
+                },
+                "name": {
+                    "description": "Name is the optional new name of the view.",
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1,
+                    "example": "My open tasks"
+                },
+                "project_id": {
+                    "description": "ProjectID optionally shares the view to another project; 0 makes the view personal again.",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 2
+                }
+            }
+        },
+        "dto.UpdateSprintRequest": {
             "type": "object",
-            "required": [
-                "end_date",
-                "goal",
-                "name",
-                "project_id",
-                "start_date"
-            ],
             "properties": {
                 "end_date": {
-                    "description": "EndDate is the date when the sprint ends.",
+                    "description": "EndDate is the optional new end date of the sprint.",
                     "type": "string",
-                    "example": "2025-04-30T00:00:00Z"
+                    "example": "2025-05-05T00:00:00Z"
                 },
                 "goal": {
-                    "description": "Goal is the objective or goal of the sprint.",
+                    "description": "Goal is the optional new goal of the sprint.",
                     "type": "string",
                     "minLength": 5,
-                    "example": "Complete initial UI design"
+                    "example": "Finalize UI and start backend integration"
                 },
                 "name": {
-                    "description": "Name is the name of the sprint.",
+                    "description": "Name is the optional new name of the sprint.",
                     "type": "string",
                     "minLength": 2,
-                    "example": "Sprint 1"
-                },
-                "project_id": {
-                    "description": "ProjectID is the ID of the project this sprint belongs to.",
-                    "type": "integer",
-                    "minimum": 1,
-                    "example": 1
+                    "example": "Sprint 1 - Revised"
                 },
                 "start_date": {
-                    "description": "StartDate is the date when the sprint begins.",
+                    "description": "StartDate is the optional new start date of the sprint.",
                     "type": "string",
-                    "example": "2025-04-15T00:00:00Z"
+                    "example": "2025-04-20T00:00:00Z"
                 }
             }
         },
-        "dto.CreateTaskRequest": {
+        "dto.UpdateTaskRequest": {
             "type": "object",
-            "required": [
-                "sprint_id",
-                "title"
-            ],
             "properties": {
                 "description": {
-                    "description": "Description is an optional detailed description of the task.",
+                    "description": "Description is the optional new description of the task.",
                     "type": "string",
                     "maxLength": 65535,
-                    "example": "Create a RESTful endpoint for user authentication."
+                    "example": "Modify endpoint to include JWT."
                 },
                 "due_date": {
-                    "description": "DueDate is the optional due date of the task.",
+                    "description": "DueDate is the optional new due date of the task.",
                     "type": "string",
-                    "example": "2025-04-20T00:00:00Z"
+                    "example": "2025-04-25T00:00:00Z"
+                },
+                "original_estimate_minutes": {
+                    "description": "OriginalEstimateMinutes is the optional new time estimate in minutes.",
+                    "type": "integer",
+                    "maximum": 100000,
+                    "minimum": 0,
+                    "example": 600
+                },
+                "override_wip_limit": {
+                    "description": "OverrideWipLimit lets a project manager move the task past the WIP limit of its new status.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "parent_task_id": {
+                    "description": "ParentTaskID is the optional new parent task ID; 0 detaches the task from its parent.",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 100
                 },
                 "priority": {
-                    "description": "Priority is the priority level of the task.",
+                    "description": "Priority is the optional new priority level of the task.",
                     "enum": [
                         "HIGH",
                         "MEDIUM",
@@ -1601,16 +8591,17 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.TaskPriority"
                         }
                     ],
-                    "example": "HIGH"
+                    "example": "MEDIUM"
                 },
-                "sprint_id": {
-                    "description": "SprintID is the ID of the sprint this task belongs to.",
+                "remaining_estimate_minutes": {
+                    "description": "RemainingEstimateMinutes is the optional new remaining time in minutes.",
                     "type": "integer",
-                    "minimum": 1,
-                    "example": 1
+                    "maximum": 100000,
+                    "minimum": 0,
+                    "example": 240
                 },
                 "status": {
-                    "description": "Status is the current status of the task.",
+                    "description": "Status is the optional new status of the task.",
                     "enum": [
                         "TO_DO",
                         "IN_PROGRESS",
@@ -1623,172 +8614,402 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.TaskStatus"
                         }
                     ],
-                    "example": "TO_DO"
+                    "example": "REVIEW"
+                },
+                "story_points": {
+                    "description": "StoryPoints is the optional new relative size of the task.",
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 8
                 },
                 "title": {
-                    "description": "Title is the title of the task.",
+                    "description": "Title is the optional new title of the task.",
                     "type": "string",
                     "maxLength": 255,
                     "minLength": 2,
-                    "example": "Implement login API"
+                    "example": "Update login API"
                 }
             }
         },
-        "dto.CreateUserRequest": {
+        "dto.UpdateUserRequest": {
+            "type": "object",
+            "properties": {
+                "email_on_assignment": {
+                    "description": "EmailOnAssignment optionally turns the task assignment emails on or off.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "email_on_due_soon": {
+                    "description": "EmailOnDueSoon optionally turns the due date reminder emails on or off.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "email_on_sprint_start": {
+                    "description": "EmailOnSprintStart optionally turns the sprint start emails on or off.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "first_name": {
+                    "description": "FirstName is the optional new first name of the user.",
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2,
+                    "example": "Johnny"
+                },
+                "last_name": {
+                    "description": "LastName is the optional new last name of the user.",
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2,
+                    "example": "Smith"
+                }
+            }
+        },
+        "dto.UpdateWebhookRequest": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Active optionally pauses (false) or resumes (true) the deliveries.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "events": {
+                    "description": "Events optionally replaces the delivered event types.",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "sprint.completed"
+                    ]
+                },
+                "url": {
+                    "description": "URL is the optional new receiving URL.",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "https://ci.example.com/hooks/v2/tasks"
+                }
+            }
+        },
+        "dto.UpdateWipLimitsRequest": {
+            "type": "object",
+            "properties": {
+                "limits": {
+                    "description": "Limits is the complete list of WIP limits, at most one per status.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WipLimitRequest"
+                    }
+                }
+            }
+        },
+        "dto.UpdateWorkflowRequest": {
+            "type": "object",
+            "properties": {
+                "transitions": {
+                    "description": "Transitions is the complete list of allowed status changes.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WorkflowTransitionRequest"
+                    }
+                }
+            }
+        },
+        "dto.UpdateWorklogRequest": {
+            "type": "object",
+            "properties": {
+                "duration_minutes": {
+                    "description": "DurationMinutes is the optional new time spent, in minutes.",
+                    "type": "integer",
+                    "maximum": 1440,
+                    "minimum": 1,
+                    "example": 60
+                },
+                "note": {
+                    "description": "Note is the optional new description of the work done.",
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "Wrote and fixed handler tests"
+                },
+                "started_at": {
+                    "description": "StartedAt is the optional new start of the work.",
+                    "type": "string",
+                    "example": "2025-04-21T10:00:00Z"
+                }
+            }
+        },
+        "dto.UserResponse": {
             "type": "object",
-            "required": [
-                "email",
-                "first_name",
-                "last_name",
-                "password"
-            ],
             "properties": {
+                "current_project_id": {
+                    "description": "CurrentProjectID is the optional ID of the user's current project.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "current_project_name": {
+                    "description": "CurrentProjectName is the optional name of the user's current project.",
+                    "type": "string",
+                    "example": "Website Redesign"
+                },
                 "email": {
                     "description": "Email is the user's email address.",
                     "type": "string",
                     "example": "john.doe@example.com"
                 },
+                "email_on_assignment": {
+                    "description": "EmailOnAssignment tells whether the user is emailed when assigned to a task.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "email_on_due_soon": {
+                    "description": "EmailOnDueSoon tells whether the user is emailed when an assigned task is due soon.",
+                    "type": "boolean",
+                    "example": true
+                },
+                "email_on_sprint_start": {
+                    "description": "EmailOnSprintStart tells whether the user is emailed when a sprint of their projects starts.",
+                    "type": "boolean",
+                    "example": true
+                },
                 "first_name": {
                     "description": "FirstName is the user's first name.",
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 2,
                     "example": "John"
                 },
+                "id": {
+                    "description": "ID is the unique identifier of the user.",
+                    "type": "integer",
+                    "example": 42
+                },
                 "last_name": {
                     "description": "LastName is the user's last name.",
                     "type": "string",
-                    "maxLength": 100,
-                    "minLength": 2,
                     "example": "Doe"
                 },
-                "password": {
-                    "description": "Password is the user's password.",
-                    "type": "string",
-                    "minLength": 8,
-                    "example": "securepassword123"
-                },
                 "role": {
                     "description": "Role is the user's role in the system.",
-                    "enum": [
-                        "TEAM_MEMBER",
-                        "PROJECT_MANAGER",
-                        "ADMIN"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.UserRole"
-                        }
-                    ],
+                    "type": "string",
                     "example": "TEAM_MEMBER"
                 }
             }
         },
-        "dto.ErrorResponse": {
+        "dto.UserSliceSuccessResponse": {
             "type": "object",
             "properties": {
-                "details": {},
+                "count": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.UserResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
                 "message": {
                     "type": "string",
-                    "example": "An error occurred"
+                    "example": "Items found successfully"
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
                 }
             }
         },
-        "dto.GenericSuccessResponse": {
+        "dto.UserSuccessResponse": {
             "type": "object",
             "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.UserResponse"
+                },
                 "message": {
                     "type": "string",
                     "example": "Operation successful"
                 }
             }
         },
-        "dto.IntSuccessResponse": {
+        "dto.VelocityResponse": {
             "type": "object",
             "properties": {
-                "data": {
+                "average_completed_points": {
+                    "description": "AverageCompletedPoints is the mean number of story points completed per sprint.",
+                    "type": "number",
+                    "example": 27.5
+                },
+                "average_completed_tasks": {
+                    "description": "AverageCompletedTasks is the mean number of tasks completed per sprint.",
+                    "type": "number",
+                    "example": 8.5
+                },
+                "project_id": {
+                    "description": "ProjectID is the ID of the project.",
                     "type": "integer",
-                    "example": 3
+                    "example": 1
                 },
-                "message": {
-                    "type": "string",
-                    "example": "Operation successful"
+                "sprints": {
+                    "description": "Sprints lists the closed sprints in the order they were completed.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.VelocitySprint"
+                    }
                 }
             }
         },
-        "dto.LoginRequest": {
+        "dto.VelocitySprint": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
-                    "description": "Email is the user's email address.",
+                "committed_points": {
+                    "description": "CommittedPoints is the sum of the story points of the sprint tasks.",
+                    "type": "integer",
+                    "example": 34
+                },
+                "committed_tasks": {
+                    "description": "CommittedTasks is the number of tasks in the sprint when it ended.",
+                    "type": "integer",
+                    "example": 10
+                },
+                "completed_at": {
+                    "description": "CompletedAt is the time the sprint was completed.",
                     "type": "string",
-                    "example": "john.doe@example.com"
+                    "example": "2025-04-30T17:00:00Z"
                 },
-                "password": {
-                    "description": "Password is the user's password.",
+                "completed_points": {
+                    "description": "CompletedPoints is the sum of the story points of the completed tasks.",
+                    "type": "integer",
+                    "example": 26
+                },
+                "completed_tasks": {
+                    "description": "CompletedTasks is the number of tasks completed in the sprint.",
+                    "type": "integer",
+                    "example": 8
+                },
+                "name": {
+                    "description": "Name is the name of the sprint.",
                     "type": "string",
-                    "minLength": 8,
-                    "example": "securepassword123"
+                    "example": "Sprint 1"
+                },
+                "sprint_id": {
+                    "description": "SprintID is the ID of the sprint.",
+                    "type": "integer",
+                    "example": 1
+                },
+                "start_date": {
+                    "description": "StartDate is the planned start date of the sprint.",
+                    "type": "string",
+                    "example": "2025-04-15T00:00:00Z"
+                }
+            }
+        },
+        "dto.VelocitySuccessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.VelocityResponse"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Operation successful"
                 }
             }
         },
-        "dto.ProjectResponse": {
+        "dto.WebhookDeliveryResponse": {
             "type": "object",
             "properties": {
-                "description": {
-                    "description": "Description is the detailed description of the project.",
-                    "type": "string",
-                    "example": "Redesign the company website to improve UX."
+                "attempts": {
+                    "description": "Attempts is the number of attempts made.",
+                    "type": "integer",
+                    "example": 2
                 },
-                "end_date": {
-                    "description": "EndDate is the date when the project is expected to end (optional).",
+                "created_at": {
+                    "description": "CreatedAt is the time the delivery was queued.",
                     "type": "string",
-                    "example": "2025-06-15T00:00:00Z"
+                    "example": "2025-04-10T09:00:00Z"
                 },
-                "id": {
-                    "description": "ID is the unique identifier of the project.",
+                "duration_ms": {
+                    "description": "DurationMs is how long the last attempt took.",
                     "type": "integer",
-                    "example": 1
+                    "example": 184
                 },
-                "manager_id": {
-                    "description": "ManagerID is the ID of the project manager.",
+                "error": {
+                    "description": "Error describes why the last attempt failed.",
+                    "type": "string",
+                    "example": "receiver answered with status 502"
+                },
+                "event_id": {
+                    "description": "EventID identifies the event; redeliveries keep it so receivers can skip duplicates.",
+                    "type": "string",
+                    "example": "8f14e45f-ceea-467a-9575-6e3c2a1b7d10"
+                },
+                "event_type": {
+                    "description": "EventType is the type of the event.",
+                    "type": "string",
+                    "example": "task.status_changed"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the delivery, also sent in the X-Webhook-Delivery header.",
                     "type": "integer",
-                    "example": 42
+                    "example": 31
                 },
-                "name": {
-                    "description": "Name is the name of the project.",
+                "last_attempt_at": {
+                    "description": "LastAttemptAt is the time of the last attempt.",
                     "type": "string",
-                    "example": "Website Redesign"
+                    "example": "2025-04-10T09:00:30Z"
                 },
-                "start_date": {
-                    "description": "StartDate is the date when the project started.",
+                "next_attempt_at": {
+                    "description": "NextAttemptAt is the time of the next attempt of a pending delivery.",
                     "type": "string",
-                    "example": "2025-04-10T00:00:00Z"
+                    "example": "2025-04-10T09:01:00Z"
                 },
-                "status": {
-                    "description": "Status is the current status of the project.",
+                "payload": {
+                    "description": "Payload is the JSON body sent.",
+                    "type": "object"
+                },
+                "redelivery_of_id": {
+                    "description": "RedeliveryOfID is the delivery this one redelivers, if any.",
+                    "type": "integer",
+                    "example": 30
+                },
+                "response_body": {
+                    "description": "ResponseBody is the beginning of the body answered to the last attempt.",
                     "type": "string",
-                    "example": "ACTIVE"
+                    "example": "ok"
                 },
-                "team_member_count": {
-                    "description": "TeamMemberCount is the total number of team members (optional).",
+                "response_status": {
+                    "description": "ResponseStatus is the HTTP status answered to the last attempt.",
                     "type": "integer",
-                    "example": 3
+                    "example": 200
                 },
-                "team_members": {
-                    "description": "TeamMembers is the list of team members assigned to the project (optional).",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.TeamMember"
-                    }
+                "status": {
+                    "description": "Status is PENDING until the delivery succeeded or ran out of attempts.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.WebhookDeliveryStatus"
+                        }
+                    ],
+                    "example": "SUCCEEDED"
+                },
+                "webhook_id": {
+                    "description": "WebhookID is the webhook the event is delivered to.",
+                    "type": "integer",
+                    "example": 2
                 }
             }
         },
-        "dto.ProjectSliceSuccessResponse": {
+        "dto.WebhookDeliverySliceSuccessResponse": {
             "type": "object",
             "properties": {
                 "count": {
@@ -1798,20 +9019,36 @@ const docTemplate = `{
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.ProjectResponse"
+                        "$ref": "#/definitions/dto.WebhookDeliveryResponse"
                     }
                 },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
                 "message": {
                     "type": "string",
                     "example": "Items found successfully"
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
                 }
             }
         },
-        "dto.ProjectSuccessResponse": {
+        "dto.WebhookDeliverySuccessResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dto.ProjectResponse"
+                    "$ref": "#/definitions/dto.WebhookDeliveryResponse"
                 },
                 "message": {
                     "type": "string",
@@ -1819,69 +9056,73 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.SprintResponse": {
+        "dto.WebhookResponse": {
             "type": "object",
             "properties": {
-                "end_date": {
-                    "description": "EndDate is the date when the sprint ends.",
-                    "type": "string",
-                    "example": "2025-04-30T00:00:00Z"
+                "active": {
+                    "description": "Active tells whether events are delivered.",
+                    "type": "boolean",
+                    "example": true
                 },
-                "goal": {
-                    "description": "Goal is the objective or goal of the sprint.",
+                "created_at": {
+                    "description": "CreatedAt is the time the webhook was created.",
                     "type": "string",
-                    "example": "Complete initial UI design"
+                    "example": "2025-04-10T09:00:00Z"
                 },
-                "id": {
-                    "description": "ID is the unique identifier of the sprint.",
+                "created_by_id": {
+                    "description": "CreatedByID is the ID of the user who created the webhook.",
                     "type": "integer",
-                    "example": 1
+                    "example": 5
                 },
-                "name": {
-                    "description": "Name is the name of the sprint.",
-                    "type": "string",
-                    "example": "Sprint 1"
+                "events": {
+                    "description": "Events lists the delivered event types.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "task.created",
+                        "task.status_changed"
+                    ]
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the webhook.",
+                    "type": "integer",
+                    "example": 2
                 },
                 "project_id": {
-                    "description": "ProjectID is the ID of the project this sprint belongs to.",
+                    "description": "ProjectID is the project whose events are delivered.",
                     "type": "integer",
                     "example": 1
                 },
-                "project_name": {
-                    "description": "ProjectName is the optional name of the associated project.",
+                "secret": {
+                    "description": "Secret is the key of the payload signatures, only returned when the webhook is created.",
                     "type": "string",
-                    "example": "Website Redesign"
+                    "example": "3f1b0c9e2d7a4e6f8a1b2c3d4e5f6a7b"
                 },
-                "start_date": {
-                    "description": "StartDate is the date when the sprint started.",
+                "updated_at": {
+                    "description": "UpdatedAt is the time the webhook was last changed.",
                     "type": "string",
-                    "example": "2025-04-15T00:00:00Z"
+                    "example": "2025-04-12T15:30:00Z"
                 },
-                "task_count": {
-                    "description": "TaskCount is the total number of tasks in the sprint (optional).",
-                    "type": "integer",
-                    "example": 3
-                },
-                "tasks": {
-                    "description": "Tasks is the list of tasks within the sprint (optional).",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.TaskInSprintResponse"
-                    }
+                "url": {
+                    "description": "URL receives the events.",
+                    "type": "string",
+                    "example": "https://ci.example.com/hooks/tasks"
                 }
             }
         },
-        "dto.SprintSliceSuccessResponse": {
+        "dto.WebhookSliceSuccessResponse": {
             "type": "object",
             "properties": {
                 "count": {
                     "type": "integer",
-                    "example": 5
+                    "example": 2
                 },
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.SprintResponse"
+                        "$ref": "#/definitions/dto.WebhookResponse"
                     }
                 },
                 "message": {
@@ -1890,11 +9131,11 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.SprintSuccessResponse": {
+        "dto.WebhookSuccessResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dto.SprintResponse"
+                    "$ref": "#/definitions/dto.WebhookResponse"
                 },
                 "message": {
                     "type": "string",
@@ -1902,283 +9143,167 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.TaskInSprintResponse": {
+        "dto.WipLimitRequest": {
             "type": "object",
+            "required": [
+                "status"
+            ],
             "properties": {
-                "due_date": {
-                    "description": "DueDate is the optional due date of the task.",
-                    "type": "string",
-                    "example": "2025-04-25T00:00:00Z"
-                },
-                "id": {
-                    "description": "ID is the unique identifier of the task.",
+                "max_tasks": {
+                    "description": "MaxTasks is the optional number of tasks a board column of the status may hold.",
                     "type": "integer",
-                    "example": 101
+                    "minimum": 1,
+                    "example": 5
                 },
-                "priority": {
-                    "description": "Priority is the priority level of the task.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.TaskPriority"
-                        }
-                    ],
-                    "example": "HIGH"
+                "max_tasks_per_assignee": {
+                    "description": "MaxTasksPerAssignee is the optional number of tasks of the column one assignee may hold.",
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 2
                 },
                 "status": {
-                    "description": "Status is the current status of the task.",
+                    "description": "Status is the status whose board column is limited.",
+                    "enum": [
+                        "TO_DO",
+                        "IN_PROGRESS",
+                        "REVIEW",
+                        "DONE",
+                        "BLOCKED"
+                    ],
                     "allOf": [
                         {
                             "$ref": "#/definitions/models.TaskStatus"
                         }
                     ],
                     "example": "IN_PROGRESS"
-                },
-                "task": {
-                    "description": "Title is the title or description of the task.",
-                    "type": "string",
-                    "example": "Design homepage layout"
                 }
             }
         },
-        "dto.TaskResponse": {
+        "dto.WipLimitResponse": {
             "type": "object",
             "properties": {
-                "assignee_first_name": {
-                    "description": "AssigneeFirstName is the optional first name of the assignee.",
-                    "type": "string",
-                    "example": "John"
-                },
-                "assignee_id": {
-                    "description": "AssigneeID is the optional ID of the user assigned to the task.",
-                    "type": "integer",
-                    "example": 42
-                },
-                "assignee_last_name": {
-                    "description": "AssigneeLastName is the optional last name of the assignee.",
-                    "type": "string",
-                    "example": "Doe"
-                },
-                "description": {
-                    "description": "Description is the detailed description of the task.",
-                    "type": "string",
-                    "example": "Create a RESTful endpoint for user authentication."
-                },
-                "due_date": {
-                    "description": "DueDate is the optional due date of the task.",
-                    "type": "string",
-                    "example": "2025-04-20T00:00:00Z"
-                },
-                "id": {
-                    "description": "ID is the unique identifier of the task.",
-                    "type": "integer",
-                    "example": 101
-                },
-                "priority": {
-                    "description": "Priority is the priority level of the task.",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.TaskPriority"
-                        }
-                    ],
-                    "example": "HIGH"
-                },
-                "project_id": {
-                    "description": "ProjectID is the ID of the project this task belongs to.",
+                "max_tasks": {
+                    "description": "MaxTasks is the number of tasks a board column of the status may hold; omitted when unlimited.",
                     "type": "integer",
-                    "example": 1
-                },
-                "project_name": {
-                    "description": "ProjectName is the name of the project this task belongs to.",
-                    "type": "string",
-                    "example": "Website Redesign"
+                    "example": 5
                 },
-                "sprint_id": {
-                    "description": "SprintID is the ID of the sprint this task belongs to.",
+                "max_tasks_per_assignee": {
+                    "description": "MaxTasksPerAssignee is the number of tasks of the column one assignee may hold; omitted when unlimited.",
                     "type": "integer",
-                    "example": 1
-                },
-                "sprint_name": {
-                    "description": "SprintName is the name of the sprint this task belongs to.",
-                    "type": "string",
-                    "example": "Sprint 1"
+                    "example": 2
                 },
                 "status": {
-                    "description": "Status is the current status of the task.",
+                    "description": "Status is the status whose board column is limited.",
                     "allOf": [
                         {
                             "$ref": "#/definitions/models.TaskStatus"
                         }
                     ],
                     "example": "IN_PROGRESS"
-                },
-                "title": {
-                    "description": "Title is the title of the task.",
-                    "type": "string",
-                    "example": "Implement login API"
                 }
             }
         },
-        "dto.TaskSliceSuccessResponse": {
+        "dto.WipLimitsResponse": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer",
-                    "example": 5
-                },
-                "data": {
+                "limits": {
+                    "description": "Limits lists the WIP limits; statuses without one are unlimited.",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.TaskResponse"
+                        "$ref": "#/definitions/dto.WipLimitResponse"
                     }
                 },
-                "message": {
-                    "type": "string",
-                    "example": "Items found successfully"
-                }
-            }
-        },
-        "dto.TaskSuccessResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/dto.TaskResponse"
-                },
-                "message": {
-                    "type": "string",
-                    "example": "Operation successful"
-                }
-            }
-        },
-        "dto.TeamMember": {
-            "type": "object",
-            "properties": {
-                "email": {
-                    "description": "Email is the email address of the team member.",
-                    "type": "string",
-                    "example": "john.doe@example.com"
-                },
-                "first_name": {
-                    "description": "FirstName is the first name of the team member.",
-                    "type": "string",
-                    "example": "John"
-                },
-                "id": {
-                    "description": "ID is the unique identifier of the team member.",
-                    "type": "integer",
-                    "example": 101
-                },
-                "last_name": {
-                    "description": "LastName is the last name of the team member.",
-                    "type": "string",
-                    "example": "Doe"
+                "project_id": {
+                    "description": "ProjectID is the ID of the project.",
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "dto.TokenResponse": {
+        "dto.WipLimitsSuccessResponse": {
             "type": "object",
             "properties": {
-                "token": {
+                "data": {
+                    "$ref": "#/definitions/dto.WipLimitsResponse"
+                },
+                "message": {
                     "type": "string",
-                    "example": "random-token"
+                    "example": "Operation successful"
                 }
             }
         },
-        "dto.UpdateProjectRequest": {
+        "dto.WorkflowResponse": {
             "type": "object",
             "properties": {
-                "description": {
-                    "description": "Description is the optional new description of the project.",
-                    "type": "string",
-                    "maxLength": 65535,
-                    "example": "Updated redesign with new features."
-                },
-                "end_date": {
-                    "description": "EndDate is the optional new end date of the project.",
-                    "type": "string",
-                    "example": "2025-07-01T00:00:00Z"
+                "is_default": {
+                    "description": "IsDefault tells whether the project uses the default workflow.",
+                    "type": "boolean",
+                    "example": false
                 },
-                "name": {
-                    "description": "Name is the optional new name of the project.",
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 2,
-                    "example": "Website Redesign v2"
+                "project_id": {
+                    "description": "ProjectID is the ID of the project.",
+                    "type": "integer",
+                    "example": 1
                 },
-                "status": {
-                    "description": "Status is the optional new status of the project.",
-                    "enum": [
-                        "ACTIVE",
-                        "ON_HOLD",
-                        "COMPLETED",
-                        "CANCELLED"
-                    ],
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/models.ProjectStatus"
-                        }
-                    ],
-                    "example": "ON_HOLD"
+                "transitions": {
+                    "description": "Transitions lists the allowed status changes.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WorkflowTransitionResponse"
+                    }
                 }
             }
         },
-        "dto.UpdateSprintRequest": {
+        "dto.WorkflowSuccessResponse": {
             "type": "object",
             "properties": {
-                "end_date": {
-                    "description": "EndDate is the optional new end date of the sprint.",
-                    "type": "string",
-                    "example": "2025-05-05T00:00:00Z"
-                },
-                "goal": {
-                    "description": "Goal is the optional new goal of the sprint.",
-                    "type": "string",
-                    "minLength": 5,
-                    "example": "Finalize UI and start backend integration"
-                },
-                "name": {
-                    "description": "Name is the optional new name of the sprint.",
-                    "type": "string",
-                    "minLength": 2,
-                    "example": "Sprint 1 - Revised"
+                "data": {
+                    "$ref": "#/definitions/dto.WorkflowResponse"
                 },
-                "start_date": {
-                    "description": "StartDate is the optional new start date of the sprint.",
+                "message": {
                     "type": "string",
-                    "example": "2025-04-20T00:00:00Z"
+                    "example": "Operation successful"
                 }
             }
         },
-        "dto.UpdateTaskRequest": {
+        "dto.WorkflowTransitionRequest": {
             "type": "object",
+            "required": [
+                "from",
+                "roles",
+                "to"
+            ],
             "properties": {
-                "description": {
-                    "description": "Description is the optional new description of the task.",
-                    "type": "string",
-                    "maxLength": 65535,
-                    "example": "Modify endpoint to include JWT."
-                },
-                "due_date": {
-                    "description": "DueDate is the optional new due date of the task.",
-                    "type": "string",
-                    "example": "2025-04-25T00:00:00Z"
-                },
-                "priority": {
-                    "description": "Priority is the optional new priority level of the task.",
+                "from": {
+                    "description": "From is the status the task is in.",
                     "enum": [
-                        "HIGH",
-                        "MEDIUM",
-                        "LOW",
-                        "CRITICAL"
+                        "TO_DO",
+                        "IN_PROGRESS",
+                        "REVIEW",
+                        "DONE",
+                        "BLOCKED"
                     ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/models.TaskPriority"
+                            "$ref": "#/definitions/models.TaskStatus"
                         }
                     ],
-                    "example": "MEDIUM"
+                    "example": "TO_DO"
                 },
-                "status": {
-                    "description": "Status is the optional new status of the task.",
+                "roles": {
+                    "description": "Roles lists the project roles allowed to make the transition.",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/models.ProjectMemberRole"
+                    },
+                    "example": [
+                        "MANAGER",
+                        "MEMBER"
+                    ]
+                },
+                "to": {
+                    "description": "To is the status the task may move to.",
                     "enum": [
                         "TO_DO",
                         "IN_PROGRESS",
@@ -2191,77 +9316,95 @@ const docTemplate = `{
                             "$ref": "#/definitions/models.TaskStatus"
                         }
                     ],
-                    "example": "REVIEW"
-                },
-                "title": {
-                    "description": "Title is the optional new title of the task.",
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 2,
-                    "example": "Update login API"
+                    "example": "IN_PROGRESS"
                 }
             }
         },
-        "dto.UpdateUserRequest": {
+        "dto.WorkflowTransitionResponse": {
             "type": "object",
             "properties": {
-                "first_name": {
-                    "description": "FirstName is the optional new first name of the user.",
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 2,
-                    "example": "Johnny"
+                "from": {
+                    "description": "From is the status the task is in.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskStatus"
+                        }
+                    ],
+                    "example": "TO_DO"
                 },
-                "last_name": {
-                    "description": "LastName is the optional new last name of the user.",
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 2,
-                    "example": "Smith"
+                "roles": {
+                    "description": "Roles lists the project roles allowed to make the transition.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ProjectMemberRole"
+                    },
+                    "example": [
+                        "MANAGER",
+                        "MEMBER"
+                    ]
+                },
+                "to": {
+                    "description": "To is the status the task may move to.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TaskStatus"
+                        }
+                    ],
+                    "example": "IN_PROGRESS"
                 }
             }
         },
-        "dto.UserResponse": {
+        "dto.WorklogResponse": {
             "type": "object",
             "properties": {
-                "current_project_id": {
-                    "description": "CurrentProjectID is the optional ID of the user's current project.",
+                "created_at": {
+                    "description": "CreatedAt is the time the worklog was created.",
+                    "type": "string",
+                    "example": "2025-04-21T10:35:00Z"
+                },
+                "duration_minutes": {
+                    "description": "DurationMinutes is the time spent, in minutes.",
                     "type": "integer",
-                    "example": 1
+                    "example": 90
                 },
-                "current_project_name": {
-                    "description": "CurrentProjectName is the optional name of the user's current project.",
+                "id": {
+                    "description": "ID is the unique identifier of the worklog.",
+                    "type": "integer",
+                    "example": 5
+                },
+                "note": {
+                    "description": "Note is the description of the work done.",
                     "type": "string",
-                    "example": "Website Redesign"
+                    "example": "Wrote handler tests"
                 },
-                "email": {
-                    "description": "Email is the user's email address.",
+                "started_at": {
+                    "description": "StartedAt is when the work started.",
                     "type": "string",
-                    "example": "john.doe@example.com"
+                    "example": "2025-04-21T09:00:00Z"
                 },
-                "first_name": {
-                    "description": "FirstName is the user's first name.",
+                "task_id": {
+                    "description": "TaskID is the ID of the task the time was logged on.",
+                    "type": "integer",
+                    "example": 101
+                },
+                "user_first_name": {
+                    "description": "UserFirstName is the first name of the user.",
                     "type": "string",
                     "example": "John"
                 },
-                "id": {
-                    "description": "ID is the unique identifier of the user.",
+                "user_id": {
+                    "description": "UserID is the ID of the user who logged the time.",
                     "type": "integer",
-                    "example": 42
+                    "example": 7
                 },
-                "last_name": {
-                    "description": "LastName is the user's last name.",
+                "user_last_name": {
+                    "description": "UserLastName is the last name of the user.",
                     "type": "string",
                     "example": "Doe"
-                },
-                "role": {
-                    "description": "Role is the user's role in the system.",
-                    "type": "string",
-                    "example": "TEAM_MEMBER"
                 }
             }
         },
-        "dto.UserSliceSuccessResponse": {
+        "dto.WorklogSliceSuccessResponse": {
             "type": "object",
             "properties": {
                 "count": {
@@ -2271,20 +9414,36 @@ const docTemplate = `{
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.UserResponse"
+                        "$ref": "#/definitions/dto.WorklogResponse"
                     }
                 },
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
                 "message": {
                     "type": "string",
                     "example": "Items found successfully"
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
                 }
             }
         },
-        "dto.UserSuccessResponse": {
+        "dto.WorklogSuccessResponse": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/dto.UserResponse"
+                    "$ref": "#/definitions/dto.WorklogResponse"
                 },
                 "message": {
                     "type": "string",
@@ -2292,6 +9451,92 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.WorklogUserTotal": {
+            "type": "object",
+            "properties": {
+                "total_minutes": {
+                    "description": "TotalMinutes is the time logged, in minutes.",
+                    "type": "integer",
+                    "example": 1200
+                },
+                "user_id": {
+                    "description": "UserID is the ID of the user.",
+                    "type": "integer",
+                    "example": 7
+                }
+            }
+        },
+        "models.ActivityAction": {
+            "type": "string",
+            "enum": [
+                "CREATE",
+                "UPDATE",
+                "DELETE",
+                "ASSIGN"
+            ],
+            "x-enum-varnames": [
+                "ActivityCreate",
+                "ActivityUpdate",
+                "ActivityDelete",
+                "ActivityAssign"
+            ]
+        },
+        "models.ActivityEntityType": {
+            "type": "string",
+            "enum": [
+                "PROJECT",
+                "PROJECT_MEMBER",
+                "SPRINT",
+                "TASK"
+            ],
+            "x-enum-varnames": [
+                "ActivityEntityProject",
+                "ActivityEntityProjectMember",
+                "ActivityEntitySprint",
+                "ActivityEntityTask"
+            ]
+        },
+        "models.CarryOverTarget": {
+            "type": "string",
+            "enum": [
+                "NEXT_SPRINT",
+                "BACKLOG"
+            ],
+            "x-enum-varnames": [
+                "CarryOverNextSprint",
+                "CarryOverBacklog"
+            ]
+        },
+        "models.NotificationType": {
+            "type": "string",
+            "enum": [
+                "TASK_ASSIGNED",
+                "TASK_STATUS_CHANGED",
+                "TASK_DUE_SOON",
+                "PROJECT_MEMBER_ADDED",
+                "PROJECT_MEMBER_REMOVED"
+            ],
+            "x-enum-varnames": [
+                "NotificationTaskAssigned",
+                "NotificationTaskStatusChanged",
+                "NotificationTaskDueSoon",
+                "NotificationProjectMemberAdded",
+                "NotificationProjectMemberRemoved"
+            ]
+        },
+        "models.ProjectMemberRole": {
+            "type": "string",
+            "enum": [
+                "MANAGER",
+                "MEMBER",
+                "VIEWER"
+            ],
+            "x-enum-varnames": [
+                "ProjectRoleManager",
+                "ProjectRoleMember",
+                "ProjectRoleViewer"
+            ]
+        },
         "models.ProjectStatus": {
             "type": "string",
             "enum": [
@@ -2307,6 +9552,43 @@ const docTemplate = `{
                 "StatusCancelled"
             ]
         },
+        "models.SprintStatus": {
+            "type": "string",
+            "enum": [
+                "PLANNED",
+                "ACTIVE",
+                "CLOSED"
+            ],
+            "x-enum-varnames": [
+                "SprintPlanned",
+                "SprintActive",
+                "SprintClosed"
+            ]
+        },
+        "models.SprintTaskOutcome": {
+            "type": "string",
+            "enum": [
+                "COMPLETED",
+                "CARRIED_OVER"
+            ],
+            "x-enum-varnames": [
+                "SprintTaskCompleted",
+                "SprintTaskCarriedOver"
+            ]
+        },
+        "models.TaskLinkType": {
+            "type": "string",
+            "enum": [
+                "BLOCKS",
+                "RELATES_TO",
+                "DUPLICATES"
+            ],
+            "x-enum-varnames": [
+                "BlocksLink",
+                "RelatesToLink",
+                "DuplicatesLink"
+            ]
+        },
         "models.TaskPriority": {
             "type": "string",
             "enum": [
@@ -2351,6 +9633,19 @@ const docTemplate = `{
                 "ProjectManager",
                 "TeamMember"
             ]
+        },
+        "models.WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "PENDING",
+                "SUCCEEDED",
+                "FAILED"
+            ],
+            "x-enum-varnames": [
+                "DeliveryPending",
+                "DeliverySucceeded",
+                "DeliveryFailed"
+            ]
         }
     }
 }`
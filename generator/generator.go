@@ -26,10 +26,30 @@ func main() {
 	})
 
 	modelsToGenerate := []any{
+		models.ActivityChange{},
+		models.ActivityLog{},
+		models.Attachment{},
+		models.Comment{},
+		models.CommentEdit{},
+		models.CommentMention{},
+		models.Label{},
 		models.Project{},
+		models.ProjectMember{},
+		models.SavedView{},
 		models.Sprint{},
+		models.SprintReport{},
+		models.SprintReportTask{},
 		models.Task{},
+		models.TaskLabel{},
+		models.TaskLink{},
 		models.User{},
+		models.Webhook{},
+		models.WebhookDelivery{},
+		models.Notification{},
+		models.OutboundEmail{},
+		models.WorkflowTransition{},
+		models.WipLimit{},
+		models.Worklog{},
 	}
 
 	g.ApplyBasic(modelsToGenerate...)
@@ -1,19 +1,26 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"log/slog"
 	"os"
 
+	"lqkhoi-go-http-api/internal/cache"
 	"lqkhoi-go-http-api/internal/config"
+	"lqkhoi-go-http-api/internal/events"
 	"lqkhoi-go-http-api/internal/handler"
 	"lqkhoi-go-http-api/internal/infrastructure"
+	"lqkhoi-go-http-api/internal/mailer"
 	"lqkhoi-go-http-api/internal/middlewares"
 	"lqkhoi-go-http-api/internal/migration"
+	"lqkhoi-go-http-api/internal/realtime"
 	"lqkhoi-go-http-api/internal/repository"
 	"lqkhoi-go-http-api/internal/routes"
 	"lqkhoi-go-http-api/internal/service"
+	"lqkhoi-go-http-api/internal/storage"
+	"lqkhoi-go-http-api/pkg/utils"
 	_ "lqkhoi-go-http-api/docs"
 
 	swagger "github.com/swaggo/fiber-swagger"
@@ -33,15 +40,30 @@ import (
 // @BasePath  /api/v1
 // @schemes http https
 
+// maxRequestBodySize bounds every request body; the upload size configured
+// for attachments stays below it.
+const maxRequestBodySize = 21 << 20
+
+// eventQueueSize bounds the domain events waiting for their subscribers.
+const eventQueueSize = 1024
+
+// boardStreamBufferSize bounds the events waiting for a board stream client;
+// a client further behind is disconnected and reloads the board.
+const boardStreamBufferSize = 64
+
 type App struct {
 	server *fiber.App
 	config *config.Config
+	// stopWorkers stops the background workers started by Setup.
+	stopWorkers context.CancelFunc
 }
 
 func New() *App {
 
 	cfg := &config.Config{}
-	app := fiber.New()
+	app := fiber.New(fiber.Config{
+		BodyLimit: maxRequestBodySize,
+	})
 
 	return &App{
 		server: app,
@@ -73,30 +95,116 @@ func (app *App) Setup() error {
 		return err
 	}
 
+	redisClient := infrastructure.NewRedisConnection(app.config.Redis)
+	cacheRepository := cache.NewRedisRepository(redisClient)
+
+	fileStorage, err := storage.NewLocalStorage(app.config.Storage.LocalPath)
+	if err != nil {
+		logger.Error("Failed to set up file storage", "error", err)
+		return err
+	}
+
 	app.server.Get("/swagger/*", swagger.WrapHandler)
 
 	prefixApp := app.server.Group("/api/v1")
 
 	userRepository := repository.NewUserRepository(db)
 	projectRepository := repository.NewProjectRepository(db, cfg.DateTime)
+	projectMemberRepository := repository.NewProjectMemberRepository(db)
 	sprintRepository := repository.NewSprintRepository(db, cfg.DateTime)
 	taskRepository := repository.NewTaskRepository(db, cfg.DateTime)
-
-	userService := service.NewUserService(userRepository)
-	projectService := service.NewProjectService(projectRepository, userService)
-	sprintService := service.NewSprintService(sprintRepository, projectService, cfg.DateTime)
-	taskService := service.NewTaskService(taskRepository, projectService, sprintService, userService)
+	commentRepository := repository.NewCommentRepository(db)
+	activityRepository := repository.NewActivityRepository(db)
+	workflowRepository := repository.NewWorkflowRepository(db)
+	wipLimitRepository := repository.NewWipLimitRepository(db)
+	worklogRepository := repository.NewWorklogRepository(db)
+	taskLinkRepository := repository.NewTaskLinkRepository(db)
+	labelRepository := repository.NewLabelRepository(db)
+	attachmentRepository := repository.NewAttachmentRepository(db)
+	searchRepository := repository.NewSearchRepository(db)
+	savedViewRepository := repository.NewSavedViewRepository(db)
+	webhookRepository := repository.NewWebhookRepository(db)
+	notificationRepository := repository.NewNotificationRepository(db)
+	outboundEmailRepository := repository.NewOutboundEmailRepository(db)
+
+	eventBus := events.NewBus(eventQueueSize)
+	webhookDispatcher := service.NewWebhookDispatcher(webhookRepository, cfg.Webhook)
+	boardHub := realtime.NewHub(boardStreamBufferSize)
+	boardBroker := realtime.NewRedisBroker(redisClient, boardHub)
+	emailDispatcher := service.NewEmailDispatcher(outboundEmailRepository, mailer.New(cfg.Mail), cfg.Mail)
+
+	emailService := service.NewEmailService(userRepository, taskRepository, projectMemberRepository, emailDispatcher, cfg.DateTime)
+	tokenService := service.NewTokenService(cacheRepository)
+	userService := service.NewUserService(userRepository, tokenService)
+	activityService := service.NewActivityService(activityRepository, eventBus)
+	projectService := service.NewProjectService(projectRepository, projectMemberRepository, userService, activityService)
+	wipLimitService := service.NewWipLimitService(wipLimitRepository, taskRepository, projectService)
+	sprintService := service.NewSprintService(sprintRepository, taskRepository, projectService, activityService, wipLimitService, emailService, cfg.DateTime)
+	workflowService := service.NewWorkflowService(workflowRepository, projectService)
+	taskService := service.NewTaskService(taskRepository, taskLinkRepository, projectService, sprintService, userService, activityService, workflowService, wipLimitService, emailService)
+	commentService := service.NewCommentService(commentRepository, taskService, userService)
+	metricsService := service.NewMetricsService(sprintRepository, taskRepository, activityRepository, sprintService, projectService)
+	worklogService := service.NewWorklogService(worklogRepository, taskService, projectService)
+	taskLinkService := service.NewTaskLinkService(taskLinkRepository, taskService)
+	labelService := service.NewLabelService(labelRepository, taskService, projectService, activityService)
+	attachmentService := service.NewAttachmentService(attachmentRepository, fileStorage, taskService)
+	searchService := service.NewSearchService(searchRepository)
+	savedViewService := service.NewSavedViewService(savedViewRepository, projectService, cfg.DateTime)
+	webhookService := service.NewWebhookService(webhookRepository, projectService, webhookDispatcher)
+	notificationService := service.NewNotificationService(notificationRepository, taskRepository, projectRepository, emailService, eventBus, cfg.DateTime)
+	dueSoonReminder := service.NewDueSoonReminder(notificationService, cfg.Notification)
+	boardStreamService := service.NewBoardStreamService(boardBroker, boardHub, projectService)
 
 	userHandler := handler.NewUserHandler(userService)
 	projectHandler := handler.NewProjectHandler(projectService, cfg.DateTime)
 	sprintHandler := handler.NewSprintHandler(sprintService, cfg.DateTime)
-	taskHandler := handler.NewTaskHandler(taskService, cfg.DateTime)
+	taskHandler := handler.NewTaskHandler(taskService, savedViewService, cfg.DateTime)
+	commentHandler := handler.NewCommentHandler(commentService)
+	workflowHandler := handler.NewWorkflowHandler(workflowService)
+	wipLimitHandler := handler.NewWipLimitHandler(wipLimitService)
+	metricsHandler := handler.NewMetricsHandler(metricsService)
+	worklogHandler := handler.NewWorklogHandler(worklogService, cfg.DateTime)
+	taskLinkHandler := handler.NewTaskLinkHandler(taskLinkService)
+	labelHandler := handler.NewLabelHandler(labelService)
+	attachmentHandler := handler.NewAttachmentHandler(attachmentService)
+	searchHandler := handler.NewSearchHandler(searchService)
+	savedViewHandler := handler.NewSavedViewHandler(savedViewService)
+	webhookHandler := handler.NewWebhookHandler(webhookService)
+	notificationHandler := handler.NewNotificationHandler(notificationService)
+	boardStreamHandler := handler.NewBoardStreamHandler(boardStreamService, tokenService)
 
 	lm := middlewares.NewLoggingMiddleware(logger)
-	routes.SetupUserRoutes(prefixApp, userHandler, lm)
-	routes.SetupProjectRoutes(prefixApp, projectHandler, lm)
-	routes.SetupSprintRoutes(prefixApp, sprintHandler, lm)
-	routes.SetupTaskRoutes(prefixApp, taskHandler, lm)
+	am := middlewares.NewAuthMiddleware(tokenService)
+	ul := middlewares.LimitUpload("file", cfg.Storage.MaxFileSize(), cfg.Storage.AllowedMimeTypes)
+	routes.SetupBoardStreamRoutes(prefixApp, boardStreamHandler, lm, am)
+	routes.SetupUserRoutes(prefixApp, userHandler, lm, am)
+	routes.SetupProjectRoutes(prefixApp, projectHandler, lm, am)
+	routes.SetupSprintRoutes(prefixApp, sprintHandler, lm, am)
+	routes.SetupTaskRoutes(prefixApp, taskHandler, lm, am)
+	routes.SetupCommentRoutes(prefixApp, commentHandler, lm, am)
+	routes.SetupWorkflowRoutes(prefixApp, workflowHandler, lm, am)
+	routes.SetupWipLimitRoutes(prefixApp, wipLimitHandler, lm, am)
+	routes.SetupMetricsRoutes(prefixApp, metricsHandler, lm, am)
+	routes.SetupWorklogRoutes(prefixApp, worklogHandler, lm, am)
+	routes.SetupTaskLinkRoutes(prefixApp, taskLinkHandler, lm, am)
+	routes.SetupLabelRoutes(prefixApp, labelHandler, lm, am)
+	routes.SetupAttachmentRoutes(prefixApp, attachmentHandler, lm, am, ul)
+	routes.SetupSearchRoutes(prefixApp, searchHandler, lm, am)
+	routes.SetupSavedViewRoutes(prefixApp, savedViewHandler, lm, am)
+	routes.SetupWebhookRoutes(prefixApp, webhookHandler, lm, am)
+	routes.SetupNotificationRoutes(prefixApp, notificationHandler, lm, am)
+
+	eventBus.Subscribe(webhookService.HandleEvent)
+	eventBus.Subscribe(notificationService.HandleEvent)
+	eventBus.Subscribe(boardStreamService.HandleEvent)
+
+	workerCtx, stopWorkers := context.WithCancel(utils.ContextWithLogger(context.Background(), logger))
+	app.stopWorkers = stopWorkers
+	go eventBus.Run(workerCtx)
+	go webhookDispatcher.Run(workerCtx)
+	go dueSoonReminder.Run(workerCtx)
+	go emailDispatcher.Run(workerCtx)
+	go boardBroker.Run(workerCtx)
 
 	return nil
 }
@@ -104,4 +212,7 @@ func (app *App) Setup() error {
 func (app *App) Run() {
 	log.Printf("Starting server on port %s...", app.config.Server.Port)
 	log.Println(app.server.Listen(fmt.Sprintf(":%s", app.config.Server.Port)))
+	if app.stopWorkers != nil {
+		app.stopWorkers()
+	}
 }
@@ -18,8 +18,19 @@ type CacheRepository interface {
 	Increment(ctx context.Context, key string) (int64, error)
 	Expire(ctx context.Context, key string, expiration time.Duration) error
 	GetTTL(ctx context.Context, key string) (time.Duration, error)
+	CompareAndSwap(ctx context.Context, key string, old, value any, exp int) (bool, error)
 }
 
+// compareAndSwapScript replaces the value of KEYS[1] with ARGV[2] only while
+// it still holds ARGV[1], so concurrent writers cannot both win.
+var compareAndSwapScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("SET", KEYS[1], ARGV[2], "EX", ARGV[3])
+	return 1
+end
+return 0
+`)
+
 type redisRepository struct {
 	client *redis.Client
 }
@@ -36,7 +47,7 @@ func (r *redisRepository) Get(ctx context.Context, key string) (string, error) {
 
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
-			slog.Error("key does not exist", "key", key)
+			slog.Debug("key does not exist", "key", key)
 			return "", structs.ErrRedisKeyNotExist
 		}
 		slog.Error("redis Get failed", "key", key, "error", err)
@@ -90,4 +101,15 @@ func (r *redisRepository) GetTTL(ctx context.Context, key string) (time.Duration
         return 0, err
     }
 	return ttl, nil
-}
\ No newline at end of file
+}
+
+func (r *redisRepository) CompareAndSwap(ctx context.Context, key string, old, value any, exp int) (bool, error) {
+	seconds := int((time.Duration(exp) * time.Minute).Seconds())
+	swapped, err := compareAndSwapScript.Run(ctx, r.client, []string{key}, old, value, seconds).Int()
+	if err != nil {
+		slog.Error("redis CompareAndSwap failed", "key", key, "error", err)
+		return false, structs.ErrRedisConnection
+	}
+	slog.Debug("Compared and swapped key", "key", key, "swapped", swapped == 1)
+	return swapped == 1, nil
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"log/slog"
 	"strings"
+	"time"
 
 	"lqkhoi-go-http-api/pkg/utils"
 
@@ -41,12 +42,57 @@ type DateTimeConfig struct {
 	Format string `mapstructure:"format" validate:"required"`
 }
 
+// StorageConfig configures where attachments are stored and which uploads
+// are accepted. MaxFileSizeMB must stay below the server body limit.
+type StorageConfig struct {
+	LocalPath        string   `mapstructure:"local_path"         validate:"required"`
+	MaxFileSizeMB    int      `mapstructure:"max_file_size_mb"   validate:"required,min=1,max=20"`
+	AllowedMimeTypes []string `mapstructure:"allowed_mime_types" validate:"required,min=1"`
+}
+
+// WebhookConfig tunes webhook deliveries. A failed delivery is retried after
+// InitialBackoffSeconds, doubling the wait after every attempt, until
+// MaxAttempts attempts were made.
+type WebhookConfig struct {
+	TimeoutSeconds        int `mapstructure:"timeout_seconds"         validate:"required,min=1,max=30"`
+	MaxAttempts           int `mapstructure:"max_attempts"            validate:"required,min=1,max=10"`
+	InitialBackoffSeconds int `mapstructure:"initial_backoff_seconds" validate:"required,min=1,max=3600"`
+	PollIntervalSeconds   int `mapstructure:"poll_interval_seconds"   validate:"required,min=1,max=300"`
+}
+
+// NotificationConfig tunes the due date reminders: every ScanIntervalMinutes,
+// the assignees of open tasks due within DueSoonHours are notified once.
+type NotificationConfig struct {
+	DueSoonHours        int `mapstructure:"due_soon_hours"        validate:"required,min=1,max=168"`
+	ScanIntervalMinutes int `mapstructure:"scan_interval_minutes" validate:"required,min=1,max=1440"`
+}
+
+// MailConfig configures how notification emails are sent: logged by the
+// "log" driver, or sent through the SMTP server at Host:Port by the "smtp"
+// driver. A failed email is retried like a webhook delivery.
+type MailConfig struct {
+	Driver                string `mapstructure:"driver"                  validate:"required,oneof=log smtp"`
+	Host                  string `mapstructure:"host"                    validate:"required_if=Driver smtp"`
+	Port                  int    `mapstructure:"port"                    validate:"required_if=Driver smtp,max=65535"`
+	Username              string `mapstructure:"username"`
+	Password              string `mapstructure:"password"`
+	From                  string `mapstructure:"from"                    validate:"required,email"`
+	TimeoutSeconds        int    `mapstructure:"timeout_seconds"         validate:"required,min=1,max=60"`
+	MaxAttempts           int    `mapstructure:"max_attempts"            validate:"required,min=1,max=10"`
+	InitialBackoffSeconds int    `mapstructure:"initial_backoff_seconds" validate:"required,min=1,max=3600"`
+	PollIntervalSeconds   int    `mapstructure:"poll_interval_seconds"   validate:"required,min=1,max=300"`
+}
+
 type Config struct {
-	Database  DBConfig       `mapstructure:"db"`
-	Redis     RedisConfig    `mapstructure:"redis"`
-	Server    ServerConfig   `mapstructure:"server"`
-	JwtSecret string         `mapstructure:"jwt_secret" validate:"required,min=15"`
-	DateTime  DateTimeConfig `mapstructure:"date_time"`
+	Database     DBConfig           `mapstructure:"db"`
+	Redis        RedisConfig        `mapstructure:"redis"`
+	Server       ServerConfig       `mapstructure:"server"`
+	JwtSecret    string             `mapstructure:"jwt_secret" validate:"required,min=15"`
+	DateTime     DateTimeConfig     `mapstructure:"date_time"`
+	Storage      StorageConfig      `mapstructure:"storage"`
+	Webhook      WebhookConfig      `mapstructure:"webhook"`
+	Notification NotificationConfig `mapstructure:"notification"`
+	Mail         MailConfig         `mapstructure:"mail"`
 }
 
 func LoadConfig(configPath string) (cfg Config, err error) {
@@ -95,3 +141,63 @@ func LoadConfig(configPath string) (cfg Config, err error) {
 func (rc RedisConfig) Addr() string {
 	return fmt.Sprintf("%s:%s", rc.Host, rc.Port)
 }
+
+// MaxFileSize returns the maximum upload size in bytes.
+func (sc StorageConfig) MaxFileSize() int64 {
+	return int64(sc.MaxFileSizeMB) << 20
+}
+
+// Timeout returns how long a delivery attempt may take.
+func (wc WebhookConfig) Timeout() time.Duration {
+	return time.Duration(wc.TimeoutSeconds) * time.Second
+}
+
+// PollInterval returns how often due deliveries are looked for.
+func (wc WebhookConfig) PollInterval() time.Duration {
+	return time.Duration(wc.PollIntervalSeconds) * time.Second
+}
+
+// Backoff returns the wait before the attempt following attempt number
+// attempts, starting at 1.
+func (wc WebhookConfig) Backoff(attempts int) time.Duration {
+	backoff := time.Duration(wc.InitialBackoffSeconds) * time.Second
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+	}
+	return backoff
+}
+
+// DueSoonWindow returns how long before its due date a task is reminded of.
+func (nc NotificationConfig) DueSoonWindow() time.Duration {
+	return time.Duration(nc.DueSoonHours) * time.Hour
+}
+
+// ScanInterval returns how often tasks due soon are looked for.
+func (nc NotificationConfig) ScanInterval() time.Duration {
+	return time.Duration(nc.ScanIntervalMinutes) * time.Minute
+}
+
+// Addr returns the address of the SMTP server.
+func (mc MailConfig) Addr() string {
+	return fmt.Sprintf("%s:%d", mc.Host, mc.Port)
+}
+
+// Timeout returns how long sending an email may take.
+func (mc MailConfig) Timeout() time.Duration {
+	return time.Duration(mc.TimeoutSeconds) * time.Second
+}
+
+// PollInterval returns how often pending emails are looked for.
+func (mc MailConfig) PollInterval() time.Duration {
+	return time.Duration(mc.PollIntervalSeconds) * time.Second
+}
+
+// Backoff returns the wait before the attempt following attempt number
+// attempts, starting at 1.
+func (mc MailConfig) Backoff(attempts int) time.Duration {
+	backoff := time.Duration(mc.InitialBackoffSeconds) * time.Second
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+	}
+	return backoff
+}
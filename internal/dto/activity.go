@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"time"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+// ActivityChangeResponse represents the before/after value of one field.
+type ActivityChangeResponse struct {
+	// Field is the column that changed.
+	Field    string  `json:"field" example:"status"`
+	// OldValue is the value before the change; omitted for created values.
+	OldValue *string `json:"old_value,omitempty" example:"TO_DO"`
+	// NewValue is the value after the change; omitted for removed values.
+	NewValue *string `json:"new_value,omitempty" example:"IN_PROGRESS"`
+}
+
+// ActivityResponse represents an entry of the activity log.
+type ActivityResponse struct {
+	// ID is the unique identifier of the activity.
+	ID             int                       `json:"id" example:"55"`
+	// ActorID is the ID of the user who made the change.
+	ActorID        int                       `json:"actor_id" example:"7"`
+	// ActorFirstName is the first name of the actor.
+	ActorFirstName string                    `json:"actor_first_name,omitempty" example:"John"`
+	// ActorLastName is the last name of the actor.
+	ActorLastName  string                    `json:"actor_last_name,omitempty" example:"Doe"`
+	// ProjectID is the ID of the project the changed entity belongs to.
+	ProjectID      int                       `json:"project_id" example:"1"`
+	// EntityType is the kind of entity that changed.
+	EntityType     models.ActivityEntityType `json:"entity_type" example:"TASK"`
+	// EntityID is the ID of the entity that changed.
+	EntityID       int                       `json:"entity_id" example:"101"`
+	// Action is what was done to the entity.
+	Action         models.ActivityAction     `json:"action" example:"UPDATE"`
+	// Changes lists the fields that changed.
+	Changes        []ActivityChangeResponse  `json:"changes"`
+	// CreatedAt is the time of the change.
+	CreatedAt      time.Time                 `json:"created_at" example:"2025-04-20T10:00:00Z"`
+}
+
+func MapToSliceOfActivityResponse(activities []*models.ActivityLog) []ActivityResponse {
+	res := make([]ActivityResponse, len(activities))
+	for i, activity := range activities {
+		res[i].ID = activity.ID
+		res[i].ActorID = activity.ActorID
+		res[i].ProjectID = activity.ProjectID
+		res[i].EntityType = activity.EntityType
+		res[i].EntityID = activity.EntityID
+		res[i].Action = activity.Action
+		res[i].CreatedAt = activity.CreatedAt
+
+		if activity.Actor != nil {
+			res[i].ActorFirstName = activity.Actor.FirstName
+			res[i].ActorLastName = activity.Actor.LastName
+		}
+
+		res[i].Changes = make([]ActivityChangeResponse, len(activity.Changes))
+		for j, change := range activity.Changes {
+			res[i].Changes[j].Field = change.Field
+			res[i].Changes[j].OldValue = change.OldValue
+			res[i].Changes[j].NewValue = change.NewValue
+		}
+	}
+	return res
+}
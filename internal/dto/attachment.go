@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+// AttachmentResponse represents the metadata of a file attached to a task.
+type AttachmentResponse struct {
+	// ID is the unique identifier of the attachment.
+	ID           int       `json:"id" example:"3"`
+	// TaskID is the ID of the task the file is attached to.
+	TaskID       int       `json:"task_id" example:"101"`
+	// FileName is the original name of the uploaded file.
+	FileName     string    `json:"file_name" example:"login-mockup.png"`
+	// ContentType is the MIME type of the file.
+	ContentType  string    `json:"content_type" example:"image/png"`
+	// Size is the size of the file in bytes.
+	Size         int64     `json:"size" example:"48213"`
+	// UploadedByID is the ID of the user who uploaded the file.
+	UploadedByID int       `json:"uploaded_by_id" example:"5"`
+	// UploaderFirstName is the first name of the uploader.
+	UploaderFirstName string `json:"uploader_first_name,omitempty" example:"John"`
+	// UploaderLastName is the last name of the uploader.
+	UploaderLastName  string `json:"uploader_last_name,omitempty" example:"Doe"`
+	// CreatedAt is the time the file was uploaded.
+	CreatedAt    time.Time `json:"created_at" example:"2025-04-10T09:00:00Z"`
+}
+
+func MapToAttachmentResponse(attachment *models.Attachment) *AttachmentResponse {
+	response := &AttachmentResponse{
+		ID:           attachment.ID,
+		TaskID:       attachment.TaskID,
+		FileName:     attachment.FileName,
+		ContentType:  attachment.ContentType,
+		Size:         attachment.Size,
+		UploadedByID: attachment.UploadedByID,
+		CreatedAt:    attachment.CreatedAt,
+	}
+	if attachment.UploadedBy != nil {
+		response.UploaderFirstName = attachment.UploadedBy.FirstName
+		response.UploaderLastName = attachment.UploadedBy.LastName
+	}
+	return response
+}
+
+func MapToSliceOfAttachmentResponse(attachments []*models.Attachment) []AttachmentResponse {
+	res := make([]AttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		res[i] = *MapToAttachmentResponse(attachment)
+	}
+	return res
+}
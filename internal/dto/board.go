@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"lqkhoi-go-http-api/internal/models"
+)
+
+// BoardStatuses are the statuses of the board columns, from left to right.
+var BoardStatuses = []models.TaskStatus{
+	models.ToDoTask,
+	models.InProgressTask,
+	models.ReviewTask,
+	models.BlockedTask,
+	models.DoneTask,
+}
+
+// MoveTaskRequest represents the request body for moving a task on the board.
+// The task goes right after AfterTaskID and right before BeforeTaskID in the
+// column of Status; without either, it goes at the end of the column.
+type MoveTaskRequest struct {
+	// Status is the status of the column the task is moved to.
+	Status           models.TaskStatus `json:"status" validate:"required,oneof=TO_DO IN_PROGRESS REVIEW DONE BLOCKED" example:"IN_PROGRESS"`
+	// AfterTaskID is the optional ID of the task the moved task follows.
+	AfterTaskID      *int              `json:"after_task_id,omitempty" validate:"omitempty,min=1" example:"12"`
+	// BeforeTaskID is the optional ID of the task the moved task precedes.
+	BeforeTaskID     *int              `json:"before_task_id,omitempty" validate:"omitempty,min=1" example:"15"`
+	// OverrideWipLimit lets a project manager move the task past the WIP limit of the status.
+	OverrideWipLimit bool              `json:"override_wip_limit,omitempty" example:"false"`
+}
+
+// BoardColumnResponse represents the tasks of one status on a board.
+type BoardColumnResponse struct {
+	// Status is the status of the tasks in the column.
+	Status models.TaskStatus     `json:"status" example:"IN_PROGRESS"`
+	// Tasks lists the tasks of the column in rank order.
+	Tasks  []TaskInSliceResponse `json:"tasks"`
+}
+
+// SprintBoardResponse represents the board of a sprint.
+type SprintBoardResponse struct {
+	// SprintID is the ID of the sprint.
+	SprintID int                   `json:"sprint_id" example:"1"`
+	// Columns lists one column per status, in board order.
+	Columns  []BoardColumnResponse `json:"columns"`
+}
+
+// MapToSprintBoardResponse groups tasks, already in rank order, into the
+// columns of the board.
+func MapToSprintBoardResponse(sprintID int, tasks []*models.Task) *SprintBoardResponse {
+	byStatus := make(map[models.TaskStatus][]*models.Task, len(BoardStatuses))
+	for _, task := range tasks {
+		byStatus[task.Status] = append(byStatus[task.Status], task)
+	}
+
+	response := &SprintBoardResponse{
+		SprintID: sprintID,
+		Columns:  make([]BoardColumnResponse, len(BoardStatuses)),
+	}
+	for i, status := range BoardStatuses {
+		response.Columns[i] = BoardColumnResponse{
+			Status: status,
+			Tasks:  MapToSliceOfTaskResponse(byStatus[status]),
+		}
+	}
+	return response
+}
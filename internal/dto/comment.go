@@ -0,0 +1,128 @@
+package dto
+
+import (
+	"time"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+// CreateCommentRequest represents the request body for commenting on a task.
+type CreateCommentRequest struct {
+	// Body is the text of the comment; users are mentioned with @email.
+	Body            string `json:"body" validate:"required,max=5000" example:"Looks good, @jane@example.com please review"`
+	// ParentCommentID is the optional ID of the comment this one replies to.
+	ParentCommentID *int   `json:"parent_comment_id,omitempty" validate:"omitempty,min=1" example:"12"`
+}
+
+func (ccr *CreateCommentRequest) MapToComment() *models.Comment {
+	return &models.Comment{
+		Body:            ccr.Body,
+		ParentCommentID: ccr.ParentCommentID,
+	}
+}
+
+// UpdateCommentRequest represents the request body for editing a comment.
+type UpdateCommentRequest struct {
+	// Body is the new text of the comment.
+	Body string `json:"body" validate:"required,max=5000" example:"Looks good, @john@example.com please review"`
+}
+
+// CommentMentionResponse represents a user mentioned in a comment.
+type CommentMentionResponse struct {
+	// UserID is the ID of the mentioned user.
+	UserID int    `json:"user_id" example:"42"`
+	// Email is the email of the mentioned user.
+	Email  string `json:"email,omitempty" example:"jane@example.com"`
+}
+
+// CommentResponse represents a comment with its reply thread.
+type CommentResponse struct {
+	// ID is the unique identifier of the comment.
+	ID              int                      `json:"id" example:"12"`
+	// TaskID is the ID of the commented task.
+	TaskID          int                      `json:"task_id" example:"101"`
+	// ParentCommentID is the ID of the comment this one replies to; omitted for top-level comments.
+	ParentCommentID *int                     `json:"parent_comment_id,omitempty" example:"10"`
+	// Body is the text of the comment.
+	Body            string                   `json:"body" example:"Looks good, @jane@example.com please review"`
+	// AuthorID is the ID of the user who wrote the comment.
+	AuthorID        int                      `json:"author_id" example:"7"`
+	// AuthorFirstName is the first name of the author.
+	AuthorFirstName string                   `json:"author_first_name,omitempty" example:"John"`
+	// AuthorLastName is the last name of the author.
+	AuthorLastName  string                   `json:"author_last_name,omitempty" example:"Doe"`
+	// Mentions lists the users mentioned in the comment.
+	Mentions        []CommentMentionResponse `json:"mentions"`
+	// EditedAt is the time of the last edit; omitted for unedited comments.
+	EditedAt        *time.Time               `json:"edited_at,omitempty" example:"2025-04-21T10:00:00Z"`
+	// CreatedAt is the time the comment was posted.
+	CreatedAt       time.Time                `json:"created_at" example:"2025-04-20T10:00:00Z"`
+	// Replies is the thread of replies to this comment.
+	Replies         []CommentResponse        `json:"replies,omitempty"`
+}
+
+func MapToCommentResponse(comment *models.Comment) *CommentResponse {
+	response := &CommentResponse{
+		ID:              comment.ID,
+		TaskID:          comment.TaskID,
+		ParentCommentID: comment.ParentCommentID,
+		Body:            comment.Body,
+		AuthorID:        comment.AuthorID,
+		EditedAt:        comment.EditedAt,
+		CreatedAt:       comment.CreatedAt,
+	}
+
+	if comment.Author != nil {
+		response.AuthorFirstName = comment.Author.FirstName
+		response.AuthorLastName = comment.Author.LastName
+	}
+
+	response.Mentions = make([]CommentMentionResponse, len(comment.Mentions))
+	for i, mention := range comment.Mentions {
+		response.Mentions[i].UserID = mention.UserID
+		if mention.User != nil {
+			response.Mentions[i].Email = mention.User.Email
+		}
+	}
+
+	if len(comment.Replies) == 0 {
+		return response
+	}
+
+	response.Replies = make([]CommentResponse, len(comment.Replies))
+	for i := range comment.Replies {
+		response.Replies[i] = *MapToCommentResponse(&comment.Replies[i])
+	}
+	return response
+}
+
+func MapToSliceOfCommentResponse(comments []*models.Comment) []CommentResponse {
+	res := make([]CommentResponse, len(comments))
+	for i, comment := range comments {
+		res[i] = *MapToCommentResponse(comment)
+	}
+	return res
+}
+
+// CommentEditResponse represents a previous version of a comment.
+type CommentEditResponse struct {
+	// ID is the unique identifier of the edit.
+	ID           int       `json:"id" example:"3"`
+	// EditorID is the ID of the user who made the edit.
+	EditorID     int       `json:"editor_id" example:"7"`
+	// PreviousBody is the body the comment had before the edit.
+	PreviousBody string    `json:"previous_body" example:"Looks good"`
+	// EditedAt is the time of the edit.
+	EditedAt     time.Time `json:"edited_at" example:"2025-04-21T10:00:00Z"`
+}
+
+func MapToSliceOfCommentEditResponse(edits []*models.CommentEdit) []CommentEditResponse {
+	res := make([]CommentEditResponse, len(edits))
+	for i, edit := range edits {
+		res[i].ID = edit.ID
+		res[i].EditorID = edit.EditorID
+		res[i].PreviousBody = edit.PreviousBody
+		res[i].EditedAt = edit.CreatedAt
+	}
+	return res
+}
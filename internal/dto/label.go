@@ -0,0 +1,77 @@
+package dto
+
+import (
+	"lqkhoi-go-http-api/internal/models"
+)
+
+// CreateLabelRequest represents the request body for creating a label in a project.
+type CreateLabelRequest struct {
+	// Name is the name of the label, unique in the project.
+	Name  string `json:"name" validate:"required,min=1,max=50" example:"frontend"`
+	// Color is the hex color of the label.
+	Color string `json:"color" validate:"required,hexcolor,max=7" example:"#1E90FF"`
+}
+
+func (clr *CreateLabelRequest) MapToLabel() *models.Label {
+	return &models.Label{
+		Name:  clr.Name,
+		Color: clr.Color,
+	}
+}
+
+// UpdateLabelRequest represents the request body for updating a label.
+type UpdateLabelRequest struct {
+	// Name is the optional new name of the label.
+	Name  *string `json:"name,omitempty" validate:"omitempty,min=1,max=50" example:"backend"`
+	// Color is the optional new hex color of the label.
+	Color *string `json:"color,omitempty" validate:"omitempty,hexcolor,max=7" example:"#FF8800"`
+}
+
+// SetTaskLabelsRequest represents the request body for replacing the labels of a task.
+type SetTaskLabelsRequest struct {
+	// LabelIDs are the IDs of the labels the task carries from now on; an empty list removes every label.
+	LabelIDs []int `json:"label_ids" validate:"max=20,dive,min=1" example:"1,4"`
+}
+
+// LabelResponse represents a label of a project.
+type LabelResponse struct {
+	// ID is the unique identifier of the label.
+	ID        int    `json:"id" example:"1"`
+	// ProjectID is the ID of the project the label belongs to.
+	ProjectID int    `json:"project_id" example:"1"`
+	// Name is the name of the label.
+	Name      string `json:"name" example:"frontend"`
+	// Color is the hex color of the label.
+	Color     string `json:"color" example:"#1E90FF"`
+}
+
+func MapToLabelResponse(label *models.Label) *LabelResponse {
+	return &LabelResponse{
+		ID:        label.ID,
+		ProjectID: label.ProjectID,
+		Name:      label.Name,
+		Color:     label.Color,
+	}
+}
+
+func MapToSliceOfLabelResponse(labels []*models.Label) []LabelResponse {
+	res := make([]LabelResponse, len(labels))
+	for i, label := range labels {
+		res[i] = *MapToLabelResponse(label)
+	}
+	return res
+}
+
+// mapTaskLabels returns the labels attached to a task, nil when it has none.
+func mapTaskLabels(taskLabels []models.TaskLabel) []LabelResponse {
+	if len(taskLabels) == 0 {
+		return nil
+	}
+	res := make([]LabelResponse, 0, len(taskLabels))
+	for _, taskLabel := range taskLabels {
+		if taskLabel.Label != nil {
+			res = append(res, *MapToLabelResponse(taskLabel.Label))
+		}
+	}
+	return res
+}
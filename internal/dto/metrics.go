@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"time"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+// BurndownPoint is the remaining work of a sprint at the end of one day.
+type BurndownPoint struct {
+	// Date is the day the point describes.
+	Date           time.Time `json:"date" example:"2025-04-16T00:00:00Z"`
+	// RemainingTasks is the number of tasks not done at the end of the day; empty for days still to come.
+	RemainingTasks *int      `json:"remaining_tasks" example:"7"`
+	// IdealTasks is the remaining number of tasks on a steady pace to zero.
+	IdealTasks     float64   `json:"ideal_tasks" example:"7.5"`
+	// RemainingPoints is the sum of the story points of the tasks not done; empty for days still to come.
+	RemainingPoints *int     `json:"remaining_points" example:"21"`
+	// IdealPoints is the remaining story points on a steady pace to zero.
+	IdealPoints    float64   `json:"ideal_points" example:"24.5"`
+}
+
+// BurndownResponse represents the daily burndown of a sprint.
+type BurndownResponse struct {
+	// SprintID is the ID of the sprint.
+	SprintID   int                 `json:"sprint_id" example:"1"`
+	// Status is the lifecycle state of the sprint.
+	Status     models.SprintStatus `json:"status" example:"ACTIVE"`
+	// StartDate is the first day of the sprint.
+	StartDate  time.Time           `json:"start_date" example:"2025-04-15T00:00:00Z"`
+	// EndDate is the last day of the sprint.
+	EndDate    time.Time           `json:"end_date" example:"2025-04-30T00:00:00Z"`
+	// TotalTasks is the number of tasks in the sprint scope.
+	TotalTasks int                 `json:"total_tasks" example:"10"`
+	// TotalPoints is the sum of the story points of the sprint scope.
+	TotalPoints int                `json:"total_points" example:"34"`
+	// Points is the daily time series, one point per sprint day.
+	Points     []BurndownPoint     `json:"points"`
+}
+
+// VelocitySprint is the work committed and completed in one closed sprint.
+type VelocitySprint struct {
+	// SprintID is the ID of the sprint.
+	SprintID       int        `json:"sprint_id" example:"1"`
+	// Name is the name of the sprint.
+	Name           string     `json:"name" example:"Sprint 1"`
+	// StartDate is the planned start date of the sprint.
+	StartDate      time.Time  `json:"start_date" example:"2025-04-15T00:00:00Z"`
+	// CompletedAt is the time the sprint was completed.
+	CompletedAt    *time.Time `json:"completed_at" example:"2025-04-30T17:00:00Z"`
+	// CommittedTasks is the number of tasks in the sprint when it ended.
+	CommittedTasks int        `json:"committed_tasks" example:"10"`
+	// CompletedTasks is the number of tasks completed in the sprint.
+	CompletedTasks int        `json:"completed_tasks" example:"8"`
+	// CommittedPoints is the sum of the story points of the sprint tasks.
+	CommittedPoints int       `json:"committed_points" example:"34"`
+	// CompletedPoints is the sum of the story points of the completed tasks.
+	CompletedPoints int       `json:"completed_points" example:"26"`
+}
+
+// VelocityResponse represents the velocity of a project over its closed sprints.
+type VelocityResponse struct {
+	// ProjectID is the ID of the project.
+	ProjectID             int              `json:"project_id" example:"1"`
+	// AverageCompletedTasks is the mean number of tasks completed per sprint.
+	AverageCompletedTasks float64          `json:"average_completed_tasks" example:"8.5"`
+	// AverageCompletedPoints is the mean number of story points completed per sprint.
+	AverageCompletedPoints float64         `json:"average_completed_points" example:"27.5"`
+	// Sprints lists the closed sprints in the order they were completed.
+	Sprints               []VelocitySprint `json:"sprints"`
+}
@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"time"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+// NotificationResponse represents a notification of the current user.
+type NotificationResponse struct {
+	// ID is the unique identifier of the notification.
+	ID             int                     `json:"id" example:"12"`
+	// Type is the kind of notification.
+	Type           models.NotificationType `json:"type" example:"TASK_ASSIGNED"`
+	// Message describes what happened.
+	Message        string                  `json:"message" example:"You were assigned to \"Design login page\""`
+	// Read tells whether the notification was read.
+	Read           bool                    `json:"read" example:"false"`
+	// ReadAt is the time the notification was read.
+	ReadAt         *time.Time              `json:"read_at,omitempty" example:"2025-04-10T10:00:00Z"`
+	// ProjectID is the project the notification is about.
+	ProjectID      int                     `json:"project_id" example:"1"`
+	// ProjectName is the name of the project.
+	ProjectName    string                  `json:"project_name,omitempty" example:"Website redesign"`
+	// TaskID is the task the notification is about, if any.
+	TaskID         *int                    `json:"task_id,omitempty" example:"101"`
+	// TaskTitle is the title of the task.
+	TaskTitle      string                  `json:"task_title,omitempty" example:"Design login page"`
+	// ActorID is the user who caused the notification; reminders have none.
+	ActorID        *int                    `json:"actor_id,omitempty" example:"5"`
+	// ActorFirstName is the first name of the actor.
+	ActorFirstName string                  `json:"actor_first_name,omitempty" example:"John"`
+	// ActorLastName is the last name of the actor.
+	ActorLastName  string                  `json:"actor_last_name,omitempty" example:"Doe"`
+	// CreatedAt is the time of the notification.
+	CreatedAt      time.Time               `json:"created_at" example:"2025-04-10T09:00:00Z"`
+}
+
+func MapToNotificationResponse(notification *models.Notification) *NotificationResponse {
+	response := &NotificationResponse{
+		ID:        notification.ID,
+		Type:      notification.Type,
+		Message:   notification.Message,
+		Read:      notification.IsRead(),
+		ReadAt:    notification.ReadAt,
+		ProjectID: notification.ProjectID,
+		TaskID:    notification.TaskID,
+		ActorID:   notification.ActorID,
+		CreatedAt: notification.CreatedAt,
+	}
+	if notification.Project != nil {
+		response.ProjectName = notification.Project.Name
+	}
+	if notification.Task != nil {
+		response.TaskTitle = notification.Task.Title
+	}
+	if notification.Actor != nil {
+		response.ActorFirstName = notification.Actor.FirstName
+		response.ActorLastName = notification.Actor.LastName
+	}
+	return response
+}
+
+func MapToSliceOfNotificationResponse(notifications []*models.Notification) []NotificationResponse {
+	res := make([]NotificationResponse, len(notifications))
+	for i, notification := range notifications {
+		res[i] = *MapToNotificationResponse(notification)
+	}
+	return res
+}
+
+// UnreadNotificationCountResponse represents the number of unread notifications of the current user.
+type UnreadNotificationCountResponse struct {
+	// Unread is the number of unread notifications.
+	Unread int64 `json:"unread" example:"3"`
+}
+
+// MarkNotificationsReadResponse represents the outcome of marking all notifications as read.
+type MarkNotificationsReadResponse struct {
+	// Marked is the number of notifications that were unread.
+	Marked int64 `json:"marked" example:"3"`
+}
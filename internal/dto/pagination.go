@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+const (
+	// DefaultPageLimit is the page size used when the request does not set one.
+	DefaultPageLimit = 20
+	// MaxPageLimit is the largest page size a client may request.
+	MaxPageLimit = 100
+	// DefaultSortField is the column lists are sorted by when no sort is given.
+	DefaultSortField = "id"
+)
+
+// Sortable columns of each list endpoint. Only non-nullable columns are
+// allowed so that keyset cursors never have to compare against NULL.
+var (
+	ProjectSortFields         = []string{"id", "name", "status", "start_date", "created_at", "updated_at"}
+	SprintSortFields          = []string{"id", "name", "start_date", "end_date", "created_at", "updated_at"}
+	TaskSortFields            = []string{"id", "title", "status", "priority", "created_at", "updated_at"}
+	UserSortFields            = []string{"id", "email", "first_name", "last_name", "role", "created_at"}
+	CommentSortFields         = []string{"id", "created_at", "updated_at"}
+	ActivitySortFields        = []string{"id", "created_at"}
+	WorklogSortFields         = []string{"id", "started_at", "created_at"}
+	WebhookDeliverySortFields = []string{"id", "created_at"}
+	NotificationSortFields    = []string{"id", "created_at"}
+)
+
+// PageRequest describes the slice of a list endpoint to return. When Cursor is
+// set it takes precedence over Page.
+type PageRequest struct {
+	// Limit is the maximum number of items of the page.
+	Limit     int
+	// Page is the 1-based page number used for offset pagination.
+	Page      int
+	// Cursor continues right after the last item of a previous page.
+	Cursor    *Cursor
+	// SortField is the column the list is sorted by.
+	SortField string
+	// SortDesc sorts the list in descending order.
+	SortDesc  bool
+}
+
+// Cursor marks the last item of a page. It is bound to the sort field and
+// direction it was produced with.
+type Cursor struct {
+	SortField string `json:"s"`
+	SortDesc  bool   `json:"d,omitempty"`
+	Value     any    `json:"v"`
+	ID        int    `json:"id"`
+}
+
+// Matches reports whether the cursor was produced for the sort of page.
+func (c *Cursor) Matches(page *PageRequest) bool {
+	return c.SortField == page.SortField && c.SortDesc == page.SortDesc
+}
+
+func (c *Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeCursor(encoded string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.New("cursor is not valid base64")
+	}
+	cursor := &Cursor{}
+	if err := json.Unmarshal(raw, cursor); err != nil || cursor.SortField == "" {
+		return nil, errors.New("cursor is malformed")
+	}
+	return cursor, nil
+}
+
+// PageInfo describes the page returned by a list query.
+type PageInfo struct {
+	// Total is the number of items matching the query across all pages.
+	Total      int64
+	// Limit is the page size that was applied.
+	Limit      int
+	// Page is the page number, zero when the page was fetched with a cursor.
+	Page       int
+	// NextCursor fetches the following page, empty on the last page.
+	NextCursor string
+}
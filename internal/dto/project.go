@@ -128,7 +128,40 @@ func MapToProjectDtoSlice(projects []*models.Project) []ProjectResponse {
 // AddTeamMembersRequest represents the request body for adding team members to a project.
 type AddTeamMembersRequest struct {
 	// UserIDs is the list of user IDs to add as team members.
-	UserIDs []int `json:"userIds" validate:"required,min=1,dive,gt=0" example:"[101, 102, 103]"`
+	UserIDs []int                    `json:"userIds" validate:"required,min=1,dive,gt=0" example:"[101, 102, 103]"`
+	// Role is the project role given to the added users; defaults to MEMBER.
+	Role    models.ProjectMemberRole `json:"role" validate:"omitempty,oneof=MANAGER MEMBER VIEWER" example:"MEMBER"`
+}
+
+// ProjectMemberResponse represents a member of a project together with their project role.
+type ProjectMemberResponse struct {
+	// UserID is the ID of the member.
+	UserID    int                      `json:"user_id" example:"101"`
+	// Email is the email address of the member.
+	Email     string                   `json:"email" example:"john.doe@example.com"`
+	// FirstName is the first name of the member.
+	FirstName string                   `json:"first_name" example:"John"`
+	// LastName is the last name of the member.
+	LastName  string                   `json:"last_name" example:"Doe"`
+	// Role is the role of the member in the project.
+	Role      models.ProjectMemberRole `json:"role" example:"MEMBER"`
+	// JoinedAt is the time the user became a member of the project.
+	JoinedAt  time.Time                `json:"joined_at" example:"2025-04-10T00:00:00Z"`
+}
+
+func MapToSliceOfProjectMemberResponse(members []*models.ProjectMember) []ProjectMemberResponse {
+	res := make([]ProjectMemberResponse, len(members))
+	for i, member := range members {
+		res[i].UserID = member.UserID
+		res[i].Role = member.Role
+		res[i].JoinedAt = member.CreatedAt
+		if member.User != nil {
+			res[i].Email = member.User.Email
+			res[i].FirstName = member.User.FirstName
+			res[i].LastName = member.User.LastName
+		}
+	}
+	return res
 }
 
 // UpdateProjectRequest represents the request body for updating an existing project.
@@ -14,9 +14,13 @@ type ErrorResponse struct {
 
 // SliceSuccessResponse represents a success response with a slice of data
 type SliceSuccessResponse[T any] struct {
-	Message string `json:"message"`
-	Data    []T    `json:"data"`
-	Count   int    `json:"count" example:"3"`
+	Message    string `json:"message"`
+	Data       []T    `json:"data"`
+	Count      int    `json:"count" example:"3"`
+	Total      int64  `json:"total,omitempty" example:"42"`
+	Limit      int    `json:"limit,omitempty" example:"20"`
+	Page       int    `json:"page,omitempty" example:"1"`
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"`
 }
 
 // AddTeamMembersPartialSuccessResponse represents a partial success response for AddTeamMembers
@@ -28,7 +32,19 @@ type AddTeamMembersPartialSuccessResponse struct {
 
 // TokenResponse represents a response contain token for authentication
 type TokenResponse struct {
-	Token string `json:"token" example:"random-token"`
+	// Token is the access token sent as the Bearer credential.
+	Token        string `json:"token" example:"random-token"`
+	// RefreshToken is exchanged at /token/refresh for a new token pair.
+	RefreshToken string `json:"refresh_token" example:"random-refresh-token"`
+	// TokenType is the authorization scheme of the access token.
+	TokenType    string `json:"token_type" example:"Bearer"`
+	// ExpiresIn is the lifetime of the access token in seconds.
+	ExpiresIn    int    `json:"expires_in" example:"3600"`
+}
+
+type TokenSuccessResponse struct {
+	Message string        `json:"message" example:"Operation successful"`
+	Data    TokenResponse `json:"data"`
 }
 
 type ProjectSuccessResponse struct {
@@ -37,9 +53,19 @@ type ProjectSuccessResponse struct {
 }
 
 type ProjectSliceSuccessResponse struct {
-	Message string            `json:"message" example:"Items found successfully"`
-	Data    []ProjectResponse `json:"data"`
-	Count   int               `json:"count" example:"5"`
+	Message    string            `json:"message" example:"Items found successfully"`
+	Data       []ProjectResponse `json:"data"`
+	Count      int               `json:"count" example:"5"`
+	Total      int64             `json:"total" example:"42"`
+	Limit      int               `json:"limit" example:"20"`
+	Page       int               `json:"page,omitempty" example:"1"`
+	NextCursor string            `json:"next_cursor,omitempty" example:"eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"`
+}
+
+type ProjectMemberSliceSuccessResponse struct {
+	Message string                  `json:"message" example:"Items found successfully"`
+	Data    []ProjectMemberResponse `json:"data"`
+	Count   int                     `json:"count" example:"5"`
 }
 
 type GenericSuccessResponse struct {
@@ -57,9 +83,13 @@ type UserSuccessResponse struct {
 }
 
 type UserSliceSuccessResponse struct {
-	Message string         `json:"message" example:"Items found successfully"`
-	Data    []UserResponse `json:"data"`
-	Count   int            `json:"count" example:"5"`
+	Message    string         `json:"message" example:"Items found successfully"`
+	Data       []UserResponse `json:"data"`
+	Count      int            `json:"count" example:"5"`
+	Total      int64          `json:"total" example:"42"`
+	Limit      int            `json:"limit" example:"20"`
+	Page       int            `json:"page,omitempty" example:"1"`
+	NextCursor string         `json:"next_cursor,omitempty" example:"eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"`
 }
 
 type TaskSuccessResponse struct {
@@ -68,9 +98,13 @@ type TaskSuccessResponse struct {
 }
 
 type TaskSliceSuccessResponse struct {
-	Message string         `json:"message" example:"Items found successfully"`
-	Data    []TaskResponse `json:"data"`
-	Count   int            `json:"count" example:"5"`
+	Message    string         `json:"message" example:"Items found successfully"`
+	Data       []TaskResponse `json:"data"`
+	Count      int            `json:"count" example:"5"`
+	Total      int64          `json:"total" example:"42"`
+	Limit      int            `json:"limit" example:"20"`
+	Page       int            `json:"page,omitempty" example:"1"`
+	NextCursor string         `json:"next_cursor,omitempty" example:"eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"`
 }
 
 type SprintSuccessResponse struct {
@@ -79,7 +113,197 @@ type SprintSuccessResponse struct {
 }
 
 type SprintSliceSuccessResponse struct {
-	Message string           `json:"message" example:"Items found successfully"`
-	Data    []SprintResponse `json:"data"`
-	Count   int              `json:"count" example:"5"`
+	Message    string           `json:"message" example:"Items found successfully"`
+	Data       []SprintResponse `json:"data"`
+	Count      int              `json:"count" example:"5"`
+	Total      int64            `json:"total" example:"42"`
+	Limit      int              `json:"limit" example:"20"`
+	Page       int              `json:"page,omitempty" example:"1"`
+	NextCursor string           `json:"next_cursor,omitempty" example:"eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"`
+}
+
+type SprintReportSuccessResponse struct {
+	Message string               `json:"message" example:"Operation successful"`
+	Data    SprintReportResponse `json:"data"`
+}
+
+type SprintBoardSuccessResponse struct {
+	Message string              `json:"message" example:"Operation successful"`
+	Data    SprintBoardResponse `json:"data"`
+}
+
+type CommentSuccessResponse struct {
+	Message string          `json:"message" example:"Operation successful"`
+	Data    CommentResponse `json:"data"`
+}
+
+type CommentSliceSuccessResponse struct {
+	Message    string            `json:"message" example:"Items found successfully"`
+	Data       []CommentResponse `json:"data"`
+	Count      int               `json:"count" example:"5"`
+	Total      int64             `json:"total" example:"42"`
+	Limit      int               `json:"limit" example:"20"`
+	Page       int               `json:"page,omitempty" example:"1"`
+	NextCursor string            `json:"next_cursor,omitempty" example:"eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"`
+}
+
+type CommentEditSliceSuccessResponse struct {
+	Message string                `json:"message" example:"Items found successfully"`
+	Data    []CommentEditResponse `json:"data"`
+	Count   int                   `json:"count" example:"2"`
+}
+
+type ActivitySliceSuccessResponse struct {
+	Message    string             `json:"message" example:"Items found successfully"`
+	Data       []ActivityResponse `json:"data"`
+	Count      int                `json:"count" example:"5"`
+	Total      int64              `json:"total" example:"42"`
+	Limit      int                `json:"limit" example:"20"`
+	Page       int                `json:"page,omitempty" example:"1"`
+	NextCursor string             `json:"next_cursor,omitempty" example:"eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"`
+}
+
+type BurndownSuccessResponse struct {
+	Message string           `json:"message" example:"Operation successful"`
+	Data    BurndownResponse `json:"data"`
+}
+
+type VelocitySuccessResponse struct {
+	Message string           `json:"message" example:"Operation successful"`
+	Data    VelocityResponse `json:"data"`
+}
+
+type SearchSuccessResponse struct {
+	Message string         `json:"message" example:"Operation successful"`
+	Data    SearchResponse `json:"data"`
+}
+
+type SavedViewSuccessResponse struct {
+	Message string            `json:"message" example:"Operation successful"`
+	Data    SavedViewResponse `json:"data"`
+}
+
+type SavedViewSliceSuccessResponse struct {
+	Message string              `json:"message" example:"Items found successfully"`
+	Data    []SavedViewResponse `json:"data"`
+	Count   int                 `json:"count" example:"3"`
+}
+
+type WebhookSuccessResponse struct {
+	Message string          `json:"message" example:"Operation successful"`
+	Data    WebhookResponse `json:"data"`
+}
+
+type WebhookSliceSuccessResponse struct {
+	Message string            `json:"message" example:"Items found successfully"`
+	Data    []WebhookResponse `json:"data"`
+	Count   int               `json:"count" example:"2"`
+}
+
+type WebhookDeliverySuccessResponse struct {
+	Message string                  `json:"message" example:"Operation successful"`
+	Data    WebhookDeliveryResponse `json:"data"`
+}
+
+type WebhookDeliverySliceSuccessResponse struct {
+	Message    string                    `json:"message" example:"Items found successfully"`
+	Data       []WebhookDeliveryResponse `json:"data"`
+	Count      int                       `json:"count" example:"5"`
+	Total      int64                     `json:"total" example:"42"`
+	Limit      int                       `json:"limit" example:"20"`
+	Page       int                       `json:"page,omitempty" example:"1"`
+	NextCursor string                    `json:"next_cursor,omitempty" example:"eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"`
+}
+
+type NotificationSuccessResponse struct {
+	Message string               `json:"message" example:"Operation successful"`
+	Data    NotificationResponse `json:"data"`
+}
+
+type NotificationSliceSuccessResponse struct {
+	Message    string                 `json:"message" example:"Items found successfully"`
+	Data       []NotificationResponse `json:"data"`
+	Count      int                    `json:"count" example:"5"`
+	Total      int64                  `json:"total" example:"42"`
+	Limit      int                    `json:"limit" example:"20"`
+	Page       int                    `json:"page,omitempty" example:"1"`
+	NextCursor string                 `json:"next_cursor,omitempty" example:"eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"`
+}
+
+type UnreadNotificationCountSuccessResponse struct {
+	Message string                          `json:"message" example:"Operation successful"`
+	Data    UnreadNotificationCountResponse `json:"data"`
+}
+
+type MarkNotificationsReadSuccessResponse struct {
+	Message string                        `json:"message" example:"Operation successful"`
+	Data    MarkNotificationsReadResponse `json:"data"`
+}
+
+type AttachmentSuccessResponse struct {
+	Message string             `json:"message" example:"Operation successful"`
+	Data    AttachmentResponse `json:"data"`
+}
+
+type AttachmentSliceSuccessResponse struct {
+	Message string               `json:"message" example:"Items found successfully"`
+	Data    []AttachmentResponse `json:"data"`
+	Count   int                  `json:"count" example:"2"`
+}
+
+type LabelSuccessResponse struct {
+	Message string        `json:"message" example:"Operation successful"`
+	Data    LabelResponse `json:"data"`
+}
+
+type LabelSliceSuccessResponse struct {
+	Message string          `json:"message" example:"Items found successfully"`
+	Data    []LabelResponse `json:"data"`
+	Count   int             `json:"count" example:"4"`
+}
+
+type TaskLinkSuccessResponse struct {
+	Message string           `json:"message" example:"Operation successful"`
+	Data    TaskLinkResponse `json:"data"`
+}
+
+type TaskLinkSliceSuccessResponse struct {
+	Message string             `json:"message" example:"Items found successfully"`
+	Data    []TaskLinkResponse `json:"data"`
+	Count   int                `json:"count" example:"2"`
+}
+
+type WorklogSuccessResponse struct {
+	Message string          `json:"message" example:"Operation successful"`
+	Data    WorklogResponse `json:"data"`
+}
+
+type WorklogSliceSuccessResponse struct {
+	Message    string            `json:"message" example:"Items found successfully"`
+	Data       []WorklogResponse `json:"data"`
+	Count      int               `json:"count" example:"5"`
+	Total      int64             `json:"total" example:"42"`
+	Limit      int               `json:"limit" example:"20"`
+	Page       int               `json:"page,omitempty" example:"1"`
+	NextCursor string            `json:"next_cursor,omitempty" example:"eyJzIjoiaWQiLCJ2IjoyMCwiaWQiOjIwfQ"`
+}
+
+type TimesheetSuccessResponse struct {
+	Message string            `json:"message" example:"Operation successful"`
+	Data    TimesheetResponse `json:"data"`
+}
+
+type ProjectWorklogTotalsSuccessResponse struct {
+	Message string                       `json:"message" example:"Operation successful"`
+	Data    ProjectWorklogTotalsResponse `json:"data"`
+}
+
+type WorkflowSuccessResponse struct {
+	Message string           `json:"message" example:"Operation successful"`
+	Data    WorkflowResponse `json:"data"`
+}
+
+type WipLimitsSuccessResponse struct {
+	Message string            `json:"message" example:"Operation successful"`
+	Data    WipLimitsResponse `json:"data"`
 }
@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"time"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+// CreateSavedViewRequest represents the request body for saving a task filter as a view.
+type CreateSavedViewRequest struct {
+	// Name is the name of the view.
+	Name      string `json:"name" validate:"required,min=1,max=100" example:"My open critical tasks"`
+	// Filter is the filter expression of the view, as accepted by the filter parameter of GET /tasks.
+	Filter    string `json:"filter" validate:"required,min=1,max=1000" example:"assignee = me and status != DONE and priority = CRITICAL"`
+	// ProjectID optionally shares the view to the members of a project and limits it to the project's tasks.
+	ProjectID *int   `json:"project_id,omitempty" validate:"omitempty,min=1" example:"1"`
+}
+
+func (cvr *CreateSavedViewRequest) MapToSavedView() *models.SavedView {
+	return &models.SavedView{
+		Name:      cvr.Name,
+		Filter:    cvr.Filter,
+		ProjectID: cvr.ProjectID,
+	}
+}
+
+// UpdateSavedViewRequest represents the request body for updating a saved view.
+type UpdateSavedViewRequest struct {
+	// Name is the optional new name of the view.
+	Name      *string `json:"name,omitempty" validate:"omitempty,min=1,max=100" example:"My open tasks"`
+	// Filter is the optional new filter expression of the view.
+	Filter    *string `json:"filter,omitempty" validate:"omitempty,min=1,max=1000" example:"assignee = me and status != DONE"`
+	// ProjectID optionally shares the view to another project; 0 makes the view personal again.
+	ProjectID *int    `json:"project_id,omitempty" validate:"omitempty,min=0" example:"2"`
+}
+
+// SavedViewResponse represents a saved view.
+type SavedViewResponse struct {
+	// ID is the unique identifier of the view, passed as the view parameter of GET /tasks.
+	ID             int       `json:"id" example:"4"`
+	// Name is the name of the view.
+	Name           string    `json:"name" example:"My open critical tasks"`
+	// Filter is the filter expression of the view.
+	Filter         string    `json:"filter" example:"assignee = me and status != DONE and priority = CRITICAL"`
+	// ProjectID is the project the view is shared to, if any.
+	ProjectID      *int      `json:"project_id,omitempty" example:"1"`
+	// OwnerID is the ID of the user who saved the view.
+	OwnerID        int       `json:"owner_id" example:"5"`
+	// OwnerFirstName is the first name of the owner.
+	OwnerFirstName string    `json:"owner_first_name,omitempty" example:"John"`
+	// OwnerLastName is the last name of the owner.
+	OwnerLastName  string    `json:"owner_last_name,omitempty" example:"Doe"`
+	// CreatedAt is the time the view was saved.
+	CreatedAt      time.Time `json:"created_at" example:"2025-04-10T09:00:00Z"`
+	// UpdatedAt is the time the view was last changed.
+	UpdatedAt      time.Time `json:"updated_at" example:"2025-04-12T15:30:00Z"`
+}
+
+func MapToSavedViewResponse(view *models.SavedView) *SavedViewResponse {
+	response := &SavedViewResponse{
+		ID:        view.ID,
+		Name:      view.Name,
+		Filter:    view.Filter,
+		ProjectID: view.ProjectID,
+		OwnerID:   view.OwnerID,
+		CreatedAt: view.CreatedAt,
+		UpdatedAt: view.UpdatedAt,
+	}
+	if view.Owner != nil {
+		response.OwnerFirstName = view.Owner.FirstName
+		response.OwnerLastName = view.Owner.LastName
+	}
+	return response
+}
+
+func MapToSliceOfSavedViewResponse(views []*models.SavedView) []SavedViewResponse {
+	res := make([]SavedViewResponse, len(views))
+	for i, view := range views {
+		res[i] = *MapToSavedViewResponse(view)
+	}
+	return res
+}
@@ -0,0 +1,95 @@
+package dto
+
+import (
+	"lqkhoi-go-http-api/internal/models"
+)
+
+// SearchScope selects the kinds of items a search looks through.
+type SearchScope string
+
+const (
+	SearchAll      SearchScope = "all"
+	SearchTasks    SearchScope = "tasks"
+	SearchProjects SearchScope = "projects"
+)
+
+const (
+	// DefaultSearchLimit is the number of results of each kind returned when
+	// no limit is given.
+	DefaultSearchLimit = 20
+	// MaxSearchLimit is the largest number of results of each kind.
+	MaxSearchLimit     = 50
+	// MaxSearchQueryLength is the longest accepted search text.
+	MaxSearchQueryLength = 200
+)
+
+// TaskSearchHitResponse represents a task matching a search.
+type TaskSearchHitResponse struct {
+	// ID is the unique identifier of the task.
+	ID          int               `json:"id" example:"101"`
+	// ProjectID is the ID of the project of the task.
+	ProjectID   int               `json:"project_id" example:"1"`
+	// ProjectName is the name of the project of the task.
+	ProjectName string            `json:"project_name" example:"Website redesign"`
+	// Title is the title of the task.
+	Title       string            `json:"title" example:"Design login page"`
+	// Status is the status of the task.
+	Status      models.TaskStatus `json:"status" example:"IN_PROGRESS"`
+	// Rank is the relevance of the task; higher is better.
+	Rank        float64           `json:"rank" example:"0.6079"`
+	// Snippet is an HTML escaped excerpt of the title and description with matches wrapped in <mark> tags.
+	Snippet     string            `json:"snippet" example:"Design <mark>login</mark> page"`
+}
+
+// ProjectSearchHitResponse represents a project matching a search.
+type ProjectSearchHitResponse struct {
+	// ID is the unique identifier of the project.
+	ID      int                  `json:"id" example:"1"`
+	// Name is the name of the project.
+	Name    string               `json:"name" example:"Website redesign"`
+	// Status is the status of the project.
+	Status  models.ProjectStatus `json:"status" example:"ACTIVE"`
+	// Rank is the relevance of the project; higher is better.
+	Rank    float64              `json:"rank" example:"0.3040"`
+	// Snippet is an HTML escaped excerpt of the name and description with matches wrapped in <mark> tags.
+	Snippet string               `json:"snippet" example:"New <mark>login</mark> flow for the website"`
+}
+
+// SearchResponse represents the results of a search, best matches first.
+type SearchResponse struct {
+	// Query is the searched text.
+	Query    string                     `json:"query" example:"login"`
+	// Tasks lists the matching tasks; empty when tasks are not searched.
+	Tasks    []TaskSearchHitResponse    `json:"tasks"`
+	// Projects lists the matching projects; empty when projects are not searched.
+	Projects []ProjectSearchHitResponse `json:"projects"`
+}
+
+func MapToSearchResponse(query string, tasks []*models.TaskSearchResult, projects []*models.ProjectSearchResult) *SearchResponse {
+	response := &SearchResponse{
+		Query:    query,
+		Tasks:    make([]TaskSearchHitResponse, len(tasks)),
+		Projects: make([]ProjectSearchHitResponse, len(projects)),
+	}
+	for i, task := range tasks {
+		response.Tasks[i] = TaskSearchHitResponse{
+			ID:          task.ID,
+			ProjectID:   task.ProjectID,
+			ProjectName: task.ProjectName,
+			Title:       task.Title,
+			Status:      task.Status,
+			Rank:        task.Rank,
+			Snippet:     task.Snippet,
+		}
+	}
+	for i, project := range projects {
+		response.Projects[i] = ProjectSearchHitResponse{
+			ID:      project.ID,
+			Name:    project.Name,
+			Status:  project.Status,
+			Rank:    project.Rank,
+			Snippet: project.Snippet,
+		}
+	}
+	return response
+}
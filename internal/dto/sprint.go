@@ -42,6 +42,8 @@ type TaskInSprintResponse struct {
 	Priority models.TaskPriority `json:"priority" example:"HIGH"`
 	// DueDate is the optional due date of the task.
 	DueDate  *time.Time          `json:"due_date" example:"2025-04-25T00:00:00Z"`
+	// StoryPoints is the optional relative size of the task.
+	StoryPoints *int             `json:"story_points,omitempty" example:"5"`
 }
 
 func MapToTaskInSprintResponse(task *models.Task) *TaskInSprintResponse {
@@ -51,6 +53,7 @@ func MapToTaskInSprintResponse(task *models.Task) *TaskInSprintResponse {
 		Status:   task.Status,
 		Priority: task.Priority,
 		DueDate:  task.DueDate,
+		StoryPoints: task.StoryPoints,
 	}
 }
 
@@ -70,10 +73,24 @@ type SprintResponse struct {
 	ProjectName *string                `json:"project_name,omitempty" example:"Website Redesign"`
 	// Goal is the objective or goal of the sprint.
 	Goal        string                 `json:"goal" example:"Complete initial UI design"`
+	// Status is the lifecycle state of the sprint.
+	Status      models.SprintStatus    `json:"status" example:"ACTIVE"`
+	// StartedAt is the time the sprint was started, if it was.
+	StartedAt   *time.Time             `json:"started_at,omitempty" example:"2025-04-15T09:00:00Z"`
+	// CompletedAt is the time the sprint was completed, if it was.
+	CompletedAt *time.Time             `json:"completed_at,omitempty" example:"2025-04-30T17:00:00Z"`
 	// Tasks is the list of tasks within the sprint (optional).
 	Tasks       []TaskInSprintResponse `json:"tasks,omitempty"`
 	// TaskCount is the total number of tasks in the sprint (optional).
 	TaskCount   *int                   `json:"task_count,omitempty" example:"3"`
+	// StoryPoints is the sum of the story points of the sprint tasks.
+	StoryPoints              int       `json:"story_points" example:"21"`
+	// CompletedStoryPoints is the sum of the story points of the done sprint tasks.
+	CompletedStoryPoints     int       `json:"completed_story_points" example:"8"`
+	// OriginalEstimateMinutes is the sum of the original estimates of the sprint tasks.
+	OriginalEstimateMinutes  int       `json:"original_estimate_minutes" example:"2400"`
+	// RemainingEstimateMinutes is the sum of the remaining estimates of the sprint tasks.
+	RemainingEstimateMinutes int       `json:"remaining_estimate_minutes" example:"960"`
 }
 
 func MapToSprintResponse(sprint *models.Sprint) *SprintResponse {
@@ -89,10 +106,29 @@ func MapToSprintResponse(sprint *models.Sprint) *SprintResponse {
 	}
 
 	sr.Goal = sprint.Goal
+	sr.Status = sprint.Status
+	sr.StartedAt = sprint.StartedAt
+	sr.CompletedAt = sprint.CompletedAt
 	if len(sprint.Tasks) == 0 {
 		return sr
 	}
 
+	for i := range sprint.Tasks {
+		task := &sprint.Tasks[i]
+		if task.StoryPoints != nil {
+			sr.StoryPoints += *task.StoryPoints
+			if task.Status == models.DoneTask {
+				sr.CompletedStoryPoints += *task.StoryPoints
+			}
+		}
+		if task.OriginalEstimateMinutes != nil {
+			sr.OriginalEstimateMinutes += *task.OriginalEstimateMinutes
+		}
+		if task.RemainingEstimateMinutes != nil {
+			sr.RemainingEstimateMinutes += *task.RemainingEstimateMinutes
+		}
+	}
+
 	numTasks := len(sprint.Tasks)
 	sr.TaskCount = &numTasks
 	sr.Tasks = make([]TaskInSprintResponse, numTasks)
@@ -111,8 +147,8 @@ func MapToSprintResponseSlice(sprints []*models.Sprint) []SprintResponse {
 	responses := make([]SprintResponse, len(sprints))
 
 	for i := range sprints {
-		sprints[i].Tasks = nil
 		responses[i] = *MapToSprintResponse(sprints[i])
+		responses[i].Tasks = nil
 	}
 
 	return responses
@@ -142,4 +178,81 @@ type UpdateSprintRequest struct {
 	EndDate   *time.Time `json:"end_date,omitempty" validate:"omitempty,gtfield=StartDate" example:"2025-05-05T00:00:00Z"`
 	// Goal is the optional new goal of the sprint.
 	Goal      *string    `json:"goal,omitempty" validate:"omitempty,min=5" example:"Finalize UI and start backend integration"`
-}
\ No newline at end of file
+}
+// CompleteSprintRequest represents the request body for completing a sprint.
+type CompleteSprintRequest struct {
+	// CarryOver tells where unfinished tasks go: the next sprint or the backlog.
+	CarryOver        models.CarryOverTarget `json:"carry_over" validate:"required,oneof=NEXT_SPRINT BACKLOG" example:"NEXT_SPRINT"`
+	// NextSprintID is the optional sprint to carry tasks over to; defaults to the next planned sprint.
+	NextSprintID     *int                   `json:"next_sprint_id,omitempty" validate:"omitempty,min=1" example:"2"`
+	// OverrideWipLimit carries the tasks over past the WIP limits of the next sprint or of the backlog.
+	OverrideWipLimit bool                   `json:"override_wip_limit,omitempty" example:"false"`
+}
+
+// SprintReportTaskResponse represents a task of a completed sprint in the report.
+type SprintReportTaskResponse struct {
+	// TaskID is the ID of the task.
+	TaskID  int                      `json:"task_id" example:"101"`
+	// Title is the title of the task when the sprint ended.
+	Title   string                   `json:"title" example:"Design homepage layout"`
+	// Status is the status of the task when the sprint ended.
+	Status  models.TaskStatus        `json:"status" example:"IN_PROGRESS"`
+	// Outcome tells whether the task was completed or carried over.
+	Outcome models.SprintTaskOutcome `json:"outcome" example:"CARRIED_OVER"`
+	// StoryPoints is the size of the task when the sprint ended.
+	StoryPoints *int                 `json:"story_points,omitempty" example:"5"`
+}
+
+// SprintReportResponse represents the report written when a sprint is completed.
+type SprintReportResponse struct {
+	// SprintID is the ID of the completed sprint.
+	SprintID             int                        `json:"sprint_id" example:"1"`
+	// ProjectID is the ID of the project the sprint belongs to.
+	ProjectID            int                        `json:"project_id" example:"1"`
+	// CompletedByID is the ID of the user who completed the sprint.
+	CompletedByID        int                        `json:"completed_by_id" example:"7"`
+	// CompletedByFirstName is the first name of the user who completed the sprint.
+	CompletedByFirstName string                     `json:"completed_by_first_name,omitempty" example:"John"`
+	// CompletedByLastName is the last name of the user who completed the sprint.
+	CompletedByLastName  string                     `json:"completed_by_last_name,omitempty" example:"Doe"`
+	// CompletedAt is the time the sprint was completed.
+	CompletedAt          time.Time                  `json:"completed_at" example:"2025-04-30T17:00:00Z"`
+	// CarryOver tells where unfinished tasks went.
+	CarryOver            models.CarryOverTarget     `json:"carry_over" example:"NEXT_SPRINT"`
+	// NextSprintID is the sprint unfinished tasks were moved to, if any.
+	NextSprintID         *int                       `json:"next_sprint_id,omitempty" example:"2"`
+	// CompletedCount is the number of tasks completed in the sprint.
+	CompletedCount       int                        `json:"completed_count" example:"8"`
+	// CarriedOverCount is the number of tasks carried over.
+	CarriedOverCount     int                        `json:"carried_over_count" example:"2"`
+	// Tasks lists every task of the sprint with its outcome.
+	Tasks                []SprintReportTaskResponse `json:"tasks"`
+}
+
+func MapToSprintReportResponse(report *models.SprintReport) *SprintReportResponse {
+	response := &SprintReportResponse{
+		SprintID:         report.SprintID,
+		ProjectID:        report.ProjectID,
+		CompletedByID:    report.CompletedByID,
+		CompletedAt:      report.CreatedAt,
+		CarryOver:        report.CarryOver,
+		NextSprintID:     report.NextSprintID,
+		CompletedCount:   report.CompletedCount,
+		CarriedOverCount: report.CarriedOverCount,
+		Tasks:            make([]SprintReportTaskResponse, len(report.Tasks)),
+	}
+	if report.CompletedBy != nil {
+		response.CompletedByFirstName = report.CompletedBy.FirstName
+		response.CompletedByLastName = report.CompletedBy.LastName
+	}
+	for i, task := range report.Tasks {
+		response.Tasks[i] = SprintReportTaskResponse{
+			TaskID:  task.TaskID,
+			Title:   task.Title,
+			Status:  task.Status,
+			Outcome: task.Outcome,
+			StoryPoints: task.StoryPoints,
+		}
+	}
+	return response
+}
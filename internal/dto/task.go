@@ -3,6 +3,7 @@ package dto
 import (
 	"time"
 
+	"lqkhoi-go-http-api/internal/filter"
 	"lqkhoi-go-http-api/internal/models"
 )
 
@@ -12,18 +13,31 @@ type CreateTaskRequest struct {
 	Title       string              `json:"title" validate:"required,min=2,max=255" example:"Implement login API"`
 	// Description is an optional detailed description of the task.
 	Description string              `json:"description" validate:"omitempty,max=65535" example:"Create a RESTful endpoint for user authentication."`
-	// SprintID is the ID of the sprint this task belongs to.
-	SprintID    int                 `json:"sprint_id" validate:"required,min=1" example:"1"`
+	// ProjectID is the ID of the project this task belongs to. Required when
+	// neither a sprint nor a parent task is given, i.e. for backlog tasks.
+	ProjectID   *int                `json:"project_id,omitempty" validate:"required_without_all=SprintID ParentTaskID,omitempty,min=1" example:"1"`
+	// SprintID is the optional ID of the sprint this task belongs to; omit it to put the task in the backlog.
+	SprintID    *int                `json:"sprint_id,omitempty" validate:"omitempty,min=1" example:"1"`
 	// Status is the current status of the task.
 	Status      models.TaskStatus   `json:"status" validate:"omitempty,oneof=TO_DO IN_PROGRESS REVIEW DONE BLOCKED" example:"TO_DO"`
 	// Priority is the priority level of the task.
 	Priority    models.TaskPriority `json:"priority" validate:"omitempty,oneof=HIGH MEDIUM LOW CRITICAL" example:"HIGH"`
 	// DueDate is the optional due date of the task.
 	DueDate     *time.Time          `json:"due_date,omitempty" validate:"omitempty" example:"2025-04-20T00:00:00Z"`
+	// ParentTaskID is the optional ID of the task this task is a subtask of.
+	ParentTaskID *int               `json:"parent_task_id,omitempty" validate:"omitempty,min=1" example:"100"`
+	// StoryPoints is the optional relative size of the task.
+	StoryPoints              *int `json:"story_points,omitempty" validate:"omitempty,min=0,max=100" example:"5"`
+	// OriginalEstimateMinutes is the optional time estimate in minutes.
+	OriginalEstimateMinutes  *int `json:"original_estimate_minutes,omitempty" validate:"omitempty,min=0,max=100000" example:"480"`
+	// RemainingEstimateMinutes is the optional remaining time in minutes; defaults to the original estimate.
+	RemainingEstimateMinutes *int `json:"remaining_estimate_minutes,omitempty" validate:"omitempty,min=0,max=100000" example:"480"`
+	// OverrideWipLimit lets a project manager create the task past the WIP limit of its status.
+	OverrideWipLimit         bool `json:"override_wip_limit,omitempty" example:"false"`
 }
 
 func (ctr *CreateTaskRequest) MapToTask() *models.Task {
-	return &models.Task{
+	task := &models.Task{
 		Title:       ctr.Title,
 		Description: ctr.Description,
 		ProjectID:   0,
@@ -31,7 +45,19 @@ func (ctr *CreateTaskRequest) MapToTask() *models.Task {
 		Status:      ctr.Status,
 		Priority:    ctr.Priority,
 		DueDate:     ctr.DueDate,
+		ParentTaskID: ctr.ParentTaskID,
+		StoryPoints:              ctr.StoryPoints,
+		OriginalEstimateMinutes:  ctr.OriginalEstimateMinutes,
+		RemainingEstimateMinutes: ctr.RemainingEstimateMinutes,
 	}
+	if ctr.ProjectID != nil {
+		task.ProjectID = *ctr.ProjectID
+	}
+	if task.RemainingEstimateMinutes == nil && task.OriginalEstimateMinutes != nil {
+		remaining := *task.OriginalEstimateMinutes
+		task.RemainingEstimateMinutes = &remaining
+	}
+	return task
 }
 
 // TaskResponse represents the response body for detailed task information.
@@ -52,16 +78,36 @@ type TaskResponse struct {
 	ProjectID         int                 `json:"project_id" example:"1"`
 	// ProjectName is the name of the project this task belongs to.
 	ProjectName       string              `json:"project_name" example:"Website Redesign"`
-	// SprintID is the ID of the sprint this task belongs to.
-	SprintID          int                 `json:"sprint_id" example:"1"`
-	// SprintName is the name of the sprint this task belongs to.
-	SprintName        string              `json:"sprint_name" example:"Sprint 1"`
+	// SprintID is the ID of the sprint this task belongs to; omitted for backlog tasks.
+	SprintID          *int                `json:"sprint_id,omitempty" example:"1"`
+	// SprintName is the name of the sprint this task belongs to; omitted for backlog tasks.
+	SprintName        string              `json:"sprint_name,omitempty" example:"Sprint 1"`
 	// Status is the current status of the task.
 	Status            models.TaskStatus   `json:"status" example:"IN_PROGRESS"`
 	// Priority is the priority level of the task.
 	Priority          models.TaskPriority `json:"priority" example:"HIGH"`
 	// DueDate is the optional due date of the task.
 	DueDate           *time.Time          `json:"due_date,omitempty" example:"2025-04-20T00:00:00Z"`
+	// ParentTaskID is the optional ID of the parent task.
+	ParentTaskID      *int                `json:"parent_task_id,omitempty" example:"100"`
+	// Rank orders the task within its board column.
+	Rank              string              `json:"rank" example:"i"`
+	// StoryPoints is the optional relative size of the task.
+	StoryPoints       *int                `json:"story_points,omitempty" example:"5"`
+	// OriginalEstimateMinutes is the optional time estimate in minutes.
+	OriginalEstimateMinutes  *int         `json:"original_estimate_minutes,omitempty" example:"480"`
+	// RemainingEstimateMinutes is the optional remaining time in minutes.
+	RemainingEstimateMinutes *int         `json:"remaining_estimate_minutes,omitempty" example:"120"`
+	// SubtaskCount is the number of direct subtasks.
+	SubtaskCount      int                 `json:"subtask_count,omitempty" example:"3"`
+	// CompletedSubtaskCount is the number of direct subtasks that are done.
+	CompletedSubtaskCount int             `json:"completed_subtask_count,omitempty" example:"1"`
+	// Subtasks is the tree of subtasks below this task (optional).
+	Subtasks          []TaskResponse      `json:"subtasks,omitempty"`
+	// Links lists the links of the task to other tasks (optional).
+	Links             []TaskLinkResponse  `json:"links,omitempty"`
+	// Labels lists the labels of the task (optional).
+	Labels            []LabelResponse     `json:"labels,omitempty"`
 }
 
 func MapToTaskResponse(task *models.Task) *TaskResponse {
@@ -70,7 +116,7 @@ func MapToTaskResponse(task *models.Task) *TaskResponse {
 	response.Title = task.Title
 	response.Description = task.Description
 
-	if task.AssigneeID != nil {
+	if task.AssigneeID != nil && task.Assignee != nil {
 		response.AssigneeFirstName = &task.Assignee.FirstName
 		response.AssigneeLastName = &task.Assignee.LastName
 	}
@@ -80,7 +126,7 @@ func MapToTaskResponse(task *models.Task) *TaskResponse {
 
 	if task.Project != nil {
 		response.ProjectName = task.Project.Name
-	} else if task.Sprint != nil {
+	} else if task.Sprint != nil && task.Sprint.Project != nil {
 		response.ProjectName = task.Sprint.Project.Name
 	} else {
 		response.ProjectName = "" //something wrong if reach this
@@ -88,13 +134,34 @@ func MapToTaskResponse(task *models.Task) *TaskResponse {
 
 	if task.Sprint != nil {
 		response.SprintName = task.Sprint.Name
-	} else {
-		response.SprintName = "" //something wrong if reach this
 	}
 
 	response.Status = task.Status
 	response.Priority = task.Priority
 	response.DueDate = task.DueDate
+	response.ParentTaskID = task.ParentTaskID
+	response.Rank = task.Rank
+	response.StoryPoints = task.StoryPoints
+	response.OriginalEstimateMinutes = task.OriginalEstimateMinutes
+	response.RemainingEstimateMinutes = task.RemainingEstimateMinutes
+	response.Labels = mapTaskLabels(task.TaskLabels)
+
+	if len(task.Links) > 0 {
+		response.Links = MapToSliceOfTaskLinkResponse(task.Links, task.ID)
+	}
+
+	if len(task.Subtasks) == 0 {
+		return response
+	}
+
+	response.SubtaskCount = len(task.Subtasks)
+	response.Subtasks = make([]TaskResponse, len(task.Subtasks))
+	for i := range task.Subtasks {
+		if task.Subtasks[i].Status == models.DoneTask {
+			response.CompletedSubtaskCount++
+		}
+		response.Subtasks[i] = *MapToTaskResponse(&task.Subtasks[i])
+	}
 
 	return response
 }
@@ -107,8 +174,8 @@ type TaskInSliceResponse struct {
 	Title             string              `json:"title" example:"Implement login API"`
 	// Description is the detailed description of the task.
 	Description       string              `json:"description" example:"Create a RESTful endpoint for user authentication."`
-	// SprintID is the ID of the sprint this task belongs to.
-	SprintID          int                 `json:"sprint_id" example:"1"`
+	// SprintID is the ID of the sprint this task belongs to; omitted for backlog tasks.
+	SprintID          *int                `json:"sprint_id,omitempty" example:"1"`
 	// ProjectID is the ID of the project this task belongs to.
 	ProjectID         int                 `json:"project_id" example:"1"`
 	// AssigneeID is the optional ID of the user assigned to the task.
@@ -123,6 +190,16 @@ type TaskInSliceResponse struct {
 	Priority          models.TaskPriority `json:"priority" example:"HIGH"`
 	// DueDate is the optional due date of the task.
 	DueDate           *time.Time          `json:"due_date,omitempty" example:"2025-04-20T00:00:00Z"`
+	// ParentTaskID is the optional ID of the parent task.
+	ParentTaskID      *int                `json:"parent_task_id,omitempty" example:"100"`
+	// Rank orders the task within its board column.
+	Rank              string              `json:"rank" example:"i"`
+	// StoryPoints is the optional relative size of the task.
+	StoryPoints       *int                `json:"story_points,omitempty" example:"5"`
+	// RemainingEstimateMinutes is the optional remaining time in minutes.
+	RemainingEstimateMinutes *int         `json:"remaining_estimate_minutes,omitempty" example:"120"`
+	// Labels lists the labels of the task (optional).
+	Labels            []LabelResponse     `json:"labels,omitempty"`
 }
 
 func MapToSliceOfTaskResponse(tasks []*models.Task) []TaskInSliceResponse {
@@ -142,6 +219,11 @@ func MapToSliceOfTaskResponse(tasks []*models.Task) []TaskInSliceResponse {
 		res[i].DueDate = task.DueDate
 		res[i].AssigneeID = task.AssigneeID
 		res[i].ProjectID = task.ProjectID
+		res[i].ParentTaskID = task.ParentTaskID
+		res[i].Rank = task.Rank
+		res[i].StoryPoints = task.StoryPoints
+		res[i].RemainingEstimateMinutes = task.RemainingEstimateMinutes
+		res[i].Labels = mapTaskLabels(task.TaskLabels)
 
 		if task.Assignee != nil {
 			res[i].AssigneeFirstName = &task.Assignee.FirstName
@@ -163,6 +245,16 @@ type UpdateTaskRequest struct {
 	Priority    *models.TaskPriority `json:"priority" validate:"omitempty,oneof=HIGH MEDIUM LOW CRITICAL" example:"MEDIUM"`
 	// DueDate is the optional new due date of the task.
 	DueDate     *time.Time           `json:"due_date,omitempty" validate:"omitempty" example:"2025-04-25T00:00:00Z"`
+	// ParentTaskID is the optional new parent task ID; 0 detaches the task from its parent.
+	ParentTaskID *int                `json:"parent_task_id,omitempty" validate:"omitempty,min=0" example:"100"`
+	// StoryPoints is the optional new relative size of the task.
+	StoryPoints              *int    `json:"story_points,omitempty" validate:"omitempty,min=0,max=100" example:"8"`
+	// OriginalEstimateMinutes is the optional new time estimate in minutes.
+	OriginalEstimateMinutes  *int    `json:"original_estimate_minutes,omitempty" validate:"omitempty,min=0,max=100000" example:"600"`
+	// RemainingEstimateMinutes is the optional new remaining time in minutes.
+	RemainingEstimateMinutes *int    `json:"remaining_estimate_minutes,omitempty" validate:"omitempty,min=0,max=100000" example:"240"`
+	// OverrideWipLimit lets a project manager move the task past the WIP limit of its new status.
+	OverrideWipLimit         bool    `json:"override_wip_limit,omitempty" example:"false"`
 }
 
 // TaskFilter represents filtering options for querying tasks.
@@ -177,4 +269,30 @@ type TaskFilter struct {
 	Priority      *models.TaskPriority
 	// DueDateBefore is the optional due date to filter tasks due before.
 	DueDateBefore *time.Time
-}
\ No newline at end of file
+	// StoryPointsMin is the optional lower bound of story points.
+	StoryPointsMin *int
+	// StoryPointsMax is the optional upper bound of story points.
+	StoryPointsMax *int
+	// Estimated optionally keeps only tasks with (true) or without (false) story points.
+	Estimated      *bool
+	// LabelIDs optionally keeps only tasks carrying the given labels.
+	LabelIDs       []int
+	// LabelMatchAll requires every label of LabelIDs instead of any of them.
+	LabelMatchAll  bool
+	// Expression is the optional parsed filter expression, combined with the other filters.
+	Expression     filter.Expr
+	// CurrentUserID is the requesting user, who "me" stands for in Expression.
+	CurrentUserID  int
+	// MemberUserID optionally keeps only tasks of the projects this user is a member of.
+	MemberUserID   *int
+}
+
+// FilterExpressionError locates the offending token of an invalid filter expression.
+type FilterExpressionError struct {
+	// Position is the 1-based character position of the offending token.
+	Position int    `json:"position" example:"17"`
+	// Token is the offending text; empty when the expression ended early.
+	Token    string `json:"token" example:"URGENT"`
+	// Message describes what is wrong.
+	Message  string `json:"message" example:"unknown priority"`
+}
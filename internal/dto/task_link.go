@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"lqkhoi-go-http-api/internal/models"
+)
+
+// CreateTaskLinkRequest represents the request body for linking a task to another task.
+type CreateTaskLinkRequest struct {
+	// TargetTaskID is the ID of the linked task; the task of the path blocks, relates to or duplicates it.
+	TargetTaskID int                 `json:"target_task_id" validate:"required,min=1" example:"102"`
+	// Type is the type of the link.
+	Type         models.TaskLinkType `json:"type" validate:"required,oneof=BLOCKS RELATES_TO DUPLICATES" example:"BLOCKS"`
+}
+
+// TaskLinkResponse represents a link as seen from one of its tasks.
+type TaskLinkResponse struct {
+	// ID is the unique identifier of the link.
+	ID         int                 `json:"id" example:"7"`
+	// Type is the type of the link.
+	Type       models.TaskLinkType `json:"type" example:"BLOCKS"`
+	// Relation reads the link from the task: blocks, is blocked by, relates to, duplicates or is duplicated by.
+	Relation   string              `json:"relation" example:"is blocked by"`
+	// TaskID is the ID of the other task of the link.
+	TaskID     int                 `json:"task_id" example:"102"`
+	// TaskTitle is the title of the other task.
+	TaskTitle  string              `json:"task_title,omitempty" example:"Design login page"`
+	// TaskStatus is the status of the other task.
+	TaskStatus models.TaskStatus   `json:"task_status,omitempty" example:"IN_PROGRESS"`
+}
+
+// MapToTaskLinkResponse maps link as seen from the task with taskID, which
+// is either its source or its target.
+func MapToTaskLinkResponse(link *models.TaskLink, taskID int) *TaskLinkResponse {
+	response := &TaskLinkResponse{
+		ID:   link.ID,
+		Type: link.Type,
+	}
+
+	other := link.TargetTask
+	response.TaskID = link.TargetTaskID
+	inward := link.SourceTaskID != taskID
+	if inward {
+		other = link.SourceTask
+		response.TaskID = link.SourceTaskID
+	}
+	if other != nil {
+		response.TaskTitle = other.Title
+		response.TaskStatus = other.Status
+	}
+
+	switch link.Type {
+	case models.BlocksLink:
+		response.Relation = "blocks"
+		if inward {
+			response.Relation = "is blocked by"
+		}
+	case models.DuplicatesLink:
+		response.Relation = "duplicates"
+		if inward {
+			response.Relation = "is duplicated by"
+		}
+	default:
+		response.Relation = "relates to"
+	}
+	return response
+}
+
+func MapToSliceOfTaskLinkResponse(links []*models.TaskLink, taskID int) []TaskLinkResponse {
+	res := make([]TaskLinkResponse, len(links))
+	for i, link := range links {
+		res[i] = *MapToTaskLinkResponse(link, taskID)
+	}
+	return res
+}
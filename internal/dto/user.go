@@ -36,6 +36,12 @@ type LoginRequest struct {
 	Password string `json:"password" validate:"required,min=8" example:"securepassword123"`
 }
 
+// RefreshTokenRequest represents the request body for renewing a token pair.
+type RefreshTokenRequest struct {
+	// RefreshToken is the refresh token received from the last login or refresh.
+	RefreshToken string `json:"refresh_token" validate:"required" example:"random-refresh-token"`
+}
+
 // UserResponse represents the response body for user details.
 type UserResponse struct {
 	// ID is the unique identifier of the user.
@@ -52,6 +58,12 @@ type UserResponse struct {
 	CurrentProjectID   int    `json:"current_project_id,omitempty" example:"1"`
 	// CurrentProjectName is the optional name of the user's current project.
 	CurrentProjectName string `json:"current_project_name,omitempty" example:"Website Redesign"`
+	// EmailOnAssignment tells whether the user is emailed when assigned to a task.
+	EmailOnAssignment  bool   `json:"email_on_assignment" example:"true"`
+	// EmailOnDueSoon tells whether the user is emailed when an assigned task is due soon.
+	EmailOnDueSoon     bool   `json:"email_on_due_soon" example:"true"`
+	// EmailOnSprintStart tells whether the user is emailed when a sprint of their projects starts.
+	EmailOnSprintStart bool   `json:"email_on_sprint_start" example:"true"`
 }
 
 func MapToUserDto(user *models.User) *UserResponse {
@@ -61,6 +73,9 @@ func MapToUserDto(user *models.User) *UserResponse {
 	ur.Role = string(user.Role)
 	ur.FirstName = user.FirstName
 	ur.LastName = user.LastName
+	ur.EmailOnAssignment = user.EmailOnAssignment
+	ur.EmailOnDueSoon = user.EmailOnDueSoon
+	ur.EmailOnSprintStart = user.EmailOnSprintStart
 	if user.CurrentProjectID != nil {
 		ur.CurrentProjectID = *user.CurrentProjectID
 	}
@@ -85,4 +100,18 @@ type UpdateUserRequest struct {
 	FirstName *string `json:"first_name,omitempty" validate:"omitempty,min=2,max=100" example:"Johnny"`
 	// LastName is the optional new last name of the user.
 	LastName  *string `json:"last_name,omitempty" validate:"omitempty,min=2,max=100" example:"Smith"`
+	// EmailOnAssignment optionally turns the task assignment emails on or off.
+	EmailOnAssignment  *bool `json:"email_on_assignment,omitempty" example:"false"`
+	// EmailOnDueSoon optionally turns the due date reminder emails on or off.
+	EmailOnDueSoon     *bool `json:"email_on_due_soon,omitempty" example:"true"`
+	// EmailOnSprintStart optionally turns the sprint start emails on or off.
+	EmailOnSprintStart *bool `json:"email_on_sprint_start,omitempty" example:"true"`
+}
+
+// ChangePasswordRequest represents the request body for changing a user's password.
+type ChangePasswordRequest struct {
+	// CurrentPassword is the password the user currently logs in with.
+	CurrentPassword string `json:"current_password" validate:"required" example:"securepassword123"`
+	// NewPassword is the password that replaces the current one.
+	NewPassword     string `json:"new_password" validate:"required,min=8,nefield=CurrentPassword" example:"evenmoresecure456"`
 }
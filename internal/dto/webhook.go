@@ -0,0 +1,142 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+// CreateWebhookRequest represents the request body for subscribing a URL to events of a project.
+type CreateWebhookRequest struct {
+	// URL receives the events as signed JSON POST requests.
+	URL    string   `json:"url" validate:"required,http_url,max=500" example:"https://ci.example.com/hooks/tasks"`
+	// Events lists the event types to deliver.
+	Events []string `json:"events" validate:"required,min=1,dive,oneof=task.created task.updated task.status_changed task.assigned task.deleted task.due_soon sprint.created sprint.updated sprint.started sprint.completed sprint.deleted project.updated project.member_added project.member_removed" example:"task.created,task.status_changed"`
+	// Secret optionally sets the key of the payload signatures; one is generated when it is empty.
+	Secret string   `json:"secret,omitempty" validate:"omitempty,min=16,max=100" example:"3f1b0c9e2d7a4e6f8a1b2c3d4e5f6a7b"`
+}
+
+func (cwr *CreateWebhookRequest) MapToWebhook() *models.Webhook {
+	return &models.Webhook{
+		URL:    cwr.URL,
+		Events: cwr.Events,
+		Secret: cwr.Secret,
+		Active: true,
+	}
+}
+
+// UpdateWebhookRequest represents the request body for updating a webhook.
+type UpdateWebhookRequest struct {
+	// URL is the optional new receiving URL.
+	URL    *string  `json:"url,omitempty" validate:"omitempty,http_url,max=500" example:"https://ci.example.com/hooks/v2/tasks"`
+	// Events optionally replaces the delivered event types.
+	Events []string `json:"events,omitempty" validate:"omitempty,min=1,dive,oneof=task.created task.updated task.status_changed task.assigned task.deleted task.due_soon sprint.created sprint.updated sprint.started sprint.completed sprint.deleted project.updated project.member_added project.member_removed" example:"sprint.completed"`
+	// Active optionally pauses (false) or resumes (true) the deliveries.
+	Active *bool    `json:"active,omitempty" example:"false"`
+}
+
+// WebhookResponse represents a webhook subscription.
+type WebhookResponse struct {
+	// ID is the unique identifier of the webhook.
+	ID          int       `json:"id" example:"2"`
+	// ProjectID is the project whose events are delivered.
+	ProjectID   int       `json:"project_id" example:"1"`
+	// URL receives the events.
+	URL         string    `json:"url" example:"https://ci.example.com/hooks/tasks"`
+	// Events lists the delivered event types.
+	Events      []string  `json:"events" example:"task.created,task.status_changed"`
+	// Active tells whether events are delivered.
+	Active      bool      `json:"active" example:"true"`
+	// Secret is the key of the payload signatures, only returned when the webhook is created.
+	Secret      string    `json:"secret,omitempty" example:"3f1b0c9e2d7a4e6f8a1b2c3d4e5f6a7b"`
+	// CreatedByID is the ID of the user who created the webhook.
+	CreatedByID int       `json:"created_by_id" example:"5"`
+	// CreatedAt is the time the webhook was created.
+	CreatedAt   time.Time `json:"created_at" example:"2025-04-10T09:00:00Z"`
+	// UpdatedAt is the time the webhook was last changed.
+	UpdatedAt   time.Time `json:"updated_at" example:"2025-04-12T15:30:00Z"`
+}
+
+func MapToWebhookResponse(webhook *models.Webhook) *WebhookResponse {
+	return &WebhookResponse{
+		ID:          webhook.ID,
+		ProjectID:   webhook.ProjectID,
+		URL:         webhook.URL,
+		Events:      webhook.Events,
+		Active:      webhook.Active,
+		CreatedByID: webhook.CreatedByID,
+		CreatedAt:   webhook.CreatedAt,
+		UpdatedAt:   webhook.UpdatedAt,
+	}
+}
+
+func MapToSliceOfWebhookResponse(webhooks []*models.Webhook) []WebhookResponse {
+	res := make([]WebhookResponse, len(webhooks))
+	for i, webhook := range webhooks {
+		res[i] = *MapToWebhookResponse(webhook)
+	}
+	return res
+}
+
+// WebhookDeliveryResponse represents an entry of the delivery log of a webhook.
+type WebhookDeliveryResponse struct {
+	// ID is the unique identifier of the delivery, also sent in the X-Webhook-Delivery header.
+	ID             int             `json:"id" example:"31"`
+	// WebhookID is the webhook the event is delivered to.
+	WebhookID      int             `json:"webhook_id" example:"2"`
+	// EventID identifies the event; redeliveries keep it so receivers can skip duplicates.
+	EventID        string          `json:"event_id" example:"8f14e45f-ceea-467a-9575-6e3c2a1b7d10"`
+	// EventType is the type of the event.
+	EventType      string          `json:"event_type" example:"task.status_changed"`
+	// Payload is the JSON body sent.
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	// Status is PENDING until the delivery succeeded or ran out of attempts.
+	Status         models.WebhookDeliveryStatus `json:"status" example:"SUCCEEDED"`
+	// Attempts is the number of attempts made.
+	Attempts       int             `json:"attempts" example:"2"`
+	// NextAttemptAt is the time of the next attempt of a pending delivery.
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty" example:"2025-04-10T09:01:00Z"`
+	// LastAttemptAt is the time of the last attempt.
+	LastAttemptAt  *time.Time      `json:"last_attempt_at,omitempty" example:"2025-04-10T09:00:30Z"`
+	// ResponseStatus is the HTTP status answered to the last attempt.
+	ResponseStatus *int            `json:"response_status,omitempty" example:"200"`
+	// ResponseBody is the beginning of the body answered to the last attempt.
+	ResponseBody   string          `json:"response_body,omitempty" example:"ok"`
+	// Error describes why the last attempt failed.
+	Error          string          `json:"error,omitempty" example:"receiver answered with status 502"`
+	// DurationMs is how long the last attempt took.
+	DurationMs     int             `json:"duration_ms" example:"184"`
+	// RedeliveryOfID is the delivery this one redelivers, if any.
+	RedeliveryOfID *int            `json:"redelivery_of_id,omitempty" example:"30"`
+	// CreatedAt is the time the delivery was queued.
+	CreatedAt      time.Time       `json:"created_at" example:"2025-04-10T09:00:00Z"`
+}
+
+func MapToWebhookDeliveryResponse(delivery *models.WebhookDelivery) *WebhookDeliveryResponse {
+	return &WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Payload:        json.RawMessage(delivery.Payload),
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		Error:          delivery.Error,
+		DurationMs:     delivery.DurationMs,
+		RedeliveryOfID: delivery.RedeliveryOfID,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+func MapToSliceOfWebhookDeliveryResponse(deliveries []*models.WebhookDelivery) []WebhookDeliveryResponse {
+	res := make([]WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		res[i] = *MapToWebhookDeliveryResponse(delivery)
+	}
+	return res
+}
@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"lqkhoi-go-http-api/internal/models"
+)
+
+// WipLimitRequest represents the WIP limit of one status.
+type WipLimitRequest struct {
+	// Status is the status whose board column is limited.
+	Status              models.TaskStatus `json:"status" validate:"required,oneof=TO_DO IN_PROGRESS REVIEW DONE BLOCKED" example:"IN_PROGRESS"`
+	// MaxTasks is the optional number of tasks a board column of the status may hold.
+	MaxTasks            *int              `json:"max_tasks,omitempty" validate:"omitempty,min=1" example:"5"`
+	// MaxTasksPerAssignee is the optional number of tasks of the column one assignee may hold.
+	MaxTasksPerAssignee *int              `json:"max_tasks_per_assignee,omitempty" validate:"omitempty,min=1" example:"2"`
+}
+
+// UpdateWipLimitsRequest represents the request body for replacing the WIP
+// limits of a project. An empty list lifts every limit.
+type UpdateWipLimitsRequest struct {
+	// Limits is the complete list of WIP limits, at most one per status.
+	Limits []WipLimitRequest `json:"limits" validate:"dive"`
+}
+
+func (uwr *UpdateWipLimitsRequest) MapToWipLimits() []*models.WipLimit {
+	limits := make([]*models.WipLimit, len(uwr.Limits))
+	for i, l := range uwr.Limits {
+		limits[i] = &models.WipLimit{
+			Status:              l.Status,
+			MaxTasks:            l.MaxTasks,
+			MaxTasksPerAssignee: l.MaxTasksPerAssignee,
+		}
+	}
+	return limits
+}
+
+// WipLimitResponse represents the WIP limit of one status.
+type WipLimitResponse struct {
+	// Status is the status whose board column is limited.
+	Status              models.TaskStatus `json:"status" example:"IN_PROGRESS"`
+	// MaxTasks is the number of tasks a board column of the status may hold; omitted when unlimited.
+	MaxTasks            *int              `json:"max_tasks,omitempty" example:"5"`
+	// MaxTasksPerAssignee is the number of tasks of the column one assignee may hold; omitted when unlimited.
+	MaxTasksPerAssignee *int              `json:"max_tasks_per_assignee,omitempty" example:"2"`
+}
+
+// WipLimitsResponse represents the WIP limits of a project.
+type WipLimitsResponse struct {
+	// ProjectID is the ID of the project.
+	ProjectID int                `json:"project_id" example:"1"`
+	// Limits lists the WIP limits; statuses without one are unlimited.
+	Limits    []WipLimitResponse `json:"limits"`
+}
+
+func MapToWipLimitsResponse(projectID int, limits []*models.WipLimit) *WipLimitsResponse {
+	response := &WipLimitsResponse{
+		ProjectID: projectID,
+		Limits:    make([]WipLimitResponse, len(limits)),
+	}
+	for i, l := range limits {
+		response.Limits[i] = WipLimitResponse{
+			Status:              l.Status,
+			MaxTasks:            l.MaxTasks,
+			MaxTasksPerAssignee: l.MaxTasksPerAssignee,
+		}
+	}
+	return response
+}
@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/pkg/structs"
+)
+
+// WorkflowTransitionRequest represents one allowed status change of a workflow.
+type WorkflowTransitionRequest struct {
+	// From is the status the task is in.
+	From  models.TaskStatus          `json:"from" validate:"required,oneof=TO_DO IN_PROGRESS REVIEW DONE BLOCKED" example:"TO_DO"`
+	// To is the status the task may move to.
+	To    models.TaskStatus          `json:"to" validate:"required,oneof=TO_DO IN_PROGRESS REVIEW DONE BLOCKED,nefield=From" example:"IN_PROGRESS"`
+	// Roles lists the project roles allowed to make the transition.
+	Roles []models.ProjectMemberRole `json:"roles" validate:"required,min=1,dive,oneof=MANAGER MEMBER VIEWER" example:"MANAGER,MEMBER"`
+}
+
+// UpdateWorkflowRequest represents the request body for replacing the
+// workflow of a project. An empty list restores the default workflow.
+type UpdateWorkflowRequest struct {
+	// Transitions is the complete list of allowed status changes.
+	Transitions []WorkflowTransitionRequest `json:"transitions" validate:"dive"`
+}
+
+func (uwr *UpdateWorkflowRequest) MapToWorkflowTransitions() []*models.WorkflowTransition {
+	transitions := make([]*models.WorkflowTransition, 0, len(uwr.Transitions))
+	for _, t := range uwr.Transitions {
+		for _, role := range t.Roles {
+			transitions = append(transitions, &models.WorkflowTransition{
+				FromStatus: t.From,
+				ToStatus:   t.To,
+				Role:       role,
+			})
+		}
+	}
+	return transitions
+}
+
+// WorkflowTransitionResponse represents one allowed status change of a workflow.
+type WorkflowTransitionResponse struct {
+	// From is the status the task is in.
+	From  models.TaskStatus          `json:"from" example:"TO_DO"`
+	// To is the status the task may move to.
+	To    models.TaskStatus          `json:"to" example:"IN_PROGRESS"`
+	// Roles lists the project roles allowed to make the transition.
+	Roles []models.ProjectMemberRole `json:"roles" example:"MANAGER,MEMBER"`
+}
+
+// WorkflowResponse represents the workflow of a project.
+type WorkflowResponse struct {
+	// ProjectID is the ID of the project.
+	ProjectID   int                          `json:"project_id" example:"1"`
+	// IsDefault tells whether the project uses the default workflow.
+	IsDefault   bool                         `json:"is_default" example:"false"`
+	// Transitions lists the allowed status changes.
+	Transitions []WorkflowTransitionResponse `json:"transitions"`
+}
+
+// MapToWorkflowResponse groups the per-role transitions of a project by
+// status change, keeping the order in which they first appear.
+func MapToWorkflowResponse(projectID int, isDefault bool, transitions []*models.WorkflowTransition) *WorkflowResponse {
+	type statusPair struct {
+		from, to models.TaskStatus
+	}
+
+	response := &WorkflowResponse{
+		ProjectID:   projectID,
+		IsDefault:   isDefault,
+		Transitions: make([]WorkflowTransitionResponse, 0),
+	}
+	index := make(map[statusPair]int)
+	for _, t := range transitions {
+		pair := statusPair{t.FromStatus, t.ToStatus}
+		i, ok := index[pair]
+		if !ok {
+			i = len(response.Transitions)
+			index[pair] = i
+			response.Transitions = append(response.Transitions, WorkflowTransitionResponse{From: t.FromStatus, To: t.ToStatus})
+		}
+		response.Transitions[i].Roles = append(response.Transitions[i].Roles, t.Role)
+	}
+	return response
+}
+
+// ChangeTaskStatusRequest represents the request body for moving a task to
+// another status.
+type ChangeTaskStatusRequest struct {
+	// Status is the new status of the task.
+	Status           models.TaskStatus `json:"status" validate:"required,oneof=TO_DO IN_PROGRESS REVIEW DONE BLOCKED" example:"IN_PROGRESS"`
+	// OverrideWipLimit lets a project manager move the task past the WIP limit of the status.
+	OverrideWipLimit bool              `json:"override_wip_limit,omitempty" example:"false"`
+}
+
+// StatusTransitionErrorDetails describes a status change rejected by the
+// workflow of the project.
+type StatusTransitionErrorDetails struct {
+	// Error is the human readable reason.
+	Error           string              `json:"error" example:"status transition is not allowed by the project workflow: cannot move task from TO_DO to DONE, allowed next statuses: IN_PROGRESS, BLOCKED"`
+	// From is the current status of the task.
+	From            models.TaskStatus   `json:"from" example:"TO_DO"`
+	// To is the rejected status.
+	To              models.TaskStatus   `json:"to" example:"DONE"`
+	// AllowedStatuses lists the statuses the task may move to instead.
+	AllowedStatuses []models.TaskStatus `json:"allowed_statuses" example:"IN_PROGRESS,BLOCKED"`
+}
+
+func MapToStatusTransitionErrorDetails(err *structs.StatusTransitionError) StatusTransitionErrorDetails {
+	allowed := err.Allowed
+	if allowed == nil {
+		allowed = []models.TaskStatus{}
+	}
+	return StatusTransitionErrorDetails{
+		Error:           err.Error(),
+		From:            err.From,
+		To:              err.To,
+		AllowedStatuses: allowed,
+	}
+}
@@ -0,0 +1,184 @@
+package dto
+
+import (
+	"time"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+// CreateWorklogRequest represents the request body for logging time on a task.
+type CreateWorklogRequest struct {
+	// StartedAt is when the work started.
+	StartedAt time.Time `json:"started_at" validate:"required" example:"2025-04-21T09:00:00Z"`
+	// DurationMinutes is the time spent, in minutes.
+	DurationMinutes int `json:"duration_minutes" validate:"required,min=1,max=1440" example:"90"`
+	// Note is an optional description of the work done.
+	Note string `json:"note" validate:"omitempty,max=2000" example:"Wrote handler tests"`
+}
+
+func (cwr *CreateWorklogRequest) MapToWorklog() *models.Worklog {
+	return &models.Worklog{
+		StartedAt:       cwr.StartedAt,
+		DurationMinutes: cwr.DurationMinutes,
+		Note:            cwr.Note,
+	}
+}
+
+// UpdateWorklogRequest represents the request body for editing a worklog.
+type UpdateWorklogRequest struct {
+	// StartedAt is the optional new start of the work.
+	StartedAt *time.Time `json:"started_at,omitempty" validate:"omitempty" example:"2025-04-21T10:00:00Z"`
+	// DurationMinutes is the optional new time spent, in minutes.
+	DurationMinutes *int `json:"duration_minutes,omitempty" validate:"omitempty,min=1,max=1440" example:"60"`
+	// Note is the optional new description of the work done.
+	Note *string `json:"note,omitempty" validate:"omitempty,max=2000" example:"Wrote and fixed handler tests"`
+}
+
+// WorklogResponse represents time logged on a task.
+type WorklogResponse struct {
+	// ID is the unique identifier of the worklog.
+	ID int `json:"id" example:"5"`
+	// TaskID is the ID of the task the time was logged on.
+	TaskID int `json:"task_id" example:"101"`
+	// UserID is the ID of the user who logged the time.
+	UserID int `json:"user_id" example:"7"`
+	// UserFirstName is the first name of the user.
+	UserFirstName string `json:"user_first_name,omitempty" example:"John"`
+	// UserLastName is the last name of the user.
+	UserLastName string `json:"user_last_name,omitempty" example:"Doe"`
+	// StartedAt is when the work started.
+	StartedAt time.Time `json:"started_at" example:"2025-04-21T09:00:00Z"`
+	// DurationMinutes is the time spent, in minutes.
+	DurationMinutes int `json:"duration_minutes" example:"90"`
+	// Note is the description of the work done.
+	Note string `json:"note" example:"Wrote handler tests"`
+	// CreatedAt is the time the worklog was created.
+	CreatedAt time.Time `json:"created_at" example:"2025-04-21T10:35:00Z"`
+}
+
+func MapToWorklogResponse(worklog *models.Worklog) *WorklogResponse {
+	response := &WorklogResponse{
+		ID:              worklog.ID,
+		TaskID:          worklog.TaskID,
+		UserID:          worklog.UserID,
+		StartedAt:       worklog.StartedAt,
+		DurationMinutes: worklog.DurationMinutes,
+		Note:            worklog.Note,
+		CreatedAt:       worklog.CreatedAt,
+	}
+	if worklog.User != nil {
+		response.UserFirstName = worklog.User.FirstName
+		response.UserLastName = worklog.User.LastName
+	}
+	return response
+}
+
+func MapToSliceOfWorklogResponse(worklogs []*models.Worklog) []WorklogResponse {
+	res := make([]WorklogResponse, len(worklogs))
+	for i, worklog := range worklogs {
+		res[i] = *MapToWorklogResponse(worklog)
+	}
+	return res
+}
+
+// TimesheetEntry represents a worklog in a timesheet.
+type TimesheetEntry struct {
+	WorklogResponse
+	// ProjectID is the ID of the project of the task.
+	ProjectID int `json:"project_id" example:"1"`
+	// TaskTitle is the title of the task.
+	TaskTitle string `json:"task_title,omitempty" example:"Implement login API"`
+}
+
+// TimesheetDay is the time logged on one day of a timesheet.
+type TimesheetDay struct {
+	// Date is the day, at midnight UTC.
+	Date time.Time `json:"date" example:"2025-04-21T00:00:00Z"`
+	// TotalMinutes is the time logged on that day, in minutes.
+	TotalMinutes int `json:"total_minutes" example:"450"`
+}
+
+// TimesheetResponse represents the time a user logged over a date range.
+type TimesheetResponse struct {
+	// UserID is the ID of the user.
+	UserID int `json:"user_id" example:"7"`
+	// From is the first day of the range.
+	From time.Time `json:"from" example:"2025-04-21T00:00:00Z"`
+	// To is the last day of the range.
+	To time.Time `json:"to" example:"2025-04-25T00:00:00Z"`
+	// TotalMinutes is the time logged over the range, in minutes.
+	TotalMinutes int `json:"total_minutes" example:"2250"`
+	// Days lists the days with logged time, in date order.
+	Days []TimesheetDay `json:"days"`
+	// Worklogs lists the worklogs of the range, oldest first.
+	Worklogs []TimesheetEntry `json:"worklogs"`
+}
+
+// MapToTimesheetResponse groups the worklogs of a user by the UTC day they
+// started on. to is the last day included in the range.
+func MapToTimesheetResponse(userID int, from, to time.Time, worklogs []*models.Worklog) *TimesheetResponse {
+	response := &TimesheetResponse{
+		UserID:   userID,
+		From:     from,
+		To:       to,
+		Days:     make([]TimesheetDay, 0),
+		Worklogs: make([]TimesheetEntry, len(worklogs)),
+	}
+
+	for i, worklog := range worklogs {
+		entry := TimesheetEntry{
+			WorklogResponse: *MapToWorklogResponse(worklog),
+			ProjectID:       worklog.ProjectID,
+		}
+		if worklog.Task != nil {
+			entry.TaskTitle = worklog.Task.Title
+		}
+		response.Worklogs[i] = entry
+		response.TotalMinutes += worklog.DurationMinutes
+
+		started := worklog.StartedAt.UTC()
+		day := time.Date(started.Year(), started.Month(), started.Day(), 0, 0, 0, 0, time.UTC)
+		if n := len(response.Days); n > 0 && response.Days[n-1].Date.Equal(day) {
+			response.Days[n-1].TotalMinutes += worklog.DurationMinutes
+			continue
+		}
+		response.Days = append(response.Days, TimesheetDay{Date: day, TotalMinutes: worklog.DurationMinutes})
+	}
+	return response
+}
+
+// WorklogUserTotal is the time one user logged on a project.
+type WorklogUserTotal struct {
+	// UserID is the ID of the user.
+	UserID int `json:"user_id" example:"7"`
+	// TotalMinutes is the time logged, in minutes.
+	TotalMinutes int `json:"total_minutes" example:"1200"`
+}
+
+// ProjectWorklogTotalsResponse represents the time logged on a project over a date range.
+type ProjectWorklogTotalsResponse struct {
+	// ProjectID is the ID of the project.
+	ProjectID int `json:"project_id" example:"1"`
+	// From is the first day of the range.
+	From time.Time `json:"from" example:"2025-04-01T00:00:00Z"`
+	// To is the last day of the range.
+	To time.Time `json:"to" example:"2025-04-30T00:00:00Z"`
+	// TotalMinutes is the time logged on the project, in minutes.
+	TotalMinutes int `json:"total_minutes" example:"5400"`
+	// Users lists the time logged per user.
+	Users []WorklogUserTotal `json:"users"`
+}
+
+func MapToProjectWorklogTotalsResponse(projectID int, from, to time.Time, totals []models.WorklogTotal) *ProjectWorklogTotalsResponse {
+	response := &ProjectWorklogTotalsResponse{
+		ProjectID: projectID,
+		From:      from,
+		To:        to,
+		Users:     make([]WorklogUserTotal, len(totals)),
+	}
+	for i, total := range totals {
+		response.Users[i] = WorklogUserTotal{UserID: total.UserID, TotalMinutes: total.TotalMinutes}
+		response.TotalMinutes += total.TotalMinutes
+	}
+	return response
+}
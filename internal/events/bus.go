@@ -0,0 +1,98 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+// Publisher accepts events for asynchronous delivery.
+type Publisher interface {
+	Publish(ctx context.Context, events ...Event)
+}
+
+// Handler reacts to an event. Handlers run one event at a time on the
+// goroutine of the bus, so slow work belongs in their own queues.
+type Handler func(ctx context.Context, event Event)
+
+type queuedEvent struct {
+	ctx   context.Context
+	event Event
+}
+
+// Bus delivers published events to its subscribers in the background, so
+// publishers never wait for them.
+type Bus struct {
+	queue chan queuedEvent
+
+	mu       sync.RWMutex
+	handlers []Handler
+}
+
+// NewBus creates a bus that holds up to bufferSize undelivered events.
+func NewBus(bufferSize int) *Bus {
+	return &Bus{
+		queue: make(chan queuedEvent, bufferSize),
+	}
+}
+
+// Subscribe registers handler for every event published from now on.
+func (b *Bus) Subscribe(handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
+
+// Publish queues the events without blocking. The values of ctx, such as its
+// logger, are kept for the handlers but its cancellation is not. Events that
+// do not fit in the queue are dropped and logged.
+func (b *Bus) Publish(ctx context.Context, events ...Event) {
+	detached := context.WithoutCancel(ctx)
+	for _, event := range events {
+		select {
+		case b.queue <- queuedEvent{ctx: detached, event: event}:
+		default:
+			utils.LoggerFromContext(ctx).Error("Event queue is full, dropping event",
+				"component", "EventBus",
+				"event_id", event.ID,
+				"event_type", event.Type)
+		}
+	}
+}
+
+// Run delivers queued events to the subscribers until ctx is done.
+func (b *Bus) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case queued := <-b.queue:
+			b.dispatch(queued)
+		}
+	}
+}
+
+func (b *Bus) dispatch(queued queuedEvent) {
+	b.mu.RLock()
+	handlers := b.handlers
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		b.safeHandle(handler, queued)
+	}
+}
+
+// safeHandle keeps a panicking handler from stopping the bus.
+func (b *Bus) safeHandle(handler Handler, queued queuedEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			utils.LoggerFromContext(queued.ctx).Error("Event handler panicked",
+				"component", "EventBus",
+				"event_id", queued.event.ID,
+				"event_type", queued.event.Type,
+				"panic", r)
+		}
+	}()
+	handler(queued.ctx, queued.event)
+}
@@ -0,0 +1,159 @@
+// Package events derives domain events, such as a task changing status, from
+// the activity log and delivers them to in-process subscribers like webhooks.
+// Reminders, which no user action causes, are published as events too.
+package events
+
+import (
+	"slices"
+	"time"
+
+	"lqkhoi-go-http-api/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// Type names a kind of domain event.
+type Type string
+
+const (
+	TaskCreated          Type = "task.created"
+	TaskUpdated          Type = "task.updated"
+	TaskStatusChanged    Type = "task.status_changed"
+	TaskAssigned         Type = "task.assigned"
+	TaskDeleted          Type = "task.deleted"
+	TaskDueSoon          Type = "task.due_soon"
+	SprintCreated        Type = "sprint.created"
+	SprintUpdated        Type = "sprint.updated"
+	SprintStarted        Type = "sprint.started"
+	SprintCompleted      Type = "sprint.completed"
+	SprintDeleted        Type = "sprint.deleted"
+	ProjectUpdated       Type = "project.updated"
+	ProjectMemberAdded   Type = "project.member_added"
+	ProjectMemberRemoved Type = "project.member_removed"
+)
+
+// Types lists every event type.
+var Types = []Type{
+	TaskCreated, TaskUpdated, TaskStatusChanged, TaskAssigned, TaskDeleted, TaskDueSoon,
+	SprintCreated, SprintUpdated, SprintStarted, SprintCompleted, SprintDeleted,
+	ProjectUpdated, ProjectMemberAdded, ProjectMemberRemoved,
+}
+
+func (t Type) IsValid() bool {
+	return slices.Contains(Types, t)
+}
+
+// Change is the before/after value of a field touched by the event.
+type Change struct {
+	Field    string  `json:"field"`
+	OldValue *string `json:"old_value"`
+	NewValue *string `json:"new_value"`
+}
+
+// Event is something that happened to an entity of a project.
+type Event struct {
+	ID         string                    `json:"id"`
+	Type       Type                      `json:"type"`
+	OccurredAt time.Time                 `json:"occurred_at"`
+	ProjectID  int                       `json:"project_id"`
+	ActorID    int                       `json:"actor_id"`
+	EntityType models.ActivityEntityType `json:"entity_type"`
+	EntityID   int                       `json:"entity_id"`
+	Changes    []Change                  `json:"changes,omitempty"`
+}
+
+// Change returns the change of field, if the event touched it.
+func (e Event) Change(field string) (Change, bool) {
+	for _, change := range e.Changes {
+		if change.Field == field {
+			return change, true
+		}
+	}
+	return Change{}, false
+}
+
+// FromActivity derives the events described by a recorded activity. An
+// update yields a generic updated event, followed by a more specific one
+// when it changes a status.
+func FromActivity(activity *models.ActivityLog) []Event {
+	var types []Type
+	statusChange, changesStatus := findStatusChange(activity.Changes)
+
+	switch activity.EntityType {
+	case models.ActivityEntityTask:
+		switch activity.Action {
+		case models.ActivityCreate:
+			types = []Type{TaskCreated}
+		case models.ActivityAssign:
+			types = []Type{TaskAssigned}
+		case models.ActivityDelete:
+			types = []Type{TaskDeleted}
+		case models.ActivityUpdate:
+			types = []Type{TaskUpdated}
+			if changesStatus {
+				types = append(types, TaskStatusChanged)
+			}
+		}
+	case models.ActivityEntitySprint:
+		switch activity.Action {
+		case models.ActivityCreate:
+			types = []Type{SprintCreated}
+		case models.ActivityDelete:
+			types = []Type{SprintDeleted}
+		case models.ActivityUpdate:
+			types = []Type{SprintUpdated}
+			if changesStatus && statusChange.NewValue != nil {
+				switch models.SprintStatus(*statusChange.NewValue) {
+				case models.SprintActive:
+					types = append(types, SprintStarted)
+				case models.SprintClosed:
+					types = append(types, SprintCompleted)
+				}
+			}
+		}
+	case models.ActivityEntityProject:
+		if activity.Action == models.ActivityUpdate {
+			types = []Type{ProjectUpdated}
+		}
+	case models.ActivityEntityProjectMember:
+		switch activity.Action {
+		case models.ActivityCreate:
+			types = []Type{ProjectMemberAdded}
+		case models.ActivityDelete:
+			types = []Type{ProjectMemberRemoved}
+		}
+	}
+
+	occurredAt := activity.CreatedAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+	changes := make([]Change, len(activity.Changes))
+	for i, change := range activity.Changes {
+		changes[i] = Change{Field: change.Field, OldValue: change.OldValue, NewValue: change.NewValue}
+	}
+
+	derived := make([]Event, len(types))
+	for i, eventType := range types {
+		derived[i] = Event{
+			ID:         uuid.NewString(),
+			Type:       eventType,
+			OccurredAt: occurredAt,
+			ProjectID:  activity.ProjectID,
+			ActorID:    activity.ActorID,
+			EntityType: activity.EntityType,
+			EntityID:   activity.EntityID,
+			Changes:    changes,
+		}
+	}
+	return derived
+}
+
+func findStatusChange(changes []models.ActivityChange) (models.ActivityChange, bool) {
+	for _, change := range changes {
+		if change.Field == "status" {
+			return change, true
+		}
+	}
+	return models.ActivityChange{}, false
+}
@@ -0,0 +1,90 @@
+package events
+
+import (
+	"testing"
+
+	"lqkhoi-go-http-api/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func eventTypes(events []Event) []Type {
+	types := make([]Type, len(events))
+	for i, event := range events {
+		types[i] = event.Type
+	}
+	return types
+}
+
+func TestFromActivity(t *testing.T) {
+	active := string(models.SprintActive)
+	closed := string(models.SprintClosed)
+	done := "DONE"
+
+	tests := []struct {
+		name     string
+		activity *models.ActivityLog
+		want     []Type
+	}{
+		{
+			name:     "task created",
+			activity: &models.ActivityLog{EntityType: models.ActivityEntityTask, Action: models.ActivityCreate},
+			want:     []Type{TaskCreated},
+		},
+		{
+			name: "task update without status",
+			activity: &models.ActivityLog{EntityType: models.ActivityEntityTask, Action: models.ActivityUpdate,
+				Changes: []models.ActivityChange{{Field: "title"}}},
+			want: []Type{TaskUpdated},
+		},
+		{
+			name: "task status change",
+			activity: &models.ActivityLog{EntityType: models.ActivityEntityTask, Action: models.ActivityUpdate,
+				Changes: []models.ActivityChange{{Field: "status", NewValue: &done}}},
+			want: []Type{TaskUpdated, TaskStatusChanged},
+		},
+		{
+			name: "sprint started",
+			activity: &models.ActivityLog{EntityType: models.ActivityEntitySprint, Action: models.ActivityUpdate,
+				Changes: []models.ActivityChange{{Field: "status", NewValue: &active}}},
+			want: []Type{SprintUpdated, SprintStarted},
+		},
+		{
+			name: "sprint completed",
+			activity: &models.ActivityLog{EntityType: models.ActivityEntitySprint, Action: models.ActivityUpdate,
+				Changes: []models.ActivityChange{{Field: "status", NewValue: &closed}}},
+			want: []Type{SprintUpdated, SprintCompleted},
+		},
+		{
+			name:     "member removed",
+			activity: &models.ActivityLog{EntityType: models.ActivityEntityProjectMember, Action: models.ActivityDelete},
+			want:     []Type{ProjectMemberRemoved},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, eventTypes(FromActivity(tt.activity)))
+		})
+	}
+}
+
+func TestFromActivity_SharesActivityFields(t *testing.T) {
+	activity := &models.ActivityLog{
+		EntityType: models.ActivityEntityTask, Action: models.ActivityUpdate,
+		ProjectID: 3, ActorID: 5, EntityID: 8,
+		Changes: []models.ActivityChange{{Field: "status"}},
+	}
+
+	derived := FromActivity(activity)
+	require.Len(t, derived, 2)
+	assert.NotEqual(t, derived[0].ID, derived[1].ID)
+	for _, event := range derived {
+		assert.Equal(t, 3, event.ProjectID)
+		assert.Equal(t, 5, event.ActorID)
+		assert.Equal(t, 8, event.EntityID)
+		_, ok := event.Change("status")
+		assert.True(t, ok)
+	}
+}
@@ -0,0 +1,94 @@
+// Package filter parses the task filter expressions accepted by GET /tasks,
+// such as
+//
+//	status in (TO_DO, IN_PROGRESS) and priority >= HIGH and due < 2025-05-01 and assignee = me
+//
+// into a tree the task repository turns into database conditions.
+package filter
+
+import (
+	"time"
+)
+
+// Field is a task attribute an expression can compare.
+type Field string
+
+const (
+	FieldID          Field = "id"
+	FieldTitle       Field = "title"
+	FieldDescription Field = "description"
+	FieldStatus      Field = "status"
+	FieldPriority    Field = "priority"
+	FieldDue         Field = "due"
+	FieldCreated     Field = "created"
+	FieldUpdated     Field = "updated"
+	FieldAssignee    Field = "assignee"
+	FieldProject     Field = "project"
+	FieldSprint      Field = "sprint"
+	FieldParent      Field = "parent"
+	FieldStoryPoints Field = "story_points"
+	FieldLabel       Field = "label"
+)
+
+// Operator compares a field with its values.
+type Operator string
+
+const (
+	OpEq       Operator = "="
+	OpNe       Operator = "!="
+	OpLt       Operator = "<"
+	OpLte      Operator = "<="
+	OpGt       Operator = ">"
+	OpGte      Operator = ">="
+	OpContains Operator = "~"
+	OpIn       Operator = "in"
+	OpNotIn    Operator = "not in"
+	OpEmpty    Operator = "is empty"
+	OpNotEmpty Operator = "is not empty"
+)
+
+// Expr is a node of a parsed expression: And, Or, Not or Comparison.
+type Expr interface {
+	expr()
+}
+
+// And matches when every one of Exprs matches.
+type And struct {
+	Exprs []Expr
+}
+
+// Or matches when any one of Exprs matches.
+type Or struct {
+	Exprs []Expr
+}
+
+// Not matches when Expr does not match.
+type Not struct {
+	Expr Expr
+}
+
+// Comparison compares Field with Values using Op. Values holds one value,
+// several for in and not in, and none for is empty and is not empty.
+type Comparison struct {
+	Field  Field
+	Op     Operator
+	Values []Value
+}
+
+// Value is a literal of a comparison, already checked against the kind of
+// its field. Only the member matching that kind is set.
+type Value struct {
+	// Int holds ids and story points.
+	Int int
+	// Text holds titles, descriptions, statuses and priorities.
+	Text string
+	// Date holds the day of date fields.
+	Date time.Time
+	// Me stands for the requesting user in assignee comparisons.
+	Me bool
+}
+
+func (And) expr()        {}
+func (Or) expr()         {}
+func (Not) expr()        {}
+func (Comparison) expr() {}
@@ -0,0 +1,28 @@
+package filter
+
+import (
+	"fmt"
+
+	"lqkhoi-go-http-api/pkg/structs"
+)
+
+// Error is a syntax or validation error of an expression, located at the
+// offending token. It wraps structs.ErrInvalidTaskFilter.
+type Error struct {
+	// Pos is the 1-based character position of the token.
+	Pos int
+	// Token is the offending text; empty at the end of the expression.
+	Token   string
+	Message string
+}
+
+func (e *Error) Error() string {
+	if e.Token == "" {
+		return fmt.Sprintf("%s at position %d", e.Message, e.Pos)
+	}
+	return fmt.Sprintf("%s at position %d near %q", e.Message, e.Pos, e.Token)
+}
+
+func (e *Error) Unwrap() error {
+	return structs.ErrInvalidTaskFilter
+}
@@ -0,0 +1,97 @@
+package filter
+
+import (
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+// token is a lexeme of an expression; pos is its 1-based character position.
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// isKeyword reports whether the token is the unquoted word keyword, ignoring case.
+func (t token) isKeyword(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '.' || r == ':' || r == '/'
+}
+
+// lex splits input into tokens, ending with a tokenEOF.
+func lex(input string) ([]token, error) {
+	runes := []rune(input)
+	tokens := make([]token, 0)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		pos := i + 1
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: pos})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: pos})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", pos: pos})
+			i++
+		case r == '=' || r == '~':
+			tokens = append(tokens, token{kind: tokenOperator, text: string(r), pos: pos})
+			i++
+		case r == '<' || r == '>' || r == '!':
+			op := string(r)
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, &Error{Pos: pos, Token: op, Message: "unexpected character, did you mean !="}
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: op, pos: pos})
+			i += len(op)
+		case r == '"':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(runes) && runes[j] != '"'; j++ {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				b.WriteRune(runes[j])
+			}
+			if j >= len(runes) {
+				return nil, &Error{Pos: pos, Token: string(runes[i:]), Message: "unterminated string"}
+			}
+			tokens = append(tokens, token{kind: tokenString, text: b.String(), pos: pos})
+			i = j + 1
+		case isWordRune(r):
+			j := i
+			for j < len(runes) && isWordRune(runes[j]) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: string(runes[i:j]), pos: pos})
+			i = j
+		default:
+			return nil, &Error{Pos: pos, Token: string(r), Message: "unexpected character"}
+		}
+	}
+
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(runes) + 1})
+	return tokens, nil
+}
@@ -0,0 +1,321 @@
+package filter
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+const (
+	// MaxLength is the longest accepted expression, in characters.
+	MaxLength = 1000
+	// maxDepth bounds the nesting of parentheses and not.
+	maxDepth = 20
+	// maxInValues bounds the values of an in list.
+	maxInValues = 100
+)
+
+type valueKind int
+
+const (
+	kindInt valueKind = iota
+	kindText
+	kindDate
+	kindStatus
+	kindPriority
+	kindUser
+)
+
+var (
+	equality   = []Operator{OpEq, OpNe}
+	ordering   = []Operator{OpLt, OpLte, OpGt, OpGte}
+	membership = []Operator{OpIn, OpNotIn}
+	emptiness  = []Operator{OpEmpty, OpNotEmpty}
+)
+
+type fieldSpec struct {
+	kind valueKind
+	ops  []Operator
+}
+
+func ops(groups ...[]Operator) []Operator {
+	var all []Operator
+	for _, group := range groups {
+		all = append(all, group...)
+	}
+	return all
+}
+
+var fieldSpecs = map[Field]fieldSpec{
+	FieldID:          {kindInt, ops(equality, ordering, membership)},
+	FieldTitle:       {kindText, ops(equality, []Operator{OpContains})},
+	FieldDescription: {kindText, []Operator{OpContains}},
+	FieldStatus:      {kindStatus, ops(equality, membership)},
+	FieldPriority:    {kindPriority, ops(equality, ordering, membership)},
+	FieldDue:         {kindDate, ops(equality, ordering, emptiness)},
+	FieldCreated:     {kindDate, ops(equality, ordering)},
+	FieldUpdated:     {kindDate, ops(equality, ordering)},
+	FieldAssignee:    {kindUser, ops(equality, membership, emptiness)},
+	FieldProject:     {kindInt, ops(equality, membership)},
+	FieldSprint:      {kindInt, ops(equality, membership, emptiness)},
+	FieldParent:      {kindInt, ops(equality, membership, emptiness)},
+	FieldStoryPoints: {kindInt, ops(equality, ordering, membership, emptiness)},
+	FieldLabel:       {kindInt, ops(equality, membership, emptiness)},
+}
+
+type parser struct {
+	tokens     []token
+	next       int
+	depth      int
+	dateLayout string
+}
+
+// Parse parses input into an expression. Dates are read with dateLayout.
+// Keywords, field names and enum values are case insensitive.
+func Parse(input, dateLayout string) (Expr, error) {
+	if length := utf8.RuneCountInString(input); length > MaxLength {
+		return nil, &Error{Pos: MaxLength + 1, Message: fmt.Sprintf("expression is longer than %d characters", MaxLength)}
+	}
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, dateLayout: dateLayout}
+	if p.peek().kind == tokenEOF {
+		return nil, p.errorAt(p.peek(), "expression is empty")
+	}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.errorAt(tok, "expected and, or or the end of the expression")
+	}
+	return expr, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) advance() token {
+	tok := p.tokens[p.next]
+	if tok.kind != tokenEOF {
+		p.next++
+	}
+	return tok
+}
+
+func (p *parser) errorAt(tok token, message string) *Error {
+	return &Error{Pos: tok.pos, Token: tok.text, Message: message}
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	first, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	exprs := []Expr{first}
+	for p.peek().isKeyword("or") {
+		p.advance()
+		next, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, next)
+	}
+	if len(exprs) == 1 {
+		return first, nil
+	}
+	return Or{Exprs: exprs}, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	first, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	exprs := []Expr{first}
+	for p.peek().isKeyword("and") {
+		p.advance()
+		next, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, next)
+	}
+	if len(exprs) == 1 {
+		return first, nil
+	}
+	return And{Exprs: exprs}, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	tok := p.peek()
+	if tok.isKeyword("not") || tok.kind == tokenLParen {
+		p.depth++
+		defer func() { p.depth-- }()
+		if p.depth > maxDepth {
+			return nil, p.errorAt(tok, fmt.Sprintf("expression is nested more than %d levels deep", maxDepth))
+		}
+	}
+
+	switch {
+	case tok.isKeyword("not"):
+		p.advance()
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return Not{Expr: expr}, nil
+	case tok.kind == tokenLParen:
+		p.advance()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.peek(); closing.kind != tokenRParen {
+			return nil, p.errorAt(closing, "expected )")
+		}
+		p.advance()
+		return expr, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (Expr, error) {
+	fieldTok := p.advance()
+	if fieldTok.kind != tokenWord {
+		return nil, p.errorAt(fieldTok, "expected a field")
+	}
+	field := Field(strings.ToLower(fieldTok.text))
+	spec, ok := fieldSpecs[field]
+	if !ok {
+		return nil, p.errorAt(fieldTok, "unknown field")
+	}
+
+	opTok := p.peek()
+	op, err := p.parseOperator()
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(spec.ops, op) {
+		return nil, p.errorAt(opTok, fmt.Sprintf("operator %s is not supported for field %s", op, field))
+	}
+
+	comparison := Comparison{Field: field, Op: op}
+	switch op {
+	case OpEmpty, OpNotEmpty:
+		return comparison, nil
+	case OpIn, OpNotIn:
+		comparison.Values, err = p.parseValueList(field, spec.kind)
+	default:
+		var value Value
+		value, err = p.parseValue(field, spec.kind)
+		comparison.Values = []Value{value}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return comparison, nil
+}
+
+func (p *parser) parseOperator() (Operator, error) {
+	tok := p.advance()
+	switch {
+	case tok.kind == tokenOperator:
+		return Operator(tok.text), nil
+	case tok.isKeyword("in"):
+		return OpIn, nil
+	case tok.isKeyword("not"):
+		if next := p.advance(); !next.isKeyword("in") {
+			return "", p.errorAt(next, "expected in after not")
+		}
+		return OpNotIn, nil
+	case tok.isKeyword("is"):
+		op := OpEmpty
+		if p.peek().isKeyword("not") {
+			p.advance()
+			op = OpNotEmpty
+		}
+		if next := p.advance(); !next.isKeyword("empty") {
+			return "", p.errorAt(next, "expected empty")
+		}
+		return op, nil
+	}
+	return "", p.errorAt(tok, "expected an operator")
+}
+
+func (p *parser) parseValueList(field Field, kind valueKind) ([]Value, error) {
+	if open := p.advance(); open.kind != tokenLParen {
+		return nil, p.errorAt(open, "expected ( to open the list of values")
+	}
+
+	values := make([]Value, 0)
+	for {
+		if len(values) == maxInValues {
+			return nil, p.errorAt(p.peek(), fmt.Sprintf("list has more than %d values", maxInValues))
+		}
+		value, err := p.parseValue(field, kind)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		sep := p.advance()
+		if sep.kind == tokenRParen {
+			return values, nil
+		}
+		if sep.kind != tokenComma {
+			return nil, p.errorAt(sep, "expected , or )")
+		}
+	}
+}
+
+func (p *parser) parseValue(field Field, kind valueKind) (Value, error) {
+	tok := p.advance()
+	if tok.kind != tokenWord && tok.kind != tokenString {
+		return Value{}, p.errorAt(tok, fmt.Sprintf("expected a value for field %s", field))
+	}
+
+	switch kind {
+	case kindText:
+		return Value{Text: tok.text}, nil
+	case kindUser:
+		if tok.isKeyword("me") {
+			return Value{Me: true}, nil
+		}
+		fallthrough
+	case kindInt:
+		n, err := strconv.Atoi(tok.text)
+		if err != nil || n < 0 {
+			return Value{}, p.errorAt(tok, fmt.Sprintf("expected a number for field %s", field))
+		}
+		return Value{Int: n}, nil
+	case kindDate:
+		date, err := time.Parse(p.dateLayout, tok.text)
+		if err != nil {
+			return Value{}, p.errorAt(tok, fmt.Sprintf("expected a date like %s for field %s", p.dateLayout, field))
+		}
+		return Value{Date: date}, nil
+	case kindStatus:
+		status := models.TaskStatus(strings.ToUpper(tok.text))
+		if !status.IsValid() {
+			return Value{}, p.errorAt(tok, "unknown status")
+		}
+		return Value{Text: string(status)}, nil
+	case kindPriority:
+		priority := models.TaskPriority(strings.ToUpper(tok.text))
+		if !priority.IsValid() {
+			return Value{}, p.errorAt(tok, "unknown priority")
+		}
+		return Value{Text: string(priority)}, nil
+	}
+	return Value{}, p.errorAt(tok, "unsupported value")
+}
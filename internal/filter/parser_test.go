@@ -0,0 +1,87 @@
+package filter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const layout = "2006-01-02"
+
+func TestParse(t *testing.T) {
+	expr, err := Parse(`status in (TO_DO, in_progress) and priority >= HIGH and due < 2025-05-01 and assignee = me`, layout)
+	require.NoError(t, err)
+
+	assert.Equal(t, And{Exprs: []Expr{
+		Comparison{Field: FieldStatus, Op: OpIn, Values: []Value{{Text: "TO_DO"}, {Text: "IN_PROGRESS"}}},
+		Comparison{Field: FieldPriority, Op: OpGte, Values: []Value{{Text: "HIGH"}}},
+		Comparison{Field: FieldDue, Op: OpLt, Values: []Value{{Date: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)}}},
+		Comparison{Field: FieldAssignee, Op: OpEq, Values: []Value{{Me: true}}},
+	}}, expr)
+}
+
+func TestParse_Precedence(t *testing.T) {
+	expr, err := Parse(`title ~ "login page" or not (sprint is empty and label not in (1, 2)) and story_points is not empty`, layout)
+	require.NoError(t, err)
+
+	assert.Equal(t, Or{Exprs: []Expr{
+		Comparison{Field: FieldTitle, Op: OpContains, Values: []Value{{Text: "login page"}}},
+		And{Exprs: []Expr{
+			Not{Expr: And{Exprs: []Expr{
+				Comparison{Field: FieldSprint, Op: OpEmpty},
+				Comparison{Field: FieldLabel, Op: OpNotIn, Values: []Value{{Int: 1}, {Int: 2}}},
+			}}},
+			Comparison{Field: FieldStoryPoints, Op: OpNotEmpty},
+		}},
+	}}, expr)
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		input   string
+		pos     int
+		token   string
+		message string
+	}{
+		{"", 1, "", "expression is empty"},
+		{"colour = red", 1, "colour", "unknown field"},
+		{"status = DOING", 10, "DOING", "unknown status"},
+		{"status >= TO_DO", 8, ">=", "operator >= is not supported for field status"},
+		{"due < 01/05/2025", 7, "01/05/2025", "expected a date like 2006-01-02 for field due"},
+		{"id = 1 & id = 2", 8, "&", "unexpected character"},
+		{"due < 2025-13-01", 7, "2025-13-01", "expected a date like 2006-01-02 for field due"},
+		{"id in (1, 2", 12, "", "expected , or )"},
+		{"(id = 1", 8, "", "expected )"},
+		{"id = 1 priority = LOW", 8, "priority", "expected and, or or the end of the expression"},
+		{"title ~ \"open", 9, "\"open", "unterminated string"},
+		{"assignee = someone", 12, "someone", "expected a number for field assignee"},
+		{"sprint is null", 11, "null", "expected empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := Parse(tt.input, layout)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, structs.ErrInvalidTaskFilter)
+
+			var filterErr *Error
+			require.ErrorAs(t, err, &filterErr)
+			assert.Equal(t, tt.pos, filterErr.Pos)
+			assert.Equal(t, tt.token, filterErr.Token)
+			assert.Equal(t, tt.message, filterErr.Message)
+		})
+	}
+}
+
+func TestParse_Limits(t *testing.T) {
+	_, err := Parse(strings.Repeat("(", maxDepth+1)+"id = 1"+strings.Repeat(")", maxDepth+1), layout)
+	assert.ErrorIs(t, err, structs.ErrInvalidTaskFilter)
+
+	_, err = Parse("title ~ "+strings.Repeat("a", MaxLength), layout)
+	assert.ErrorIs(t, err, structs.ErrInvalidTaskFilter)
+}
@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/service"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AttachmentHandler handles task attachment HTTP requests
+type AttachmentHandler struct {
+	attachmentService service.AttachmentService
+}
+
+// NewAttachmentHandler creates a new AttachmentHandler instance
+func NewAttachmentHandler(attachmentService service.AttachmentService) *AttachmentHandler {
+	return &AttachmentHandler{
+		attachmentService: attachmentService,
+	}
+}
+
+// UploadAttachment attaches a file to a task
+// @Summary Upload an attachment
+// @Description Uploads a file as multipart form data and attaches it to the task; project viewers may not upload. The size and MIME type of the file are limited by the server configuration
+// @Tags Attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param file formData file true "File to attach"
+// @Success 201 {object} dto.AttachmentSuccessResponse "Attachment uploaded successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid task ID or missing file"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task not found"
+// @Failure 413 {object} dto.ErrorResponse "Request entity too large - File exceeds the size limit"
+// @Failure 415 {object} dto.ErrorResponse "Unsupported media type - File type not allowed"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/attachments [post]
+func (h *AttachmentHandler) UploadAttachment(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "AttachmentHandler",
+		"handler", "UploadAttachment",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	contentType, ok := c.Locals("upload_content_type").(string)
+	if !ok {
+		logger.Error("Upload was not checked by the upload middleware")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		logger.Error("Cannot read uploaded file", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("File is required", nil))
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		logger.Error("Cannot open uploaded file", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot read file", nil))
+	}
+	defer file.Close()
+
+	attachment := &models.Attachment{
+		FileName:    fileHeader.Filename,
+		ContentType: contentType,
+		Size:        fileHeader.Size,
+	}
+	created, err := h.attachmentService.UploadAttachment(ctx, userClaims.UserID, taskID, attachment, file)
+	if err != nil {
+		return attachmentErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToAttachmentResponse(created)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusCreated).JSON(createSuccessResponse("Attachment uploaded successfully", output))
+}
+
+// ListAttachments retrieves the attachments of a task
+// @Summary Get attachments of a task
+// @Description Retrieves the metadata of the files attached to a task, newest first
+// @Tags Attachments
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Success 200 {object} dto.AttachmentSliceSuccessResponse "Attachments found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid task ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/attachments [get]
+func (h *AttachmentHandler) ListAttachments(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "AttachmentHandler",
+		"handler", "ListAttachments",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	attachments, err := h.attachmentService.ListAttachments(ctx, userClaims.UserID, taskID)
+	if err != nil {
+		return attachmentErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToSliceOfAttachmentResponse(attachments)
+	return c.Status(fiber.StatusOK).JSON(createSliceSuccessResponseGeneric("Attachments found successfully", output))
+}
+
+// DownloadAttachment downloads the file of an attachment
+// @Summary Download an attachment
+// @Description Streams the content of an attached file
+// @Tags Attachments
+// @Produce octet-stream
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param attachmentId path int true "Attachment ID"
+// @Success 200 {file} file "Attachment content"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task or attachment not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/attachments/{attachmentId} [get]
+func (h *AttachmentHandler) DownloadAttachment(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "AttachmentHandler",
+		"handler", "DownloadAttachment",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+	attachmentID, err := verifyIdParamInt(c, logger, "attachmentId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	attachment, content, err := h.attachmentService.OpenAttachment(ctx, userClaims.UserID, taskID, attachmentID)
+	if err != nil {
+		return attachmentErrorResponse(c, logger, err)
+	}
+
+	// The stream is closed by fasthttp once the response is sent.
+	c.Attachment(attachment.FileName)
+	c.Set(fiber.HeaderContentType, attachment.ContentType)
+	c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	return c.Status(fiber.StatusOK).SendStream(content, int(attachment.Size))
+}
+
+// DeleteAttachment removes an attachment of a task
+// @Summary Delete an attachment
+// @Description Deletes an attachment and its file. Only the uploader or a manager of the project may delete it
+// @Tags Attachments
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param attachmentId path int true "Attachment ID"
+// @Success 200 {object} dto.GenericSuccessResponse "Attachment deleted successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task or attachment not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/attachments/{attachmentId} [delete]
+func (h *AttachmentHandler) DeleteAttachment(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "AttachmentHandler",
+		"handler", "DeleteAttachment",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+	attachmentID, err := verifyIdParamInt(c, logger, "attachmentId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	if err := h.attachmentService.DeleteAttachment(ctx, userClaims.UserID, taskID, attachmentID); err != nil {
+		return attachmentErrorResponse(c, logger, err)
+	}
+
+	logger.Info("Attachment deleted successfully", "attachment_id", attachmentID)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse[any]("Attachment deleted successfully", nil))
+}
+
+// attachmentErrorResponse maps the errors of the attachment service to responses.
+func attachmentErrorResponse(c *fiber.Ctx, logger *slog.Logger, err error) error {
+	if errors.Is(err, structs.ErrTaskNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Task not found", err.Error()))
+	} else if errors.Is(err, structs.ErrAttachmentNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Attachment not found", err.Error()))
+	} else if errors.Is(err, structs.ErrUserNotAuthorizedForTask) ||
+		errors.Is(err, structs.ErrUserNotManageProject) ||
+		errors.Is(err, structs.ErrUserNotPartProject) ||
+		errors.Is(err, structs.ErrUserNotAttachmentUploader) {
+		return c.Status(fiber.StatusForbidden).JSON(
+			createErrorResponse("Forbidden", err.Error()))
+	}
+	logger.Error("Attachment operation failed", "error", err.Error())
+	return c.Status(fiber.StatusInternalServerError).JSON(
+		createErrorResponse("Internal server error", nil))
+}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"lqkhoi-go-http-api/internal/events"
+	"lqkhoi-go-http-api/internal/service"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// boardStreamHeartbeat is how often an idle stream sends a comment, which
+	// keeps proxies from closing it and detects clients that went away.
+	boardStreamHeartbeat = 15 * time.Second
+	// boardStreamRetry is how long clients wait before reconnecting.
+	boardStreamRetry = 3 * time.Second
+)
+
+// BoardStreamHandler handles the real-time board HTTP requests
+type BoardStreamHandler struct {
+	boardStreamService service.BoardStreamService
+	tokenService       service.TokenService
+}
+
+// NewBoardStreamHandler creates a new BoardStreamHandler instance
+func NewBoardStreamHandler(boardStreamService service.BoardStreamService, tokenService service.TokenService) *BoardStreamHandler {
+	return &BoardStreamHandler{
+		boardStreamService: boardStreamService,
+		tokenService:       tokenService,
+	}
+}
+
+// StreamProjectEvents streams the task events of a project as Server-Sent Events
+// @Summary Stream project board events
+// @Description Streams the task events of a project (task.created, task.updated, task.status_changed, task.assigned, task.deleted) as Server-Sent Events while they happen, on any instance of the API; available to any project member. Each message carries the event type as its SSE event name and the event as JSON data, the same payload webhooks receive. Browsers' EventSource cannot set headers, so the access token may be passed as the access_token query parameter instead. The stream ends when the access token expires, the access token is revoked or the user is no longer a project member, the last two checked on every heartbeat. A client that reconnects should reload the board, since events sent while it was disconnected are not replayed.
+// @Tags Projects
+// @Produce text/event-stream
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param access_token query string false "Access token, when the Authorization header cannot be set"
+// @Success 200 {string} string "Stream of task events"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid project ID"
+// @Failure 401 {object} dto.ErrorResponse "Unauthorized - Missing or invalid token"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User is not a project member"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/events [get]
+func (h *BoardStreamHandler) StreamProjectEvents(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "BoardStreamHandler",
+		"handler", "StreamProjectEvents",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	stream, unsubscribe, err := h.boardStreamService.Subscribe(ctx, userClaims.UserID, projectID)
+	if err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Project not found", err.Error()))
+		} else if errors.Is(err, structs.ErrUserNotPartProject) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		}
+		logger.Error("Failed to subscribe to board events", "error", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	var expiresAt time.Time
+	if userClaims.ExpiresAt != nil {
+		expiresAt = userClaims.ExpiresAt.Time
+	}
+	verify := func() error {
+		return h.verifyStream(ctx, userClaims, projectID)
+	}
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer unsubscribe()
+		if err := writeBoardStream(w, stream, boardStreamHeartbeat, expiresAt, verify); err != nil {
+			logger.Debug("Board stream closed", "error", err)
+			return
+		}
+		logger.Debug("Board stream ended")
+	})
+	return nil
+}
+
+// verifyStream checks that the stream of the user with claims may stay
+// open: the access token must not have been revoked, e.g. by logging out,
+// and the user must still be a member of the project. A failed revocation
+// check ends the stream too.
+func (h *BoardStreamHandler) verifyStream(ctx context.Context, claims *structs.Claims, projectID int) error {
+	revoked, err := h.tokenService.IsTokenRevoked(ctx, claims)
+	if err != nil {
+		return fmt.Errorf("failed to check access token revocation: %w", err)
+	}
+	if revoked {
+		return structs.ErrAccessTokenRevoked
+	}
+	return h.boardStreamService.VerifySubscriber(ctx, claims.UserID, projectID)
+}
+
+// writeBoardStream writes the events of stream to w as Server-Sent Events
+// until stream is closed, expiresAt passes or a write fails, which happens
+// once the client is gone. On each heartbeat verify is called and the stream
+// ends when it fails, e.g. after the subscriber left the project. A zero
+// expiresAt never expires.
+func writeBoardStream(w *bufio.Writer, stream <-chan events.Event, heartbeat time.Duration, expiresAt time.Time, verify func() error) error {
+	fmt.Fprintf(w, "retry: %d\n\n", boardStreamRetry.Milliseconds())
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+
+	var expired <-chan time.Time
+	if !expiresAt.IsZero() {
+		timer := time.NewTimer(time.Until(expiresAt))
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		select {
+		case event, ok := <-stream:
+			if !ok {
+				return nil
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+		case <-expired:
+			return structs.ErrAccessTokenExpired
+		case <-ticker.C:
+			if err := verify(); err != nil {
+				return err
+			}
+			fmt.Fprint(w, ": heartbeat\n\n")
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+}
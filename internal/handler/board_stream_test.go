@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"lqkhoi-go-http-api/internal/events"
+	servicemocks "lqkhoi-go-http-api/internal/service/mocks"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// stubBoardStreamService streams the given events to user 7, the only
+// member of project 1.
+type stubBoardStreamService struct {
+	events []events.Event
+}
+
+func (s *stubBoardStreamService) Subscribe(ctx context.Context, userID, projectID int) (<-chan events.Event, func(), error) {
+	if projectID != 1 {
+		return nil, nil, structs.ErrProjectNotExist
+	}
+	stream := make(chan events.Event, len(s.events))
+	for _, event := range s.events {
+		stream <- event
+	}
+	close(stream)
+	return stream, func() {}, nil
+}
+
+func (s *stubBoardStreamService) VerifySubscriber(ctx context.Context, userID, projectID int) error {
+	if userID != 7 {
+		return structs.ErrUserNotPartProject
+	}
+	return nil
+}
+
+func (s *stubBoardStreamService) HandleEvent(ctx context.Context, event events.Event) {}
+
+func TestWriteBoardStream(t *testing.T) {
+	var b bytes.Buffer
+	stream := make(chan events.Event, 1)
+	stream <- events.Event{ID: "evt-1", Type: events.TaskCreated, ProjectID: 1, EntityID: 3}
+	close(stream)
+
+	require.NoError(t, writeBoardStream(bufio.NewWriter(&b), stream, time.Minute, time.Time{}, func() error { return nil }))
+
+	assert.Equal(t, "retry: 3000\n\n"+
+		"id: evt-1\nevent: task.created\n"+
+		`data: {"id":"evt-1","type":"task.created","occurred_at":"0001-01-01T00:00:00Z","project_id":1,"actor_id":0,"entity_type":"","entity_id":3}`+"\n\n",
+		b.String())
+}
+
+func TestWriteBoardStream_Ends(t *testing.T) {
+	t.Run("when the access token expires", func(t *testing.T) {
+		var b bytes.Buffer
+		stream := make(chan events.Event)
+
+		err := writeBoardStream(bufio.NewWriter(&b), stream, time.Minute, time.Now().Add(10*time.Millisecond), func() error { return nil })
+
+		assert.ErrorIs(t, err, structs.ErrAccessTokenExpired)
+	})
+
+	t.Run("when the subscriber leaves the project", func(t *testing.T) {
+		var b bytes.Buffer
+		stream := make(chan events.Event)
+		checks := 0
+		verify := func() error {
+			checks++
+			if checks > 1 {
+				return structs.ErrUserNotPartProject
+			}
+			return nil
+		}
+
+		err := writeBoardStream(bufio.NewWriter(&b), stream, time.Millisecond, time.Time{}, verify)
+
+		assert.ErrorIs(t, err, structs.ErrUserNotPartProject)
+		assert.Equal(t, "retry: 3000\n\n: heartbeat\n\n", b.String())
+	})
+}
+
+func TestBoardStreamHandler_StreamProjectEvents(t *testing.T) {
+	h := NewBoardStreamHandler(&stubBoardStreamService{events: []events.Event{{ID: "evt-1", Type: events.TaskDeleted, ProjectID: 1}}}, nil)
+	app := fiber.New()
+	app.Get("/projects/:projectId/events", func(c *fiber.Ctx) error {
+		c.Locals("user_claims", &structs.Claims{UserID: 7})
+		return c.Next()
+	}, h.StreamProjectEvents)
+
+	t.Run("events are streamed", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/projects/1/events", nil))
+		require.NoError(t, err)
+
+		assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get(fiber.HeaderContentType))
+		body, _ := io.ReadAll(resp.Body)
+		assert.Contains(t, string(body), "event: task.deleted\n")
+	})
+
+	t.Run("unknown project is not found", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/projects/2/events", nil))
+		require.NoError(t, err)
+
+		assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestBoardStreamHandler_VerifyStream(t *testing.T) {
+	errCache := errors.New("cache unavailable")
+
+	cases := []struct {
+		name     string
+		claims   *structs.Claims
+		revoked  bool
+		checkErr error
+		err      error
+	}{
+		{name: "Success", claims: &structs.Claims{UserID: 7}},
+		{name: "Failure - Token Revoked", claims: &structs.Claims{UserID: 7}, revoked: true, err: structs.ErrAccessTokenRevoked},
+		{name: "Failure - Revocation Check Failed", claims: &structs.Claims{UserID: 7}, checkErr: errCache, err: errCache},
+		{name: "Failure - No Longer a Member", claims: &structs.Claims{UserID: 8}, err: structs.ErrUserNotPartProject},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockTokenService := servicemocks.NewMockTokenService(ctrl)
+			h := NewBoardStreamHandler(&stubBoardStreamService{}, mockTokenService)
+			ctx := context.Background()
+
+			mockTokenService.EXPECT().IsTokenRevoked(ctx, tc.claims).Return(tc.revoked, tc.checkErr).Times(1)
+
+			err := h.verifyStream(ctx, tc.claims, 1)
+
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/service"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CommentHandler handles task comment HTTP requests
+type CommentHandler struct {
+	commentService service.CommentService
+}
+
+// NewCommentHandler creates a new CommentHandler instance
+func NewCommentHandler(commentService service.CommentService) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+	}
+}
+
+// ListComments retrieves the comments of a task
+// @Summary Get comments of a task
+// @Description Retrieves the top-level comments of a task, each one with its thread of replies
+// @Tags Comments
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param page query int false "Page number, ignored when cursor is set"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort as field:asc or field:desc (fields: id, created_at, updated_at)"
+// @Success 200 {object} dto.CommentSliceSuccessResponse "Comments found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid task ID or query parameters"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/comments [get]
+func (h *CommentHandler) ListComments(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentHandler",
+		"handler", "ListComments",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+
+	page, parseErrors := parsePageRequest(c, dto.CommentSortFields)
+	if len(parseErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid query parameters", parseErrors))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	comments, pageInfo, err := h.commentService.ListComments(ctx, userClaims.UserID, taskID, page)
+	if err != nil {
+		return commentErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToSliceOfCommentResponse(comments)
+	return c.Status(fiber.StatusOK).JSON(createPageSuccessResponse("Comments found successfully", output, pageInfo))
+}
+
+// CreateComment adds a comment to a task
+// @Summary Comment on a task
+// @Description Adds a comment, or a reply to another comment, on a task. Users mentioned as @email are recorded
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param comment body dto.CreateCommentRequest true "Comment creation request"
+// @Success 201 {object} dto.CommentSuccessResponse "Comment created successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input, parent comment or reply nested too deep"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/comments [post]
+func (h *CommentHandler) CreateComment(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentHandler",
+		"handler", "CreateComment",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Parsing input...")
+	input := &dto.CreateCommentRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	comment, err := h.commentService.CreateComment(ctx, userClaims.UserID, taskID, input.MapToComment())
+	if err != nil {
+		return commentErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToCommentResponse(comment)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusCreated).JSON(createSuccessResponse("Comment created successfully", output))
+}
+
+// UpdateComment edits a comment
+// @Summary Edit a comment
+// @Description Replaces the body of a comment, keeping the previous body in its edit history. Only the author may edit a comment
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param commentId path int true "Comment ID"
+// @Param comment body dto.UpdateCommentRequest true "Comment update request"
+// @Success 200 {object} dto.CommentSuccessResponse "Comment updated successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task or comment not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/comments/{commentId} [put]
+func (h *CommentHandler) UpdateComment(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentHandler",
+		"handler", "UpdateComment",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+	commentID, err := verifyIdParamInt(c, logger, "commentId")
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Parsing input...")
+	input := &dto.UpdateCommentRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	comment, err := h.commentService.UpdateComment(ctx, userClaims.UserID, taskID, commentID, input.Body)
+	if err != nil {
+		return commentErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToCommentResponse(comment)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Comment updated successfully", output))
+}
+
+// DeleteComment deletes a comment
+// @Summary Delete a comment
+// @Description Deletes a comment together with all of its replies. Only the author or a project manager may delete a comment
+// @Tags Comments
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param commentId path int true "Comment ID"
+// @Success 200 {object} dto.GenericSuccessResponse "Comment deleted successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task or comment not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/comments/{commentId} [delete]
+func (h *CommentHandler) DeleteComment(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentHandler",
+		"handler", "DeleteComment",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+	commentID, err := verifyIdParamInt(c, logger, "commentId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	if err := h.commentService.DeleteComment(ctx, userClaims.UserID, taskID, commentID); err != nil {
+		return commentErrorResponse(c, logger, err)
+	}
+
+	logger.Info("Comment deleted successfully", "comment_id", commentID)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse[any]("Comment deleted successfully", nil))
+}
+
+// ListCommentEdits retrieves the edit history of a comment
+// @Summary Get edit history of a comment
+// @Description Retrieves the previous bodies of a comment, oldest first
+// @Tags Comments
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param commentId path int true "Comment ID"
+// @Success 200 {object} dto.CommentEditSliceSuccessResponse "Comment edits found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task or comment not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/comments/{commentId}/history [get]
+func (h *CommentHandler) ListCommentEdits(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentHandler",
+		"handler", "ListCommentEdits",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+	commentID, err := verifyIdParamInt(c, logger, "commentId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	edits, err := h.commentService.ListCommentEdits(ctx, userClaims.UserID, taskID, commentID)
+	if err != nil {
+		return commentErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToSliceOfCommentEditResponse(edits)
+	return c.Status(fiber.StatusOK).JSON(createSliceSuccessResponseGeneric("Comment edits found successfully", output))
+}
+
+// commentErrorResponse maps the errors of the comment service to responses.
+func commentErrorResponse(c *fiber.Ctx, logger *slog.Logger, err error) error {
+	if errors.Is(err, structs.ErrTaskNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Task not found", err.Error()))
+	} else if errors.Is(err, structs.ErrCommentNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Comment not found", err.Error()))
+	} else if errors.Is(err, structs.ErrParentCommentNotExist) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Parent comment not found on this task", err.Error()))
+	} else if errors.Is(err, structs.ErrCommentThreadTooDeep) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Comment thread too deep", err.Error()))
+	} else if errors.Is(err, structs.ErrUserNotAuthorizedForTask) ||
+		errors.Is(err, structs.ErrUserNotManageProject) ||
+		errors.Is(err, structs.ErrUserNotPartProject) ||
+		errors.Is(err, structs.ErrUserNotCommentAuthor) {
+		return c.Status(fiber.StatusForbidden).JSON(
+			createErrorResponse("Forbidden", err.Error()))
+	}
+	logger.Error("Comment operation failed", "error", err.Error())
+	return c.Status(fiber.StatusInternalServerError).JSON(
+		createErrorResponse("Internal server error", nil))
+}
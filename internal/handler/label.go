@@ -0,0 +1,328 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/service"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// LabelHandler handles label HTTP requests
+type LabelHandler struct {
+	labelService service.LabelService
+}
+
+// NewLabelHandler creates a new LabelHandler instance
+func NewLabelHandler(labelService service.LabelService) *LabelHandler {
+	return &LabelHandler{
+		labelService: labelService,
+	}
+}
+
+// ListLabels retrieves the labels of a project
+// @Summary Get labels of a project
+// @Description Retrieves the labels of a project ordered by name
+// @Tags Labels
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Success 200 {object} dto.LabelSliceSuccessResponse "Labels found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid project ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not part of the project"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/labels [get]
+func (h *LabelHandler) ListLabels(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelHandler",
+		"handler", "ListLabels",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	labels, err := h.labelService.ListLabels(ctx, userClaims.UserID, projectID)
+	if err != nil {
+		return labelErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToSliceOfLabelResponse(labels)
+	return c.Status(fiber.StatusOK).JSON(createSliceSuccessResponseGeneric("Labels found successfully", output))
+}
+
+// CreateLabel creates a label in a project
+// @Summary Create a label
+// @Description Creates a label with a name unique in the project and a hex color
+// @Tags Labels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param label body dto.CreateLabelRequest true "Label creation request"
+// @Success 201 {object} dto.LabelSuccessResponse "Label created successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
+// @Failure 409 {object} dto.ErrorResponse "Conflict - Label name already used in the project"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/labels [post]
+func (h *LabelHandler) CreateLabel(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelHandler",
+		"handler", "CreateLabel",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Parsing input...")
+	input := &dto.CreateLabelRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	label, err := h.labelService.CreateLabel(ctx, userClaims.UserID, projectID, input.MapToLabel())
+	if err != nil {
+		return labelErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToLabelResponse(label)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusCreated).JSON(createSuccessResponse("Label created successfully", output))
+}
+
+// UpdateLabel updates a label of a project
+// @Summary Update a label
+// @Description Renames or recolors a label of the project
+// @Tags Labels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param labelId path int true "Label ID"
+// @Param label body dto.UpdateLabelRequest true "Label update request"
+// @Success 200 {object} dto.LabelSuccessResponse "Label updated successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project or label not found"
+// @Failure 409 {object} dto.ErrorResponse "Conflict - Label name already used in the project"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/labels/{labelId} [put]
+func (h *LabelHandler) UpdateLabel(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelHandler",
+		"handler", "UpdateLabel",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+	labelID, err := verifyIdParamInt(c, logger, "labelId")
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Parsing input...")
+	input := &dto.UpdateLabelRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	label, err := h.labelService.UpdateLabel(ctx, userClaims.UserID, projectID, labelID, input)
+	if err != nil {
+		return labelErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToLabelResponse(label)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Label updated successfully", output))
+}
+
+// DeleteLabel deletes a label of a project
+// @Summary Delete a label
+// @Description Deletes a label of the project and removes it from every task
+// @Tags Labels
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param labelId path int true "Label ID"
+// @Success 200 {object} dto.GenericSuccessResponse "Label deleted successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project or label not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/labels/{labelId} [delete]
+func (h *LabelHandler) DeleteLabel(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelHandler",
+		"handler", "DeleteLabel",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+	labelID, err := verifyIdParamInt(c, logger, "labelId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	if err := h.labelService.DeleteLabel(ctx, userClaims.UserID, projectID, labelID); err != nil {
+		return labelErrorResponse(c, logger, err)
+	}
+
+	logger.Info("Label deleted successfully", "label_id", labelID)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse[any]("Label deleted successfully", nil))
+}
+
+// SetTaskLabels replaces the labels of a task
+// @Summary Set labels of a task
+// @Description Replaces the labels of a task with labels of its project; an empty list removes every label
+// @Tags Labels
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param labels body dto.SetTaskLabelsRequest true "Task labels request"
+// @Success 200 {object} dto.TaskSuccessResponse "Task labels updated successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input or label of another project"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task or label not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/labels [put]
+func (h *LabelHandler) SetTaskLabels(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelHandler",
+		"handler", "SetTaskLabels",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Parsing input...")
+	input := &dto.SetTaskLabelsRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	task, err := h.labelService.SetTaskLabels(ctx, userClaims.UserID, taskID, input.LabelIDs)
+	if err != nil {
+		return labelErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToTaskResponse(task)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Task labels updated successfully", output))
+}
+
+// labelErrorResponse maps the errors of the label service to responses.
+func labelErrorResponse(c *fiber.Ctx, logger *slog.Logger, err error) error {
+	if errors.Is(err, structs.ErrProjectNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Project not found", err.Error()))
+	} else if errors.Is(err, structs.ErrTaskNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Task not found", err.Error()))
+	} else if errors.Is(err, structs.ErrLabelNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Label not found", err.Error()))
+	} else if errors.Is(err, structs.ErrLabelNotInProject) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid label", err.Error()))
+	} else if errors.Is(err, structs.ErrLabelNameTaken) {
+		return c.Status(fiber.StatusConflict).JSON(
+			createErrorResponse("Label name already used", err.Error()))
+	} else if errors.Is(err, structs.ErrUserNotAuthorizedForTask) ||
+		errors.Is(err, structs.ErrUserNotManageProject) ||
+		errors.Is(err, structs.ErrUserNotPartProject) {
+		return c.Status(fiber.StatusForbidden).JSON(
+			createErrorResponse("Forbidden", err.Error()))
+	}
+	logger.Error("Label operation failed", "error", err.Error())
+	return c.Status(fiber.StatusInternalServerError).JSON(
+		createErrorResponse("Internal server error", nil))
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+
+	"lqkhoi-go-http-api/internal/service"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// MetricsHandler handles sprint and project reporting HTTP requests
+type MetricsHandler struct {
+	metricsService service.MetricsService
+}
+
+// NewMetricsHandler creates a new MetricsHandler instance
+func NewMetricsHandler(metricsService service.MetricsService) *MetricsHandler {
+	return &MetricsHandler{
+		metricsService: metricsService,
+	}
+}
+
+// GetSprintBurndown retrieves the burndown of a sprint
+// @Summary Get sprint burndown
+// @Description Retrieves the number of unfinished tasks at the end of every sprint day, replayed from the task status history, together with the ideal line
+// @Tags Metrics
+// @Produce json
+// @Security BearerAuth
+// @Param sprintId path int true "Sprint ID"
+// @Success 200 {object} dto.BurndownSuccessResponse "Burndown computed"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid sprint ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Sprint not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /sprints/{sprintId}/burndown [get]
+func (h *MetricsHandler) GetSprintBurndown(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "MetricsHandler",
+		"handler", "GetSprintBurndown",
+	)
+
+	sprintID, err := verifyIdParamInt(c, logger, "sprintId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	burndown, err := h.metricsService.GetSprintBurndown(ctx, userClaims.UserID, sprintID)
+	if err != nil {
+		return metricsErrorResponse(c, logger, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Burndown computed successfully", burndown))
+}
+
+// GetProjectVelocity retrieves the velocity of a project
+// @Summary Get project velocity
+// @Description Retrieves the tasks committed and completed in every closed sprint of the project, in completion order
+// @Tags Metrics
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Success 200 {object} dto.VelocitySuccessResponse "Velocity computed"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid project ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/velocity [get]
+func (h *MetricsHandler) GetProjectVelocity(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "MetricsHandler",
+		"handler", "GetProjectVelocity",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	velocity, err := h.metricsService.GetProjectVelocity(ctx, userClaims.UserID, projectID)
+	if err != nil {
+		return metricsErrorResponse(c, logger, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Velocity computed successfully", velocity))
+}
+
+func metricsErrorResponse(c *fiber.Ctx, logger *slog.Logger, err error) error {
+	if errors.Is(err, structs.ErrSprintNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Sprint not found", err.Error()))
+	} else if errors.Is(err, structs.ErrProjectNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Project not found", err.Error()))
+	} else if errors.Is(err, structs.ErrSprintReportNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Sprint report not found", err.Error()))
+	} else if errors.Is(err, structs.ErrUserNotManageProject) {
+		return c.Status(fiber.StatusForbidden).JSON(
+			createErrorResponse("Forbidden", err.Error()))
+	}
+	logger.Error("Metrics operation failed", "error", err.Error())
+	return c.Status(fiber.StatusInternalServerError).JSON(
+		createErrorResponse("Internal server error", nil))
+}
@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/service"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// NotificationHandler handles notification HTTP requests
+type NotificationHandler struct {
+	notificationService service.NotificationService
+}
+
+// NewNotificationHandler creates a new NotificationHandler instance
+func NewNotificationHandler(notificationService service.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+// ListNotifications retrieves the notifications of the current user
+// @Summary Get my notifications
+// @Description Retrieves the notifications of the authenticated user, newest first by default: task assignments, status changes of assigned tasks, due date reminders and project membership changes
+// @Tags Notifications
+// @Produce json
+// @Security BearerAuth
+// @Param unread query bool false "Only list unread notifications"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param page query int false "Page number, ignored when cursor is set"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort as field:asc or field:desc (fields: id, created_at; default id:desc)"
+// @Success 200 {object} dto.NotificationSliceSuccessResponse "Notifications found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid query parameters"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /me/notifications [get]
+func (h *NotificationHandler) ListNotifications(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "NotificationHandler",
+		"handler", "ListNotifications",
+	)
+
+	page, parseErrors := parseFeedPageRequest(c, dto.NotificationSortFields)
+	unreadOnly := false
+	if unreadStr := c.Query("unread"); unreadStr != "" {
+		unread, err := strconv.ParseBool(unreadStr)
+		if err != nil {
+			logger.Error("Invalid unread parameter", "unread", unreadStr)
+			parseErrors = append(parseErrors, "Invalid unread parameter")
+		} else {
+			unreadOnly = unread
+		}
+	}
+	if len(parseErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid query parameters", parseErrors))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	notifications, pageInfo, err := h.notificationService.ListNotifications(ctx, userClaims.UserID, unreadOnly, page)
+	if err != nil {
+		return notificationErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToSliceOfNotificationResponse(notifications)
+	return c.Status(fiber.StatusOK).JSON(createPageSuccessResponse("Notifications found successfully", output, pageInfo))
+}
+
+// CountUnread counts the unread notifications of the current user
+// @Summary Count my unread notifications
+// @Description Counts the unread notifications of the authenticated user
+// @Tags Notifications
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.UnreadNotificationCountSuccessResponse "Unread notifications counted"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /me/notifications/unread-count [get]
+func (h *NotificationHandler) CountUnread(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "NotificationHandler",
+		"handler", "CountUnread",
+	)
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	unread, err := h.notificationService.CountUnread(ctx, userClaims.UserID)
+	if err != nil {
+		return notificationErrorResponse(c, logger, err)
+	}
+
+	output := dto.UnreadNotificationCountResponse{Unread: unread}
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Unread notifications counted successfully", output))
+}
+
+// MarkRead marks a notification of the current user as read
+// @Summary Mark a notification as read
+// @Description Marks a notification of the authenticated user as read; marking a read notification again keeps its read time
+// @Tags Notifications
+// @Produce json
+// @Security BearerAuth
+// @Param notificationId path int true "Notification ID"
+// @Success 200 {object} dto.NotificationSuccessResponse "Notification marked as read"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid notification ID"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Notification not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /me/notifications/{notificationId}/read [post]
+func (h *NotificationHandler) MarkRead(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "NotificationHandler",
+		"handler", "MarkRead",
+	)
+
+	notificationID, err := verifyIdParamInt(c, logger, "notificationId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	notification, err := h.notificationService.MarkRead(ctx, userClaims.UserID, notificationID)
+	if err != nil {
+		return notificationErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToNotificationResponse(notification)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Notification marked as read successfully", output))
+}
+
+// MarkAllRead marks every notification of the current user as read
+// @Summary Mark all my notifications as read
+// @Description Marks every unread notification of the authenticated user as read
+// @Tags Notifications
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.MarkNotificationsReadSuccessResponse "Notifications marked as read"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /me/notifications/read-all [post]
+func (h *NotificationHandler) MarkAllRead(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "NotificationHandler",
+		"handler", "MarkAllRead",
+	)
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	marked, err := h.notificationService.MarkAllRead(ctx, userClaims.UserID)
+	if err != nil {
+		return notificationErrorResponse(c, logger, err)
+	}
+
+	logger.Info("Notifications marked as read", "marked", marked)
+	output := dto.MarkNotificationsReadResponse{Marked: marked}
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Notifications marked as read successfully", output))
+}
+
+// notificationErrorResponse maps the errors of the notification service to
+// responses.
+func notificationErrorResponse(c *fiber.Ctx, logger *slog.Logger, err error) error {
+	if errors.Is(err, structs.ErrNotificationNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Notification not found", err.Error()))
+	}
+	logger.Error("Notification operation failed", "error", err.Error())
+	return c.Status(fiber.StatusInternalServerError).JSON(
+		createErrorResponse("Internal server error", nil))
+}
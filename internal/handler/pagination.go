@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"lqkhoi-go-http-api/internal/dto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// parsePageRequest reads the limit, page, cursor and sort query parameters of
+// a list endpoint. sortFields lists the columns the endpoint may be sorted by.
+// Parse problems are returned as messages so callers can merge them with their
+// own filter errors.
+func parsePageRequest(c *fiber.Ctx, sortFields []string) (*dto.PageRequest, []string) {
+	return parseSortedPageRequest(c, sortFields, false)
+}
+
+// parseFeedPageRequest is parsePageRequest for feeds, which list the newest
+// items first unless the client asks for another sort.
+func parseFeedPageRequest(c *fiber.Ctx, sortFields []string) (*dto.PageRequest, []string) {
+	return parseSortedPageRequest(c, sortFields, true)
+}
+
+// parseSortedPageRequest is parsePageRequest with the direction used when the
+// client does not ask for a sort. The direction is settled before the cursor
+// is checked against it.
+func parseSortedPageRequest(c *fiber.Ctx, sortFields []string, descByDefault bool) (*dto.PageRequest, []string) {
+	page := &dto.PageRequest{
+		Limit:     dto.DefaultPageLimit,
+		Page:      1,
+		SortField: dto.DefaultSortField,
+		SortDesc:  descByDefault,
+	}
+	var parseErrors []string
+
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > dto.MaxPageLimit {
+			parseErrors = append(parseErrors, fmt.Sprintf("Invalid 'limit' parameter (1-%d): %s", dto.MaxPageLimit, limitStr))
+		} else {
+			page.Limit = limit
+		}
+	}
+
+	if pageStr := c.Query("page"); pageStr != "" {
+		pageNumber, err := strconv.Atoi(pageStr)
+		if err != nil || pageNumber < 1 {
+			parseErrors = append(parseErrors, fmt.Sprintf("Invalid 'page' parameter: %s", pageStr))
+		} else {
+			page.Page = pageNumber
+		}
+	}
+
+	if sortStr := c.Query("sort"); sortStr != "" {
+		field, direction, _ := strings.Cut(sortStr, ":")
+		if !slices.Contains(sortFields, field) {
+			parseErrors = append(parseErrors, fmt.Sprintf("Invalid 'sort' field, allowed fields are %s: %s", strings.Join(sortFields, ", "), field))
+		} else {
+			page.SortField = field
+		}
+		switch direction {
+		case "", "asc":
+			page.SortDesc = false
+		case "desc":
+			page.SortDesc = true
+		default:
+			parseErrors = append(parseErrors, fmt.Sprintf("Invalid 'sort' direction (use asc or desc): %s", direction))
+		}
+	}
+
+	if cursorStr := c.Query("cursor"); cursorStr != "" {
+		cursor, err := dto.DecodeCursor(cursorStr)
+		if err != nil {
+			parseErrors = append(parseErrors, fmt.Sprintf("Invalid 'cursor' parameter: %s", err.Error()))
+		} else if !cursor.Matches(page) {
+			parseErrors = append(parseErrors, "Invalid 'cursor' parameter: it was issued for a different sort")
+		} else {
+			page.Cursor = cursor
+		}
+	}
+
+	return page, parseErrors
+}
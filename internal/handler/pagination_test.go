@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/dto"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePageRequest_Cursor(t *testing.T) {
+	asc := (&dto.Cursor{SortField: "id", Value: 20, ID: 20}).Encode()
+	desc := (&dto.Cursor{SortField: "id", SortDesc: true, Value: 20, ID: 20}).Encode()
+
+	cases := []struct {
+		name  string
+		feed  bool
+		query string
+		valid bool
+	}{
+		{name: "cursor of the same sort", query: "cursor=" + asc, valid: true},
+		{name: "cursor of another direction", query: "cursor=" + desc},
+		{name: "cursor of another field", query: "sort=created_at&cursor=" + asc},
+		{name: "feed cursor of the default sort", feed: true, query: "cursor=" + desc, valid: true},
+		{name: "feed cursor of another direction", feed: true, query: "cursor=" + asc},
+		{name: "feed cursor of an ascending sort", feed: true, query: "sort=id:asc&cursor=" + asc, valid: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var page *dto.PageRequest
+			var parseErrors []string
+			app := fiber.New()
+			app.Get("/", func(ctx *fiber.Ctx) error {
+				if c.feed {
+					page, parseErrors = parseFeedPageRequest(ctx, []string{"id", "created_at"})
+				} else {
+					page, parseErrors = parsePageRequest(ctx, []string{"id", "created_at"})
+				}
+				return nil
+			})
+
+			_, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/?"+c.query, nil))
+			require.NoError(t, err)
+
+			if !c.valid {
+				assert.Nil(t, page.Cursor)
+				assert.NotEmpty(t, parseErrors)
+				return
+			}
+			assert.Empty(t, parseErrors)
+			require.NotNil(t, page.Cursor)
+			assert.Equal(t, page.SortDesc, page.Cursor.SortDesc)
+		})
+	}
+}
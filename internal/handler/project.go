@@ -100,6 +100,10 @@ func (h *ProjectHandler) CreateProjectHandler(c *fiber.Ctx) error {
 // @Param managerid query int false "Manager ID"
 // @Param startdate query string false "Start date after (format: YYYY-MM-DD)"
 // @Param enddate query string false "End date before (format: YYYY-MM-DD)"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param page query int false "Page number, ignored when cursor is set"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort as field:asc or field:desc (fields: id, name, status, start_date, created_at, updated_at)"
 // @Success 200 {object} dto.ProjectSliceSuccessResponse "Projects found"
 // @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid query parameters"
 // @Failure 500 {object} dto.ErrorResponse "Internal server error"
@@ -157,13 +161,16 @@ func (h *ProjectHandler) ListProjectsHanlder(c *fiber.Ctx) error {
 		}
 	}
 
+	page, pageErrors := parsePageRequest(c, dto.ProjectSortFields)
+	parseErrors = append(parseErrors, pageErrors...)
+
 	if len(parseErrors) > 0 {
 		return c.Status(fiber.StatusBadRequest).JSON(
 			createErrorResponse("Invalid query parameters", parseErrors))
 	}
 
 	ctx := c.UserContext()
-	projects, err := h.projectService.ListProjects(ctx, filter)
+	projects, pageInfo, err := h.projectService.ListProjects(ctx, filter, page)
 	if err != nil {
 		log.Printf("Service error listing projects: %v\n", err)
 		return c.Status(fiber.StatusInternalServerError).JSON(
@@ -172,7 +179,7 @@ func (h *ProjectHandler) ListProjectsHanlder(c *fiber.Ctx) error {
 
 	outputs := dto.MapToProjectDtoSlice(projects)
 	return c.Status(fiber.StatusOK).JSON(
-		createSliceSuccessResponseGeneric("Projects found successfully", outputs))
+		createPageSuccessResponse("Projects found successfully", outputs, pageInfo))
 }
 
 // GetProject retrieves a project by ID
@@ -341,7 +348,7 @@ func (h *ProjectHandler) DeleteProject(c *fiber.Ctx) error {
 
 // AddTeamMembers adds team members to a project
 // @Summary Add team members to a project
-// @Description Adds one or more users to a project as team members
+// @Description Adds one or more users to a project with the given project role (MEMBER by default)
 // @Tags Projects
 // @Accept json
 // @Produce json
@@ -352,6 +359,7 @@ func (h *ProjectHandler) DeleteProject(c *fiber.Ctx) error {
 // @Success 207 {object} dto.AddTeamMembersPartialSuccessResponse "Some team members added successfully"
 // @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input or project ID"
 // @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
 // @Failure 500 {object} dto.ErrorResponse "Internal server error"
 // @Router /projects/{projectId}/members [post]
 func (h *ProjectHandler) AddTeamMembers(c *fiber.Ctx) error {
@@ -388,7 +396,12 @@ func (h *ProjectHandler) AddTeamMembers(c *fiber.Ctx) error {
 			createErrorResponse("Internal server error", nil))
 	}
 
-	count, err := h.projectService.AddTeamMembers(ctx, userClaims.UserID, projectID, input.UserIDs)
+	role := input.Role
+	if role == "" {
+		role = models.ProjectRoleMember
+	}
+
+	count, err := h.projectService.AddTeamMembers(ctx, userClaims.UserID, projectID, input.UserIDs, role)
 	if err != nil {
 		if errors.Is(err, structs.ErrProjectNotExist) {
 			return c.Status(fiber.StatusNotFound).JSON(
@@ -414,4 +427,179 @@ func (h *ProjectHandler) AddTeamMembers(c *fiber.Ctx) error {
 
 	logger.Info("All team members added successfully", "project_id", projectID, "count", count)
 	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("All team members added successfully", count))
-}
\ No newline at end of file
+}
+
+// ListMembers lists the members of a project
+// @Summary List project members
+// @Description Retrieves all members of a project with their project role; available to any project member
+// @Tags Projects
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Success 200 {object} dto.ProjectMemberSliceSuccessResponse "Project members found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid project ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User is not a project member"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/members [get]
+func (h *ProjectHandler) ListMembers(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ProjectHandler",
+		"handler", "ListMembers",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	members, err := h.projectService.ListMembers(ctx, userClaims.UserID, projectID)
+	if err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Project not found", err.Error()))
+		} else if errors.Is(err, structs.ErrUserNotPartProject) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		}
+		logger.Error("Failed to list project members", "error", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	output := dto.MapToSliceOfProjectMemberResponse(members)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusOK).JSON(createSliceSuccessResponseGeneric("Project members found successfully", output))
+}
+
+// ListActivity lists the activity log of a project
+// @Summary Get project activity
+// @Description Retrieves who changed what in a project, its sprints and its tasks, newest first by default; available to any project member
+// @Tags Projects
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param page query int false "Page number, ignored when cursor is set"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort as field:asc or field:desc (fields: id, created_at; default id:desc)"
+// @Success 200 {object} dto.ActivitySliceSuccessResponse "Project activity found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid project ID or query parameters"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User is not a project member"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/activity [get]
+func (h *ProjectHandler) ListActivity(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ProjectHandler",
+		"handler", "ListActivity",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	page, parseErrors := parseFeedPageRequest(c, dto.ActivitySortFields)
+	if len(parseErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid query parameters", parseErrors))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	activities, pageInfo, err := h.projectService.ListActivity(ctx, userClaims.UserID, projectID, page)
+	if err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Project not found", err.Error()))
+		} else if errors.Is(err, structs.ErrUserNotPartProject) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		}
+		logger.Error("Failed to list project activity", "error", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	output := dto.MapToSliceOfActivityResponse(activities)
+	return c.Status(fiber.StatusOK).JSON(createPageSuccessResponse("Project activity found successfully", output, pageInfo))
+}
+
+// RemoveMember removes a member from a project
+// @Summary Remove a project member
+// @Description Removes a user from a project; the project manager cannot be removed
+// @Tags Projects
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param userId path int true "User ID"
+// @Success 200 {object} dto.GenericSuccessResponse "Member removed successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid IDs or user is the project manager"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found or user is not a member"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/members/{userId} [delete]
+func (h *ProjectHandler) RemoveMember(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ProjectHandler",
+		"handler", "RemoveMember",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	memberID, err := verifyIdParamInt(c, logger, "userId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	if err := h.projectService.RemoveMember(ctx, userClaims.UserID, projectID, memberID); err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Project not found", err.Error()))
+		} else if errors.Is(err, structs.ErrUserNotPartProject) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Member not found", err.Error()))
+		} else if errors.Is(err, structs.ErrUserNotManageProject) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		} else if errors.Is(err, structs.ErrProjectOwnerRemoval) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("Project manager cannot be removed", err.Error()))
+		}
+		logger.Error("Failed to remove project member", "error", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	logger.Info("Project member removed successfully", "project_id", projectID, "user_id", memberID)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse[any]("Member removed successfully", nil))
+}
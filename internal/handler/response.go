@@ -24,4 +24,15 @@ func createSliceSuccessResponseGeneric[T any](msg string, data []T) dto.SliceSuc
         Data:    data,
         Count:   len(data),
     }
-}
\ No newline at end of file
+}
+
+func createPageSuccessResponse[T any](msg string, data []T, info *dto.PageInfo) dto.SliceSuccessResponse[T] {
+    response := createSliceSuccessResponseGeneric(msg, data)
+    if info != nil {
+        response.Total = info.Total
+        response.Limit = info.Limit
+        response.Page = info.Page
+        response.NextCursor = info.NextCursor
+    }
+    return response
+}
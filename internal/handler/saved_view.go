@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/service"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SavedViewHandler handles saved view HTTP requests
+type SavedViewHandler struct {
+	savedViewService service.SavedViewService
+}
+
+// NewSavedViewHandler creates a new SavedViewHandler instance
+func NewSavedViewHandler(savedViewService service.SavedViewService) *SavedViewHandler {
+	return &SavedViewHandler{
+		savedViewService: savedViewService,
+	}
+}
+
+// ListViews retrieves the saved views of the current user
+// @Summary Get saved views
+// @Description Retrieves the views saved by the current user and the views shared to the user's projects, ordered by name
+// @Tags Saved Views
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.SavedViewSliceSuccessResponse "Saved views found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /views [get]
+func (h *SavedViewHandler) ListViews(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SavedViewHandler",
+		"handler", "ListViews",
+	)
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	views, err := h.savedViewService.ListViews(ctx, userClaims.UserID)
+	if err != nil {
+		return savedViewErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToSliceOfSavedViewResponse(views)
+	return c.Status(fiber.StatusOK).JSON(createSliceSuccessResponseGeneric("Saved views found successfully", output))
+}
+
+// GetView retrieves a saved view
+// @Summary Get a saved view
+// @Description Retrieves a view of the current user or a view shared to one of the user's projects
+// @Tags Saved Views
+// @Produce json
+// @Security BearerAuth
+// @Param viewId path int true "Saved view ID"
+// @Success 200 {object} dto.SavedViewSuccessResponse "Saved view found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid view ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not part of the project of the view"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Saved view not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /views/{viewId} [get]
+func (h *SavedViewHandler) GetView(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SavedViewHandler",
+		"handler", "GetView",
+	)
+
+	viewID, err := verifyIdParamInt(c, logger, "viewId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	view, err := h.savedViewService.GetView(ctx, userClaims.UserID, viewID)
+	if err != nil {
+		return savedViewErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToSavedViewResponse(view)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Saved view found successfully", output))
+}
+
+// CreateView saves a task filter as a view
+// @Summary Save a view
+// @Description Saves a named task filter expression; with a project ID the view is shared to the members of the project and lists only its tasks. Run it with GET /tasks?view={id}
+// @Tags Saved Views
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param view body dto.CreateSavedViewRequest true "Saved view creation request"
+// @Success 201 {object} dto.SavedViewSuccessResponse "Saved view created successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input or filter expression"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not part of the project"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /views [post]
+func (h *SavedViewHandler) CreateView(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SavedViewHandler",
+		"handler", "CreateView",
+	)
+
+	logger.Debug("Parsing input...")
+	input := &dto.CreateSavedViewRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	view, err := h.savedViewService.CreateView(ctx, userClaims.UserID, input.MapToSavedView())
+	if err != nil {
+		return savedViewErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToSavedViewResponse(view)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusCreated).JSON(createSuccessResponse("Saved view created successfully", output))
+}
+
+// UpdateView updates a saved view
+// @Summary Update a saved view
+// @Description Renames a view of the current user, changes its filter expression or shares it to another project; project ID 0 makes it personal again
+// @Tags Saved Views
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param viewId path int true "Saved view ID"
+// @Param view body dto.UpdateSavedViewRequest true "Saved view update request"
+// @Success 200 {object} dto.SavedViewSuccessResponse "Saved view updated successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input or filter expression"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not the owner of the view"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Saved view not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /views/{viewId} [put]
+func (h *SavedViewHandler) UpdateView(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SavedViewHandler",
+		"handler", "UpdateView",
+	)
+
+	viewID, err := verifyIdParamInt(c, logger, "viewId")
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Parsing input...")
+	input := &dto.UpdateSavedViewRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	view, err := h.savedViewService.UpdateView(ctx, userClaims.UserID, viewID, input)
+	if err != nil {
+		return savedViewErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToSavedViewResponse(view)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Saved view updated successfully", output))
+}
+
+// DeleteView deletes a saved view
+// @Summary Delete a saved view
+// @Description Deletes a view of the current user
+// @Tags Saved Views
+// @Produce json
+// @Security BearerAuth
+// @Param viewId path int true "Saved view ID"
+// @Success 200 {object} dto.GenericSuccessResponse "Saved view deleted successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid view ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not the owner of the view"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Saved view not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /views/{viewId} [delete]
+func (h *SavedViewHandler) DeleteView(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SavedViewHandler",
+		"handler", "DeleteView",
+	)
+
+	viewID, err := verifyIdParamInt(c, logger, "viewId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	if err := h.savedViewService.DeleteView(ctx, userClaims.UserID, viewID); err != nil {
+		return savedViewErrorResponse(c, logger, err)
+	}
+
+	logger.Info("Saved view deleted successfully", "view_id", viewID)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse[any]("Saved view deleted successfully", nil))
+}
+
+// savedViewErrorResponse maps the errors of the saved view service to responses.
+func savedViewErrorResponse(c *fiber.Ctx, logger *slog.Logger, err error) error {
+	if errors.Is(err, structs.ErrSavedViewNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Saved view not found", err.Error()))
+	} else if errors.Is(err, structs.ErrProjectNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Project not found", err.Error()))
+	} else if errors.Is(err, structs.ErrInvalidTaskFilter) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid filter expression", mapFilterExpressionError(err)))
+	} else if errors.Is(err, structs.ErrUserNotViewOwner) ||
+		errors.Is(err, structs.ErrUserNotPartProject) {
+		return c.Status(fiber.StatusForbidden).JSON(
+			createErrorResponse("Forbidden", err.Error()))
+	}
+	logger.Error("Saved view operation failed", "error", err.Error())
+	return c.Status(fiber.StatusInternalServerError).JSON(
+		createErrorResponse("Internal server error", nil))
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/service"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SearchHandler handles full-text search HTTP requests
+type SearchHandler struct {
+	searchService service.SearchService
+}
+
+// NewSearchHandler creates a new SearchHandler instance
+func NewSearchHandler(searchService service.SearchService) *SearchHandler {
+	return &SearchHandler{
+		searchService: searchService,
+	}
+}
+
+// Search searches tasks and projects
+// @Summary Search tasks and projects
+// @Description Full-text search over task titles and descriptions and project names and descriptions. Results are ranked by relevance, carry highlighted snippets and only include the projects the user is a member of and their tasks, plus the tasks assigned to the user. The query accepts web search syntax: quoted phrases, OR and -word
+// @Tags Search
+// @Produce json
+// @Security BearerAuth
+// @Param q query string true "Search text"
+// @Param type query string false "Kinds of items to search (default all)" Enums(all, tasks, projects)
+// @Param limit query int false "Maximum results of each kind (default 20, max 50)"
+// @Success 200 {object} dto.SearchSuccessResponse "Search results"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid query parameters"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /search [get]
+func (h *SearchHandler) Search(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SearchHandler",
+		"handler", "Search",
+	)
+
+	var parseErrors []string
+	query := c.Query("q")
+	if query == "" {
+		parseErrors = append(parseErrors, "Missing q parameter")
+	}
+
+	scope := dto.SearchScope(c.Query("type", string(dto.SearchAll)))
+	switch scope {
+	case dto.SearchAll, dto.SearchTasks, dto.SearchProjects:
+	default:
+		logger.Error("Invalid type parameter", "type", scope)
+		parseErrors = append(parseErrors, "Invalid type parameter")
+	}
+
+	limit := dto.DefaultSearchLimit
+	if limitStr := c.Query("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > dto.MaxSearchLimit {
+			logger.Error("Invalid limit parameter", "limit", limitStr)
+			parseErrors = append(parseErrors, "Invalid limit parameter")
+		} else {
+			limit = parsed
+		}
+	}
+
+	if len(parseErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", parseErrors))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	tasks, projects, err := h.searchService.Search(ctx, userClaims.UserID, query, scope, limit)
+	if err != nil {
+		if errors.Is(err, structs.ErrInvalidSearchQuery) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("Invalid search query", err.Error()))
+		}
+		logger.Error("Failed to search", "error", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	output := dto.MapToSearchResponse(query, tasks, projects)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Search completed successfully", output))
+}
@@ -3,11 +3,13 @@ package handler
 import (
 	"errors"
 	"fmt"
+	"log/slog"
 	"strconv"
 	"time"
 
 	"lqkhoi-go-http-api/internal/config"
 	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
 	"lqkhoi-go-http-api/internal/service"
 	"lqkhoi-go-http-api/pkg/structs"
 	"lqkhoi-go-http-api/pkg/utils"
@@ -159,6 +161,10 @@ func (h *SprintHandler) GetSprint(c *fiber.Ctx) error {
 // @Param projectid query int false "Project ID"
 // @Param startdate query string false "Start date after (format: YYYY-MM-DD)"
 // @Param enddate query string false "End date before (format: YYYY-MM-DD)"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param page query int false "Page number, ignored when cursor is set"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort as field:asc or field:desc (fields: id, name, start_date, end_date, created_at, updated_at)"
 // @Success 200 {object} dto.SprintSliceSuccessResponse "Sprints found"
 // @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid query parameters"
 // @Failure 500 {object} dto.ErrorResponse "Internal server error"
@@ -223,12 +229,15 @@ func (h *SprintHandler) FindSprints(c *fiber.Ctx) error {
 		}
 	}
 
+	page, pageErrors := parsePageRequest(c, dto.SprintSortFields)
+	parseErrors = append(parseErrors, pageErrors...)
+
 	if len(parseErrors) > 0 {
 		return c.Status(fiber.StatusBadRequest).JSON(
 			createErrorResponse("Invalid query parameters", parseErrors))
 	}
 
-	sprints, err := h.sprintService.FindSprints(ctx, filter)
+	sprints, pageInfo, err := h.sprintService.FindSprints(ctx, filter, page)
 	if err != nil {
 		logger.Error("Service error finding sprints", "error", err.Error())
 		return c.Status(fiber.StatusInternalServerError).JSON(
@@ -239,7 +248,7 @@ func (h *SprintHandler) FindSprints(c *fiber.Ctx) error {
 	outputs := dto.MapToSprintResponseSlice(sprints)
 	logger.Debug("Response is prepared", "response", outputs)
 	return c.Status(fiber.StatusOK).JSON(
-		createSliceSuccessResponseGeneric("Sprints found successfully", outputs))
+		createPageSuccessResponse("Sprints found successfully", outputs, pageInfo))
 }
 
 // UpdateSprint updates an existing sprint
@@ -362,4 +371,224 @@ func (h *SprintHandler) DeleteSprint(c *fiber.Ctx) error {
 
 	logger.Info("Sprint deleted successfully", "sprint_id", sprintID)
 	return c.Status(fiber.StatusOK).JSON(createSuccessResponse[any]("Sprint deleted successfully", nil))
-}
\ No newline at end of file
+}
+
+// StartSprint starts a planned sprint
+// @Summary Start a sprint
+// @Description Makes a planned sprint the active sprint of its project. Only one sprint per project can be active.
+// @Tags Sprints
+// @Produce json
+// @Security BearerAuth
+// @Param sprintId path int true "Sprint ID"
+// @Success 200 {object} dto.SprintSuccessResponse "Sprint started"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid sprint ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Sprint not found"
+// @Failure 409 {object} dto.ErrorResponse "Conflict - Sprint is not planned or another sprint is active"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /sprints/{sprintId}/start [post]
+func (h *SprintHandler) StartSprint(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintHandler",
+		"handler", "StartSprint",
+	)
+
+	sprintID, err := verifyIdParamInt(c, logger, "sprintId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	sprint, err := h.sprintService.StartSprint(ctx, userClaims.UserID, sprintID)
+	if err != nil {
+		return sprintLifecycleErrorResponse(c, logger, err)
+	}
+
+	logger.Info("Sprint started successfully", "sprint_id", sprintID)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Sprint started successfully", dto.MapToSprintResponse(sprint)))
+}
+
+// CompleteSprint completes the active sprint
+// @Summary Complete a sprint
+// @Description Closes the active sprint and moves its unfinished tasks to the next sprint or to the backlog, within the WIP limits there unless override_wip_limit is set. The outcome is recorded in the sprint report.
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param sprintId path int true "Sprint ID"
+// @Param request body dto.CompleteSprintRequest true "Where unfinished tasks go"
+// @Success 200 {object} dto.SprintReportSuccessResponse "Sprint completed"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input or next sprint"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Sprint not found"
+// @Failure 409 {object} dto.ErrorResponse "Conflict - Sprint is not active or WIP limit reached"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /sprints/{sprintId}/complete [post]
+func (h *SprintHandler) CompleteSprint(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintHandler",
+		"handler", "CompleteSprint",
+	)
+
+	sprintID, err := verifyIdParamInt(c, logger, "sprintId")
+	if err != nil {
+		return err
+	}
+
+	input := &dto.CompleteSprintRequest{}
+	if err = c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse JSON", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(createErrorResponse("Validation failed", errs))
+	}
+
+	if input.CarryOver == models.CarryOverBacklog && input.NextSprintID != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", "next_sprint_id is only allowed when carry_over is NEXT_SPRINT"))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	report, err := h.sprintService.CompleteSprint(ctx, userClaims.UserID, sprintID, input.CarryOver, input.NextSprintID, input.OverrideWipLimit)
+	if err != nil {
+		return sprintLifecycleErrorResponse(c, logger, err)
+	}
+
+	logger.Info("Sprint completed successfully", "sprint_id", sprintID)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Sprint completed successfully", dto.MapToSprintReportResponse(report)))
+}
+
+// GetSprintReport retrieves the report of a completed sprint
+// @Summary Get a sprint report
+// @Description Retrieves the report written when the sprint was completed
+// @Tags Sprints
+// @Produce json
+// @Security BearerAuth
+// @Param sprintId path int true "Sprint ID"
+// @Success 200 {object} dto.SprintReportSuccessResponse "Sprint report found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid sprint ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Sprint or report not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /sprints/{sprintId}/report [get]
+func (h *SprintHandler) GetSprintReport(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintHandler",
+		"handler", "GetSprintReport",
+	)
+
+	sprintID, err := verifyIdParamInt(c, logger, "sprintId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	report, err := h.sprintService.GetSprintReport(ctx, userClaims.UserID, sprintID)
+	if err != nil {
+		return sprintLifecycleErrorResponse(c, logger, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Sprint report found", dto.MapToSprintReportResponse(report)))
+}
+
+// GetSprintBoard retrieves the board of a sprint
+// @Summary Get a sprint board
+// @Description Retrieves the tasks of a sprint grouped by status into the columns TO_DO, IN_PROGRESS, REVIEW, BLOCKED and DONE, each in rank order; available to any project member
+// @Tags Sprints
+// @Produce json
+// @Security BearerAuth
+// @Param sprintId path int true "Sprint ID"
+// @Success 200 {object} dto.SprintBoardSuccessResponse "Sprint board found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid sprint ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User is not a project member"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Sprint not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /sprints/{sprintId}/board [get]
+func (h *SprintHandler) GetSprintBoard(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintHandler",
+		"handler", "GetSprintBoard",
+	)
+
+	sprintID, err := verifyIdParamInt(c, logger, "sprintId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	tasks, err := h.sprintService.GetSprintBoard(ctx, userClaims.UserID, sprintID)
+	if err != nil {
+		if errors.Is(err, structs.ErrUserNotPartProject) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		}
+		return sprintLifecycleErrorResponse(c, logger, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Sprint board found", dto.MapToSprintBoardResponse(sprintID, tasks)))
+}
+
+func sprintLifecycleErrorResponse(c *fiber.Ctx, logger *slog.Logger, err error) error {
+	if errors.Is(err, structs.ErrSprintNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Sprint not found", err.Error()))
+	} else if errors.Is(err, structs.ErrSprintReportNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Sprint report not found", err.Error()))
+	} else if errors.Is(err, structs.ErrUserNotManageProject) {
+		return c.Status(fiber.StatusForbidden).JSON(
+			createErrorResponse("Forbidden", err.Error()))
+	} else if errors.Is(err, structs.ErrSprintNotPlanned) ||
+		errors.Is(err, structs.ErrSprintNotActive) ||
+		errors.Is(err, structs.ErrActiveSprintExists) {
+		return c.Status(fiber.StatusConflict).JSON(
+			createErrorResponse("Sprint state conflict", err.Error()))
+	} else if errors.Is(err, structs.ErrSprintClosed) ||
+		errors.Is(err, structs.ErrNoNextSprint) ||
+		errors.Is(err, structs.ErrSprintNotInProject) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid sprint", err.Error()))
+	} else if errors.Is(err, structs.ErrWipLimitReached) {
+		return c.Status(fiber.StatusConflict).JSON(
+			createErrorResponse("WIP limit reached", err.Error()))
+	}
+	logger.Error("Sprint operation failed", "error", err.Error())
+	return c.Status(fiber.StatusInternalServerError).JSON(
+		createErrorResponse("Internal server error", nil))
+}
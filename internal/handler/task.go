@@ -2,13 +2,16 @@ package handler
 
 import (
 	"errors"
+	"log/slog"
 	"lqkhoi-go-http-api/internal/config"
 	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/filter"
 	"lqkhoi-go-http-api/internal/models"
 	"lqkhoi-go-http-api/internal/service"
 	"lqkhoi-go-http-api/pkg/structs"
 	"lqkhoi-go-http-api/pkg/utils"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -16,30 +19,33 @@ import (
 
 // TaskHandler handles task-related HTTP requests
 type TaskHandler struct {
-	taskService service.TaskService
-	cfg         config.DateTimeConfig
+	taskService      service.TaskService
+	savedViewService service.SavedViewService
+	cfg              config.DateTimeConfig
 }
 
 // NewTaskHandler creates a new TaskHandler instance
-func NewTaskHandler(taskService service.TaskService, cfg config.DateTimeConfig) *TaskHandler {
+func NewTaskHandler(taskService service.TaskService, savedViewService service.SavedViewService, cfg config.DateTimeConfig) *TaskHandler {
 	return &TaskHandler{
-		taskService: taskService,
-		cfg:         cfg,
+		taskService:      taskService,
+		savedViewService: savedViewService,
+		cfg:              cfg,
 	}
 }
 
 // CreateTask creates a new task
 // @Summary Create a new task
-// @Description Creates a new task with the provided details for a specific sprint
+// @Description Creates a new task in a sprint, or in the project backlog when no sprint is given, optionally as a subtask of another task. The board column of its status must be within its WIP limit unless override_wip_limit is set
 // @Tags Tasks
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param task body dto.CreateTaskRequest true "Task creation request"
 // @Success 201 {object} dto.TaskSuccessResponse "Task created successfully"
-// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input or task hierarchy"
 // @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
-// @Failure 404 {object} dto.ErrorResponse "Not found - Sprint not found"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project, sprint or parent task not found"
+// @Failure 409 {object} dto.ErrorResponse "Conflict - WIP limit reached"
 // @Failure 500 {object} dto.ErrorResponse "Internal server error"
 // @Router /tasks [post]
 func (h *TaskHandler) CreateTask(c *fiber.Ctx) error {
@@ -74,14 +80,38 @@ func (h *TaskHandler) CreateTask(c *fiber.Ctx) error {
 	}
 
 	task := input.MapToTask()
-	task, err := h.taskService.CreateTask(ctx, userClaims.UserID, task.SprintID, task)
+	task, err := h.taskService.CreateTask(ctx, userClaims.UserID, task, input.OverrideWipLimit)
 	if err != nil {
 		if errors.Is(err, structs.ErrSprintNotExist) {
 			return c.Status(fiber.StatusNotFound).JSON(
 				createErrorResponse("Sprint not found", err.Error()))
+		} else if errors.Is(err, structs.ErrProjectNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Project not found", err.Error()))
+		} else if errors.Is(err, structs.ErrSprintNotInProject) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("Sprint does not belong to the project", err.Error()))
+		} else if errors.Is(err, structs.ErrSprintClosed) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("Sprint is closed", err.Error()))
 		} else if errors.Is(err, structs.ErrUserNotManageProject) {
 			return c.Status(fiber.StatusForbidden).JSON(
 				createErrorResponse("User not authorized", err.Error()))
+		} else if errors.Is(err, structs.ErrParentTaskNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Parent task not found", err.Error()))
+		} else if errors.Is(err, structs.ErrSubtaskSprintMismatch) ||
+			errors.Is(err, structs.ErrSubtaskProjectMismatch) ||
+			errors.Is(err, structs.ErrParentTaskDone) ||
+			errors.Is(err, structs.ErrTaskHierarchyTooDeep) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("Invalid task hierarchy", err.Error()))
+		} else if errors.Is(err, structs.ErrWipLimitReached) {
+			return c.Status(fiber.StatusConflict).JSON(
+				createErrorResponse("WIP limit reached", err.Error()))
+		} else if errors.Is(err, structs.ErrWipLimitOverrideDenied) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
 		}
 		logger.Error("Failed to create task", "error", err.Error())
 		return c.Status(fiber.StatusInternalServerError).JSON(
@@ -95,7 +125,7 @@ func (h *TaskHandler) CreateTask(c *fiber.Ctx) error {
 
 // GetTask retrieves a task by ID
 // @Summary Get a task by ID
-// @Description Retrieves details of a specific task
+// @Description Retrieves details of a specific task, including its nested subtasks and its links to other tasks
 // @Tags Tasks
 // @Produce json
 // @Security BearerAuth
@@ -131,7 +161,10 @@ func (h *TaskHandler) GetTask(c *fiber.Ctx) error {
 		if errors.Is(err, structs.ErrTaskNotExist) {
 			return c.Status(fiber.StatusNotFound).JSON(
 				createErrorResponse("Task not found", err.Error()))
-		} else if errors.Is(err, structs.ErrDatabaseFail) {
+		} else if errors.Is(err, structs.ErrDatabaseFail) ||
+			errors.Is(err, structs.ErrTaskHierarchyCycle) ||
+			errors.Is(err, structs.ErrTaskHierarchyTooDeep) {
+			logger.Error("Failed to load task", "error", err.Error())
 			return c.Status(fiber.StatusInternalServerError).JSON(
 				createErrorResponse("Internal server error", nil))
 		}
@@ -150,6 +183,10 @@ func (h *TaskHandler) GetTask(c *fiber.Ctx) error {
 // @Tags Tasks
 // @Produce json
 // @Param userId path int true "User ID"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param page query int false "Page number, ignored when cursor is set"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort as field:asc or field:desc (fields: id, title, status, priority, created_at, updated_at)"
 // @Success 202 {object} dto.TaskSliceSuccessResponse "Tasks found"
 // @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid user ID"
 // @Failure 404 {object} dto.ErrorResponse "Not found - User not found"
@@ -168,7 +205,13 @@ func (h *TaskHandler) FindTasksByUserID(c *fiber.Ctx) error {
 		return err
 	}
 
-	tasks, err := h.taskService.FindTasksByUserID(ctx, id)
+	page, parseErrors := parsePageRequest(c, dto.TaskSortFields)
+	if len(parseErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid query parameters", parseErrors))
+	}
+
+	tasks, pageInfo, err := h.taskService.FindTasksByUserID(ctx, id, page)
 	if err != nil {
 		if errors.Is(err, structs.ErrUserNotExist) {
 			return c.Status(fiber.StatusNotFound).JSON(
@@ -181,7 +224,7 @@ func (h *TaskHandler) FindTasksByUserID(c *fiber.Ctx) error {
 
 	output := dto.MapToSliceOfTaskResponse(tasks)
 	logger.Debug("Response is prepared", "response", output)
-	return c.Status(fiber.StatusOK).JSON(createSliceSuccessResponseGeneric("Tasks found successfully", output))
+	return c.Status(fiber.StatusOK).JSON(createPageSuccessResponse("Tasks found successfully", output, pageInfo))
 }
 
 // FindTasksByProjectID retrieves tasks for a project
@@ -191,6 +234,10 @@ func (h *TaskHandler) FindTasksByUserID(c *fiber.Ctx) error {
 // @Produce json
 // @Security BearerAuth
 // @Param projectId path int true "Project ID"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param page query int false "Page number, ignored when cursor is set"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort as field:asc or field:desc (fields: id, title, status, priority, created_at, updated_at)"
 // @Success 202 {object} dto.TaskSliceSuccessResponse "Tasks found"
 // @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid project ID"
 // @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
@@ -217,7 +264,13 @@ func (h *TaskHandler) FindTasksByProjectID(c *fiber.Ctx) error {
 			createErrorResponse("Internal server error", nil))
 	}
 
-	tasks, err := h.taskService.FindTasksByProjectID(ctx, userClaims.UserID, projectID)
+	page, parseErrors := parsePageRequest(c, dto.TaskSortFields)
+	if len(parseErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid query parameters", parseErrors))
+	}
+
+	tasks, pageInfo, err := h.taskService.FindTasksByProjectID(ctx, userClaims.UserID, projectID, page)
 	if err != nil {
 		if errors.Is(err, structs.ErrProjectNotExist) {
 			return c.Status(fiber.StatusNotFound).JSON(
@@ -233,7 +286,206 @@ func (h *TaskHandler) FindTasksByProjectID(c *fiber.Ctx) error {
 
 	output := dto.MapToSliceOfTaskResponse(tasks)
 	logger.Debug("Response is prepared", "response", output)
-	return c.Status(fiber.StatusOK).JSON(createSliceSuccessResponseGeneric("Tasks found successfully", output))
+	return c.Status(fiber.StatusOK).JSON(createPageSuccessResponse("Tasks found successfully", output, pageInfo))
+}
+
+// FindBacklogByProjectID retrieves the backlog of a project
+// @Summary Get project backlog
+// @Description Retrieves all tasks of a specific project that do not belong to any sprint
+// @Tags Tasks
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param page query int false "Page number, ignored when cursor is set"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort as field:asc or field:desc (fields: id, title, status, priority, created_at, updated_at)"
+// @Success 200 {object} dto.TaskSliceSuccessResponse "Backlog tasks found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid project ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/backlog [get]
+func (h *TaskHandler) FindBacklogByProjectID(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskHandler",
+		"handler", "FindBacklogByProjectID",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	page, parseErrors := parsePageRequest(c, dto.TaskSortFields)
+	if len(parseErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid query parameters", parseErrors))
+	}
+
+	tasks, pageInfo, err := h.taskService.FindBacklogByProjectID(ctx, userClaims.UserID, projectID, page)
+	if err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Project not found", err.Error()))
+		} else if errors.Is(err, structs.ErrUserNotManageProject) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		}
+		logger.Error("Failed to find backlog tasks", "error", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	output := dto.MapToSliceOfTaskResponse(tasks)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusOK).JSON(createPageSuccessResponse("Backlog tasks found successfully", output, pageInfo))
+}
+
+// MoveTaskToSprint moves a task into a sprint
+// @Summary Move task to sprint
+// @Description Moves a top-level task together with all of its subtasks into a sprint of the same project, unless the board columns of the sprint cannot take them within their WIP limits
+// @Tags Tasks
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param sprintId path int true "Sprint ID"
+// @Param override_wip_limit query bool false "Move past the WIP limits of the sprint"
+// @Success 200 {object} dto.TaskSuccessResponse "Task moved"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid IDs, sprint of another project or task is a subtask"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task or sprint not found"
+// @Failure 409 {object} dto.ErrorResponse "Conflict - WIP limit reached"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/sprint/{sprintId} [post]
+func (h *TaskHandler) MoveTaskToSprint(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskHandler",
+		"handler", "MoveTaskToSprint",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+
+	sprintID, err := verifyIdParamInt(c, logger, "sprintId")
+	if err != nil {
+		return err
+	}
+
+	overrideWipLimit, err := verifyOverrideWipLimitQuery(c, logger)
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	task, err := h.taskService.MoveTaskToSprint(ctx, userClaims.UserID, taskID, sprintID, overrideWipLimit)
+	if err != nil {
+		return h.handleMoveTaskError(c, logger, err)
+	}
+
+	output := dto.MapToTaskResponse(task)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Task moved to sprint successfully", output))
+}
+
+// MoveTaskToBacklog moves a task back to the project backlog
+// @Summary Move task to backlog
+// @Description Removes a top-level task together with all of its subtasks from its sprint, unless the board columns of the backlog cannot take them within their WIP limits
+// @Tags Tasks
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param override_wip_limit query bool false "Move past the WIP limits of the backlog"
+// @Success 200 {object} dto.TaskSuccessResponse "Task moved"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid task ID or task is a subtask"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task not found"
+// @Failure 409 {object} dto.ErrorResponse "Conflict - WIP limit reached"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/sprint [delete]
+func (h *TaskHandler) MoveTaskToBacklog(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskHandler",
+		"handler", "MoveTaskToBacklog",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+
+	overrideWipLimit, err := verifyOverrideWipLimitQuery(c, logger)
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	task, err := h.taskService.MoveTaskToBacklog(ctx, userClaims.UserID, taskID, overrideWipLimit)
+	if err != nil {
+		return h.handleMoveTaskError(c, logger, err)
+	}
+
+	output := dto.MapToTaskResponse(task)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Task moved to backlog successfully", output))
+}
+
+func (h *TaskHandler) handleMoveTaskError(c *fiber.Ctx, logger *slog.Logger, err error) error {
+	if errors.Is(err, structs.ErrTaskNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Task not found", err.Error()))
+	} else if errors.Is(err, structs.ErrSprintNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Sprint not found", err.Error()))
+	} else if errors.Is(err, structs.ErrUserNotManageProject) {
+		return c.Status(fiber.StatusForbidden).JSON(
+			createErrorResponse("Forbidden", err.Error()))
+	} else if errors.Is(err, structs.ErrSprintNotInProject) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Sprint does not belong to the project", err.Error()))
+	} else if errors.Is(err, structs.ErrSprintClosed) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Sprint is closed", err.Error()))
+	} else if errors.Is(err, structs.ErrSubtaskSprintMismatch) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid task hierarchy", err.Error()))
+	} else if errors.Is(err, structs.ErrWipLimitReached) {
+		return c.Status(fiber.StatusConflict).JSON(
+			createErrorResponse("WIP limit reached", err.Error()))
+	} else if errors.Is(err, structs.ErrWipLimitOverrideDenied) {
+		return c.Status(fiber.StatusForbidden).JSON(
+			createErrorResponse("Forbidden", err.Error()))
+	}
+	logger.Error("Failed to move task", "error", err.Error())
+	return c.Status(fiber.StatusInternalServerError).JSON(
+		createErrorResponse("Internal server error", nil))
 }
 
 // UpdateTask updates an existing task
@@ -246,9 +498,10 @@ func (h *TaskHandler) FindTasksByProjectID(c *fiber.Ctx) error {
 // @Param taskId path int true "Task ID"
 // @Param task body dto.UpdateTaskRequest true "Task update request"
 // @Success 202 {object} dto.TaskSuccessResponse "Task updated"
-// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input or task ID"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input, task ID or task hierarchy"
 // @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
-// @Failure 404 {object} dto.ErrorResponse "Not found - Task not found"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task or parent task not found"
+// @Failure 409 {object} dto.ErrorResponse{details=dto.StatusTransitionErrorDetails} "Conflict - Status transition not allowed by the project workflow, task blocked by open tasks or WIP limit reached"
 // @Failure 500 {object} dto.ErrorResponse "Internal server error"
 // @Router /tasks/{taskId} [put]
 func (h *TaskHandler) UpdateTask(c *fiber.Ctx) error {
@@ -288,12 +541,36 @@ func (h *TaskHandler) UpdateTask(c *fiber.Ctx) error {
 	logger.Debug("Validation successful", "input", *input)
 	updatedTask, err := h.taskService.UpdateTask(ctx, userClaims.UserID, id, input)
 	if err != nil {
-		if errors.Is(err, structs.ErrTaskNotExist) {
+		var transitionErr *structs.StatusTransitionError
+		if errors.As(err, &transitionErr) {
+			return c.Status(fiber.StatusConflict).JSON(
+				createErrorResponse("Status transition not allowed", dto.MapToStatusTransitionErrorDetails(transitionErr)))
+		} else if errors.Is(err, structs.ErrTaskHasOpenBlockers) {
+			return c.Status(fiber.StatusConflict).JSON(
+				createErrorResponse("Task is blocked", err.Error()))
+		} else if errors.Is(err, structs.ErrWipLimitReached) {
+			return c.Status(fiber.StatusConflict).JSON(
+				createErrorResponse("WIP limit reached", err.Error()))
+		} else if errors.Is(err, structs.ErrWipLimitOverrideDenied) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		} else if errors.Is(err, structs.ErrTaskNotExist) {
 			return c.Status(fiber.StatusNotFound).JSON(
 				createErrorResponse("Task not found", err.Error()))
 		} else if errors.Is(err, structs.ErrUserNotManageProject) {
 			return c.Status(fiber.StatusForbidden).JSON(
 				createErrorResponse("Forbidden", err.Error()))
+		} else if errors.Is(err, structs.ErrParentTaskNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Parent task not found", err.Error()))
+		} else if errors.Is(err, structs.ErrSubtaskSprintMismatch) ||
+			errors.Is(err, structs.ErrSubtaskProjectMismatch) ||
+			errors.Is(err, structs.ErrParentTaskDone) ||
+			errors.Is(err, structs.ErrTaskHierarchyCycle) ||
+			errors.Is(err, structs.ErrTaskHierarchyTooDeep) ||
+			errors.Is(err, structs.ErrTaskHasOpenSubtasks) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("Invalid task hierarchy", err.Error()))
 		}
 		logger.Error("Failed to update task", "error", err.Error())
 		return c.Status(fiber.StatusInternalServerError).JSON(
@@ -305,18 +582,196 @@ func (h *TaskHandler) UpdateTask(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Task updated successfully", output))
 }
 
+// ChangeTaskStatus moves a task to another status
+// @Summary Change task status
+// @Description Moves a task to another status; any project member may do it when the project workflow allows the transition for their role and the WIP limit of the status is not reached. Project managers may override the WIP limit with override_wip_limit
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param status body dto.ChangeTaskStatusRequest true "Status change request"
+// @Success 200 {object} dto.TaskSuccessResponse "Task status changed"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input or task hierarchy"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized or not allowed to override the WIP limit"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task not found"
+// @Failure 409 {object} dto.ErrorResponse{details=dto.StatusTransitionErrorDetails} "Conflict - Status transition not allowed by the project workflow, task blocked by open tasks or WIP limit reached"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/status [put]
+func (h *TaskHandler) ChangeTaskStatus(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskHandler",
+		"handler", "ChangeTaskStatus",
+	)
+
+	id, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	input := &dto.ChangeTaskStatusRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	updatedTask, err := h.taskService.ChangeTaskStatus(ctx, userClaims.UserID, id, input.Status, input.OverrideWipLimit)
+	if err != nil {
+		var transitionErr *structs.StatusTransitionError
+		if errors.As(err, &transitionErr) {
+			return c.Status(fiber.StatusConflict).JSON(
+				createErrorResponse("Status transition not allowed", dto.MapToStatusTransitionErrorDetails(transitionErr)))
+		} else if errors.Is(err, structs.ErrTaskHasOpenBlockers) {
+			return c.Status(fiber.StatusConflict).JSON(
+				createErrorResponse("Task is blocked", err.Error()))
+		} else if errors.Is(err, structs.ErrWipLimitReached) {
+			return c.Status(fiber.StatusConflict).JSON(
+				createErrorResponse("WIP limit reached", err.Error()))
+		} else if errors.Is(err, structs.ErrWipLimitOverrideDenied) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		} else if errors.Is(err, structs.ErrTaskNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Task not found", err.Error()))
+		} else if errors.Is(err, structs.ErrUserNotAuthorizedForTask) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		} else if errors.Is(err, structs.ErrParentTaskDone) ||
+			errors.Is(err, structs.ErrTaskHasOpenSubtasks) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("Invalid task hierarchy", err.Error()))
+		}
+		logger.Error("Failed to change task status", "error", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	output := dto.MapToTaskResponse(updatedTask)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Task status changed successfully", output))
+}
+
+// MoveTask moves a task on the board
+// @Summary Move task on the board
+// @Description Moves a task to the column of a status and to a position within it, in one step: right after after_task_id and/or right before before_task_id, or at the end of the column without either. Like a status change, any project member may do it when the project workflow allows the transition for their role and the WIP limit of the status is not reached. Project managers may override the WIP limit with override_wip_limit
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param move body dto.MoveTaskRequest true "Move request"
+// @Success 200 {object} dto.TaskSuccessResponse "Task moved"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input or task hierarchy"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized or not allowed to override the WIP limit"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task not found"
+// @Failure 409 {object} dto.ErrorResponse{details=dto.StatusTransitionErrorDetails} "Conflict - Status transition not allowed, task blocked by open tasks, WIP limit reached, or neighbour tasks not in the target column"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/move [patch]
+func (h *TaskHandler) MoveTask(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskHandler",
+		"handler", "MoveTask",
+	)
+
+	id, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	input := &dto.MoveTaskRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	movedTask, err := h.taskService.MoveTask(ctx, userClaims.UserID, id, input)
+	if err != nil {
+		var transitionErr *structs.StatusTransitionError
+		if errors.As(err, &transitionErr) {
+			return c.Status(fiber.StatusConflict).JSON(
+				createErrorResponse("Status transition not allowed", dto.MapToStatusTransitionErrorDetails(transitionErr)))
+		} else if errors.Is(err, structs.ErrTaskHasOpenBlockers) {
+			return c.Status(fiber.StatusConflict).JSON(
+				createErrorResponse("Task is blocked", err.Error()))
+		} else if errors.Is(err, structs.ErrWipLimitReached) {
+			return c.Status(fiber.StatusConflict).JSON(
+				createErrorResponse("WIP limit reached", err.Error()))
+		} else if errors.Is(err, structs.ErrWipLimitOverrideDenied) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		} else if errors.Is(err, structs.ErrTaskNotInBoardColumn) ||
+			errors.Is(err, structs.ErrInvalidBoardPosition) {
+			return c.Status(fiber.StatusConflict).JSON(
+				createErrorResponse("Invalid board position", err.Error()))
+		} else if errors.Is(err, structs.ErrTaskNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Task not found", err.Error()))
+		} else if errors.Is(err, structs.ErrUserNotAuthorizedForTask) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		} else if errors.Is(err, structs.ErrParentTaskDone) ||
+			errors.Is(err, structs.ErrTaskHasOpenSubtasks) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("Invalid task hierarchy", err.Error()))
+		}
+		logger.Error("Failed to move task", "error", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	output := dto.MapToTaskResponse(movedTask)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Task moved successfully", output))
+}
+
 // AssignTaskToUser assigns a task to a user
 // @Summary Assign task to user
-// @Description Assigns a specific task to a user
+// @Description Assigns a specific task to a user, unless the user already holds as many tasks of the task's status as the WIP limit per assignee allows
 // @Tags Tasks
 // @Produce json
 // @Security BearerAuth
 // @Param taskId path int true "Task ID"
 // @Param userId path int true "User ID"
+// @Param override_wip_limit query bool false "Assign past the WIP limit per assignee"
 // @Success 202 {object} dto.GenericSuccessResponse "Task assigned successfully"
-// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid IDs or user not in project"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid IDs, user not in project or project viewer"
 // @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
 // @Failure 404 {object} dto.ErrorResponse "Not found - Task or user not found"
+// @Failure 409 {object} dto.ErrorResponse "Conflict - WIP limit per assignee reached"
 // @Failure 500 {object} dto.ErrorResponse "Internal server error"
 // @Router /tasks/{taskId}/user/{userId} [post]
 func (h *TaskHandler) AssignTaskToUser(c *fiber.Ctx) error {
@@ -344,7 +799,12 @@ func (h *TaskHandler) AssignTaskToUser(c *fiber.Ctx) error {
 			createErrorResponse("Internal server error", nil))
 	}
 
-	if err := h.taskService.AssignTaskToUser(ctx, userID, userClaims.UserID, taskID); err != nil {
+	overrideWipLimit, err := verifyOverrideWipLimitQuery(c, logger)
+	if err != nil {
+		return err
+	}
+
+	if err := h.taskService.AssignTaskToUser(ctx, userID, userClaims.UserID, taskID, overrideWipLimit); err != nil {
 		if errors.Is(err, structs.ErrTaskNotExist) {
 			return c.Status(fiber.StatusNotFound).JSON(
 				createErrorResponse("Task not found", err.Error()))
@@ -357,6 +817,15 @@ func (h *TaskHandler) AssignTaskToUser(c *fiber.Ctx) error {
 		} else if errors.Is(err, structs.ErrUserNotPartProject) {
 			return c.Status(fiber.StatusBadRequest).JSON(
 				createErrorResponse("User is not part of the project", err.Error()))
+		} else if errors.Is(err, structs.ErrMemberCannotBeAssigned) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("User cannot be assigned tasks in this project", err.Error()))
+		} else if errors.Is(err, structs.ErrWipLimitReached) {
+			return c.Status(fiber.StatusConflict).JSON(
+				createErrorResponse("WIP limit reached", err.Error()))
+		} else if errors.Is(err, structs.ErrWipLimitOverrideDenied) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
 		}
 		logger.Error("Failed to assign task", "error", err.Error())
 		return c.Status(fiber.StatusInternalServerError).JSON(
@@ -369,16 +838,34 @@ func (h *TaskHandler) AssignTaskToUser(c *fiber.Ctx) error {
 
 // FindTasks retrieves tasks based on filters
 // @Summary Find tasks with filters
-// @Description Retrieves tasks based on optional query parameters (id, title, status, priority, due_date_before)
+// @Description Retrieves tasks based on optional query parameters (id, title, status, priority, due_date_before, story points, labels)
+// @Description and on a filter expression such as `status = TO_DO and (priority >= HIGH or due < 2025-01-01) and assignee = me`.
+// @Description Fields: id, title, description, status, priority, due, created, updated, assignee, project, sprint, parent, story_points, label.
+// @Description Operators: = != < <= > >= ~ (contains) in (...) not in (...) is empty, is not empty; combined with and, or, not and parentheses.
+// @Description Searches the tasks of the projects the requestor is a member of.
 // @Tags Tasks
 // @Produce json
+// @Security BearerAuth
 // @Param id query int false "Task ID"
 // @Param title query string false "Task title"
 // @Param status query string false "Task status" Enums(OPEN, IN_PROGRESS, DONE)
 // @Param priority query string false "Task priority" Enums(LOW, MEDIUM, HIGH)
 // @Param due_date_before query string false "Due date before (format: YYYY-MM-DD)"
+// @Param story_points_min query int false "Minimum story points"
+// @Param story_points_max query int false "Maximum story points"
+// @Param estimated query bool false "Only tasks with (true) or without (false) story points"
+// @Param labels query string false "Comma separated label IDs, e.g. 1,4"
+// @Param labels_match query string false "Whether tasks carry any (default) or all of the labels" Enums(any, all)
+// @Param filter query string false "Filter expression, e.g. status in (TO_DO, IN_PROGRESS) and label = 4"
+// @Param view query int false "Saved view ID; its filter is combined with the other parameters"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param page query int false "Page number, ignored when cursor is set"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort as field:asc or field:desc (fields: id, title, status, priority, created_at, updated_at)"
 // @Success 202 {object} dto.TaskSliceSuccessResponse "Tasks found"
 // @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid query parameters"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - Saved view of a project the user is not part of"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Saved view not found"
 // @Failure 500 {object} dto.ErrorResponse "Internal server error"
 // @Router /tasks [get]
 func (h *TaskHandler) FindTasks(c *fiber.Ctx) error {
@@ -424,6 +911,91 @@ func (h *TaskHandler) FindTasks(c *fiber.Ctx) error {
 			filter.DueDateBefore = &dueDate
 		}
 	}
+	if minStr := c.Query("story_points_min"); minStr != "" {
+		storyPointsMin, err := strconv.Atoi(minStr)
+		if err != nil || storyPointsMin < 0 {
+			logger.Error("Invalid story_points_min parameter", "story_points_min", minStr)
+			parseErrors = append(parseErrors, "Invalid story_points_min parameter")
+		} else {
+			filter.StoryPointsMin = &storyPointsMin
+		}
+	}
+	if maxStr := c.Query("story_points_max"); maxStr != "" {
+		storyPointsMax, err := strconv.Atoi(maxStr)
+		if err != nil || storyPointsMax < 0 {
+			logger.Error("Invalid story_points_max parameter", "story_points_max", maxStr)
+			parseErrors = append(parseErrors, "Invalid story_points_max parameter")
+		} else {
+			filter.StoryPointsMax = &storyPointsMax
+		}
+	}
+	if estimatedStr := c.Query("estimated"); estimatedStr != "" {
+		estimated, err := strconv.ParseBool(estimatedStr)
+		if err != nil {
+			logger.Error("Invalid estimated parameter", "estimated", estimatedStr)
+			parseErrors = append(parseErrors, "Invalid estimated parameter")
+		} else {
+			filter.Estimated = &estimated
+		}
+	}
+	if labelsStr := c.Query("labels"); labelsStr != "" {
+		seen := make(map[int]struct{})
+		for _, part := range strings.Split(labelsStr, ",") {
+			labelID, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil || labelID < 1 {
+				logger.Error("Invalid labels parameter", "labels", labelsStr)
+				parseErrors = append(parseErrors, "Invalid labels parameter")
+				break
+			}
+			if _, ok := seen[labelID]; ok {
+				continue
+			}
+			seen[labelID] = struct{}{}
+			filter.LabelIDs = append(filter.LabelIDs, labelID)
+		}
+	}
+	switch match := c.Query("labels_match", "any"); match {
+	case "any":
+	case "all":
+		filter.LabelMatchAll = true
+	default:
+		logger.Error("Invalid labels_match parameter", "labels_match", match)
+		parseErrors = append(parseErrors, "Invalid labels_match parameter")
+	}
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+	filter.CurrentUserID = userClaims.UserID
+	filter.MemberUserID = &userClaims.UserID
+	expression, viewStr := c.Query("filter"), c.Query("view")
+	if expression != "" {
+		expr, exprErr := parseFilterExpression(expression, h.cfg.Format)
+		if exprErr != nil {
+			logger.Warn("Invalid filter parameter", "filter", expression, "error", exprErr)
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("Invalid filter expression", exprErr))
+		}
+		filter.Expression = expr
+	}
+	if viewStr != "" {
+		viewID, err := strconv.Atoi(viewStr)
+		if err != nil || viewID < 1 {
+			logger.Error("Invalid view parameter", "view", viewStr)
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("Validation failed", []string{"Invalid view parameter"}))
+		}
+		viewExpr, err := h.savedViewService.ResolveViewFilter(ctx, filter.CurrentUserID, viewID)
+		if err != nil {
+			return savedViewErrorResponse(c, logger, err)
+		}
+		filter.Expression = combineFilterExpressions(filter.Expression, viewExpr)
+	}
+
+	page, pageErrors := parsePageRequest(c, dto.TaskSortFields)
+	parseErrors = append(parseErrors, pageErrors...)
 
 	if len(parseErrors) > 0 {
 		return c.Status(fiber.StatusBadRequest).JSON(
@@ -431,7 +1003,7 @@ func (h *TaskHandler) FindTasks(c *fiber.Ctx) error {
 	}
 
 	logger.Debug("Validation successful", "filter", *filter)
-	tasks, err := h.taskService.FindTasks(ctx, filter)
+	tasks, pageInfo, err := h.taskService.FindTasks(ctx, filter, page)
 	if err != nil {
 		logger.Error("Service error finding tasks", "error", err.Error())
 		return c.Status(fiber.StatusInternalServerError).JSON(
@@ -441,12 +1013,107 @@ func (h *TaskHandler) FindTasks(c *fiber.Ctx) error {
 	output := dto.MapToSliceOfTaskResponse(tasks)
 	logger.Debug("Response is prepared", "response", output)
 	return c.Status(fiber.StatusOK).JSON(
-		createSliceSuccessResponseGeneric("Tasks found successfully", output))
+		createPageSuccessResponse("Tasks found successfully", output, pageInfo))
+}
+
+// parseFilterExpression parses the filter query parameter of FindTasks,
+// locating the offending token when the expression is invalid.
+func parseFilterExpression(input, dateLayout string) (filter.Expr, *dto.FilterExpressionError) {
+	expr, err := filter.Parse(input, dateLayout)
+	if err != nil {
+		return nil, mapFilterExpressionError(err)
+	}
+	return expr, nil
+}
+
+// mapFilterExpressionError maps an error of filter.Parse to its response details.
+func mapFilterExpressionError(err error) *dto.FilterExpressionError {
+	var filterErr *filter.Error
+	if errors.As(err, &filterErr) {
+		return &dto.FilterExpressionError{
+			Position: filterErr.Pos,
+			Token:    filterErr.Token,
+			Message:  filterErr.Message,
+		}
+	}
+	return &dto.FilterExpressionError{Message: err.Error()}
+}
+
+// combineFilterExpressions requires both expressions; either may be nil.
+func combineFilterExpressions(a, b filter.Expr) filter.Expr {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	return filter.And{Exprs: []filter.Expr{a, b}}
+}
+
+// FindTaskHistory retrieves the change history of a task
+// @Summary Get task history
+// @Description Retrieves who changed what in a task and when, newest first by default
+// @Tags Tasks
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param page query int false "Page number, ignored when cursor is set"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort as field:asc or field:desc (fields: id, created_at; default id:desc)"
+// @Success 200 {object} dto.ActivitySliceSuccessResponse "Task history found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid task ID or query parameters"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/history [get]
+func (h *TaskHandler) FindTaskHistory(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskHandler",
+		"handler", "FindTaskHistory",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+
+	page, parseErrors := parseFeedPageRequest(c, dto.ActivitySortFields)
+	if len(parseErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid query parameters", parseErrors))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	activities, pageInfo, err := h.taskService.FindTaskHistory(ctx, userClaims.UserID, taskID, page)
+	if err != nil {
+		if errors.Is(err, structs.ErrTaskNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Task not found", err.Error()))
+		} else if errors.Is(err, structs.ErrUserNotAuthorizedForTask) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		}
+		logger.Error("Failed to find task history", "error", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	output := dto.MapToSliceOfActivityResponse(activities)
+	return c.Status(fiber.StatusOK).JSON(createPageSuccessResponse("Task history found successfully", output, pageInfo))
 }
 
 // DeleteTask deletes a task by ID
 // @Summary Delete a task
-// @Description Deletes a specific task
+// @Description Deletes a specific task together with all of its subtasks
 // @Tags Tasks
 // @Produce json
 // @Security BearerAuth
@@ -481,7 +1148,9 @@ func (h *TaskHandler) DeleteTask(c *fiber.Ctx) error {
 		if errors.Is(err, structs.ErrTaskNotExist) {
 			return c.Status(fiber.StatusNotFound).JSON(
 				createErrorResponse("Task not found", err.Error()))
-		} else if errors.Is(err, structs.ErrDatabaseFail) {
+		} else if errors.Is(err, structs.ErrDatabaseFail) ||
+			errors.Is(err, structs.ErrTaskHierarchyCycle) ||
+			errors.Is(err, structs.ErrTaskHierarchyTooDeep) {
 			return c.Status(fiber.StatusInternalServerError).JSON(
 				createErrorResponse("Internal server error", nil))
 		}
@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/service"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// TaskLinkHandler handles task link HTTP requests
+type TaskLinkHandler struct {
+	taskLinkService service.TaskLinkService
+}
+
+// NewTaskLinkHandler creates a new TaskLinkHandler instance
+func NewTaskLinkHandler(taskLinkService service.TaskLinkService) *TaskLinkHandler {
+	return &TaskLinkHandler{
+		taskLinkService: taskLinkService,
+	}
+}
+
+// ListTaskLinks retrieves the links of a task
+// @Summary Get links of a task
+// @Description Retrieves the links of a task to other tasks, in both directions
+// @Tags Task Links
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Success 200 {object} dto.TaskLinkSliceSuccessResponse "Task links found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid task ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/links [get]
+func (h *TaskLinkHandler) ListTaskLinks(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskLinkHandler",
+		"handler", "ListTaskLinks",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	links, err := h.taskLinkService.ListTaskLinks(ctx, userClaims.UserID, taskID)
+	if err != nil {
+		return taskLinkErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToSliceOfTaskLinkResponse(links, taskID)
+	return c.Status(fiber.StatusOK).JSON(createSliceSuccessResponseGeneric("Task links found successfully", output))
+}
+
+// CreateTaskLink links a task to another task
+// @Summary Link a task to another task
+// @Description Links the task to a target task of the same project; the task blocks, relates to or duplicates the target. Blocking links cannot form cycles
+// @Tags Task Links
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param link body dto.CreateTaskLinkRequest true "Task link creation request"
+// @Success 201 {object} dto.TaskLinkSuccessResponse "Task link created successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input, self link or tasks of different projects"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task not found"
+// @Failure 409 {object} dto.ErrorResponse "Conflict - Tasks already linked or blocking cycle"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/links [post]
+func (h *TaskLinkHandler) CreateTaskLink(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskLinkHandler",
+		"handler", "CreateTaskLink",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Parsing input...")
+	input := &dto.CreateTaskLinkRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	link, err := h.taskLinkService.CreateTaskLink(ctx, userClaims.UserID, taskID, input.TargetTaskID, input.Type)
+	if err != nil {
+		return taskLinkErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToTaskLinkResponse(link, taskID)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusCreated).JSON(createSuccessResponse("Task link created successfully", output))
+}
+
+// DeleteTaskLink removes a link of a task
+// @Summary Delete a task link
+// @Description Removes a link the task is the source or the target of
+// @Tags Task Links
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param linkId path int true "Link ID"
+// @Success 200 {object} dto.GenericSuccessResponse "Task link deleted successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task or link not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/links/{linkId} [delete]
+func (h *TaskLinkHandler) DeleteTaskLink(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskLinkHandler",
+		"handler", "DeleteTaskLink",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+	linkID, err := verifyIdParamInt(c, logger, "linkId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	if err := h.taskLinkService.DeleteTaskLink(ctx, userClaims.UserID, taskID, linkID); err != nil {
+		return taskLinkErrorResponse(c, logger, err)
+	}
+
+	logger.Info("Task link deleted successfully", "link_id", linkID)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse[any]("Task link deleted successfully", nil))
+}
+
+// taskLinkErrorResponse maps the errors of the task link service to responses.
+func taskLinkErrorResponse(c *fiber.Ctx, logger *slog.Logger, err error) error {
+	if errors.Is(err, structs.ErrTaskNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Task not found", err.Error()))
+	} else if errors.Is(err, structs.ErrTaskLinkNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Task link not found", err.Error()))
+	} else if errors.Is(err, structs.ErrTaskLinkSelf) ||
+		errors.Is(err, structs.ErrTaskLinkProjectMismatch) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid task link", err.Error()))
+	} else if errors.Is(err, structs.ErrTaskLinkExists) ||
+		errors.Is(err, structs.ErrTaskLinkCycle) {
+		return c.Status(fiber.StatusConflict).JSON(
+			createErrorResponse("Task link conflict", err.Error()))
+	} else if errors.Is(err, structs.ErrUserNotAuthorizedForTask) ||
+		errors.Is(err, structs.ErrUserNotManageProject) ||
+		errors.Is(err, structs.ErrUserNotPartProject) {
+		return c.Status(fiber.StatusForbidden).JSON(
+			createErrorResponse("Forbidden", err.Error()))
+	}
+	logger.Error("Task link operation failed", "error", err.Error())
+	return c.Status(fiber.StatusInternalServerError).JSON(
+		createErrorResponse("Internal server error", nil))
+}
@@ -86,7 +86,7 @@ func (h *UserHandler) CreateUserHandler(c *fiber.Ctx) error {
 // @Accept json
 // @Produce json
 // @Param login body dto.LoginRequest true "Login credentials"
-// @Success 202 {object} dto.TokenResponse "Login successful"
+// @Success 202 {object} dto.TokenSuccessResponse "Login successful"
 // @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid credentials or input"
 // @Failure 500 {object} dto.ErrorResponse "Internal server error"
 // @Router /login [post]
@@ -116,7 +116,8 @@ func (h *UserHandler) Login(c *fiber.Ctx) error {
 	}
 
 	if token, err := h.userService.Login(ctx, *input); err != nil {
-		if errors.Is(err, structs.ErrDatabaseFail) || errors.Is(err, structs.ErrInternalServer) {
+		if errors.Is(err, structs.ErrDatabaseFail) || errors.Is(err, structs.ErrInternalServer) ||
+			errors.Is(err, structs.ErrRedisConnection) {
 			return c.Status(fiber.StatusInternalServerError).JSON(
 				createErrorResponse("Internal server error", err))
 		} else if errors.Is(err, structs.ErrEmailNotExist) || errors.Is(err, structs.ErrPasswordIncorrect) {
@@ -127,12 +128,93 @@ func (h *UserHandler) Login(c *fiber.Ctx) error {
 				createErrorResponse("Bad request", err.Error()))
 		}
 	} else {
-		return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
-			"token": token,
-		})
+		return c.Status(fiber.StatusAccepted).JSON(
+			createSuccessResponse("user login successfully", token))
 	}
 }
 
+// RefreshToken exchanges a refresh token for a new token pair
+// @Summary Refresh tokens
+// @Description Rotates the refresh token and returns a new access and refresh token. Reusing an already exchanged refresh token revokes every token of the user.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param refresh body dto.RefreshTokenRequest true "Refresh token"
+// @Success 200 {object} dto.TokenSuccessResponse "Token refreshed"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input"
+// @Failure 401 {object} dto.ErrorResponse "Refresh token is invalid, expired or reused"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /token/refresh [post]
+func (h *UserHandler) RefreshToken(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+
+	logger := baseLogger.With(
+		"component", "UserHandler",
+		"handler", "RefreshToken",
+	)
+
+	input := &dto.RefreshTokenRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Can not parse JSON", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	if errs := utils.ValidateStruct(*input); errs != nil {
+		logger.Error("Validation failed", "error", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	token, err := h.userService.RefreshToken(ctx, input.RefreshToken)
+	if err != nil {
+		logger.Warn("Failed to refresh token", "error", err)
+		if errors.Is(err, structs.ErrRefreshTokenReused) {
+			return c.Status(fiber.StatusUnauthorized).JSON(
+				createErrorResponse("Refresh token has already been used, please log in again", nil))
+		} else if errors.Is(err, structs.ErrRefreshTokenInvalid) {
+			return c.Status(fiber.StatusUnauthorized).JSON(
+				createErrorResponse("Refresh token is invalid or expired", nil))
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", err.Error()))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		createSuccessResponse("token refreshed successfully", token))
+}
+
+// Logout ends the session of the current access token
+// @Summary User logout
+// @Description Revokes the access token of the request and its refresh token
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.GenericSuccessResponse "Logged out"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /logout [post]
+func (h *UserHandler) Logout(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+
+	logger := baseLogger.With(
+		"component", "UserHandler",
+		"handler", "Logout",
+	)
+
+	userClaims, _ := c.Locals("user_claims").(*structs.Claims)
+
+	if err := h.userService.Logout(ctx, userClaims); err != nil {
+		logger.Error("Failed to logout", "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", err.Error()))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		createSuccessResponse[any]("user logged out successfully", nil))
+}
+
 // GetMe retrieves the authenticated user's details
 // @Summary Get current user
 // @Description Retrieves details of the authenticated user
@@ -217,14 +299,17 @@ func (h *UserHandler) GetUser(c *fiber.Ctx) error {
 
 // UpdateUser updates a user's details
 // @Summary Update a user
-// @Description Updates an existing user's details
+// @Description Updates an existing user's details, including which notification emails the user receives
 // @Tags Users
 // @Accept json
 // @Produce json
 // @Param userId path int true "User ID"
 // @Param user body dto.UpdateUserRequest true "User update request"
+// @Security BearerAuth
 // @Success 202 {object} dto.UserSuccessResponse "User updated"
 // @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input or ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - Owner or admin access required"
+// @Failure 404 {object} dto.ErrorResponse "User not found"
 // @Failure 500 {object} dto.ErrorResponse "Internal server error"
 // @Router /users/{userId} [put]
 func (h *UserHandler) UpdateUser(c *fiber.Ctx) error {
@@ -254,6 +339,10 @@ func (h *UserHandler) UpdateUser(c *fiber.Ctx) error {
 	logger.Debug("Validation finish successfully for input", "input", *input)
 	updatedUser, err := h.userService.UpdateUser(ctx, id, input)
 	if err != nil {
+		if errors.Is(err, structs.ErrUserNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("user is not found", err.Error()))
+		}
 		if errors.Is(err, structs.ErrDatabaseFail) {
 			return c.Status(fiber.StatusInternalServerError).JSON(
 				createErrorResponse("Internal database fail", nil),
@@ -265,27 +354,96 @@ func (h *UserHandler) UpdateUser(c *fiber.Ctx) error {
 	}
 	output := dto.MapToUserDto(updatedUser)
 	return c.Status(fiber.StatusAccepted).JSON(createSuccessResponse(
-		"Student has been updated", output,
+		"User has been updated", output,
 	))
 }
 
+// ChangePassword changes a user's password
+// @Summary Change password
+// @Description Changes the password of a user after checking the current one; every token issued to the user before the change stops working
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param userId path int true "User ID"
+// @Param password body dto.ChangePasswordRequest true "Password change request"
+// @Success 200 {object} dto.GenericSuccessResponse "Password changed"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input, ID or current password"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - Owner or admin access required"
+// @Failure 404 {object} dto.ErrorResponse "User not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /users/{userId}/password [put]
+func (h *UserHandler) ChangePassword(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	logger := utils.LoggerFromContext(ctx).With(
+		"component", "UserHandler",
+		"handler", "ChangePassword",
+	)
+	id, err := verifyIdParamInt(c, logger, "userId")
+	if err != nil {
+		return err
+	}
+
+	input := &dto.ChangePasswordRequest{}
+	if err = c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse JSON", "error", err)
+		return c.Status(fiber.StatusBadRequest).JSON(createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(createErrorResponse("Validation failed", errs))
+	}
+
+	if err := h.userService.ChangePassword(ctx, id, input); err != nil {
+		if errors.Is(err, structs.ErrUserNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("user is not found", err.Error()))
+		} else if errors.Is(err, structs.ErrPasswordIncorrect) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("Current password is not correct", nil))
+		} else if errors.Is(err, structs.ErrPasswordTooLong) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("New password is too long", nil))
+		}
+		logger.Error("Failed to change password", "error", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	logger.Info("Password changed", "user_id", id)
+	return c.Status(fiber.StatusOK).JSON(
+		createSuccessResponse[any]("Password has been changed, please log in again", nil))
+}
+
 // GetUsers retrieves all users
 // @Summary Get all users
 // @Description Retrieves a list of all users
 // @Tags Users
 // @Produce json
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param page query int false "Page number, ignored when cursor is set"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort as field:asc or field:desc (fields: id, email, first_name, last_name, role, created_at)"
 // @Success 200 {object} dto.UserSliceSuccessResponse "Users found"
-// @Failure 400 {object} dto.ErrorResponse "Bad request - Database error"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid query parameters or database error"
 // @Router /users [get]
 func (h *UserHandler) GetUsers(c *fiber.Ctx) error {
 	ctx := c.UserContext()
-	if users, err := h.userService.GetAllUsers(ctx); err != nil {
+	page, parseErrors := parsePageRequest(c, dto.UserSortFields)
+	if len(parseErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid query parameters", parseErrors))
+	}
+
+	if users, pageInfo, err := h.userService.GetAllUsers(ctx, page); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(
 			createErrorResponse("Internal database error", err.Error()))
 	} else {
 		output := dto.MapToUserDtoSlice(users)
 		return c.Status(fiber.StatusOK).JSON(
-			createSliceSuccessResponseGeneric("Found all users", output))
+			createPageSuccessResponse("Found all users", output, pageInfo))
 	}
 }
 
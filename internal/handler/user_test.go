@@ -154,7 +154,7 @@ func TestUserHandler_Login(t *testing.T) {
 		Password: "password123",
 	}
 	validInputJson, _ := json.Marshal(validInput)
-	expectedToken := "mock_jwt_token"
+	expectedToken := &dto.TokenResponse{Token: "mock_jwt_token", RefreshToken: "mock_refresh_token", TokenType: "Bearer", ExpiresIn: 3600}
 
 	t.Run("Success", func(t *testing.T) {
 		mockUserService.EXPECT().
@@ -171,7 +171,8 @@ func TestUserHandler_Login(t *testing.T) {
 		assert.Equal(t, "user login successfully", body["message"])
 		data, ok := body["data"].(map[string]any)
 		require.True(t, ok)
-		assert.Equal(t, expectedToken, data["token"])
+		assert.Equal(t, expectedToken.Token, data["token"])
+		assert.Equal(t, expectedToken.RefreshToken, data["refresh_token"])
 	})
 
 	t.Run("Bad JSON", func(t *testing.T) {
@@ -189,7 +190,7 @@ func TestUserHandler_Login(t *testing.T) {
 	t.Run("Credentials Incorrect (Email)", func(t *testing.T) {
 		mockUserService.EXPECT().
 			Login(gomock.Any(), validInput).
-			Return("", structs.ErrEmailNotExist).
+			Return(nil, structs.ErrEmailNotExist).
 			Times(1)
 
 		resp := performRequest(t, app, "POST", "/login", bytes.NewReader(validInputJson), nil)
@@ -203,7 +204,7 @@ func TestUserHandler_Login(t *testing.T) {
 	t.Run("Credentials Incorrect (Password)", func(t *testing.T) {
 		mockUserService.EXPECT().
 			Login(gomock.Any(), validInput).
-			Return("", structs.ErrPasswordIncorrect).
+			Return(nil, structs.ErrPasswordIncorrect).
 			Times(1)
 
 		resp := performRequest(t, app, "POST", "/login", bytes.NewReader(validInputJson), nil)
@@ -217,7 +218,7 @@ func TestUserHandler_Login(t *testing.T) {
 	t.Run("Internal Server Error (DB)", func(t *testing.T) {
 		mockUserService.EXPECT().
 			Login(gomock.Any(), validInput).
-			Return("", structs.ErrDatabaseFail).
+			Return(nil, structs.ErrDatabaseFail).
 			Times(1)
 
 		resp := performRequest(t, app, "POST", "/login", bytes.NewReader(validInputJson), nil)
@@ -232,7 +233,7 @@ func TestUserHandler_Login(t *testing.T) {
 		otherErr := errors.New("some token signing issue maybe")
 		mockUserService.EXPECT().
 			Login(gomock.Any(), validInput).
-			Return("", otherErr).
+			Return(nil, otherErr).
 			Times(1)
 
 		resp := performRequest(t, app, "POST", "/login", bytes.NewReader(validInputJson), nil)
@@ -244,6 +245,71 @@ func TestUserHandler_Login(t *testing.T) {
 	})
 }
 
+func TestUserHandler_RefreshToken(t *testing.T) {
+	ctrl, mockUserService, handler := setupUserHandlerTest(t)
+	defer ctrl.Finish()
+
+	app := setupTestAppWithLogger(handler)
+	app.Post("/token/refresh", handler.RefreshToken)
+
+	input := dto.RefreshTokenRequest{RefreshToken: "old_refresh_token"}
+	inputJson, _ := json.Marshal(input)
+	expectedToken := &dto.TokenResponse{Token: "new_jwt_token", RefreshToken: "new_refresh_token", TokenType: "Bearer", ExpiresIn: 3600}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserService.EXPECT().
+			RefreshToken(gomock.Any(), input.RefreshToken).
+			Return(expectedToken, nil).
+			Times(1)
+
+		resp := performRequest(t, app, "POST", "/token/refresh", bytes.NewReader(inputJson), nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var body map[string]any
+		err := json.NewDecoder(resp.Body).Decode(&body)
+		require.NoError(t, err)
+		data, ok := body["data"].(map[string]any)
+		require.True(t, ok)
+		assert.Equal(t, expectedToken.Token, data["token"])
+		assert.Equal(t, expectedToken.RefreshToken, data["refresh_token"])
+	})
+
+	t.Run("Validation Error", func(t *testing.T) {
+		resp := performRequest(t, app, "POST", "/token/refresh", strings.NewReader("{}"), nil)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Token Reused", func(t *testing.T) {
+		mockUserService.EXPECT().
+			RefreshToken(gomock.Any(), input.RefreshToken).
+			Return(nil, structs.ErrRefreshTokenReused).
+			Times(1)
+
+		resp := performRequest(t, app, "POST", "/token/refresh", bytes.NewReader(inputJson), nil)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("Token Invalid", func(t *testing.T) {
+		mockUserService.EXPECT().
+			RefreshToken(gomock.Any(), input.RefreshToken).
+			Return(nil, structs.ErrRefreshTokenInvalid).
+			Times(1)
+
+		resp := performRequest(t, app, "POST", "/token/refresh", bytes.NewReader(inputJson), nil)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("Internal Server Error", func(t *testing.T) {
+		mockUserService.EXPECT().
+			RefreshToken(gomock.Any(), input.RefreshToken).
+			Return(nil, structs.ErrRedisConnection).
+			Times(1)
+
+		resp := performRequest(t, app, "POST", "/token/refresh", bytes.NewReader(inputJson), nil)
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+}
+
 func TestUserHandler_GetMe(t *testing.T) {
 	ctrl, mockUserService, handler := setupUserHandlerTest(t)
 	defer ctrl.Finish()
@@ -402,7 +468,7 @@ func TestUserHandler_UpdateUser(t *testing.T) {
 		var body map[string]any
 		err := json.NewDecoder(resp.Body).Decode(&body)
 		require.NoError(t, err)
-		assert.Equal(t, "Student has been updated", body["message"])
+		assert.Equal(t, "User has been updated", body["message"])
 		data, ok := body["data"].(map[string]any)
 		require.True(t, ok)
 		assert.Equal(t, float64(updatedUser.ID), data["id"])
@@ -418,8 +484,24 @@ func TestUserHandler_UpdateUser(t *testing.T) {
 	t.Run("Validation Error in Body", func(t *testing.T) {
 	})
 
-	t.Run("Service Validation Error (e.g., Not Found)", func(t *testing.T) {
-		serviceErr := structs.ErrUserNotExist
+	t.Run("User Not Found", func(t *testing.T) {
+		serviceErr := fmt.Errorf("repository failed to update user: %w", structs.ErrUserNotExist)
+		mockUserService.EXPECT().
+			UpdateUser(gomock.Any(), targetUserID, &updateInput).
+			Return(nil, serviceErr).
+			Times(1)
+
+		resp := performRequest(t, app, "PUT", urlPath, bytes.NewReader(updateInputJson), nil)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+		var body map[string]any
+		err := json.NewDecoder(resp.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "user is not found", body["message"])
+		assert.Contains(t, body["details"], structs.ErrUserNotExist.Error())
+	})
+
+	t.Run("Service Validation Error", func(t *testing.T) {
+		serviceErr := errors.New("invalid update")
 		mockUserService.EXPECT().
 			UpdateUser(gomock.Any(), targetUserID, &updateInput).
 			Return(nil, serviceErr).
@@ -449,6 +531,64 @@ func TestUserHandler_UpdateUser(t *testing.T) {
 	})
 }
 
+func TestUserHandler_ChangePassword(t *testing.T) {
+	ctrl, mockUserService, handler := setupUserHandlerTest(t)
+	defer ctrl.Finish()
+
+	app := setupTestAppWithLogger(handler)
+	app.Put("/users/:userId/password", handler.ChangePassword)
+
+	targetUserID := 15
+	input := dto.ChangePasswordRequest{
+		CurrentPassword: "oldPassword123",
+		NewPassword:     "newPassword456",
+	}
+	inputJson, _ := json.Marshal(input)
+	urlPath := fmt.Sprintf("/users/%d/password", targetUserID)
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserService.EXPECT().
+			ChangePassword(gomock.Any(), targetUserID, &input).
+			Return(nil).
+			Times(1)
+
+		resp := performRequest(t, app, "PUT", urlPath, bytes.NewReader(inputJson), nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Validation Error - Same Password", func(t *testing.T) {
+		samePassword := dto.ChangePasswordRequest{CurrentPassword: "samePassword1", NewPassword: "samePassword1"}
+		body, _ := json.Marshal(samePassword)
+
+		resp := performRequest(t, app, "PUT", urlPath, bytes.NewReader(body), nil)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Current Password Incorrect", func(t *testing.T) {
+		mockUserService.EXPECT().
+			ChangePassword(gomock.Any(), targetUserID, &input).
+			Return(structs.ErrPasswordIncorrect).
+			Times(1)
+
+		resp := performRequest(t, app, "PUT", urlPath, bytes.NewReader(inputJson), nil)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		var body map[string]any
+		err := json.NewDecoder(resp.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "Current password is not correct", body["message"])
+	})
+
+	t.Run("User Not Found", func(t *testing.T) {
+		mockUserService.EXPECT().
+			ChangePassword(gomock.Any(), targetUserID, &input).
+			Return(structs.ErrUserNotExist).
+			Times(1)
+
+		resp := performRequest(t, app, "PUT", urlPath, bytes.NewReader(inputJson), nil)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
 func TestUserHandler_GetUsers(t *testing.T) {
 	ctrl, mockUserService, handler := setupUserHandlerTest(t)
 	defer ctrl.Finish()
@@ -463,8 +603,8 @@ func TestUserHandler_GetUsers(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockUserService.EXPECT().
-			GetAllUsers(gomock.Any()).
-			Return(foundUsers, nil).
+			GetAllUsers(gomock.Any(), &dto.PageRequest{Limit: dto.DefaultPageLimit, Page: 1, SortField: "id"}).
+			Return(foundUsers, &dto.PageInfo{Total: 2, Limit: dto.DefaultPageLimit, Page: 1}, nil).
 			Times(1)
 
 		resp := performRequest(t, app, "GET", "/users", nil, nil)
@@ -484,13 +624,36 @@ func TestUserHandler_GetUsers(t *testing.T) {
 		assert.Equal(t, "user1@example.com", user1["email"])
 		assert.Equal(t, float64(2), user2["id"])
 		assert.Equal(t, "user2@example.com", user2["email"])
+		assert.Equal(t, float64(2), body["total"])
+	})
+
+	t.Run("Paging Parameters", func(t *testing.T) {
+		mockUserService.EXPECT().
+			GetAllUsers(gomock.Any(), &dto.PageRequest{Limit: 1, Page: 2, SortField: "email", SortDesc: true}).
+			Return(foundUsers[:1], &dto.PageInfo{Total: 2, Limit: 1, Page: 2}, nil).
+			Times(1)
+
+		resp := performRequest(t, app, "GET", "/users?limit=1&page=2&sort=email:desc", nil, nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Invalid Paging Parameters", func(t *testing.T) {
+		resp := performRequest(t, app, "GET", "/users?limit=1000&sort=password:asc", nil, nil)
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var body map[string]any
+		err := json.NewDecoder(resp.Body).Decode(&body)
+		require.NoError(t, err)
+		details, ok := body["details"].([]any)
+		require.True(t, ok)
+		assert.Len(t, details, 2)
 	})
 
 	t.Run("Service Error", func(t *testing.T) {
 		serviceErr := errors.New("failed to list users")
 		mockUserService.EXPECT().
-			GetAllUsers(gomock.Any()).
-			Return(nil, serviceErr).
+			GetAllUsers(gomock.Any(), gomock.Any()).
+			Return(nil, nil, serviceErr).
 			Times(1)
 
 		resp := performRequest(t, app, "GET", "/users", nil, nil)
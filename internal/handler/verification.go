@@ -2,6 +2,7 @@ package handler
 
 import (
 	"log/slog"
+	"strconv"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -24,3 +25,24 @@ func verifyIdParamInt(c *fiber.Ctx, baseLogger *slog.Logger, param string) (int,
 
 	return id, nil
 }
+
+// verifyOverrideWipLimitQuery reads the optional override_wip_limit query
+// parameter, false when it is absent.
+func verifyOverrideWipLimitQuery(c *fiber.Ctx, baseLogger *slog.Logger) (bool, error) {
+	logger := baseLogger.With(
+		"method", "verifyOverrideWipLimitQuery",
+	)
+
+	overrideStr := c.Query("override_wip_limit")
+	if overrideStr == "" {
+		return false, nil
+	}
+
+	override, err := strconv.ParseBool(overrideStr)
+	if err != nil {
+		logger.Error("Invalid override_wip_limit parameter", "override_wip_limit", overrideStr)
+		return false, c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid query parameters", []string{"Invalid override_wip_limit parameter"}))
+	}
+	return override, nil
+}
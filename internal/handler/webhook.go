@@ -0,0 +1,370 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/service"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// WebhookHandler handles webhook HTTP requests
+type WebhookHandler struct {
+	webhookService service.WebhookService
+}
+
+// NewWebhookHandler creates a new WebhookHandler instance
+func NewWebhookHandler(webhookService service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// ListWebhooks retrieves the webhooks of a project
+// @Summary Get webhooks of a project
+// @Description Retrieves the webhook subscriptions of a project; secrets are not returned
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Success 200 {object} dto.WebhookSliceSuccessResponse "Webhooks found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid project ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/webhooks [get]
+func (h *WebhookHandler) ListWebhooks(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookHandler",
+		"handler", "ListWebhooks",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	webhooks, err := h.webhookService.ListWebhooks(ctx, userClaims.UserID, projectID)
+	if err != nil {
+		return webhookErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToSliceOfWebhookResponse(webhooks)
+	return c.Status(fiber.StatusOK).JSON(createSliceSuccessResponseGeneric("Webhooks found successfully", output))
+}
+
+// CreateWebhook subscribes a URL to events of a project
+// @Summary Create a webhook
+// @Description Subscribes a URL to events of a project. Events are POSTed as JSON, signed in the X-Webhook-Signature-256 header as "sha256=" followed by the hex HMAC-SHA256 of the body keyed with the secret, which is only returned by this call. Failed deliveries are retried with exponential backoff
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param webhook body dto.CreateWebhookRequest true "Webhook creation request"
+// @Success 201 {object} dto.WebhookSuccessResponse "Webhook created successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input or URL not pointing to a public host"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/webhooks [post]
+func (h *WebhookHandler) CreateWebhook(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookHandler",
+		"handler", "CreateWebhook",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Parsing input...")
+	input := &dto.CreateWebhookRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	webhook, err := h.webhookService.CreateWebhook(ctx, userClaims.UserID, projectID, input.MapToWebhook())
+	if err != nil {
+		return webhookErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToWebhookResponse(webhook)
+	output.Secret = webhook.Secret
+	return c.Status(fiber.StatusCreated).JSON(createSuccessResponse("Webhook created successfully", output))
+}
+
+// UpdateWebhook updates a webhook
+// @Summary Update a webhook
+// @Description Changes the URL or the events of a webhook, or pauses and resumes its deliveries
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param webhookId path int true "Webhook ID"
+// @Param webhook body dto.UpdateWebhookRequest true "Webhook update request"
+// @Success 200 {object} dto.WebhookSuccessResponse "Webhook updated successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input or URL not pointing to a public host"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project or webhook not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/webhooks/{webhookId} [put]
+func (h *WebhookHandler) UpdateWebhook(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookHandler",
+		"handler", "UpdateWebhook",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+	webhookID, err := verifyIdParamInt(c, logger, "webhookId")
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Parsing input...")
+	input := &dto.UpdateWebhookRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	webhook, err := h.webhookService.UpdateWebhook(ctx, userClaims.UserID, projectID, webhookID, input)
+	if err != nil {
+		return webhookErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToWebhookResponse(webhook)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Webhook updated successfully", output))
+}
+
+// DeleteWebhook deletes a webhook
+// @Summary Delete a webhook
+// @Description Deletes a webhook together with its delivery log
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param webhookId path int true "Webhook ID"
+// @Success 200 {object} dto.GenericSuccessResponse "Webhook deleted successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project or webhook not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/webhooks/{webhookId} [delete]
+func (h *WebhookHandler) DeleteWebhook(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookHandler",
+		"handler", "DeleteWebhook",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+	webhookID, err := verifyIdParamInt(c, logger, "webhookId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	if err := h.webhookService.DeleteWebhook(ctx, userClaims.UserID, projectID, webhookID); err != nil {
+		return webhookErrorResponse(c, logger, err)
+	}
+
+	logger.Info("Webhook deleted successfully", "webhook_id", webhookID)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse[any]("Webhook deleted successfully", nil))
+}
+
+// ListDeliveries retrieves the delivery log of a webhook
+// @Summary Get deliveries of a webhook
+// @Description Retrieves the deliveries of a webhook with the outcome of their last attempt, newest first by default
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param webhookId path int true "Webhook ID"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param page query int false "Page number, ignored when cursor is set"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort as field:asc or field:desc (fields: id, created_at; default id:desc)"
+// @Success 200 {object} dto.WebhookDeliverySliceSuccessResponse "Webhook deliveries found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid ID or query parameters"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project or webhook not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/webhooks/{webhookId}/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookHandler",
+		"handler", "ListDeliveries",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+	webhookID, err := verifyIdParamInt(c, logger, "webhookId")
+	if err != nil {
+		return err
+	}
+
+	page, parseErrors := parseFeedPageRequest(c, dto.WebhookDeliverySortFields)
+	if len(parseErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid query parameters", parseErrors))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	deliveries, pageInfo, err := h.webhookService.ListDeliveries(ctx, userClaims.UserID, projectID, webhookID, page)
+	if err != nil {
+		return webhookErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToSliceOfWebhookDeliveryResponse(deliveries)
+	return c.Status(fiber.StatusOK).JSON(createPageSuccessResponse("Webhook deliveries found successfully", output, pageInfo))
+}
+
+// Redeliver sends a past delivery of a webhook again
+// @Summary Redeliver a webhook delivery
+// @Description Queues the payload of a past delivery again as a new delivery with the same event ID, whatever the outcome of the original
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param webhookId path int true "Webhook ID"
+// @Param deliveryId path int true "Delivery ID"
+// @Success 202 {object} dto.WebhookDeliverySuccessResponse "Redelivery queued"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project, webhook or delivery not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver [post]
+func (h *WebhookHandler) Redeliver(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookHandler",
+		"handler", "Redeliver",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+	webhookID, err := verifyIdParamInt(c, logger, "webhookId")
+	if err != nil {
+		return err
+	}
+	deliveryID, err := verifyIdParamInt(c, logger, "deliveryId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	delivery, err := h.webhookService.Redeliver(ctx, userClaims.UserID, projectID, webhookID, deliveryID)
+	if err != nil {
+		return webhookErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToWebhookDeliveryResponse(delivery)
+	return c.Status(fiber.StatusAccepted).JSON(createSuccessResponse("Redelivery queued successfully", output))
+}
+
+// webhookErrorResponse maps the errors of the webhook service to responses.
+func webhookErrorResponse(c *fiber.Ctx, logger *slog.Logger, err error) error {
+	if errors.Is(err, structs.ErrProjectNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Project not found", err.Error()))
+	} else if errors.Is(err, structs.ErrWebhookNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Webhook not found", err.Error()))
+	} else if errors.Is(err, structs.ErrWebhookDeliveryNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Webhook delivery not found", err.Error()))
+	} else if errors.Is(err, structs.ErrUserNotManageProject) ||
+		errors.Is(err, structs.ErrUserNotPartProject) {
+		return c.Status(fiber.StatusForbidden).JSON(
+			createErrorResponse("Forbidden", err.Error()))
+	} else if errors.Is(err, structs.ErrWebhookURLNotAllowed) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Webhook URL not allowed", err.Error()))
+	}
+	logger.Error("Webhook operation failed", "error", err.Error())
+	return c.Status(fiber.StatusInternalServerError).JSON(
+		createErrorResponse("Internal server error", nil))
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"errors"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/service"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// WipLimitHandler handles project WIP limit HTTP requests
+type WipLimitHandler struct {
+	wipLimitService service.WipLimitService
+}
+
+// NewWipLimitHandler creates a new WipLimitHandler instance
+func NewWipLimitHandler(wipLimitService service.WipLimitService) *WipLimitHandler {
+	return &WipLimitHandler{
+		wipLimitService: wipLimitService,
+	}
+}
+
+// GetWipLimits retrieves the WIP limits of a project
+// @Summary Get project WIP limits
+// @Description Retrieves how many tasks each board column of a project may hold, in total and per assignee; statuses without a limit are unlimited. Available to any project member
+// @Tags WIP Limits
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Success 200 {object} dto.WipLimitsSuccessResponse "WIP limits found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid project ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User is not a project member"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/wip-limits [get]
+func (h *WipLimitHandler) GetWipLimits(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WipLimitHandler",
+		"handler", "GetWipLimits",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	limits, err := h.wipLimitService.GetWipLimits(ctx, userClaims.UserID, projectID)
+	if err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Project not found", err.Error()))
+		} else if errors.Is(err, structs.ErrUserNotPartProject) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		}
+		logger.Error("Failed to get WIP limits", "error", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	output := dto.MapToWipLimitsResponse(projectID, limits)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("WIP limits found successfully", output))
+}
+
+// UpdateWipLimits replaces the WIP limits of a project
+// @Summary Update project WIP limits
+// @Description Replaces the WIP limits of a project, at most one per status; an empty list lifts every limit. Status changes and assignments past a limit are then rejected unless a project manager overrides them
+// @Tags WIP Limits
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param limits body dto.UpdateWipLimitsRequest true "WIP limits update request"
+// @Success 200 {object} dto.WipLimitsSuccessResponse "WIP limits updated"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/wip-limits [put]
+func (h *WipLimitHandler) UpdateWipLimits(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WipLimitHandler",
+		"handler", "UpdateWipLimits",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	input := &dto.UpdateWipLimitsRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	limits, err := h.wipLimitService.UpdateWipLimits(ctx, userClaims.UserID, projectID, input.MapToWipLimits())
+	if err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Project not found", err.Error()))
+		} else if errors.Is(err, structs.ErrUserNotManageProject) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		} else if errors.Is(err, structs.ErrInvalidWipLimit) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("Invalid WIP limits", err.Error()))
+		}
+		logger.Error("Failed to update WIP limits", "error", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	output := dto.MapToWipLimitsResponse(projectID, limits)
+	logger.Info("WIP limits updated successfully", "project_id", projectID)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("WIP limits updated successfully", output))
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"errors"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/service"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// WorkflowHandler handles project workflow HTTP requests
+type WorkflowHandler struct {
+	workflowService service.WorkflowService
+}
+
+// NewWorkflowHandler creates a new WorkflowHandler instance
+func NewWorkflowHandler(workflowService service.WorkflowService) *WorkflowHandler {
+	return &WorkflowHandler{
+		workflowService: workflowService,
+	}
+}
+
+// GetWorkflow retrieves the task status workflow of a project
+// @Summary Get project workflow
+// @Description Retrieves the status transitions allowed in a project and the roles that may make them; available to any project member
+// @Tags Workflows
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Success 200 {object} dto.WorkflowSuccessResponse "Workflow found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid project ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User is not a project member"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/workflow [get]
+func (h *WorkflowHandler) GetWorkflow(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorkflowHandler",
+		"handler", "GetWorkflow",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	transitions, isDefault, err := h.workflowService.GetWorkflow(ctx, userClaims.UserID, projectID)
+	if err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Project not found", err.Error()))
+		} else if errors.Is(err, structs.ErrUserNotPartProject) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		}
+		logger.Error("Failed to get workflow", "error", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	output := dto.MapToWorkflowResponse(projectID, isDefault, transitions)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Workflow found successfully", output))
+}
+
+// UpdateWorkflow replaces the task status workflow of a project
+// @Summary Update project workflow
+// @Description Replaces the status transitions allowed in a project; an empty list restores the default workflow
+// @Tags Workflows
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param workflow body dto.UpdateWorkflowRequest true "Workflow update request"
+// @Success 200 {object} dto.WorkflowSuccessResponse "Workflow updated"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/workflow [put]
+func (h *WorkflowHandler) UpdateWorkflow(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorkflowHandler",
+		"handler", "UpdateWorkflow",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	input := &dto.UpdateWorkflowRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	transitions, isDefault, err := h.workflowService.UpdateWorkflow(ctx, userClaims.UserID, projectID, input.MapToWorkflowTransitions())
+	if err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return c.Status(fiber.StatusNotFound).JSON(
+				createErrorResponse("Project not found", err.Error()))
+		} else if errors.Is(err, structs.ErrUserNotManageProject) {
+			return c.Status(fiber.StatusForbidden).JSON(
+				createErrorResponse("Forbidden", err.Error()))
+		} else if errors.Is(err, structs.ErrInvalidWorkflow) {
+			return c.Status(fiber.StatusBadRequest).JSON(
+				createErrorResponse("Invalid workflow", err.Error()))
+		}
+		logger.Error("Failed to update workflow", "error", err.Error())
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	output := dto.MapToWorkflowResponse(projectID, isDefault, transitions)
+	logger.Info("Workflow updated successfully", "project_id", projectID)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Workflow updated successfully", output))
+}
@@ -0,0 +1,394 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"lqkhoi-go-http-api/internal/config"
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/service"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// WorklogHandler handles time tracking HTTP requests
+type WorklogHandler struct {
+	worklogService service.WorklogService
+	cfg            config.DateTimeConfig
+}
+
+// NewWorklogHandler creates a new WorklogHandler instance
+func NewWorklogHandler(worklogService service.WorklogService, cfg config.DateTimeConfig) *WorklogHandler {
+	return &WorklogHandler{
+		worklogService: worklogService,
+		cfg:            cfg,
+	}
+}
+
+// ListWorklogs retrieves the worklogs of a task
+// @Summary Get worklogs of a task
+// @Description Retrieves the time logged on a task
+// @Tags Worklogs
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param page query int false "Page number, ignored when cursor is set"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Param sort query string false "Sort as field:asc or field:desc (fields: id, started_at, created_at)"
+// @Success 200 {object} dto.WorklogSliceSuccessResponse "Worklogs found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid task ID or query parameters"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/worklogs [get]
+func (h *WorklogHandler) ListWorklogs(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogHandler",
+		"handler", "ListWorklogs",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+
+	page, parseErrors := parsePageRequest(c, dto.WorklogSortFields)
+	if len(parseErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid query parameters", parseErrors))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	worklogs, pageInfo, err := h.worklogService.ListWorklogs(ctx, userClaims.UserID, taskID, page)
+	if err != nil {
+		return worklogErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToSliceOfWorklogResponse(worklogs)
+	return c.Status(fiber.StatusOK).JSON(createPageSuccessResponse("Worklogs found successfully", output, pageInfo))
+}
+
+// CreateWorklog logs time on a task
+// @Summary Log time on a task
+// @Description Logs time spent on a task. Assignees may log time on their own tasks and project managers on any task of the project
+// @Tags Worklogs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param worklog body dto.CreateWorklogRequest true "Worklog creation request"
+// @Success 201 {object} dto.WorklogSuccessResponse "Worklog created successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/worklogs [post]
+func (h *WorklogHandler) CreateWorklog(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogHandler",
+		"handler", "CreateWorklog",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Parsing input...")
+	input := &dto.CreateWorklogRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	worklog, err := h.worklogService.CreateWorklog(ctx, userClaims.UserID, taskID, input.MapToWorklog())
+	if err != nil {
+		return worklogErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToWorklogResponse(worklog)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusCreated).JSON(createSuccessResponse("Worklog created successfully", output))
+}
+
+// UpdateWorklog edits a worklog
+// @Summary Edit a worklog
+// @Description Updates the start, duration or note of a worklog. Only the author may edit a worklog
+// @Tags Worklogs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param worklogId path int true "Worklog ID"
+// @Param worklog body dto.UpdateWorklogRequest true "Worklog update request"
+// @Success 200 {object} dto.WorklogSuccessResponse "Worklog updated successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid input"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task or worklog not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/worklogs/{worklogId} [put]
+func (h *WorklogHandler) UpdateWorklog(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogHandler",
+		"handler", "UpdateWorklog",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+	worklogID, err := verifyIdParamInt(c, logger, "worklogId")
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Parsing input...")
+	input := &dto.UpdateWorklogRequest{}
+	if err := c.BodyParser(input); err != nil {
+		logger.Error("Cannot parse input", "error", err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Cannot parse JSON", nil))
+	}
+
+	errs := utils.ValidateStruct(*input)
+	if errs != nil {
+		logger.Error("Validation failed", "errors", errs)
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Validation failed", errs))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	worklog, err := h.worklogService.UpdateWorklog(ctx, userClaims.UserID, taskID, worklogID, input)
+	if err != nil {
+		return worklogErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToWorklogResponse(worklog)
+	logger.Debug("Response is prepared", "response", output)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Worklog updated successfully", output))
+}
+
+// DeleteWorklog deletes a worklog
+// @Summary Delete a worklog
+// @Description Deletes a worklog. Only the author or a project manager may delete a worklog
+// @Tags Worklogs
+// @Produce json
+// @Security BearerAuth
+// @Param taskId path int true "Task ID"
+// @Param worklogId path int true "Worklog ID"
+// @Success 200 {object} dto.GenericSuccessResponse "Worklog deleted successfully"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid ID"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Task or worklog not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /tasks/{taskId}/worklogs/{worklogId} [delete]
+func (h *WorklogHandler) DeleteWorklog(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogHandler",
+		"handler", "DeleteWorklog",
+	)
+
+	taskID, err := verifyIdParamInt(c, logger, "taskId")
+	if err != nil {
+		return err
+	}
+	worklogID, err := verifyIdParamInt(c, logger, "worklogId")
+	if err != nil {
+		return err
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	if err := h.worklogService.DeleteWorklog(ctx, userClaims.UserID, taskID, worklogID); err != nil {
+		return worklogErrorResponse(c, logger, err)
+	}
+
+	logger.Info("Worklog deleted successfully", "worklog_id", worklogID)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse[any]("Worklog deleted successfully", nil))
+}
+
+// GetTimesheet retrieves the timesheet of a user
+// @Summary Get timesheet of a user
+// @Description Retrieves the time a user logged between two days, both included, with daily totals. Available to the user and admins
+// @Tags Worklogs
+// @Produce json
+// @Security BearerAuth
+// @Param userId path int true "User ID"
+// @Param from query string true "First day (format: YYYY-MM-DD)"
+// @Param to query string true "Last day (format: YYYY-MM-DD)"
+// @Success 200 {object} dto.TimesheetSuccessResponse "Timesheet found"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid user ID or date range"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - Owner or admin access required"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /users/{userId}/timesheet [get]
+func (h *WorklogHandler) GetTimesheet(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogHandler",
+		"handler", "GetTimesheet",
+	)
+
+	userID, err := verifyIdParamInt(c, logger, "userId")
+	if err != nil {
+		return err
+	}
+
+	from, to, parseErrors := h.parseDateRange(c)
+	if len(parseErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid query parameters", parseErrors))
+	}
+
+	worklogs, err := h.worklogService.GetTimesheet(ctx, userID, from, to)
+	if err != nil {
+		return worklogErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToTimesheetResponse(userID, from, to, worklogs)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Timesheet found successfully", output))
+}
+
+// GetProjectTotals retrieves the time logged on a project
+// @Summary Get worklog totals of a project
+// @Description Retrieves the time logged on a project per user between two days, both included
+// @Tags Worklogs
+// @Produce json
+// @Security BearerAuth
+// @Param projectId path int true "Project ID"
+// @Param from query string true "First day (format: YYYY-MM-DD)"
+// @Param to query string true "Last day (format: YYYY-MM-DD)"
+// @Success 200 {object} dto.ProjectWorklogTotalsSuccessResponse "Totals computed"
+// @Failure 400 {object} dto.ErrorResponse "Bad request - Invalid project ID or date range"
+// @Failure 403 {object} dto.ErrorResponse "Forbidden - User not authorized"
+// @Failure 404 {object} dto.ErrorResponse "Not found - Project not found"
+// @Failure 500 {object} dto.ErrorResponse "Internal server error"
+// @Router /projects/{projectId}/worklogs/totals [get]
+func (h *WorklogHandler) GetProjectTotals(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogHandler",
+		"handler", "GetProjectTotals",
+	)
+
+	projectID, err := verifyIdParamInt(c, logger, "projectId")
+	if err != nil {
+		return err
+	}
+
+	from, to, parseErrors := h.parseDateRange(c)
+	if len(parseErrors) > 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid query parameters", parseErrors))
+	}
+
+	userClaims, ok := c.Locals("user_claims").(*structs.Claims)
+	if !ok {
+		logger.Error("Failed to retrieve user claims")
+		return c.Status(fiber.StatusInternalServerError).JSON(
+			createErrorResponse("Internal server error", nil))
+	}
+
+	totals, err := h.worklogService.GetProjectTotals(ctx, userClaims.UserID, projectID, from, to)
+	if err != nil {
+		return worklogErrorResponse(c, logger, err)
+	}
+
+	output := dto.MapToProjectWorklogTotalsResponse(projectID, from, to, totals)
+	return c.Status(fiber.StatusOK).JSON(createSuccessResponse("Worklog totals computed successfully", output))
+}
+
+// parseDateRange reads the required from and to days of the query string.
+func (h *WorklogHandler) parseDateRange(c *fiber.Ctx) (time.Time, time.Time, []string) {
+	var from, to time.Time
+	var parseErrors []string
+
+	for _, param := range []struct {
+		name  string
+		value *time.Time
+	}{{"from", &from}, {"to", &to}} {
+		raw := c.Query(param.name)
+		if raw == "" {
+			parseErrors = append(parseErrors, "Missing "+param.name+" parameter")
+			continue
+		}
+		parsed, err := time.Parse(h.cfg.Format, raw)
+		if err != nil {
+			parseErrors = append(parseErrors, "Invalid "+param.name+" parameter")
+			continue
+		}
+		*param.value = parsed
+	}
+	return from, to, parseErrors
+}
+
+// worklogErrorResponse maps the errors of the worklog service to responses.
+func worklogErrorResponse(c *fiber.Ctx, logger *slog.Logger, err error) error {
+	if errors.Is(err, structs.ErrTaskNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Task not found", err.Error()))
+	} else if errors.Is(err, structs.ErrWorklogNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Worklog not found", err.Error()))
+	} else if errors.Is(err, structs.ErrProjectNotExist) {
+		return c.Status(fiber.StatusNotFound).JSON(
+			createErrorResponse("Project not found", err.Error()))
+	} else if errors.Is(err, structs.ErrInvalidDateRange) {
+		return c.Status(fiber.StatusBadRequest).JSON(
+			createErrorResponse("Invalid date range", err.Error()))
+	} else if errors.Is(err, structs.ErrUserNotAuthorizedForTask) ||
+		errors.Is(err, structs.ErrUserNotManageProject) ||
+		errors.Is(err, structs.ErrUserNotPartProject) ||
+		errors.Is(err, structs.ErrUserNotWorklogAuthor) {
+		return c.Status(fiber.StatusForbidden).JSON(
+			createErrorResponse("Forbidden", err.Error()))
+	}
+	logger.Error("Worklog operation failed", "error", err.Error())
+	return c.Status(fiber.StatusInternalServerError).JSON(
+		createErrorResponse("Internal server error", nil))
+}
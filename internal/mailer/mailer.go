@@ -0,0 +1,48 @@
+// Package mailer renders notification emails from templates and sends them.
+package mailer
+
+import (
+	"context"
+
+	"lqkhoi-go-http-api/internal/config"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+// Message is an email ready to be sent.
+type Message struct {
+	To       string
+	Subject  string
+	HTMLBody string
+}
+
+// Mailer sends emails. Implementations other than SMTP, e.g. the API of an
+// email provider, only need to satisfy this interface.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// New returns the Mailer of the configured driver.
+func New(cfg config.MailConfig) Mailer {
+	if cfg.Driver == "smtp" {
+		return NewSMTPMailer(cfg)
+	}
+	return NewLogMailer()
+}
+
+type logMailer struct{}
+
+// NewLogMailer returns a Mailer that logs emails instead of sending them,
+// for development.
+func NewLogMailer() Mailer {
+	return logMailer{}
+}
+
+func (logMailer) Send(ctx context.Context, msg Message) error {
+	utils.LoggerFromContext(ctx).Info("Email not sent, mail driver is log",
+		"component", "LogMailer",
+		"to", msg.To,
+		"subject", msg.Subject,
+		"body_length", len(msg.HTMLBody),
+	)
+	return nil
+}
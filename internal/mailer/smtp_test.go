@@ -0,0 +1,173 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strconv"
+	"strings"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer accepts SMTP sessions on localhost and records the
+// envelopes and data it receives. Recipients listed in reject are refused.
+type fakeSMTPServer struct {
+	listener net.Listener
+	reject   map[string]bool
+	received chan fakeEmail
+}
+
+type fakeEmail struct {
+	From string
+	To   []string
+	Data string
+}
+
+func startFakeSMTPServer(t *testing.T, reject ...string) *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeSMTPServer{listener: listener, reject: map[string]bool{}, received: make(chan fakeEmail, 10)}
+	for _, address := range reject {
+		s.reject[address] = true
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+	reply("220 fake.local ESMTP")
+	var email fakeEmail
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(command, " ", 2)[0])
+		switch verb {
+		case "EHLO", "HELO":
+			reply("250 fake.local")
+		case "MAIL":
+			email = fakeEmail{From: smtpPath(command)}
+			reply("250 OK")
+		case "RCPT":
+			to := smtpPath(command)
+			if s.reject[to] {
+				reply("550 No such user")
+				continue
+			}
+			email.To = append(email.To, to)
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				dataLine, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			email.Data = data.String()
+			s.received <- email
+			reply("250 OK queued")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func smtpPath(command string) string {
+	start := strings.Index(command, "<")
+	end := strings.LastIndex(command, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return command[start+1 : end]
+}
+
+func testMailConfig(t *testing.T, addr string) config.MailConfig {
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+	portNumber, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	return config.MailConfig{Driver: "smtp", Host: host, Port: portNumber, From: "no-reply@example.com", TimeoutSeconds: 5}
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	server := startFakeSMTPServer(t, "unknown@example.com")
+	m := NewSMTPMailer(testMailConfig(t, server.listener.Addr().String()))
+	ctx := context.Background()
+
+	t.Run("sends an HTML email", func(t *testing.T) {
+		err := m.Send(ctx, Message{
+			To:       "jane@example.com",
+			Subject:  "Assigned: Design login page ✓",
+			HTMLBody: "<p>Hi Jane, " + strings.Repeat("long line ", 20) + "</p>",
+		})
+		require.NoError(t, err)
+
+		email := <-server.received
+		assert.Equal(t, "no-reply@example.com", email.From)
+		assert.Equal(t, []string{"jane@example.com"}, email.To)
+
+		parsed, err := mail.ReadMessage(strings.NewReader(email.Data))
+		require.NoError(t, err)
+		subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+		require.NoError(t, err)
+		assert.Equal(t, "Assigned: Design login page ✓", subject)
+		assert.Equal(t, "text/html; charset=UTF-8", parsed.Header.Get("Content-Type"))
+		body, err := io.ReadAll(quotedprintable.NewReader(parsed.Body))
+		require.NoError(t, err)
+		// The data writer ends the last line with CRLF.
+		assert.Equal(t, "<p>Hi Jane, "+strings.Repeat("long line ", 20)+"</p>", strings.TrimRight(string(body), "\r\n"))
+	})
+
+	t.Run("subject cannot inject headers", func(t *testing.T) {
+		require.NoError(t, m.Send(ctx, Message{To: "jane@example.com", Subject: "Hi\r\nBcc: evil@example.com", HTMLBody: "x"}))
+
+		email := <-server.received
+		parsed, err := mail.ReadMessage(strings.NewReader(email.Data))
+		require.NoError(t, err)
+		assert.Empty(t, parsed.Header.Get("Bcc"))
+	})
+
+	t.Run("refused recipient is an error", func(t *testing.T) {
+		err := m.Send(ctx, Message{To: "unknown@example.com", Subject: "Hi", HTMLBody: "x"})
+		assert.ErrorContains(t, err, "refused recipient")
+	})
+
+	t.Run("invalid recipient is an error", func(t *testing.T) {
+		err := m.Send(ctx, Message{To: "not an address", Subject: "Hi", HTMLBody: "x"})
+		assert.ErrorContains(t, err, "invalid recipient")
+	})
+}
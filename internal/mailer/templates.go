@@ -0,0 +1,61 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.html"))
+
+// Template names the template of a kind of email.
+type Template string
+
+const (
+	TaskAssignedTemplate  Template = "task_assigned.html"
+	TaskDueSoonTemplate   Template = "task_due_soon.html"
+	SprintStartedTemplate Template = "sprint_started.html"
+)
+
+// TaskAssignedData fills TaskAssignedTemplate.
+type TaskAssignedData struct {
+	RecipientName string
+	ActorName     string
+	ProjectName   string
+	TaskTitle     string
+	Priority      string
+	DueDate       string
+}
+
+// TaskDueSoonData fills TaskDueSoonTemplate.
+type TaskDueSoonData struct {
+	RecipientName string
+	ProjectName   string
+	TaskTitle     string
+	Status        string
+	DueDate       string
+}
+
+// SprintStartedData fills SprintStartedTemplate.
+type SprintStartedData struct {
+	RecipientName string
+	ProjectName   string
+	SprintName    string
+	Goal          string
+	StartDate     string
+	EndDate       string
+	TaskCount     int
+}
+
+// Render executes the template with data, escaping the values for HTML.
+func Render(name Template, data any) (string, error) {
+	var b bytes.Buffer
+	if err := templates.ExecuteTemplate(&b, string(name), data); err != nil {
+		return "", fmt.Errorf("cannot render email template %s: %w", name, err)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,38 @@
+package mailer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	t.Run("values are escaped", func(t *testing.T) {
+		body, err := Render(TaskAssignedTemplate, TaskAssignedData{
+			RecipientName: "Jane",
+			ActorName:     "John Doe",
+			ProjectName:   "Website",
+			TaskTitle:     `<script>alert("x")</script>`,
+			Priority:      "HIGH",
+		})
+		require.NoError(t, err)
+		assert.Contains(t, body, "Hi Jane,")
+		assert.Contains(t, body, "John Doe assigned you a task")
+		assert.Contains(t, body, "&lt;script&gt;")
+		assert.NotContains(t, body, "<script>")
+		assert.NotContains(t, body, "Due</td>")
+	})
+
+	t.Run("every template renders", func(t *testing.T) {
+		for name, data := range map[Template]any{
+			TaskAssignedTemplate:  TaskAssignedData{TaskTitle: "Design login page", DueDate: "2025-05-01"},
+			TaskDueSoonTemplate:   TaskDueSoonData{TaskTitle: "Design login page", DueDate: "2025-05-01"},
+			SprintStartedTemplate: SprintStartedData{SprintName: "Sprint 4", Goal: "Ship login", TaskCount: 12},
+		} {
+			body, err := Render(name, data)
+			require.NoError(t, err, name)
+			assert.Contains(t, body, "</html>", name)
+		}
+	})
+}
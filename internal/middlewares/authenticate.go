@@ -2,9 +2,11 @@
 package middlewares
 
 import (
+	"errors"
 	"log"
 	"strings"
 
+	"lqkhoi-go-http-api/internal/service"
 	"lqkhoi-go-http-api/pkg/structs"
 	"lqkhoi-go-http-api/pkg/utils"
 
@@ -12,7 +14,15 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func AuthMiddleware(c *fiber.Ctx) error {
+// NewAuthMiddleware validates the bearer token of the request and rejects
+// tokens that were revoked through tokenService.
+func NewAuthMiddleware(tokenService service.TokenService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return authenticate(c, tokenService)
+	}
+}
+
+func authenticate(c *fiber.Ctx, tokenService service.TokenService) error {
 	authHeader := c.Get("Authorization")
 	if authHeader == "" {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
@@ -32,16 +42,10 @@ func AuthMiddleware(c *fiber.Ctx) error {
 	}
 	tokenString := parts[1]
 
-	token, err := jwt.ParseWithClaims(tokenString, &structs.Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fiber.NewError(fiber.StatusUnauthorized, "Unexpected signing method")
-		}
-		return []byte(utils.GetenvStringValue("JWT_SECRET", "randomkey")), nil
-	})
-
+	claims, err := utils.ParseToken(tokenString)
 	if err != nil {
 		log.Printf("JWT Error: %v", err)
-		if err == jwt.ErrSignatureInvalid {
+		if errors.Is(err, jwt.ErrSignatureInvalid) || errors.Is(err, jwt.ErrTokenSignatureInvalid) {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
 				"status":  "error",
 				"message": "Invalid token signature",
@@ -49,7 +53,7 @@ func AuthMiddleware(c *fiber.Ctx) error {
 			})
 		}
 
-		if err == jwt.ErrTokenExpired {
+		if errors.Is(err, jwt.ErrTokenExpired) {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
 				"status":  "error",
 				"message": "Token has expired",
@@ -64,14 +68,46 @@ func AuthMiddleware(c *fiber.Ctx) error {
 		})
 	}
 
-	if claims, ok := token.Claims.(*structs.Claims); ok && token.Valid {
-		c.Locals("user_claims", claims)
-		return c.Next()
+	if claims.TokenType == structs.TokenTypeRefresh {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Refresh token cannot be used for authentication",
+			"data":    nil,
+		})
+	}
+
+	revoked, err := tokenService.IsTokenRevoked(c.UserContext(), claims)
+	if err != nil {
+		log.Printf("Token revocation check failed for user %d: %v", claims.UserID, err)
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Token could not be verified, try again later",
+			"data":    nil,
+		})
 	}
+	if revoked {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"status":  "error",
+			"message": "Token has been revoked",
+			"data":    nil,
+		})
+	}
+
+	c.Locals("user_claims", claims)
+	return c.Next()
+}
 
-	return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-		"status":  "error",
-		"message": "Invalid token claims",
-		"data":    nil,
-	})
+// NewQueryTokenMiddleware lets clients that cannot set headers, such as the
+// EventSource of browsers, pass their access token as the access_token query
+// parameter. It goes before the auth middleware, which then checks the token
+// as if it came in the Authorization header.
+func NewQueryTokenMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if c.Get(fiber.HeaderAuthorization) == "" {
+			if token := c.Query("access_token"); token != "" {
+				c.Request().Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+			}
+		}
+		return c.Next()
+	}
 }
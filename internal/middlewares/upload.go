@@ -0,0 +1,60 @@
+package middlewares
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"slices"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// multipartOverhead is the room left in the request body for multipart
+// boundaries and headers around the uploaded file.
+const multipartOverhead = 64 << 10
+
+// LimitUpload rejects multipart requests whose file in field is larger than
+// maxSize bytes or whose content, sniffed from its first bytes, is not one of
+// allowedTypes. The detected MIME type is stored in the "upload_content_type"
+// local for the handler.
+func LimitUpload(field string, maxSize int64, allowedTypes []string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if contentLength := c.Request().Header.ContentLength(); contentLength > 0 && int64(contentLength) > maxSize+multipartOverhead {
+			return c.Status(fiber.StatusRequestEntityTooLarge).JSON(fiber.Map{
+				"error": fmt.Sprintf("Request Entity Too Large: files are limited to %d bytes", maxSize),
+			})
+		}
+
+		fileHeader, err := c.FormFile(field)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": fmt.Sprintf("Bad Request: multipart field %q with a file is required", field),
+			})
+		}
+		if fileHeader.Size > maxSize {
+			return c.Status(fiber.StatusRequestEntityTooLarge).JSON(fiber.Map{
+				"error": fmt.Sprintf("Request Entity Too Large: files are limited to %d bytes", maxSize),
+			})
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Bad Request: uploaded file cannot be read",
+			})
+		}
+		head := make([]byte, 512)
+		n, _ := file.Read(head)
+		file.Close()
+
+		contentType, _, err := mime.ParseMediaType(http.DetectContentType(head[:n]))
+		if err != nil || !slices.Contains(allowedTypes, contentType) {
+			return c.Status(fiber.StatusUnsupportedMediaType).JSON(fiber.Map{
+				"error": fmt.Sprintf("Unsupported Media Type: %s files are not allowed", contentType),
+			})
+		}
+
+		c.Locals("upload_content_type", contentType)
+		return c.Next()
+	}
+}
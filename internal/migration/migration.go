@@ -89,6 +89,150 @@ func createEnumTaskPriority(tx *gorm.DB) error {
 	return nil
 }
 
+func createEnumProjectMemberRole(tx *gorm.DB) error {
+	log.Println("Ensuring ENUM type 'project_member_role' exists...")
+	sqlProjectMemberRoleSafe := `
+	DO $$
+	BEGIN
+	    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'project_member_role') THEN
+	        CREATE TYPE project_member_role AS ENUM ('MANAGER', 'MEMBER', 'VIEWER');
+	    END IF;
+	END$$;
+	`
+	if err := tx.Exec(sqlProjectMemberRoleSafe).Error; err != nil {
+		log.Printf("Error creating/ensuring ENUM type 'project_member_role': %v\n", err)
+		return fmt.Errorf("failed to ensure enum 'project_member_role': %w", err)
+	}
+	log.Println("'project_member_role' ENUM type checked/created.")
+	return nil
+}
+
+func createEnumSprintStatus(tx *gorm.DB) error {
+	log.Println("Ensuring ENUM type 'sprint_status' exists...")
+	sqlSprintStatusSafe := `
+	DO $$
+	BEGIN
+	    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'sprint_status') THEN
+	        CREATE TYPE sprint_status AS ENUM ('PLANNED', 'ACTIVE', 'CLOSED');
+	    END IF;
+	END$$;
+	`
+	if err := tx.Exec(sqlSprintStatusSafe).Error; err != nil {
+		log.Printf("Error creating/ensuring ENUM type 'sprint_status': %v\n", err)
+		return fmt.Errorf("failed to ensure enum 'sprint_status': %w", err)
+	}
+	log.Println("'sprint_status' ENUM type checked/created.")
+	return nil
+}
+
+func createEnumActivityEntityType(tx *gorm.DB) error {
+	log.Println("Ensuring ENUM type 'activity_entity_type' exists...")
+	sqlActivityEntityTypeSafe := `
+	DO $$
+	BEGIN
+	    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'activity_entity_type') THEN
+	        CREATE TYPE activity_entity_type AS ENUM ('PROJECT', 'PROJECT_MEMBER', 'SPRINT', 'TASK');
+	    END IF;
+	END$$;
+	`
+	if err := tx.Exec(sqlActivityEntityTypeSafe).Error; err != nil {
+		log.Printf("Error creating/ensuring ENUM type 'activity_entity_type': %v\n", err)
+		return fmt.Errorf("failed to ensure enum 'activity_entity_type': %w", err)
+	}
+	log.Println("'activity_entity_type' ENUM type checked/created.")
+	return nil
+}
+
+func createEnumActivityAction(tx *gorm.DB) error {
+	log.Println("Ensuring ENUM type 'activity_action' exists...")
+	sqlActivityActionSafe := `
+	DO $$
+	BEGIN
+	    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'activity_action') THEN
+	        CREATE TYPE activity_action AS ENUM ('CREATE', 'UPDATE', 'DELETE', 'ASSIGN');
+	    END IF;
+	END$$;
+	`
+	if err := tx.Exec(sqlActivityActionSafe).Error; err != nil {
+		log.Printf("Error creating/ensuring ENUM type 'activity_action': %v\n", err)
+		return fmt.Errorf("failed to ensure enum 'activity_action': %w", err)
+	}
+	log.Println("'activity_action' ENUM type checked/created.")
+	return nil
+}
+
+func createEnumTaskLinkType(tx *gorm.DB) error {
+	log.Println("Ensuring ENUM type 'task_link_type' exists...")
+	sqlTaskLinkTypeSafe := `
+	DO $$
+	BEGIN
+	    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'task_link_type') THEN
+	        CREATE TYPE task_link_type AS ENUM ('BLOCKS', 'RELATES_TO', 'DUPLICATES');
+	    END IF;
+	END$$;
+	`
+	if err := tx.Exec(sqlTaskLinkTypeSafe).Error; err != nil {
+		log.Printf("Error creating/ensuring ENUM type 'task_link_type': %v\n", err)
+		return fmt.Errorf("failed to ensure enum 'task_link_type': %w", err)
+	}
+	log.Println("'task_link_type' ENUM type checked/created.")
+	return nil
+}
+
+func createEnumWebhookDeliveryStatus(tx *gorm.DB) error {
+	log.Println("Ensuring ENUM type 'webhook_delivery_status' exists...")
+	sqlWebhookDeliveryStatusSafe := `
+	DO $$
+	BEGIN
+	    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'webhook_delivery_status') THEN
+	        CREATE TYPE webhook_delivery_status AS ENUM ('PENDING', 'SUCCEEDED', 'FAILED');
+	    END IF;
+	END$$;
+	`
+	if err := tx.Exec(sqlWebhookDeliveryStatusSafe).Error; err != nil {
+		log.Printf("Error creating/ensuring ENUM type 'webhook_delivery_status': %v\n", err)
+		return fmt.Errorf("failed to ensure enum 'webhook_delivery_status': %w", err)
+	}
+	log.Println("'webhook_delivery_status' ENUM type checked/created.")
+	return nil
+}
+
+func createEnumNotificationType(tx *gorm.DB) error {
+	log.Println("Ensuring ENUM type 'notification_type' exists...")
+	sqlNotificationTypeSafe := `
+	DO $$
+	BEGIN
+	    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'notification_type') THEN
+	        CREATE TYPE notification_type AS ENUM ('TASK_ASSIGNED', 'TASK_STATUS_CHANGED', 'TASK_DUE_SOON', 'PROJECT_MEMBER_ADDED', 'PROJECT_MEMBER_REMOVED');
+	    END IF;
+	END$$;
+	`
+	if err := tx.Exec(sqlNotificationTypeSafe).Error; err != nil {
+		log.Printf("Error creating/ensuring ENUM type 'notification_type': %v\n", err)
+		return fmt.Errorf("failed to ensure enum 'notification_type': %w", err)
+	}
+	log.Println("'notification_type' ENUM type checked/created.")
+	return nil
+}
+
+func createEnumOutboundEmailStatus(tx *gorm.DB) error {
+	log.Println("Ensuring ENUM type 'outbound_email_status' exists...")
+	sqlOutboundEmailStatusSafe := `
+	DO $$
+	BEGIN
+	    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'outbound_email_status') THEN
+	        CREATE TYPE outbound_email_status AS ENUM ('PENDING', 'SENT', 'FAILED');
+	    END IF;
+	END$$;
+	`
+	if err := tx.Exec(sqlOutboundEmailStatusSafe).Error; err != nil {
+		log.Printf("Error creating/ensuring ENUM type 'outbound_email_status': %v\n", err)
+		return fmt.Errorf("failed to ensure enum 'outbound_email_status': %w", err)
+	}
+	log.Println("'outbound_email_status' ENUM type checked/created.")
+	return nil
+}
+
 func createTables(tx *gorm.DB) error {
 	log.Println("Running GORM AutoMigrate for creating tables...")
 
@@ -97,6 +241,26 @@ func createTables(tx *gorm.DB) error {
 		&models.Project{},
 		&models.Sprint{},
 		&models.Task{},
+		&models.ProjectMember{},
+		&models.Comment{},
+		&models.CommentEdit{},
+		&models.CommentMention{},
+		&models.ActivityLog{},
+		&models.ActivityChange{},
+		&models.WorkflowTransition{},
+		&models.SprintReport{},
+		&models.SprintReportTask{},
+		&models.Worklog{},
+		&models.TaskLink{},
+		&models.Label{},
+		&models.TaskLabel{},
+		&models.Attachment{},
+		&models.SavedView{},
+		&models.Webhook{},
+		&models.WebhookDelivery{},
+		&models.Notification{},
+		&models.OutboundEmail{},
+		&models.WipLimit{},
 	}
 
 	for _, model := range modelsToMigrate {
@@ -111,6 +275,114 @@ func createTables(tx *gorm.DB) error {
 	return nil
 }
 
+// createActiveSprintIndex guarantees at the database level that a project
+// never has more than one active sprint.
+func createActiveSprintIndex(tx *gorm.DB) error {
+	log.Println("Ensuring unique index on the active sprint of each project...")
+	sqlActiveSprintIndex := `
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_sprints_one_active_per_project
+	ON sprints (project_id)
+	WHERE status = 'ACTIVE' AND deleted_at IS NULL;
+	`
+	if err := tx.Exec(sqlActiveSprintIndex).Error; err != nil {
+		log.Printf("Error creating active sprint index: %v\n", err)
+		return fmt.Errorf("failed to create active sprint index: %w", err)
+	}
+	log.Println("Active sprint index checked/created.")
+	return nil
+}
+
+// createSearchVectors adds the generated tsvector columns used by full-text
+// search, weighting titles and names above descriptions, and their GIN
+// indexes. The columns are maintained by Postgres and unknown to the models.
+func createSearchVectors(tx *gorm.DB) error {
+	log.Println("Ensuring full-text search columns and indexes...")
+	statements := []string{`
+	ALTER TABLE tasks ADD COLUMN IF NOT EXISTS search_vector tsvector
+	GENERATED ALWAYS AS (
+		setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+		setweight(to_tsvector('english', coalesce(description, '')), 'B')
+	) STORED;
+	`, `
+	CREATE INDEX IF NOT EXISTS idx_tasks_search_vector ON tasks USING GIN (search_vector);
+	`, `
+	ALTER TABLE projects ADD COLUMN IF NOT EXISTS search_vector tsvector
+	GENERATED ALWAYS AS (
+		setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+		setweight(to_tsvector('english', coalesce(description, '')), 'B')
+	) STORED;
+	`, `
+	CREATE INDEX IF NOT EXISTS idx_projects_search_vector ON projects USING GIN (search_vector);
+	`}
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			log.Printf("Error creating full-text search column or index: %v\n", err)
+			return fmt.Errorf("failed to create full-text search column or index: %w", err)
+		}
+	}
+	log.Println("Full-text search columns checked/created.")
+	return nil
+}
+
+// backfillProjectMembers copies the memberships that existed before the
+// project_members table was introduced: every project manager becomes a
+// MANAGER of their project and every user with a current project becomes a
+// MEMBER of it.
+func backfillProjectMembers(tx *gorm.DB) error {
+	log.Println("Backfilling project members from existing projects and users...")
+
+	sqlManagers := `
+	INSERT INTO project_members (project_id, user_id, role, created_at, updated_at)
+	SELECT p.id, p.manager_id, 'MANAGER', NOW(), NOW()
+	FROM projects p
+	JOIN users u ON u.id = p.manager_id AND u.deleted_at IS NULL
+	WHERE p.deleted_at IS NULL
+	ON CONFLICT (project_id, user_id) DO NOTHING;
+	`
+	if err := tx.Exec(sqlManagers).Error; err != nil {
+		log.Printf("Error backfilling project managers: %v\n", err)
+		return fmt.Errorf("failed to backfill project managers: %w", err)
+	}
+
+	sqlMembers := `
+	INSERT INTO project_members (project_id, user_id, role, created_at, updated_at)
+	SELECT u.current_project_id, u.id, 'MEMBER', NOW(), NOW()
+	FROM users u
+	JOIN projects p ON p.id = u.current_project_id AND p.deleted_at IS NULL
+	WHERE u.deleted_at IS NULL
+	ON CONFLICT (project_id, user_id) DO NOTHING;
+	`
+	if err := tx.Exec(sqlMembers).Error; err != nil {
+		log.Printf("Error backfilling project team members: %v\n", err)
+		return fmt.Errorf("failed to backfill project team members: %w", err)
+	}
+
+	log.Println("Project members backfilled.")
+	return nil
+}
+
+// backfillTaskRanks ranks the tasks created before tasks had a rank, in the
+// order they were created. The ranks are ten decimal digits and a last
+// digit that is not zero, so further ranks always fit around them.
+func backfillTaskRanks(tx *gorm.DB) error {
+	log.Println("Backfilling ranks of unranked tasks...")
+	sqlTaskRanks := `
+	UPDATE tasks SET rank = ranked.rank
+	FROM (
+		SELECT id, lpad((row_number() OVER (PARTITION BY project_id ORDER BY id))::text, 10, '0') || 'i' AS rank
+		FROM tasks
+		WHERE rank = ''
+	) ranked
+	WHERE tasks.id = ranked.id;
+	`
+	if err := tx.Exec(sqlTaskRanks).Error; err != nil {
+		log.Printf("Error backfilling task ranks: %v\n", err)
+		return fmt.Errorf("failed to backfill task ranks: %w", err)
+	}
+	log.Println("Task ranks backfilled.")
+	return nil
+}
+
 func createForeignKeyTranSaction(tx *gorm.DB) error {
 	log.Println("Manually adding foreign key constraints...")
 
@@ -152,6 +424,222 @@ func createForeignKeyTranSaction(tx *gorm.DB) error {
 			ConstraintName: "fk_projects_team_members",
 			Description:    "users.current_project_id -> projects.id",
 		},
+		{ // 7. Task.ParentTaskID -> tasks.id (Nullable)
+			Model:          &models.Task{},
+			RelationField:  "Subtasks",
+			ConstraintName: "fk_tasks_subtasks",
+			Description:    "tasks.parent_task_id -> tasks.id",
+		},
+		{ // 8. ProjectMember.ProjectID -> projects.id
+			Model:          &models.Project{},
+			RelationField:  "Members",
+			ConstraintName: "fk_projects_members",
+			Description:    "project_members.project_id -> projects.id",
+		},
+		{ // 9. ProjectMember.UserID -> users.id
+			Model:          &models.ProjectMember{},
+			RelationField:  "User",
+			ConstraintName: "fk_project_members_user",
+			Description:    "project_members.user_id -> users.id",
+		},
+		{ // 10. Comment.TaskID -> tasks.id
+			Model:          &models.Comment{},
+			RelationField:  "Task",
+			ConstraintName: "fk_comments_task",
+			Description:    "comments.task_id -> tasks.id",
+		},
+		{ // 11. Comment.AuthorID -> users.id
+			Model:          &models.Comment{},
+			RelationField:  "Author",
+			ConstraintName: "fk_comments_author",
+			Description:    "comments.author_id -> users.id",
+		},
+		{ // 12. Comment.ParentCommentID -> comments.id (Nullable)
+			Model:          &models.Comment{},
+			RelationField:  "Replies",
+			ConstraintName: "fk_comments_replies",
+			Description:    "comments.parent_comment_id -> comments.id",
+		},
+		{ // 13. CommentEdit.CommentID -> comments.id
+			Model:          &models.Comment{},
+			RelationField:  "Edits",
+			ConstraintName: "fk_comments_edits",
+			Description:    "comment_edits.comment_id -> comments.id",
+		},
+		{ // 14. CommentMention.CommentID -> comments.id
+			Model:          &models.Comment{},
+			RelationField:  "Mentions",
+			ConstraintName: "fk_comments_mentions",
+			Description:    "comment_mentions.comment_id -> comments.id",
+		},
+		{ // 15. CommentMention.UserID -> users.id
+			Model:          &models.CommentMention{},
+			RelationField:  "User",
+			ConstraintName: "fk_comment_mentions_user",
+			Description:    "comment_mentions.user_id -> users.id",
+		},
+		{ // 16. ActivityLog.ActorID -> users.id
+			Model:          &models.ActivityLog{},
+			RelationField:  "Actor",
+			ConstraintName: "fk_activity_logs_actor",
+			Description:    "activity_logs.actor_id -> users.id",
+		},
+		{ // 17. ActivityChange.ActivityLogID -> activity_logs.id
+			Model:          &models.ActivityLog{},
+			RelationField:  "Changes",
+			ConstraintName: "fk_activity_logs_changes",
+			Description:    "activity_changes.activity_log_id -> activity_logs.id",
+		},
+		{ // 18. WorkflowTransition.ProjectID -> projects.id
+			Model:          &models.WorkflowTransition{},
+			RelationField:  "Project",
+			ConstraintName: "fk_workflow_transitions_project",
+			Description:    "workflow_transitions.project_id -> projects.id",
+		},
+		{ // 19. SprintReport.SprintID -> sprints.id
+			Model:          &models.Sprint{},
+			RelationField:  "Report",
+			ConstraintName: "fk_sprints_report",
+			Description:    "sprint_reports.sprint_id -> sprints.id",
+		},
+		{ // 20. SprintReport.CompletedByID -> users.id
+			Model:          &models.SprintReport{},
+			RelationField:  "CompletedBy",
+			ConstraintName: "fk_sprint_reports_completed_by",
+			Description:    "sprint_reports.completed_by_id -> users.id",
+		},
+		{ // 21. SprintReport.NextSprintID -> sprints.id (Nullable)
+			Model:          &models.SprintReport{},
+			RelationField:  "NextSprint",
+			ConstraintName: "fk_sprint_reports_next_sprint",
+			Description:    "sprint_reports.next_sprint_id -> sprints.id",
+		},
+		{ // 22. SprintReportTask.SprintReportID -> sprint_reports.id
+			Model:          &models.SprintReport{},
+			RelationField:  "Tasks",
+			ConstraintName: "fk_sprint_reports_tasks",
+			Description:    "sprint_report_tasks.sprint_report_id -> sprint_reports.id",
+		},
+		{ // 23. Worklog.TaskID -> tasks.id
+			Model:          &models.Worklog{},
+			RelationField:  "Task",
+			ConstraintName: "fk_worklogs_task",
+			Description:    "worklogs.task_id -> tasks.id",
+		},
+		{ // 24. Worklog.UserID -> users.id
+			Model:          &models.Worklog{},
+			RelationField:  "User",
+			ConstraintName: "fk_worklogs_user",
+			Description:    "worklogs.user_id -> users.id",
+		},
+		{ // 25. TaskLink.SourceTaskID -> tasks.id
+			Model:          &models.TaskLink{},
+			RelationField:  "SourceTask",
+			ConstraintName: "fk_task_links_source_task",
+			Description:    "task_links.source_task_id -> tasks.id",
+		},
+		{ // 26. TaskLink.TargetTaskID -> tasks.id
+			Model:          &models.TaskLink{},
+			RelationField:  "TargetTask",
+			ConstraintName: "fk_task_links_target_task",
+			Description:    "task_links.target_task_id -> tasks.id",
+		},
+		{ // 27. Label.ProjectID -> projects.id
+			Model:          &models.Label{},
+			RelationField:  "Project",
+			ConstraintName: "fk_labels_project",
+			Description:    "labels.project_id -> projects.id",
+		},
+		{ // 28. TaskLabel.TaskID -> tasks.id
+			Model:          &models.Task{},
+			RelationField:  "TaskLabels",
+			ConstraintName: "fk_tasks_task_labels",
+			Description:    "task_labels.task_id -> tasks.id",
+		},
+		{ // 29. TaskLabel.LabelID -> labels.id
+			Model:          &models.TaskLabel{},
+			RelationField:  "Label",
+			ConstraintName: "fk_task_labels_label",
+			Description:    "task_labels.label_id -> labels.id",
+		},
+		{ // 30. Attachment.TaskID -> tasks.id
+			Model:          &models.Attachment{},
+			RelationField:  "Task",
+			ConstraintName: "fk_attachments_task",
+			Description:    "attachments.task_id -> tasks.id",
+		},
+		{ // 31. Attachment.UploadedByID -> users.id
+			Model:          &models.Attachment{},
+			RelationField:  "UploadedBy",
+			ConstraintName: "fk_attachments_uploaded_by",
+			Description:    "attachments.uploaded_by_id -> users.id",
+		},
+		{ // 32. SavedView.OwnerID -> users.id
+			Model:          &models.SavedView{},
+			RelationField:  "Owner",
+			ConstraintName: "fk_saved_views_owner",
+			Description:    "saved_views.owner_id -> users.id",
+		},
+		{ // 33. SavedView.ProjectID -> projects.id
+			Model:          &models.SavedView{},
+			RelationField:  "Project",
+			ConstraintName: "fk_saved_views_project",
+			Description:    "saved_views.project_id -> projects.id",
+		},
+		{ // 34. Webhook.ProjectID -> projects.id
+			Model:          &models.Webhook{},
+			RelationField:  "Project",
+			ConstraintName: "fk_webhooks_project",
+			Description:    "webhooks.project_id -> projects.id",
+		},
+		{ // 35. Webhook.CreatedByID -> users.id
+			Model:          &models.Webhook{},
+			RelationField:  "CreatedBy",
+			ConstraintName: "fk_webhooks_created_by",
+			Description:    "webhooks.created_by_id -> users.id",
+		},
+		{ // 36. WebhookDelivery.WebhookID -> webhooks.id
+			Model:          &models.WebhookDelivery{},
+			RelationField:  "Webhook",
+			ConstraintName: "fk_webhook_deliveries_webhook",
+			Description:    "webhook_deliveries.webhook_id -> webhooks.id",
+		},
+		{ // 37. Notification.UserID -> users.id
+			Model:          &models.Notification{},
+			RelationField:  "User",
+			ConstraintName: "fk_notifications_user",
+			Description:    "notifications.user_id -> users.id",
+		},
+		{ // 38. Notification.ProjectID -> projects.id
+			Model:          &models.Notification{},
+			RelationField:  "Project",
+			ConstraintName: "fk_notifications_project",
+			Description:    "notifications.project_id -> projects.id",
+		},
+		{ // 39. Notification.TaskID -> tasks.id
+			Model:          &models.Notification{},
+			RelationField:  "Task",
+			ConstraintName: "fk_notifications_task",
+			Description:    "notifications.task_id -> tasks.id",
+		},
+		{ // 40. Notification.ActorID -> users.id
+			Model:          &models.Notification{},
+			RelationField:  "Actor",
+			ConstraintName: "fk_notifications_actor",
+			Description:    "notifications.actor_id -> users.id",
+		},
+		{ // 41. OutboundEmail.UserID -> users.id
+			Model:          &models.OutboundEmail{},
+			RelationField:  "User",
+			ConstraintName: "fk_outbound_emails_user",
+			Description:    "outbound_emails.user_id -> users.id",
+		},
+		{ // 42. WipLimit.ProjectID -> projects.id
+			Model:          &models.WipLimit{},
+			RelationField:  "Project",
+			ConstraintName: "fk_wip_limits_project",
+			Description:    "wip_limits.project_id -> projects.id",
+		},
 	}
 	for _, c := range constraints {
 		log.Printf("Processing constraint: %s", c.Description)
@@ -219,10 +707,64 @@ func autoMigrateWithoutCreateFkConstraint(db *gorm.DB) error {
 		return err // Return immediately on error
 	}
 
+	if err = createEnumProjectMemberRole(tx); err != nil {
+		return err // Return immediately on error
+	}
+
+	if err = createEnumSprintStatus(tx); err != nil {
+		return err // Return immediately on error
+	}
+
+	if err = createEnumActivityEntityType(tx); err != nil {
+		return err // Return immediately on error
+	}
+
+	if err = createEnumActivityAction(tx); err != nil {
+		return err // Return immediately on error
+	}
+
+	if err = createEnumTaskLinkType(tx); err != nil {
+		return err // Return immediately on error
+	}
+
+	if err = createEnumWebhookDeliveryStatus(tx); err != nil {
+		return err // Return immediately on error
+	}
+
+	if err = createEnumNotificationType(tx); err != nil {
+		return err // Return immediately on error
+	}
+
+	if err = createEnumOutboundEmailStatus(tx); err != nil {
+		return err // Return immediately on error
+	}
+
+	// Memberships are only backfilled once, when the table is first created,
+	// so members removed later are not added back on the next start.
+	needsMemberBackfill := !tx.Migrator().HasTable(&models.ProjectMember{})
+
 	if err = createTables(tx); err != nil {
 		return err // Return immediately on error
 	}
 
+	if err = createActiveSprintIndex(tx); err != nil {
+		return err // Return immediately on error
+	}
+
+	if err = createSearchVectors(tx); err != nil {
+		return err // Return immediately on error
+	}
+
+	if needsMemberBackfill {
+		if err = backfillProjectMembers(tx); err != nil {
+			return err // Return immediately on error
+		}
+	}
+
+	if err = backfillTaskRanks(tx); err != nil {
+		return err // Return immediately on error
+	}
+
 	log.Println("Database migration completed successfully.")
 	return err
 }
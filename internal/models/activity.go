@@ -0,0 +1,58 @@
+package models
+
+import (
+	"time"
+)
+
+type ActivityEntityType string
+
+const (
+	ActivityEntityProject       ActivityEntityType = "PROJECT"
+	ActivityEntityProjectMember ActivityEntityType = "PROJECT_MEMBER"
+	ActivityEntitySprint        ActivityEntityType = "SPRINT"
+	ActivityEntityTask          ActivityEntityType = "TASK"
+)
+
+type ActivityAction string
+
+const (
+	ActivityCreate ActivityAction = "CREATE"
+	ActivityUpdate ActivityAction = "UPDATE"
+	ActivityDelete ActivityAction = "DELETE"
+	ActivityAssign ActivityAction = "ASSIGN"
+)
+
+// ActivityLog is an append-only record of a change made by a user to an
+// entity of a project. Its Changes hold the field-level before/after values.
+type ActivityLog struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+
+	ActorID    int                `gorm:"index;not null" json:"actor_id"`
+	ProjectID  int                `gorm:"index;not null" json:"project_id"`
+	EntityType ActivityEntityType `gorm:"type:activity_entity_type;not null;index:idx_activity_logs_entity" json:"entity_type"`
+	EntityID   int                `gorm:"not null;index:idx_activity_logs_entity" json:"entity_id"`
+	Action     ActivityAction     `gorm:"type:activity_action;not null" json:"action"`
+
+	Actor   *User            `gorm:"foreignKey:ActorID;references:ID" json:"actor,omitempty"`
+	Changes []ActivityChange `gorm:"foreignKey:ActivityLogID" json:"changes,omitempty"`
+}
+
+// ActivityChange is the before/after value of one field touched by an
+// activity. OldValue is nil for created values and NewValue for removed ones.
+type ActivityChange struct {
+	ID            int `gorm:"primaryKey;autoIncrement" json:"id"`
+	ActivityLogID int `gorm:"index;not null" json:"activity_log_id"`
+
+	Field    string  `gorm:"not null;size:64" json:"field"`
+	OldValue *string `gorm:"type:text" json:"old_value"`
+	NewValue *string `gorm:"type:text" json:"new_value"`
+}
+
+func (a *ActivityLog) GetID() int {
+	return a.ID
+}
+
+func (a *ActivityLog) GetPKColumnName() string {
+	return "id"
+}
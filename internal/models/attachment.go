@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+)
+
+// Attachment is the metadata of a file attached to a task. The content of
+// the file is kept by the storage backend under StorageKey.
+type Attachment struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	TaskID       int    `gorm:"index;not null" json:"task_id"`
+	UploadedByID int    `gorm:"index;not null" json:"uploaded_by_id"`
+	FileName     string `gorm:"not null;size:255" json:"file_name"`
+	// ContentType is the MIME type detected from the content at upload.
+	ContentType string `gorm:"not null;size:100" json:"content_type"`
+	Size        int64  `gorm:"not null" json:"size"`
+	StorageKey  string `gorm:"not null;size:255;uniqueIndex" json:"-"`
+
+	Task       *Task `gorm:"foreignKey:TaskID;references:ID" json:"task,omitempty"`
+	UploadedBy *User `gorm:"foreignKey:UploadedByID;references:ID" json:"uploaded_by,omitempty"`
+}
+
+func (a *Attachment) GetID() int {
+	return a.ID
+}
+
+func (a *Attachment) GetPKColumnName() string {
+	return "id"
+}
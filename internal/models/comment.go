@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Comment is a message posted on a task, optionally as a reply to another
+// comment of the same task.
+type Comment struct {
+	ID        int            `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+
+	TaskID          int        `gorm:"index;not null" json:"task_id"`
+	AuthorID        int        `gorm:"index;not null" json:"author_id"`
+	ParentCommentID *int       `gorm:"index" json:"parent_comment_id"`
+	Body            string     `gorm:"type:text;not null" json:"body"`
+	EditedAt        *time.Time `json:"edited_at,omitempty"`
+
+	Task     *Task            `gorm:"foreignKey:TaskID;references:ID" json:"task,omitempty"`
+	Author   *User            `gorm:"foreignKey:AuthorID;references:ID" json:"author,omitempty"`
+	Replies  []Comment        `gorm:"foreignKey:ParentCommentID" json:"replies,omitempty"`
+	Edits    []CommentEdit    `gorm:"foreignKey:CommentID" json:"edits,omitempty"`
+	Mentions []CommentMention `gorm:"foreignKey:CommentID" json:"mentions,omitempty"`
+}
+
+// CommentEdit keeps the body a comment had before one of its edits.
+type CommentEdit struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	CommentID    int    `gorm:"index;not null" json:"comment_id"`
+	EditorID     int    `gorm:"not null" json:"editor_id"`
+	PreviousBody string `gorm:"type:text;not null" json:"previous_body"`
+}
+
+// CommentMention records a user mentioned with @email in a comment.
+type CommentMention struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	CommentID int `gorm:"not null;uniqueIndex:idx_comment_mentions_comment_user" json:"comment_id"`
+	UserID    int `gorm:"not null;index;uniqueIndex:idx_comment_mentions_comment_user" json:"user_id"`
+
+	User *User `gorm:"foreignKey:UserID;references:ID" json:"user,omitempty"`
+}
+
+func (c *Comment) GetID() int {
+	return c.ID
+}
+
+func (c *Comment) GetPKColumnName() string {
+	return "id"
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+)
+
+// Label categorizes tasks of a project. Its name is unique in the project.
+type Label struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	ProjectID int    `gorm:"not null;uniqueIndex:idx_labels_project_name" json:"project_id"`
+	Name      string `gorm:"not null;size:50;uniqueIndex:idx_labels_project_name" json:"name"`
+	// Color is a hex color such as "#FF8800".
+	Color string `gorm:"not null;size:7" json:"color"`
+
+	Project *Project `gorm:"foreignKey:ProjectID;references:ID" json:"project,omitempty"`
+}
+
+// TaskLabel attaches a label to a task.
+type TaskLabel struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	TaskID  int `gorm:"not null;uniqueIndex:idx_task_labels_task_label" json:"task_id"`
+	LabelID int `gorm:"not null;index;uniqueIndex:idx_task_labels_task_label" json:"label_id"`
+
+	Label *Label `gorm:"foreignKey:LabelID;references:ID" json:"label,omitempty"`
+}
+
+func (l *Label) GetID() int {
+	return l.ID
+}
+
+func (l *Label) GetPKColumnName() string {
+	return "id"
+}
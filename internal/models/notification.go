@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+)
+
+type NotificationType string
+
+const (
+	NotificationTaskAssigned         NotificationType = "TASK_ASSIGNED"
+	NotificationTaskStatusChanged    NotificationType = "TASK_STATUS_CHANGED"
+	NotificationTaskDueSoon          NotificationType = "TASK_DUE_SOON"
+	NotificationProjectMemberAdded   NotificationType = "PROJECT_MEMBER_ADDED"
+	NotificationProjectMemberRemoved NotificationType = "PROJECT_MEMBER_REMOVED"
+)
+
+// Notification tells a user about something that happened to them or to a
+// task they are assigned, such as being assigned a task. It stays unread
+// until ReadAt is set.
+type Notification struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+
+	UserID    int              `gorm:"not null;index:idx_notifications_user_read" json:"user_id"`
+	ReadAt    *time.Time       `gorm:"index:idx_notifications_user_read" json:"read_at"`
+	Type      NotificationType `gorm:"type:notification_type;not null" json:"type"`
+	ProjectID int              `gorm:"not null;index" json:"project_id"`
+	TaskID    *int             `gorm:"index" json:"task_id,omitempty"`
+	// ActorID is the user who caused the notification, nil for reminders.
+	ActorID *int   `json:"actor_id,omitempty"`
+	Message string `gorm:"not null;size:500" json:"message"`
+
+	User    *User    `gorm:"foreignKey:UserID;references:ID" json:"user,omitempty"`
+	Project *Project `gorm:"foreignKey:ProjectID;references:ID" json:"project,omitempty"`
+	Task    *Task    `gorm:"foreignKey:TaskID;references:ID" json:"task,omitempty"`
+	Actor   *User    `gorm:"foreignKey:ActorID;references:ID" json:"actor,omitempty"`
+}
+
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+func (n *Notification) GetID() int {
+	return n.ID
+}
+
+func (n *Notification) GetPKColumnName() string {
+	return "id"
+}
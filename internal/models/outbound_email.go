@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+)
+
+type OutboundEmailStatus string
+
+const (
+	EmailPending OutboundEmailStatus = "PENDING"
+	EmailSent    OutboundEmailStatus = "SENT"
+	EmailFailed  OutboundEmailStatus = "FAILED"
+)
+
+// OutboundEmail is a rendered email in the outbox. Emails are queued here and
+// sent in the background, so pending ones survive restarts and failed
+// attempts are retried until MaxAttempts of the mail configuration.
+type OutboundEmail struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	UserID   *int   `gorm:"index" json:"user_id,omitempty"`
+	To       string `gorm:"column:recipient;not null;size:255" json:"to"`
+	Subject  string `gorm:"not null;size:255" json:"subject"`
+	HTMLBody string `gorm:"column:html_body;type:text;not null" json:"html_body"`
+	// Template names the template the body was rendered from.
+	Template string `gorm:"not null;size:64" json:"template"`
+
+	Status        OutboundEmailStatus `gorm:"type:outbound_email_status;not null;default:'PENDING';index:idx_outbound_emails_due" json:"status"`
+	Attempts      int                 `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt *time.Time          `gorm:"index:idx_outbound_emails_due" json:"next_attempt_at,omitempty"`
+	LastAttemptAt *time.Time          `json:"last_attempt_at,omitempty"`
+	SentAt        *time.Time          `json:"sent_at,omitempty"`
+	Error         string              `gorm:"type:text" json:"error,omitempty"`
+
+	User *User `gorm:"foreignKey:UserID;references:ID" json:"user,omitempty"`
+}
+
+func (e *OutboundEmail) GetID() int {
+	return e.ID
+}
+
+func (e *OutboundEmail) GetPKColumnName() string {
+	return "id"
+}
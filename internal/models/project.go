@@ -32,6 +32,7 @@ type Project struct {
 	Tasks       []Task   `gorm:"foreignKey:ProjectID" json:"tasks,omitempty"`
 	Sprints     []Sprint `gorm:"foreignKey:ProjectID" json:"sprints,omitempty"`
 	TeamMembers []User   `gorm:"foreignKey:CurrentProjectID" json:"team_members,omitempty"`
+	Members     []ProjectMember `gorm:"foreignKey:ProjectID" json:"members,omitempty"`
 }
 
 func (ps ProjectStatus) IsValid() bool {
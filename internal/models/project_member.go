@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+)
+
+type ProjectMemberRole string
+
+const (
+	ProjectRoleManager ProjectMemberRole = "MANAGER"
+	ProjectRoleMember  ProjectMemberRole = "MEMBER"
+	ProjectRoleViewer  ProjectMemberRole = "VIEWER"
+)
+
+type ProjectMember struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	ProjectID int               `gorm:"not null;uniqueIndex:idx_project_members_project_user" json:"project_id"`
+	UserID    int               `gorm:"not null;index;uniqueIndex:idx_project_members_project_user" json:"user_id"`
+	Role      ProjectMemberRole `gorm:"type:project_member_role;not null;default:'MEMBER'" json:"role"`
+
+	Project *Project `gorm:"foreignKey:ProjectID;references:ID" json:"project,omitempty"`
+	User    *User    `gorm:"foreignKey:UserID;references:ID" json:"user,omitempty"`
+}
+
+func (r ProjectMemberRole) IsValid() bool {
+	switch r {
+	case ProjectRoleManager, ProjectRoleMember, ProjectRoleViewer:
+		return true
+	}
+	return false
+}
+
+// CanBeAssigned reports whether members with this role may own tasks.
+func (r ProjectMemberRole) CanBeAssigned() bool {
+	return r == ProjectRoleManager || r == ProjectRoleMember
+}
+
+func (m *ProjectMember) GetID() int {
+	return m.ID
+}
+
+func (m *ProjectMember) GetPKColumnName() string {
+	return "id"
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+)
+
+// SavedView is a named task filter of a user. A view shared to a project is
+// visible to the members of the project and lists only its tasks.
+type SavedView struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	OwnerID   int    `gorm:"not null;index" json:"owner_id"`
+	ProjectID *int   `gorm:"index" json:"project_id,omitempty"`
+	Name      string `gorm:"not null;size:100" json:"name"`
+	// Filter is an expression of the task filter language, e.g.
+	// "assignee = me and status != DONE and priority = CRITICAL".
+	Filter string `gorm:"not null;size:1000" json:"filter"`
+
+	Owner   *User    `gorm:"foreignKey:OwnerID;references:ID" json:"owner,omitempty"`
+	Project *Project `gorm:"foreignKey:ProjectID;references:ID" json:"project,omitempty"`
+}
+
+// IsShared reports whether the view is visible to a project.
+func (v *SavedView) IsShared() bool {
+	return v.ProjectID != nil
+}
+
+func (v *SavedView) GetID() int {
+	return v.ID
+}
+
+func (v *SavedView) GetPKColumnName() string {
+	return "id"
+}
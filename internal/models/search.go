@@ -0,0 +1,25 @@
+package models
+
+// TaskSearchResult is a task matching a full-text search; it is read from a
+// ranking query and has no table.
+type TaskSearchResult struct {
+	ID          int
+	ProjectID   int
+	ProjectName string
+	Title       string
+	Status      TaskStatus
+	Rank        float64
+	// Snippet is an HTML escaped excerpt of the title and description with
+	// the matched words wrapped in <mark> tags.
+	Snippet string
+}
+
+// ProjectSearchResult is a project matching a full-text search; it is read
+// from a ranking query and has no table.
+type ProjectSearchResult struct {
+	ID      int
+	Name    string
+	Status  ProjectStatus
+	Rank    float64
+	Snippet string
+}
@@ -6,6 +6,14 @@ import (
 	"gorm.io/gorm"
 )
 
+type SprintStatus string
+
+const (
+	SprintPlanned SprintStatus = "PLANNED"
+	SprintActive  SprintStatus = "ACTIVE"
+	SprintClosed  SprintStatus = "CLOSED"
+)
+
 type Sprint struct {
 	ID        int            `gorm:"primaryKey;autoIncrement" json:"id"`
 	CreatedAt time.Time      `json:"created_at"`
@@ -18,8 +26,15 @@ type Sprint struct {
 	ProjectID int       `gorm:"index;not null" json:"project_id"`
 	Goal      string    `gorm:"type:text" json:"goal"`
 
+	// Status moves from PLANNED to ACTIVE when the sprint is started and to
+	// CLOSED when it is completed; a project has at most one ACTIVE sprint.
+	Status      SprintStatus `gorm:"type:sprint_status;not null;default:'PLANNED'" json:"status"`
+	StartedAt   *time.Time   `json:"started_at,omitempty"`
+	CompletedAt *time.Time   `json:"completed_at,omitempty"`
+
 	Project *Project `gorm:"foreignKey:ProjectID;references:ID" json:"project"`
 	Tasks   []Task   `gorm:"foreignKey:SprintID" json:"tasks,omitempty"`
+	Report  *SprintReport `gorm:"foreignKey:SprintID" json:"report,omitempty"`
 }
 
 func (s *Sprint) GetID() int {
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+)
+
+// CarryOverTarget tells where the unfinished tasks of a completed sprint go.
+type CarryOverTarget string
+
+const (
+	CarryOverNextSprint CarryOverTarget = "NEXT_SPRINT"
+	CarryOverBacklog    CarryOverTarget = "BACKLOG"
+)
+
+type SprintTaskOutcome string
+
+const (
+	SprintTaskCompleted   SprintTaskOutcome = "COMPLETED"
+	SprintTaskCarriedOver SprintTaskOutcome = "CARRIED_OVER"
+)
+
+// SprintReport records how a sprint ended: which tasks were done and which
+// ones were carried over, and where to.
+type SprintReport struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	SprintID      int             `gorm:"not null;uniqueIndex" json:"sprint_id"`
+	ProjectID     int             `gorm:"index;not null" json:"project_id"`
+	CompletedByID int             `gorm:"not null" json:"completed_by_id"`
+	CarryOver     CarryOverTarget `gorm:"size:20;not null" json:"carry_over"`
+	NextSprintID  *int            `json:"next_sprint_id,omitempty"`
+
+	CompletedCount   int `gorm:"not null" json:"completed_count"`
+	CarriedOverCount int `gorm:"not null" json:"carried_over_count"`
+
+	CompletedBy *User              `gorm:"foreignKey:CompletedByID;references:ID" json:"completed_by,omitempty"`
+	NextSprint  *Sprint            `gorm:"foreignKey:NextSprintID;references:ID" json:"next_sprint,omitempty"`
+	Tasks       []SprintReportTask `gorm:"foreignKey:SprintReportID" json:"tasks,omitempty"`
+}
+
+// SprintReportTask is a task of a completed sprint as it was when the sprint
+// ended.
+type SprintReportTask struct {
+	ID             int `gorm:"primaryKey;autoIncrement" json:"id"`
+	SprintReportID int `gorm:"index;not null" json:"sprint_report_id"`
+
+	TaskID  int               `gorm:"index;not null" json:"task_id"`
+	Title   string            `gorm:"not null;size:255" json:"title"`
+	Status  TaskStatus        `gorm:"type:task_status;not null" json:"status"`
+	Outcome SprintTaskOutcome `gorm:"size:20;not null" json:"outcome"`
+
+	StoryPoints *int `json:"story_points"`
+}
+
+func (r *SprintReport) GetID() int {
+	return r.ID
+}
+
+func (r *SprintReport) GetPKColumnName() string {
+	return "id"
+}
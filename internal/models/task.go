@@ -25,6 +25,29 @@ const (
 	CriticalPriority TaskPriority = "CRITICAL"
 )
 
+// TaskPriorities lists the task priorities from the lowest to the highest.
+var TaskPriorities = []TaskPriority{LowPriority, MediumPriority, HighPriority, CriticalPriority}
+
+func (tp TaskPriority) IsValid() bool {
+	switch tp {
+	case LowPriority, MediumPriority, HighPriority, CriticalPriority:
+		return true
+	}
+	return false
+}
+
+func (ts TaskStatus) IsValid() bool {
+	switch ts {
+	case ToDoTask, InProgressTask, ReviewTask, DoneTask, BlockedTask:
+		return true
+	}
+	return false
+}
+
+// MaxTaskDepth is the maximum number of levels in a task hierarchy,
+// counting the top-level task itself.
+const MaxTaskDepth = 5
+
 type Task struct {
 	ID        int            `gorm:"primaryKey;autoIncrement" json:"id"`
 	CreatedAt time.Time      `json:"created_at"`
@@ -35,14 +58,30 @@ type Task struct {
 	Description string       `gorm:"type:text" json:"description"`
 	AssigneeID  *int         `gorm:"index" json:"assignee_id"`
 	ProjectID   int          `gorm:"index;not null" json:"project_id"`
-	SprintID    int          `gorm:"index;not null" json:"sprint_id"`
+	SprintID    *int         `gorm:"index" json:"sprint_id"`
 	Status      TaskStatus   `gorm:"type:task_status;not null;default:'TO_DO'" json:"status"`
 	Priority    TaskPriority `gorm:"type:task_priority;not null;default:'MEDIUM'" json:"priority"`
 	DueDate     *time.Time   `json:"due_date"`
+	ParentTaskID *int        `gorm:"index" json:"parent_task_id"`
+	// Rank orders the task within its board column, the tasks of its sprint,
+	// or of the backlog, with the same status. See utils.RankBetween.
+	Rank        string       `gorm:"size:255;not null;default:''" json:"rank"`
+
+	// StoryPoints is the relative size of the task; nil when not estimated.
+	StoryPoints              *int `gorm:"index" json:"story_points"`
+	// OriginalEstimateMinutes and RemainingEstimateMinutes are time
+	// estimates in minutes.
+	OriginalEstimateMinutes  *int `json:"original_estimate_minutes"`
+	RemainingEstimateMinutes *int `json:"remaining_estimate_minutes"`
 
-	Assignee *User    `gorm:"foreignKey:AssigneeID;references:ID" json:"assignee,omitempty"`
-	Project  *Project `gorm:"foreignKey:ProjectID;references:ID" json:"project"`
-	Sprint   *Sprint  `gorm:"foreignKey:SprintID;references:ID" json:"sprint"`
+	Assignee   *User       `gorm:"foreignKey:AssigneeID;references:ID" json:"assignee,omitempty"`
+	Project    *Project    `gorm:"foreignKey:ProjectID;references:ID" json:"project"`
+	Sprint     *Sprint     `gorm:"foreignKey:SprintID;references:ID" json:"sprint"`
+	Subtasks   []Task      `gorm:"foreignKey:ParentTaskID" json:"subtasks,omitempty"`
+	TaskLabels []TaskLabel `gorm:"foreignKey:TaskID" json:"task_labels,omitempty"`
+	// Links holds the links of the task in both directions. It is filled by
+	// the task service when a single task is read, not by gorm.
+	Links []*TaskLink `gorm:"-" json:"links,omitempty"`
 }
 
 func (t *Task) GetID() int {
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+)
+
+type TaskLinkType string
+
+const (
+	// BlocksLink means the source task must be done before the target task
+	// can be started.
+	BlocksLink     TaskLinkType = "BLOCKS"
+	RelatesToLink  TaskLinkType = "RELATES_TO"
+	DuplicatesLink TaskLinkType = "DUPLICATES"
+)
+
+func (lt TaskLinkType) IsValid() bool {
+	switch lt {
+	case BlocksLink, RelatesToLink, DuplicatesLink:
+		return true
+	}
+	return false
+}
+
+// TaskLink is a typed link from SourceTaskID to TargetTaskID, read as
+// "source blocks / relates to / duplicates target".
+type TaskLink struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	SourceTaskID int          `gorm:"not null;uniqueIndex:idx_task_links_unique" json:"source_task_id"`
+	TargetTaskID int          `gorm:"not null;index;uniqueIndex:idx_task_links_unique" json:"target_task_id"`
+	Type         TaskLinkType `gorm:"type:task_link_type;not null;uniqueIndex:idx_task_links_unique" json:"type"`
+	CreatedByID  int          `gorm:"not null" json:"created_by_id"`
+
+	SourceTask *Task `gorm:"foreignKey:SourceTaskID;references:ID" json:"source_task,omitempty"`
+	TargetTask *Task `gorm:"foreignKey:TargetTaskID;references:ID" json:"target_task,omitempty"`
+}
+
+func (l *TaskLink) GetID() int {
+	return l.ID
+}
+
+func (l *TaskLink) GetPKColumnName() string {
+	return "id"
+}
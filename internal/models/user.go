@@ -27,6 +27,11 @@ type User struct {
 	LastName         string   `gorm:"size:100" json:"last_name"`
 	CurrentProjectID *int     `gorm:"index" json:"current_project_id,omitempty"`
 
+	// Email preferences: each kind of notification email can be turned off.
+	EmailOnAssignment  bool `gorm:"not null;default:true" json:"email_on_assignment"`
+	EmailOnDueSoon     bool `gorm:"not null;default:true" json:"email_on_due_soon"`
+	EmailOnSprintStart bool `gorm:"not null;default:true" json:"email_on_sprint_start"`
+
 	ManagedProjects []Project `gorm:"foreignKey:ManagerID" json:"managed_projects,omitempty"`
 	AssignedTasks   []Task    `gorm:"foreignKey:AssigneeID" json:"assigned_tasks,omitempty"`
 	CurrentProject  *Project  `gorm:"foreignKey:CurrentProjectID;references:ID" json:"current_project,omitempty"`
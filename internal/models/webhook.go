@@ -0,0 +1,87 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// Webhook subscribes a URL to domain events of a project. Payloads are
+// signed with Secret so that receivers can check where they come from.
+type Webhook struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	ProjectID   int    `gorm:"not null;index" json:"project_id"`
+	CreatedByID int    `gorm:"not null" json:"created_by_id"`
+	URL         string `gorm:"not null;size:500" json:"url"`
+	// Secret is the HMAC key of the payload signatures; it is only shown
+	// when the webhook is created.
+	Secret string `gorm:"not null;size:100" json:"-"`
+	// Events lists the event types delivered, e.g. "task.created".
+	Events []string `gorm:"serializer:json;type:text;not null" json:"events"`
+	Active bool     `gorm:"not null;default:true" json:"active"`
+
+	Project   *Project `gorm:"foreignKey:ProjectID;references:ID" json:"project,omitempty"`
+	CreatedBy *User    `gorm:"foreignKey:CreatedByID;references:ID" json:"created_by,omitempty"`
+}
+
+// Subscribes reports whether the webhook delivers events of eventType.
+func (w *Webhook) Subscribes(eventType string) bool {
+	return w.Active && slices.Contains(w.Events, eventType)
+}
+
+func (w *Webhook) GetID() int {
+	return w.ID
+}
+
+func (w *Webhook) GetPKColumnName() string {
+	return "id"
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	DeliveryPending   WebhookDeliveryStatus = "PENDING"
+	DeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	DeliveryFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+// WebhookDelivery is one event sent, or to be sent, to a webhook. Pending
+// deliveries are attempted at NextAttemptAt until they succeed or run out of
+// attempts; the outcome of the last attempt is kept for the delivery log.
+type WebhookDelivery struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `gorm:"index" json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	WebhookID int    `gorm:"not null;index" json:"webhook_id"`
+	EventID   string `gorm:"not null;size:36;index" json:"event_id"`
+	EventType string `gorm:"not null;size:50" json:"event_type"`
+	// Payload is the signed JSON body.
+	Payload string `gorm:"type:text;not null" json:"payload"`
+	// RedeliveryOfID is the delivery this one was manually redelivered from.
+	RedeliveryOfID *int `json:"redelivery_of_id,omitempty"`
+
+	Status        WebhookDeliveryStatus `gorm:"type:webhook_delivery_status;not null;default:'PENDING';index:idx_webhook_deliveries_due" json:"status"`
+	Attempts      int                   `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt *time.Time            `gorm:"index:idx_webhook_deliveries_due" json:"next_attempt_at,omitempty"`
+	LastAttemptAt *time.Time            `json:"last_attempt_at,omitempty"`
+	// ResponseStatus is the HTTP status of the last attempt, if it got one.
+	ResponseStatus *int `json:"response_status,omitempty"`
+	// ResponseBody is the beginning of the body of the last response.
+	ResponseBody string `gorm:"type:text" json:"response_body,omitempty"`
+	// Error describes why the last attempt failed without a response.
+	Error      string `gorm:"size:500" json:"error,omitempty"`
+	DurationMs int    `gorm:"not null;default:0" json:"duration_ms"`
+
+	Webhook *Webhook `gorm:"foreignKey:WebhookID;references:ID" json:"webhook,omitempty"`
+}
+
+func (d *WebhookDelivery) GetID() int {
+	return d.ID
+}
+
+func (d *WebhookDelivery) GetPKColumnName() string {
+	return "id"
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+)
+
+// WipLimit caps the work in progress of a project for one status: how many
+// tasks a board column, the tasks with the status in a sprint or in the
+// backlog, may hold, and how many of them one assignee may hold. A nil cap
+// leaves that side unlimited.
+type WipLimit struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	ProjectID           int        `gorm:"not null;uniqueIndex:idx_wip_limits_project_status" json:"project_id"`
+	Status              TaskStatus `gorm:"type:task_status;not null;uniqueIndex:idx_wip_limits_project_status" json:"status"`
+	MaxTasks            *int       `json:"max_tasks,omitempty"`
+	MaxTasksPerAssignee *int       `json:"max_tasks_per_assignee,omitempty"`
+
+	Project *Project `gorm:"foreignKey:ProjectID;references:ID" json:"project,omitempty"`
+}
+
+func (w *WipLimit) GetID() int {
+	return w.ID
+}
+
+func (w *WipLimit) GetPKColumnName() string {
+	return "id"
+}
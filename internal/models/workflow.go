@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+)
+
+// WorkflowTransition allows members of a project with Role to move a task of
+// the project from FromStatus to ToStatus. A project without transitions
+// follows DefaultWorkflowTransitions.
+type WorkflowTransition struct {
+	ID        int       `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	ProjectID  int               `gorm:"not null;uniqueIndex:idx_workflow_transitions_unique" json:"project_id"`
+	FromStatus TaskStatus        `gorm:"type:task_status;not null;uniqueIndex:idx_workflow_transitions_unique" json:"from_status"`
+	ToStatus   TaskStatus        `gorm:"type:task_status;not null;uniqueIndex:idx_workflow_transitions_unique" json:"to_status"`
+	Role       ProjectMemberRole `gorm:"type:project_member_role;not null;uniqueIndex:idx_workflow_transitions_unique" json:"role"`
+
+	Project *Project `gorm:"foreignKey:ProjectID;references:ID" json:"project,omitempty"`
+}
+
+// DefaultWorkflowTransitions returns the workflow of projects that did not
+// configure their own: work moves forward through review, can be blocked and
+// unblocked, and done tasks can be reopened, by managers and members alike.
+func DefaultWorkflowTransitions(projectID int) []*WorkflowTransition {
+	pairs := [][2]TaskStatus{
+		{ToDoTask, InProgressTask},
+		{ToDoTask, BlockedTask},
+		{InProgressTask, ToDoTask},
+		{InProgressTask, ReviewTask},
+		{InProgressTask, BlockedTask},
+		{ReviewTask, InProgressTask},
+		{ReviewTask, DoneTask},
+		{ReviewTask, BlockedTask},
+		{BlockedTask, ToDoTask},
+		{BlockedTask, InProgressTask},
+		{DoneTask, InProgressTask},
+	}
+
+	transitions := make([]*WorkflowTransition, 0, len(pairs)*2)
+	for _, pair := range pairs {
+		for _, role := range []ProjectMemberRole{ProjectRoleManager, ProjectRoleMember} {
+			transitions = append(transitions, &WorkflowTransition{
+				ProjectID:  projectID,
+				FromStatus: pair[0],
+				ToStatus:   pair[1],
+				Role:       role,
+			})
+		}
+	}
+	return transitions
+}
+
+func (w *WorkflowTransition) GetID() int {
+	return w.ID
+}
+
+func (w *WorkflowTransition) GetPKColumnName() string {
+	return "id"
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Worklog is time a user spent on a task.
+type Worklog struct {
+	ID        int            `gorm:"primaryKey;autoIncrement" json:"id"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+
+	TaskID int `gorm:"index;not null" json:"task_id"`
+	UserID int `gorm:"not null;index:idx_worklogs_user_started,priority:1" json:"user_id"`
+	// ProjectID is copied from the task so project totals need no join.
+	ProjectID       int       `gorm:"index;not null" json:"project_id"`
+	StartedAt       time.Time `gorm:"not null;index:idx_worklogs_user_started,priority:2" json:"started_at"`
+	DurationMinutes int       `gorm:"not null" json:"duration_minutes"`
+	Note            string    `gorm:"type:text" json:"note"`
+
+	Task *Task `gorm:"foreignKey:TaskID;references:ID" json:"task,omitempty"`
+	User *User `gorm:"foreignKey:UserID;references:ID" json:"user,omitempty"`
+}
+
+func (w *Worklog) GetID() int {
+	return w.ID
+}
+
+func (w *Worklog) GetPKColumnName() string {
+	return "id"
+}
+
+// WorklogTotal is the time logged by one user; it is read from an aggregate
+// query and has no table.
+type WorklogTotal struct {
+	UserID       int
+	TotalMinutes int
+}
@@ -0,0 +1,408 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newActivityChange(db *gorm.DB, opts ...gen.DOOption) activityChange {
+	_activityChange := activityChange{}
+
+	_activityChange.activityChangeDo.UseDB(db, opts...)
+	_activityChange.activityChangeDo.UseModel(&models.ActivityChange{})
+
+	tableName := _activityChange.activityChangeDo.TableName()
+	_activityChange.ALL = field.NewAsterisk(tableName)
+	_activityChange.ID = field.NewInt(tableName, "id")
+	_activityChange.ActivityLogID = field.NewInt(tableName, "activity_log_id")
+	_activityChange.Field = field.NewString(tableName, "field")
+	_activityChange.OldValue = field.NewString(tableName, "old_value")
+	_activityChange.NewValue = field.NewString(tableName, "new_value")
+
+	_activityChange.fillFieldMap()
+
+	return _activityChange
+}
+
+type activityChange struct {
+	activityChangeDo activityChangeDo
+
+	ALL           field.Asterisk
+	ID            field.Int
+	ActivityLogID field.Int
+	Field         field.String
+	OldValue      field.String
+	NewValue      field.String
+
+	fieldMap map[string]field.Expr
+}
+
+func (a activityChange) Table(newTableName string) *activityChange {
+	a.activityChangeDo.UseTable(newTableName)
+	return a.updateTableName(newTableName)
+}
+
+func (a activityChange) As(alias string) *activityChange {
+	a.activityChangeDo.DO = *(a.activityChangeDo.As(alias).(*gen.DO))
+	return a.updateTableName(alias)
+}
+
+func (a *activityChange) updateTableName(table string) *activityChange {
+	a.ALL = field.NewAsterisk(table)
+	a.ID = field.NewInt(table, "id")
+	a.ActivityLogID = field.NewInt(table, "activity_log_id")
+	a.Field = field.NewString(table, "field")
+	a.OldValue = field.NewString(table, "old_value")
+	a.NewValue = field.NewString(table, "new_value")
+
+	a.fillFieldMap()
+
+	return a
+}
+
+func (a *activityChange) WithContext(ctx context.Context) IActivityChangeDo {
+	return a.activityChangeDo.WithContext(ctx)
+}
+
+func (a activityChange) TableName() string { return a.activityChangeDo.TableName() }
+
+func (a activityChange) Alias() string { return a.activityChangeDo.Alias() }
+
+func (a activityChange) Columns(cols ...field.Expr) gen.Columns {
+	return a.activityChangeDo.Columns(cols...)
+}
+
+func (a *activityChange) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := a.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (a *activityChange) fillFieldMap() {
+	a.fieldMap = make(map[string]field.Expr, 5)
+	a.fieldMap["id"] = a.ID
+	a.fieldMap["activity_log_id"] = a.ActivityLogID
+	a.fieldMap["field"] = a.Field
+	a.fieldMap["old_value"] = a.OldValue
+	a.fieldMap["new_value"] = a.NewValue
+}
+
+func (a activityChange) clone(db *gorm.DB) activityChange {
+	a.activityChangeDo.ReplaceConnPool(db.Statement.ConnPool)
+	return a
+}
+
+func (a activityChange) replaceDB(db *gorm.DB) activityChange {
+	a.activityChangeDo.ReplaceDB(db)
+	return a
+}
+
+type activityChangeDo struct{ gen.DO }
+
+type IActivityChangeDo interface {
+	gen.SubQuery
+	Debug() IActivityChangeDo
+	WithContext(ctx context.Context) IActivityChangeDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IActivityChangeDo
+	WriteDB() IActivityChangeDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IActivityChangeDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IActivityChangeDo
+	Not(conds ...gen.Condition) IActivityChangeDo
+	Or(conds ...gen.Condition) IActivityChangeDo
+	Select(conds ...field.Expr) IActivityChangeDo
+	Where(conds ...gen.Condition) IActivityChangeDo
+	Order(conds ...field.Expr) IActivityChangeDo
+	Distinct(cols ...field.Expr) IActivityChangeDo
+	Omit(cols ...field.Expr) IActivityChangeDo
+	Join(table schema.Tabler, on ...field.Expr) IActivityChangeDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IActivityChangeDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IActivityChangeDo
+	Group(cols ...field.Expr) IActivityChangeDo
+	Having(conds ...gen.Condition) IActivityChangeDo
+	Limit(limit int) IActivityChangeDo
+	Offset(offset int) IActivityChangeDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IActivityChangeDo
+	Unscoped() IActivityChangeDo
+	Create(values ...*models.ActivityChange) error
+	CreateInBatches(values []*models.ActivityChange, batchSize int) error
+	Save(values ...*models.ActivityChange) error
+	First() (*models.ActivityChange, error)
+	Take() (*models.ActivityChange, error)
+	Last() (*models.ActivityChange, error)
+	Find() ([]*models.ActivityChange, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.ActivityChange, err error)
+	FindInBatches(result *[]*models.ActivityChange, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.ActivityChange) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IActivityChangeDo
+	Assign(attrs ...field.AssignExpr) IActivityChangeDo
+	Joins(fields ...field.RelationField) IActivityChangeDo
+	Preload(fields ...field.RelationField) IActivityChangeDo
+	FirstOrInit() (*models.ActivityChange, error)
+	FirstOrCreate() (*models.ActivityChange, error)
+	FindByPage(offset int, limit int) (result []*models.ActivityChange, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IActivityChangeDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (a activityChangeDo) Debug() IActivityChangeDo {
+	return a.withDO(a.DO.Debug())
+}
+
+func (a activityChangeDo) WithContext(ctx context.Context) IActivityChangeDo {
+	return a.withDO(a.DO.WithContext(ctx))
+}
+
+func (a activityChangeDo) ReadDB() IActivityChangeDo {
+	return a.Clauses(dbresolver.Read)
+}
+
+func (a activityChangeDo) WriteDB() IActivityChangeDo {
+	return a.Clauses(dbresolver.Write)
+}
+
+func (a activityChangeDo) Session(config *gorm.Session) IActivityChangeDo {
+	return a.withDO(a.DO.Session(config))
+}
+
+func (a activityChangeDo) Clauses(conds ...clause.Expression) IActivityChangeDo {
+	return a.withDO(a.DO.Clauses(conds...))
+}
+
+func (a activityChangeDo) Returning(value interface{}, columns ...string) IActivityChangeDo {
+	return a.withDO(a.DO.Returning(value, columns...))
+}
+
+func (a activityChangeDo) Not(conds ...gen.Condition) IActivityChangeDo {
+	return a.withDO(a.DO.Not(conds...))
+}
+
+func (a activityChangeDo) Or(conds ...gen.Condition) IActivityChangeDo {
+	return a.withDO(a.DO.Or(conds...))
+}
+
+func (a activityChangeDo) Select(conds ...field.Expr) IActivityChangeDo {
+	return a.withDO(a.DO.Select(conds...))
+}
+
+func (a activityChangeDo) Where(conds ...gen.Condition) IActivityChangeDo {
+	return a.withDO(a.DO.Where(conds...))
+}
+
+func (a activityChangeDo) Order(conds ...field.Expr) IActivityChangeDo {
+	return a.withDO(a.DO.Order(conds...))
+}
+
+func (a activityChangeDo) Distinct(cols ...field.Expr) IActivityChangeDo {
+	return a.withDO(a.DO.Distinct(cols...))
+}
+
+func (a activityChangeDo) Omit(cols ...field.Expr) IActivityChangeDo {
+	return a.withDO(a.DO.Omit(cols...))
+}
+
+func (a activityChangeDo) Join(table schema.Tabler, on ...field.Expr) IActivityChangeDo {
+	return a.withDO(a.DO.Join(table, on...))
+}
+
+func (a activityChangeDo) LeftJoin(table schema.Tabler, on ...field.Expr) IActivityChangeDo {
+	return a.withDO(a.DO.LeftJoin(table, on...))
+}
+
+func (a activityChangeDo) RightJoin(table schema.Tabler, on ...field.Expr) IActivityChangeDo {
+	return a.withDO(a.DO.RightJoin(table, on...))
+}
+
+func (a activityChangeDo) Group(cols ...field.Expr) IActivityChangeDo {
+	return a.withDO(a.DO.Group(cols...))
+}
+
+func (a activityChangeDo) Having(conds ...gen.Condition) IActivityChangeDo {
+	return a.withDO(a.DO.Having(conds...))
+}
+
+func (a activityChangeDo) Limit(limit int) IActivityChangeDo {
+	return a.withDO(a.DO.Limit(limit))
+}
+
+func (a activityChangeDo) Offset(offset int) IActivityChangeDo {
+	return a.withDO(a.DO.Offset(offset))
+}
+
+func (a activityChangeDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IActivityChangeDo {
+	return a.withDO(a.DO.Scopes(funcs...))
+}
+
+func (a activityChangeDo) Unscoped() IActivityChangeDo {
+	return a.withDO(a.DO.Unscoped())
+}
+
+func (a activityChangeDo) Create(values ...*models.ActivityChange) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Create(values)
+}
+
+func (a activityChangeDo) CreateInBatches(values []*models.ActivityChange, batchSize int) error {
+	return a.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (a activityChangeDo) Save(values ...*models.ActivityChange) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Save(values)
+}
+
+func (a activityChangeDo) First() (*models.ActivityChange, error) {
+	if result, err := a.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ActivityChange), nil
+	}
+}
+
+func (a activityChangeDo) Take() (*models.ActivityChange, error) {
+	if result, err := a.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ActivityChange), nil
+	}
+}
+
+func (a activityChangeDo) Last() (*models.ActivityChange, error) {
+	if result, err := a.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ActivityChange), nil
+	}
+}
+
+func (a activityChangeDo) Find() ([]*models.ActivityChange, error) {
+	result, err := a.DO.Find()
+	return result.([]*models.ActivityChange), err
+}
+
+func (a activityChangeDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.ActivityChange, err error) {
+	buf := make([]*models.ActivityChange, 0, batchSize)
+	err = a.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (a activityChangeDo) FindInBatches(result *[]*models.ActivityChange, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return a.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (a activityChangeDo) Attrs(attrs ...field.AssignExpr) IActivityChangeDo {
+	return a.withDO(a.DO.Attrs(attrs...))
+}
+
+func (a activityChangeDo) Assign(attrs ...field.AssignExpr) IActivityChangeDo {
+	return a.withDO(a.DO.Assign(attrs...))
+}
+
+func (a activityChangeDo) Joins(fields ...field.RelationField) IActivityChangeDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Joins(_f))
+	}
+	return &a
+}
+
+func (a activityChangeDo) Preload(fields ...field.RelationField) IActivityChangeDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Preload(_f))
+	}
+	return &a
+}
+
+func (a activityChangeDo) FirstOrInit() (*models.ActivityChange, error) {
+	if result, err := a.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ActivityChange), nil
+	}
+}
+
+func (a activityChangeDo) FirstOrCreate() (*models.ActivityChange, error) {
+	if result, err := a.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ActivityChange), nil
+	}
+}
+
+func (a activityChangeDo) FindByPage(offset int, limit int) (result []*models.ActivityChange, count int64, err error) {
+	result, err = a.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = a.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (a activityChangeDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = a.Count()
+	if err != nil {
+		return
+	}
+
+	err = a.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (a activityChangeDo) Scan(result interface{}) (err error) {
+	return a.DO.Scan(result)
+}
+
+func (a activityChangeDo) Delete(models ...*models.ActivityChange) (result gen.ResultInfo, err error) {
+	return a.DO.Delete(models)
+}
+
+func (a *activityChangeDo) withDO(do gen.Dao) *activityChangeDo {
+	a.DO = *do.(*gen.DO)
+	return a
+}
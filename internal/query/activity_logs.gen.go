@@ -0,0 +1,897 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newActivityLog(db *gorm.DB, opts ...gen.DOOption) activityLog {
+	_activityLog := activityLog{}
+
+	_activityLog.activityLogDo.UseDB(db, opts...)
+	_activityLog.activityLogDo.UseModel(&models.ActivityLog{})
+
+	tableName := _activityLog.activityLogDo.TableName()
+	_activityLog.ALL = field.NewAsterisk(tableName)
+	_activityLog.ID = field.NewInt(tableName, "id")
+	_activityLog.CreatedAt = field.NewTime(tableName, "created_at")
+	_activityLog.ActorID = field.NewInt(tableName, "actor_id")
+	_activityLog.ProjectID = field.NewInt(tableName, "project_id")
+	_activityLog.EntityType = field.NewString(tableName, "entity_type")
+	_activityLog.EntityID = field.NewInt(tableName, "entity_id")
+	_activityLog.Action = field.NewString(tableName, "action")
+	_activityLog.Changes = activityLogHasManyChanges{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Changes", "models.ActivityChange"),
+	}
+
+	_activityLog.Actor = activityLogBelongsToActor{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Actor", "models.User"),
+		CurrentProject: struct {
+			field.RelationField
+			Manager struct {
+				field.RelationField
+			}
+			Tasks struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			Sprints struct {
+				field.RelationField
+			}
+			TeamMembers struct {
+				field.RelationField
+			}
+			Members struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Actor.CurrentProject", "models.Project"),
+			Manager: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Actor.CurrentProject.Manager", "models.User"),
+			},
+			Tasks: struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Actor.CurrentProject.Tasks", "models.Task"),
+				Assignee: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Actor.CurrentProject.Tasks.Assignee", "models.User"),
+				},
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Actor.CurrentProject.Tasks.Project", "models.Project"),
+				},
+				Sprint: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Actor.CurrentProject.Tasks.Sprint", "models.Sprint"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Actor.CurrentProject.Tasks.Sprint.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Actor.CurrentProject.Tasks.Sprint.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Actor.CurrentProject.Tasks.Sprint.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Actor.CurrentProject.Tasks.Sprint.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Actor.CurrentProject.Tasks.Sprint.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Actor.CurrentProject.Tasks.Sprint.Tasks", "models.Task"),
+					},
+				},
+				Subtasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Actor.CurrentProject.Tasks.Subtasks", "models.Task"),
+				},
+				TaskLabels: struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Actor.CurrentProject.Tasks.TaskLabels", "models.TaskLabel"),
+					Label: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Actor.CurrentProject.Tasks.TaskLabels.Label", "models.Label"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Actor.CurrentProject.Tasks.TaskLabels.Label.Project", "models.Project"),
+						},
+					},
+				},
+			},
+			Sprints: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Actor.CurrentProject.Sprints", "models.Sprint"),
+			},
+			TeamMembers: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Actor.CurrentProject.TeamMembers", "models.User"),
+			},
+			Members: struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Actor.CurrentProject.Members", "models.ProjectMember"),
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Actor.CurrentProject.Members.Project", "models.Project"),
+				},
+				User: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Actor.CurrentProject.Members.User", "models.User"),
+				},
+			},
+		},
+		ManagedProjects: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Actor.ManagedProjects", "models.Project"),
+		},
+		AssignedTasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Actor.AssignedTasks", "models.Task"),
+		},
+	}
+
+	_activityLog.fillFieldMap()
+
+	return _activityLog
+}
+
+type activityLog struct {
+	activityLogDo activityLogDo
+
+	ALL        field.Asterisk
+	ID         field.Int
+	CreatedAt  field.Time
+	ActorID    field.Int
+	ProjectID  field.Int
+	EntityType field.String
+	EntityID   field.Int
+	Action     field.String
+	Changes    activityLogHasManyChanges
+
+	Actor activityLogBelongsToActor
+
+	fieldMap map[string]field.Expr
+}
+
+func (a activityLog) Table(newTableName string) *activityLog {
+	a.activityLogDo.UseTable(newTableName)
+	return a.updateTableName(newTableName)
+}
+
+func (a activityLog) As(alias string) *activityLog {
+	a.activityLogDo.DO = *(a.activityLogDo.As(alias).(*gen.DO))
+	return a.updateTableName(alias)
+}
+
+func (a *activityLog) updateTableName(table string) *activityLog {
+	a.ALL = field.NewAsterisk(table)
+	a.ID = field.NewInt(table, "id")
+	a.CreatedAt = field.NewTime(table, "created_at")
+	a.ActorID = field.NewInt(table, "actor_id")
+	a.ProjectID = field.NewInt(table, "project_id")
+	a.EntityType = field.NewString(table, "entity_type")
+	a.EntityID = field.NewInt(table, "entity_id")
+	a.Action = field.NewString(table, "action")
+
+	a.fillFieldMap()
+
+	return a
+}
+
+func (a *activityLog) WithContext(ctx context.Context) IActivityLogDo {
+	return a.activityLogDo.WithContext(ctx)
+}
+
+func (a activityLog) TableName() string { return a.activityLogDo.TableName() }
+
+func (a activityLog) Alias() string { return a.activityLogDo.Alias() }
+
+func (a activityLog) Columns(cols ...field.Expr) gen.Columns { return a.activityLogDo.Columns(cols...) }
+
+func (a *activityLog) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := a.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (a *activityLog) fillFieldMap() {
+	a.fieldMap = make(map[string]field.Expr, 9)
+	a.fieldMap["id"] = a.ID
+	a.fieldMap["created_at"] = a.CreatedAt
+	a.fieldMap["actor_id"] = a.ActorID
+	a.fieldMap["project_id"] = a.ProjectID
+	a.fieldMap["entity_type"] = a.EntityType
+	a.fieldMap["entity_id"] = a.EntityID
+	a.fieldMap["action"] = a.Action
+
+}
+
+func (a activityLog) clone(db *gorm.DB) activityLog {
+	a.activityLogDo.ReplaceConnPool(db.Statement.ConnPool)
+	return a
+}
+
+func (a activityLog) replaceDB(db *gorm.DB) activityLog {
+	a.activityLogDo.ReplaceDB(db)
+	return a
+}
+
+type activityLogHasManyChanges struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a activityLogHasManyChanges) Where(conds ...field.Expr) *activityLogHasManyChanges {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a activityLogHasManyChanges) WithContext(ctx context.Context) *activityLogHasManyChanges {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a activityLogHasManyChanges) Session(session *gorm.Session) *activityLogHasManyChanges {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a activityLogHasManyChanges) Model(m *models.ActivityLog) *activityLogHasManyChangesTx {
+	return &activityLogHasManyChangesTx{a.db.Model(m).Association(a.Name())}
+}
+
+type activityLogHasManyChangesTx struct{ tx *gorm.Association }
+
+func (a activityLogHasManyChangesTx) Find() (result []*models.ActivityChange, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a activityLogHasManyChangesTx) Append(values ...*models.ActivityChange) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a activityLogHasManyChangesTx) Replace(values ...*models.ActivityChange) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a activityLogHasManyChangesTx) Delete(values ...*models.ActivityChange) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a activityLogHasManyChangesTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a activityLogHasManyChangesTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type activityLogBelongsToActor struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	CurrentProject struct {
+		field.RelationField
+		Manager struct {
+			field.RelationField
+		}
+		Tasks struct {
+			field.RelationField
+			Assignee struct {
+				field.RelationField
+			}
+			Project struct {
+				field.RelationField
+			}
+			Sprint struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Tasks struct {
+					field.RelationField
+				}
+			}
+			Subtasks struct {
+				field.RelationField
+			}
+			TaskLabels struct {
+				field.RelationField
+				Label struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+		Sprints struct {
+			field.RelationField
+		}
+		TeamMembers struct {
+			field.RelationField
+		}
+		Members struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+		}
+	}
+	ManagedProjects struct {
+		field.RelationField
+	}
+	AssignedTasks struct {
+		field.RelationField
+	}
+}
+
+func (a activityLogBelongsToActor) Where(conds ...field.Expr) *activityLogBelongsToActor {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a activityLogBelongsToActor) WithContext(ctx context.Context) *activityLogBelongsToActor {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a activityLogBelongsToActor) Session(session *gorm.Session) *activityLogBelongsToActor {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a activityLogBelongsToActor) Model(m *models.ActivityLog) *activityLogBelongsToActorTx {
+	return &activityLogBelongsToActorTx{a.db.Model(m).Association(a.Name())}
+}
+
+type activityLogBelongsToActorTx struct{ tx *gorm.Association }
+
+func (a activityLogBelongsToActorTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a activityLogBelongsToActorTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a activityLogBelongsToActorTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a activityLogBelongsToActorTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a activityLogBelongsToActorTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a activityLogBelongsToActorTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type activityLogDo struct{ gen.DO }
+
+type IActivityLogDo interface {
+	gen.SubQuery
+	Debug() IActivityLogDo
+	WithContext(ctx context.Context) IActivityLogDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IActivityLogDo
+	WriteDB() IActivityLogDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IActivityLogDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IActivityLogDo
+	Not(conds ...gen.Condition) IActivityLogDo
+	Or(conds ...gen.Condition) IActivityLogDo
+	Select(conds ...field.Expr) IActivityLogDo
+	Where(conds ...gen.Condition) IActivityLogDo
+	Order(conds ...field.Expr) IActivityLogDo
+	Distinct(cols ...field.Expr) IActivityLogDo
+	Omit(cols ...field.Expr) IActivityLogDo
+	Join(table schema.Tabler, on ...field.Expr) IActivityLogDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IActivityLogDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IActivityLogDo
+	Group(cols ...field.Expr) IActivityLogDo
+	Having(conds ...gen.Condition) IActivityLogDo
+	Limit(limit int) IActivityLogDo
+	Offset(offset int) IActivityLogDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IActivityLogDo
+	Unscoped() IActivityLogDo
+	Create(values ...*models.ActivityLog) error
+	CreateInBatches(values []*models.ActivityLog, batchSize int) error
+	Save(values ...*models.ActivityLog) error
+	First() (*models.ActivityLog, error)
+	Take() (*models.ActivityLog, error)
+	Last() (*models.ActivityLog, error)
+	Find() ([]*models.ActivityLog, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.ActivityLog, err error)
+	FindInBatches(result *[]*models.ActivityLog, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.ActivityLog) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IActivityLogDo
+	Assign(attrs ...field.AssignExpr) IActivityLogDo
+	Joins(fields ...field.RelationField) IActivityLogDo
+	Preload(fields ...field.RelationField) IActivityLogDo
+	FirstOrInit() (*models.ActivityLog, error)
+	FirstOrCreate() (*models.ActivityLog, error)
+	FindByPage(offset int, limit int) (result []*models.ActivityLog, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IActivityLogDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (a activityLogDo) Debug() IActivityLogDo {
+	return a.withDO(a.DO.Debug())
+}
+
+func (a activityLogDo) WithContext(ctx context.Context) IActivityLogDo {
+	return a.withDO(a.DO.WithContext(ctx))
+}
+
+func (a activityLogDo) ReadDB() IActivityLogDo {
+	return a.Clauses(dbresolver.Read)
+}
+
+func (a activityLogDo) WriteDB() IActivityLogDo {
+	return a.Clauses(dbresolver.Write)
+}
+
+func (a activityLogDo) Session(config *gorm.Session) IActivityLogDo {
+	return a.withDO(a.DO.Session(config))
+}
+
+func (a activityLogDo) Clauses(conds ...clause.Expression) IActivityLogDo {
+	return a.withDO(a.DO.Clauses(conds...))
+}
+
+func (a activityLogDo) Returning(value interface{}, columns ...string) IActivityLogDo {
+	return a.withDO(a.DO.Returning(value, columns...))
+}
+
+func (a activityLogDo) Not(conds ...gen.Condition) IActivityLogDo {
+	return a.withDO(a.DO.Not(conds...))
+}
+
+func (a activityLogDo) Or(conds ...gen.Condition) IActivityLogDo {
+	return a.withDO(a.DO.Or(conds...))
+}
+
+func (a activityLogDo) Select(conds ...field.Expr) IActivityLogDo {
+	return a.withDO(a.DO.Select(conds...))
+}
+
+func (a activityLogDo) Where(conds ...gen.Condition) IActivityLogDo {
+	return a.withDO(a.DO.Where(conds...))
+}
+
+func (a activityLogDo) Order(conds ...field.Expr) IActivityLogDo {
+	return a.withDO(a.DO.Order(conds...))
+}
+
+func (a activityLogDo) Distinct(cols ...field.Expr) IActivityLogDo {
+	return a.withDO(a.DO.Distinct(cols...))
+}
+
+func (a activityLogDo) Omit(cols ...field.Expr) IActivityLogDo {
+	return a.withDO(a.DO.Omit(cols...))
+}
+
+func (a activityLogDo) Join(table schema.Tabler, on ...field.Expr) IActivityLogDo {
+	return a.withDO(a.DO.Join(table, on...))
+}
+
+func (a activityLogDo) LeftJoin(table schema.Tabler, on ...field.Expr) IActivityLogDo {
+	return a.withDO(a.DO.LeftJoin(table, on...))
+}
+
+func (a activityLogDo) RightJoin(table schema.Tabler, on ...field.Expr) IActivityLogDo {
+	return a.withDO(a.DO.RightJoin(table, on...))
+}
+
+func (a activityLogDo) Group(cols ...field.Expr) IActivityLogDo {
+	return a.withDO(a.DO.Group(cols...))
+}
+
+func (a activityLogDo) Having(conds ...gen.Condition) IActivityLogDo {
+	return a.withDO(a.DO.Having(conds...))
+}
+
+func (a activityLogDo) Limit(limit int) IActivityLogDo {
+	return a.withDO(a.DO.Limit(limit))
+}
+
+func (a activityLogDo) Offset(offset int) IActivityLogDo {
+	return a.withDO(a.DO.Offset(offset))
+}
+
+func (a activityLogDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IActivityLogDo {
+	return a.withDO(a.DO.Scopes(funcs...))
+}
+
+func (a activityLogDo) Unscoped() IActivityLogDo {
+	return a.withDO(a.DO.Unscoped())
+}
+
+func (a activityLogDo) Create(values ...*models.ActivityLog) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Create(values)
+}
+
+func (a activityLogDo) CreateInBatches(values []*models.ActivityLog, batchSize int) error {
+	return a.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (a activityLogDo) Save(values ...*models.ActivityLog) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Save(values)
+}
+
+func (a activityLogDo) First() (*models.ActivityLog, error) {
+	if result, err := a.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ActivityLog), nil
+	}
+}
+
+func (a activityLogDo) Take() (*models.ActivityLog, error) {
+	if result, err := a.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ActivityLog), nil
+	}
+}
+
+func (a activityLogDo) Last() (*models.ActivityLog, error) {
+	if result, err := a.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ActivityLog), nil
+	}
+}
+
+func (a activityLogDo) Find() ([]*models.ActivityLog, error) {
+	result, err := a.DO.Find()
+	return result.([]*models.ActivityLog), err
+}
+
+func (a activityLogDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.ActivityLog, err error) {
+	buf := make([]*models.ActivityLog, 0, batchSize)
+	err = a.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (a activityLogDo) FindInBatches(result *[]*models.ActivityLog, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return a.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (a activityLogDo) Attrs(attrs ...field.AssignExpr) IActivityLogDo {
+	return a.withDO(a.DO.Attrs(attrs...))
+}
+
+func (a activityLogDo) Assign(attrs ...field.AssignExpr) IActivityLogDo {
+	return a.withDO(a.DO.Assign(attrs...))
+}
+
+func (a activityLogDo) Joins(fields ...field.RelationField) IActivityLogDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Joins(_f))
+	}
+	return &a
+}
+
+func (a activityLogDo) Preload(fields ...field.RelationField) IActivityLogDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Preload(_f))
+	}
+	return &a
+}
+
+func (a activityLogDo) FirstOrInit() (*models.ActivityLog, error) {
+	if result, err := a.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ActivityLog), nil
+	}
+}
+
+func (a activityLogDo) FirstOrCreate() (*models.ActivityLog, error) {
+	if result, err := a.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ActivityLog), nil
+	}
+}
+
+func (a activityLogDo) FindByPage(offset int, limit int) (result []*models.ActivityLog, count int64, err error) {
+	result, err = a.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = a.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (a activityLogDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = a.Count()
+	if err != nil {
+		return
+	}
+
+	err = a.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (a activityLogDo) Scan(result interface{}) (err error) {
+	return a.DO.Scan(result)
+}
+
+func (a activityLogDo) Delete(models ...*models.ActivityLog) (result gen.ResultInfo, err error) {
+	return a.DO.Delete(models)
+}
+
+func (a *activityLogDo) withDO(do gen.Dao) *activityLogDo {
+	a.DO = *do.(*gen.DO)
+	return a
+}
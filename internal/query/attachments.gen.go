@@ -0,0 +1,889 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newAttachment(db *gorm.DB, opts ...gen.DOOption) attachment {
+	_attachment := attachment{}
+
+	_attachment.attachmentDo.UseDB(db, opts...)
+	_attachment.attachmentDo.UseModel(&models.Attachment{})
+
+	tableName := _attachment.attachmentDo.TableName()
+	_attachment.ALL = field.NewAsterisk(tableName)
+	_attachment.ID = field.NewInt(tableName, "id")
+	_attachment.CreatedAt = field.NewTime(tableName, "created_at")
+	_attachment.TaskID = field.NewInt(tableName, "task_id")
+	_attachment.UploadedByID = field.NewInt(tableName, "uploaded_by_id")
+	_attachment.FileName = field.NewString(tableName, "file_name")
+	_attachment.ContentType = field.NewString(tableName, "content_type")
+	_attachment.Size = field.NewInt64(tableName, "size")
+	_attachment.StorageKey = field.NewString(tableName, "storage_key")
+	_attachment.Task = attachmentBelongsToTask{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Task", "models.Task"),
+		Assignee: struct {
+			field.RelationField
+			CurrentProject struct {
+				field.RelationField
+				Manager struct {
+					field.RelationField
+				}
+				Tasks struct {
+					field.RelationField
+				}
+				Sprints struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				TeamMembers struct {
+					field.RelationField
+				}
+				Members struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}
+			}
+			ManagedProjects struct {
+				field.RelationField
+			}
+			AssignedTasks struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Task.Assignee", "models.User"),
+			CurrentProject: struct {
+				field.RelationField
+				Manager struct {
+					field.RelationField
+				}
+				Tasks struct {
+					field.RelationField
+				}
+				Sprints struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				TeamMembers struct {
+					field.RelationField
+				}
+				Members struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Task.Assignee.CurrentProject", "models.Project"),
+				Manager: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Task.Assignee.CurrentProject.Manager", "models.User"),
+				},
+				Tasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Task.Assignee.CurrentProject.Tasks", "models.Task"),
+				},
+				Sprints: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Task.Assignee.CurrentProject.Sprints", "models.Sprint"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Task.Assignee.CurrentProject.Sprints.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Task.Assignee.CurrentProject.Sprints.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Task.Assignee.CurrentProject.Sprints.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Task.Assignee.CurrentProject.Sprints.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Task.Assignee.CurrentProject.Sprints.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Task.Assignee.CurrentProject.Sprints.Tasks", "models.Task"),
+					},
+				},
+				TeamMembers: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Task.Assignee.CurrentProject.TeamMembers", "models.User"),
+				},
+				Members: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Task.Assignee.CurrentProject.Members", "models.ProjectMember"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Task.Assignee.CurrentProject.Members.Project", "models.Project"),
+					},
+					User: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Task.Assignee.CurrentProject.Members.User", "models.User"),
+					},
+				},
+			},
+			ManagedProjects: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Task.Assignee.ManagedProjects", "models.Project"),
+			},
+			AssignedTasks: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Task.Assignee.AssignedTasks", "models.Task"),
+			},
+		},
+		Project: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Task.Project", "models.Project"),
+		},
+		Sprint: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Task.Sprint", "models.Sprint"),
+		},
+		Subtasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Task.Subtasks", "models.Task"),
+		},
+		TaskLabels: struct {
+			field.RelationField
+			Label struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Task.TaskLabels", "models.TaskLabel"),
+			Label: struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Task.TaskLabels.Label", "models.Label"),
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Task.TaskLabels.Label.Project", "models.Project"),
+				},
+			},
+		},
+	}
+
+	_attachment.UploadedBy = attachmentBelongsToUploadedBy{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("UploadedBy", "models.User"),
+	}
+
+	_attachment.fillFieldMap()
+
+	return _attachment
+}
+
+type attachment struct {
+	attachmentDo attachmentDo
+
+	ALL          field.Asterisk
+	ID           field.Int
+	CreatedAt    field.Time
+	TaskID       field.Int
+	UploadedByID field.Int
+	FileName     field.String
+	ContentType  field.String
+	Size         field.Int64
+	StorageKey   field.String
+	Task         attachmentBelongsToTask
+
+	UploadedBy attachmentBelongsToUploadedBy
+
+	fieldMap map[string]field.Expr
+}
+
+func (a attachment) Table(newTableName string) *attachment {
+	a.attachmentDo.UseTable(newTableName)
+	return a.updateTableName(newTableName)
+}
+
+func (a attachment) As(alias string) *attachment {
+	a.attachmentDo.DO = *(a.attachmentDo.As(alias).(*gen.DO))
+	return a.updateTableName(alias)
+}
+
+func (a *attachment) updateTableName(table string) *attachment {
+	a.ALL = field.NewAsterisk(table)
+	a.ID = field.NewInt(table, "id")
+	a.CreatedAt = field.NewTime(table, "created_at")
+	a.TaskID = field.NewInt(table, "task_id")
+	a.UploadedByID = field.NewInt(table, "uploaded_by_id")
+	a.FileName = field.NewString(table, "file_name")
+	a.ContentType = field.NewString(table, "content_type")
+	a.Size = field.NewInt64(table, "size")
+	a.StorageKey = field.NewString(table, "storage_key")
+
+	a.fillFieldMap()
+
+	return a
+}
+
+func (a *attachment) WithContext(ctx context.Context) IAttachmentDo {
+	return a.attachmentDo.WithContext(ctx)
+}
+
+func (a attachment) TableName() string { return a.attachmentDo.TableName() }
+
+func (a attachment) Alias() string { return a.attachmentDo.Alias() }
+
+func (a attachment) Columns(cols ...field.Expr) gen.Columns { return a.attachmentDo.Columns(cols...) }
+
+func (a *attachment) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := a.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (a *attachment) fillFieldMap() {
+	a.fieldMap = make(map[string]field.Expr, 10)
+	a.fieldMap["id"] = a.ID
+	a.fieldMap["created_at"] = a.CreatedAt
+	a.fieldMap["task_id"] = a.TaskID
+	a.fieldMap["uploaded_by_id"] = a.UploadedByID
+	a.fieldMap["file_name"] = a.FileName
+	a.fieldMap["content_type"] = a.ContentType
+	a.fieldMap["size"] = a.Size
+	a.fieldMap["storage_key"] = a.StorageKey
+
+}
+
+func (a attachment) clone(db *gorm.DB) attachment {
+	a.attachmentDo.ReplaceConnPool(db.Statement.ConnPool)
+	return a
+}
+
+func (a attachment) replaceDB(db *gorm.DB) attachment {
+	a.attachmentDo.ReplaceDB(db)
+	return a
+}
+
+type attachmentBelongsToTask struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Assignee struct {
+		field.RelationField
+		CurrentProject struct {
+			field.RelationField
+			Manager struct {
+				field.RelationField
+			}
+			Tasks struct {
+				field.RelationField
+			}
+			Sprints struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Tasks struct {
+					field.RelationField
+				}
+			}
+			TeamMembers struct {
+				field.RelationField
+			}
+			Members struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}
+		}
+		ManagedProjects struct {
+			field.RelationField
+		}
+		AssignedTasks struct {
+			field.RelationField
+		}
+	}
+	Project struct {
+		field.RelationField
+	}
+	Sprint struct {
+		field.RelationField
+	}
+	Subtasks struct {
+		field.RelationField
+	}
+	TaskLabels struct {
+		field.RelationField
+		Label struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+		}
+	}
+}
+
+func (a attachmentBelongsToTask) Where(conds ...field.Expr) *attachmentBelongsToTask {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a attachmentBelongsToTask) WithContext(ctx context.Context) *attachmentBelongsToTask {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a attachmentBelongsToTask) Session(session *gorm.Session) *attachmentBelongsToTask {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a attachmentBelongsToTask) Model(m *models.Attachment) *attachmentBelongsToTaskTx {
+	return &attachmentBelongsToTaskTx{a.db.Model(m).Association(a.Name())}
+}
+
+type attachmentBelongsToTaskTx struct{ tx *gorm.Association }
+
+func (a attachmentBelongsToTaskTx) Find() (result *models.Task, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a attachmentBelongsToTaskTx) Append(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a attachmentBelongsToTaskTx) Replace(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a attachmentBelongsToTaskTx) Delete(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a attachmentBelongsToTaskTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a attachmentBelongsToTaskTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type attachmentBelongsToUploadedBy struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a attachmentBelongsToUploadedBy) Where(conds ...field.Expr) *attachmentBelongsToUploadedBy {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a attachmentBelongsToUploadedBy) WithContext(ctx context.Context) *attachmentBelongsToUploadedBy {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a attachmentBelongsToUploadedBy) Session(session *gorm.Session) *attachmentBelongsToUploadedBy {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a attachmentBelongsToUploadedBy) Model(m *models.Attachment) *attachmentBelongsToUploadedByTx {
+	return &attachmentBelongsToUploadedByTx{a.db.Model(m).Association(a.Name())}
+}
+
+type attachmentBelongsToUploadedByTx struct{ tx *gorm.Association }
+
+func (a attachmentBelongsToUploadedByTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a attachmentBelongsToUploadedByTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a attachmentBelongsToUploadedByTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a attachmentBelongsToUploadedByTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a attachmentBelongsToUploadedByTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a attachmentBelongsToUploadedByTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type attachmentDo struct{ gen.DO }
+
+type IAttachmentDo interface {
+	gen.SubQuery
+	Debug() IAttachmentDo
+	WithContext(ctx context.Context) IAttachmentDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IAttachmentDo
+	WriteDB() IAttachmentDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IAttachmentDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IAttachmentDo
+	Not(conds ...gen.Condition) IAttachmentDo
+	Or(conds ...gen.Condition) IAttachmentDo
+	Select(conds ...field.Expr) IAttachmentDo
+	Where(conds ...gen.Condition) IAttachmentDo
+	Order(conds ...field.Expr) IAttachmentDo
+	Distinct(cols ...field.Expr) IAttachmentDo
+	Omit(cols ...field.Expr) IAttachmentDo
+	Join(table schema.Tabler, on ...field.Expr) IAttachmentDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IAttachmentDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IAttachmentDo
+	Group(cols ...field.Expr) IAttachmentDo
+	Having(conds ...gen.Condition) IAttachmentDo
+	Limit(limit int) IAttachmentDo
+	Offset(offset int) IAttachmentDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IAttachmentDo
+	Unscoped() IAttachmentDo
+	Create(values ...*models.Attachment) error
+	CreateInBatches(values []*models.Attachment, batchSize int) error
+	Save(values ...*models.Attachment) error
+	First() (*models.Attachment, error)
+	Take() (*models.Attachment, error)
+	Last() (*models.Attachment, error)
+	Find() ([]*models.Attachment, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.Attachment, err error)
+	FindInBatches(result *[]*models.Attachment, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.Attachment) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IAttachmentDo
+	Assign(attrs ...field.AssignExpr) IAttachmentDo
+	Joins(fields ...field.RelationField) IAttachmentDo
+	Preload(fields ...field.RelationField) IAttachmentDo
+	FirstOrInit() (*models.Attachment, error)
+	FirstOrCreate() (*models.Attachment, error)
+	FindByPage(offset int, limit int) (result []*models.Attachment, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IAttachmentDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (a attachmentDo) Debug() IAttachmentDo {
+	return a.withDO(a.DO.Debug())
+}
+
+func (a attachmentDo) WithContext(ctx context.Context) IAttachmentDo {
+	return a.withDO(a.DO.WithContext(ctx))
+}
+
+func (a attachmentDo) ReadDB() IAttachmentDo {
+	return a.Clauses(dbresolver.Read)
+}
+
+func (a attachmentDo) WriteDB() IAttachmentDo {
+	return a.Clauses(dbresolver.Write)
+}
+
+func (a attachmentDo) Session(config *gorm.Session) IAttachmentDo {
+	return a.withDO(a.DO.Session(config))
+}
+
+func (a attachmentDo) Clauses(conds ...clause.Expression) IAttachmentDo {
+	return a.withDO(a.DO.Clauses(conds...))
+}
+
+func (a attachmentDo) Returning(value interface{}, columns ...string) IAttachmentDo {
+	return a.withDO(a.DO.Returning(value, columns...))
+}
+
+func (a attachmentDo) Not(conds ...gen.Condition) IAttachmentDo {
+	return a.withDO(a.DO.Not(conds...))
+}
+
+func (a attachmentDo) Or(conds ...gen.Condition) IAttachmentDo {
+	return a.withDO(a.DO.Or(conds...))
+}
+
+func (a attachmentDo) Select(conds ...field.Expr) IAttachmentDo {
+	return a.withDO(a.DO.Select(conds...))
+}
+
+func (a attachmentDo) Where(conds ...gen.Condition) IAttachmentDo {
+	return a.withDO(a.DO.Where(conds...))
+}
+
+func (a attachmentDo) Order(conds ...field.Expr) IAttachmentDo {
+	return a.withDO(a.DO.Order(conds...))
+}
+
+func (a attachmentDo) Distinct(cols ...field.Expr) IAttachmentDo {
+	return a.withDO(a.DO.Distinct(cols...))
+}
+
+func (a attachmentDo) Omit(cols ...field.Expr) IAttachmentDo {
+	return a.withDO(a.DO.Omit(cols...))
+}
+
+func (a attachmentDo) Join(table schema.Tabler, on ...field.Expr) IAttachmentDo {
+	return a.withDO(a.DO.Join(table, on...))
+}
+
+func (a attachmentDo) LeftJoin(table schema.Tabler, on ...field.Expr) IAttachmentDo {
+	return a.withDO(a.DO.LeftJoin(table, on...))
+}
+
+func (a attachmentDo) RightJoin(table schema.Tabler, on ...field.Expr) IAttachmentDo {
+	return a.withDO(a.DO.RightJoin(table, on...))
+}
+
+func (a attachmentDo) Group(cols ...field.Expr) IAttachmentDo {
+	return a.withDO(a.DO.Group(cols...))
+}
+
+func (a attachmentDo) Having(conds ...gen.Condition) IAttachmentDo {
+	return a.withDO(a.DO.Having(conds...))
+}
+
+func (a attachmentDo) Limit(limit int) IAttachmentDo {
+	return a.withDO(a.DO.Limit(limit))
+}
+
+func (a attachmentDo) Offset(offset int) IAttachmentDo {
+	return a.withDO(a.DO.Offset(offset))
+}
+
+func (a attachmentDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IAttachmentDo {
+	return a.withDO(a.DO.Scopes(funcs...))
+}
+
+func (a attachmentDo) Unscoped() IAttachmentDo {
+	return a.withDO(a.DO.Unscoped())
+}
+
+func (a attachmentDo) Create(values ...*models.Attachment) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Create(values)
+}
+
+func (a attachmentDo) CreateInBatches(values []*models.Attachment, batchSize int) error {
+	return a.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (a attachmentDo) Save(values ...*models.Attachment) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Save(values)
+}
+
+func (a attachmentDo) First() (*models.Attachment, error) {
+	if result, err := a.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Attachment), nil
+	}
+}
+
+func (a attachmentDo) Take() (*models.Attachment, error) {
+	if result, err := a.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Attachment), nil
+	}
+}
+
+func (a attachmentDo) Last() (*models.Attachment, error) {
+	if result, err := a.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Attachment), nil
+	}
+}
+
+func (a attachmentDo) Find() ([]*models.Attachment, error) {
+	result, err := a.DO.Find()
+	return result.([]*models.Attachment), err
+}
+
+func (a attachmentDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.Attachment, err error) {
+	buf := make([]*models.Attachment, 0, batchSize)
+	err = a.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (a attachmentDo) FindInBatches(result *[]*models.Attachment, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return a.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (a attachmentDo) Attrs(attrs ...field.AssignExpr) IAttachmentDo {
+	return a.withDO(a.DO.Attrs(attrs...))
+}
+
+func (a attachmentDo) Assign(attrs ...field.AssignExpr) IAttachmentDo {
+	return a.withDO(a.DO.Assign(attrs...))
+}
+
+func (a attachmentDo) Joins(fields ...field.RelationField) IAttachmentDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Joins(_f))
+	}
+	return &a
+}
+
+func (a attachmentDo) Preload(fields ...field.RelationField) IAttachmentDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Preload(_f))
+	}
+	return &a
+}
+
+func (a attachmentDo) FirstOrInit() (*models.Attachment, error) {
+	if result, err := a.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Attachment), nil
+	}
+}
+
+func (a attachmentDo) FirstOrCreate() (*models.Attachment, error) {
+	if result, err := a.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Attachment), nil
+	}
+}
+
+func (a attachmentDo) FindByPage(offset int, limit int) (result []*models.Attachment, count int64, err error) {
+	result, err = a.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = a.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (a attachmentDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = a.Count()
+	if err != nil {
+		return
+	}
+
+	err = a.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (a attachmentDo) Scan(result interface{}) (err error) {
+	return a.DO.Scan(result)
+}
+
+func (a attachmentDo) Delete(models ...*models.Attachment) (result gen.ResultInfo, err error) {
+	return a.DO.Delete(models)
+}
+
+func (a *attachmentDo) withDO(do gen.Dao) *attachmentDo {
+	a.DO = *do.(*gen.DO)
+	return a
+}
@@ -0,0 +1,406 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newCommentEdit(db *gorm.DB, opts ...gen.DOOption) commentEdit {
+	_commentEdit := commentEdit{}
+
+	_commentEdit.commentEditDo.UseDB(db, opts...)
+	_commentEdit.commentEditDo.UseModel(&models.CommentEdit{})
+
+	tableName := _commentEdit.commentEditDo.TableName()
+	_commentEdit.ALL = field.NewAsterisk(tableName)
+	_commentEdit.ID = field.NewInt(tableName, "id")
+	_commentEdit.CreatedAt = field.NewTime(tableName, "created_at")
+	_commentEdit.CommentID = field.NewInt(tableName, "comment_id")
+	_commentEdit.EditorID = field.NewInt(tableName, "editor_id")
+	_commentEdit.PreviousBody = field.NewString(tableName, "previous_body")
+
+	_commentEdit.fillFieldMap()
+
+	return _commentEdit
+}
+
+type commentEdit struct {
+	commentEditDo commentEditDo
+
+	ALL          field.Asterisk
+	ID           field.Int
+	CreatedAt    field.Time
+	CommentID    field.Int
+	EditorID     field.Int
+	PreviousBody field.String
+
+	fieldMap map[string]field.Expr
+}
+
+func (c commentEdit) Table(newTableName string) *commentEdit {
+	c.commentEditDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c commentEdit) As(alias string) *commentEdit {
+	c.commentEditDo.DO = *(c.commentEditDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *commentEdit) updateTableName(table string) *commentEdit {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewInt(table, "id")
+	c.CreatedAt = field.NewTime(table, "created_at")
+	c.CommentID = field.NewInt(table, "comment_id")
+	c.EditorID = field.NewInt(table, "editor_id")
+	c.PreviousBody = field.NewString(table, "previous_body")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *commentEdit) WithContext(ctx context.Context) ICommentEditDo {
+	return c.commentEditDo.WithContext(ctx)
+}
+
+func (c commentEdit) TableName() string { return c.commentEditDo.TableName() }
+
+func (c commentEdit) Alias() string { return c.commentEditDo.Alias() }
+
+func (c commentEdit) Columns(cols ...field.Expr) gen.Columns { return c.commentEditDo.Columns(cols...) }
+
+func (c *commentEdit) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *commentEdit) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 5)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["created_at"] = c.CreatedAt
+	c.fieldMap["comment_id"] = c.CommentID
+	c.fieldMap["editor_id"] = c.EditorID
+	c.fieldMap["previous_body"] = c.PreviousBody
+}
+
+func (c commentEdit) clone(db *gorm.DB) commentEdit {
+	c.commentEditDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c commentEdit) replaceDB(db *gorm.DB) commentEdit {
+	c.commentEditDo.ReplaceDB(db)
+	return c
+}
+
+type commentEditDo struct{ gen.DO }
+
+type ICommentEditDo interface {
+	gen.SubQuery
+	Debug() ICommentEditDo
+	WithContext(ctx context.Context) ICommentEditDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ICommentEditDo
+	WriteDB() ICommentEditDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ICommentEditDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ICommentEditDo
+	Not(conds ...gen.Condition) ICommentEditDo
+	Or(conds ...gen.Condition) ICommentEditDo
+	Select(conds ...field.Expr) ICommentEditDo
+	Where(conds ...gen.Condition) ICommentEditDo
+	Order(conds ...field.Expr) ICommentEditDo
+	Distinct(cols ...field.Expr) ICommentEditDo
+	Omit(cols ...field.Expr) ICommentEditDo
+	Join(table schema.Tabler, on ...field.Expr) ICommentEditDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ICommentEditDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ICommentEditDo
+	Group(cols ...field.Expr) ICommentEditDo
+	Having(conds ...gen.Condition) ICommentEditDo
+	Limit(limit int) ICommentEditDo
+	Offset(offset int) ICommentEditDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ICommentEditDo
+	Unscoped() ICommentEditDo
+	Create(values ...*models.CommentEdit) error
+	CreateInBatches(values []*models.CommentEdit, batchSize int) error
+	Save(values ...*models.CommentEdit) error
+	First() (*models.CommentEdit, error)
+	Take() (*models.CommentEdit, error)
+	Last() (*models.CommentEdit, error)
+	Find() ([]*models.CommentEdit, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.CommentEdit, err error)
+	FindInBatches(result *[]*models.CommentEdit, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.CommentEdit) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ICommentEditDo
+	Assign(attrs ...field.AssignExpr) ICommentEditDo
+	Joins(fields ...field.RelationField) ICommentEditDo
+	Preload(fields ...field.RelationField) ICommentEditDo
+	FirstOrInit() (*models.CommentEdit, error)
+	FirstOrCreate() (*models.CommentEdit, error)
+	FindByPage(offset int, limit int) (result []*models.CommentEdit, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ICommentEditDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c commentEditDo) Debug() ICommentEditDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c commentEditDo) WithContext(ctx context.Context) ICommentEditDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c commentEditDo) ReadDB() ICommentEditDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c commentEditDo) WriteDB() ICommentEditDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c commentEditDo) Session(config *gorm.Session) ICommentEditDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c commentEditDo) Clauses(conds ...clause.Expression) ICommentEditDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c commentEditDo) Returning(value interface{}, columns ...string) ICommentEditDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c commentEditDo) Not(conds ...gen.Condition) ICommentEditDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c commentEditDo) Or(conds ...gen.Condition) ICommentEditDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c commentEditDo) Select(conds ...field.Expr) ICommentEditDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c commentEditDo) Where(conds ...gen.Condition) ICommentEditDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c commentEditDo) Order(conds ...field.Expr) ICommentEditDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c commentEditDo) Distinct(cols ...field.Expr) ICommentEditDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c commentEditDo) Omit(cols ...field.Expr) ICommentEditDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c commentEditDo) Join(table schema.Tabler, on ...field.Expr) ICommentEditDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c commentEditDo) LeftJoin(table schema.Tabler, on ...field.Expr) ICommentEditDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c commentEditDo) RightJoin(table schema.Tabler, on ...field.Expr) ICommentEditDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c commentEditDo) Group(cols ...field.Expr) ICommentEditDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c commentEditDo) Having(conds ...gen.Condition) ICommentEditDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c commentEditDo) Limit(limit int) ICommentEditDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c commentEditDo) Offset(offset int) ICommentEditDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c commentEditDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ICommentEditDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c commentEditDo) Unscoped() ICommentEditDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c commentEditDo) Create(values ...*models.CommentEdit) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c commentEditDo) CreateInBatches(values []*models.CommentEdit, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c commentEditDo) Save(values ...*models.CommentEdit) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c commentEditDo) First() (*models.CommentEdit, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommentEdit), nil
+	}
+}
+
+func (c commentEditDo) Take() (*models.CommentEdit, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommentEdit), nil
+	}
+}
+
+func (c commentEditDo) Last() (*models.CommentEdit, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommentEdit), nil
+	}
+}
+
+func (c commentEditDo) Find() ([]*models.CommentEdit, error) {
+	result, err := c.DO.Find()
+	return result.([]*models.CommentEdit), err
+}
+
+func (c commentEditDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.CommentEdit, err error) {
+	buf := make([]*models.CommentEdit, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c commentEditDo) FindInBatches(result *[]*models.CommentEdit, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c commentEditDo) Attrs(attrs ...field.AssignExpr) ICommentEditDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c commentEditDo) Assign(attrs ...field.AssignExpr) ICommentEditDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c commentEditDo) Joins(fields ...field.RelationField) ICommentEditDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c commentEditDo) Preload(fields ...field.RelationField) ICommentEditDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c commentEditDo) FirstOrInit() (*models.CommentEdit, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommentEdit), nil
+	}
+}
+
+func (c commentEditDo) FirstOrCreate() (*models.CommentEdit, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommentEdit), nil
+	}
+}
+
+func (c commentEditDo) FindByPage(offset int, limit int) (result []*models.CommentEdit, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c commentEditDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c commentEditDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c commentEditDo) Delete(models ...*models.CommentEdit) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *commentEditDo) withDO(do gen.Dao) *commentEditDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
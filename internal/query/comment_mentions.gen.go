@@ -0,0 +1,808 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newCommentMention(db *gorm.DB, opts ...gen.DOOption) commentMention {
+	_commentMention := commentMention{}
+
+	_commentMention.commentMentionDo.UseDB(db, opts...)
+	_commentMention.commentMentionDo.UseModel(&models.CommentMention{})
+
+	tableName := _commentMention.commentMentionDo.TableName()
+	_commentMention.ALL = field.NewAsterisk(tableName)
+	_commentMention.ID = field.NewInt(tableName, "id")
+	_commentMention.CreatedAt = field.NewTime(tableName, "created_at")
+	_commentMention.CommentID = field.NewInt(tableName, "comment_id")
+	_commentMention.UserID = field.NewInt(tableName, "user_id")
+	_commentMention.User = commentMentionBelongsToUser{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("User", "models.User"),
+		CurrentProject: struct {
+			field.RelationField
+			Manager struct {
+				field.RelationField
+			}
+			Tasks struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			Sprints struct {
+				field.RelationField
+			}
+			TeamMembers struct {
+				field.RelationField
+			}
+			Members struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("User.CurrentProject", "models.Project"),
+			Manager: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.Manager", "models.User"),
+			},
+			Tasks: struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.Tasks", "models.Task"),
+				Assignee: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.Assignee", "models.User"),
+				},
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.Project", "models.Project"),
+				},
+				Sprint: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint", "models.Sprint"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Tasks", "models.Task"),
+					},
+				},
+				Subtasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.Subtasks", "models.Task"),
+				},
+				TaskLabels: struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.TaskLabels", "models.TaskLabel"),
+					Label: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("User.CurrentProject.Tasks.TaskLabels.Label", "models.Label"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("User.CurrentProject.Tasks.TaskLabels.Label.Project", "models.Project"),
+						},
+					},
+				},
+			},
+			Sprints: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.Sprints", "models.Sprint"),
+			},
+			TeamMembers: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.TeamMembers", "models.User"),
+			},
+			Members: struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.Members", "models.ProjectMember"),
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Members.Project", "models.Project"),
+				},
+				User: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Members.User", "models.User"),
+				},
+			},
+		},
+		ManagedProjects: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("User.ManagedProjects", "models.Project"),
+		},
+		AssignedTasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("User.AssignedTasks", "models.Task"),
+		},
+	}
+
+	_commentMention.fillFieldMap()
+
+	return _commentMention
+}
+
+type commentMention struct {
+	commentMentionDo commentMentionDo
+
+	ALL       field.Asterisk
+	ID        field.Int
+	CreatedAt field.Time
+	CommentID field.Int
+	UserID    field.Int
+	User      commentMentionBelongsToUser
+
+	fieldMap map[string]field.Expr
+}
+
+func (c commentMention) Table(newTableName string) *commentMention {
+	c.commentMentionDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c commentMention) As(alias string) *commentMention {
+	c.commentMentionDo.DO = *(c.commentMentionDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *commentMention) updateTableName(table string) *commentMention {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewInt(table, "id")
+	c.CreatedAt = field.NewTime(table, "created_at")
+	c.CommentID = field.NewInt(table, "comment_id")
+	c.UserID = field.NewInt(table, "user_id")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *commentMention) WithContext(ctx context.Context) ICommentMentionDo {
+	return c.commentMentionDo.WithContext(ctx)
+}
+
+func (c commentMention) TableName() string { return c.commentMentionDo.TableName() }
+
+func (c commentMention) Alias() string { return c.commentMentionDo.Alias() }
+
+func (c commentMention) Columns(cols ...field.Expr) gen.Columns {
+	return c.commentMentionDo.Columns(cols...)
+}
+
+func (c *commentMention) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *commentMention) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 5)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["created_at"] = c.CreatedAt
+	c.fieldMap["comment_id"] = c.CommentID
+	c.fieldMap["user_id"] = c.UserID
+
+}
+
+func (c commentMention) clone(db *gorm.DB) commentMention {
+	c.commentMentionDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c commentMention) replaceDB(db *gorm.DB) commentMention {
+	c.commentMentionDo.ReplaceDB(db)
+	return c
+}
+
+type commentMentionBelongsToUser struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	CurrentProject struct {
+		field.RelationField
+		Manager struct {
+			field.RelationField
+		}
+		Tasks struct {
+			field.RelationField
+			Assignee struct {
+				field.RelationField
+			}
+			Project struct {
+				field.RelationField
+			}
+			Sprint struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Tasks struct {
+					field.RelationField
+				}
+			}
+			Subtasks struct {
+				field.RelationField
+			}
+			TaskLabels struct {
+				field.RelationField
+				Label struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+		Sprints struct {
+			field.RelationField
+		}
+		TeamMembers struct {
+			field.RelationField
+		}
+		Members struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+		}
+	}
+	ManagedProjects struct {
+		field.RelationField
+	}
+	AssignedTasks struct {
+		field.RelationField
+	}
+}
+
+func (a commentMentionBelongsToUser) Where(conds ...field.Expr) *commentMentionBelongsToUser {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a commentMentionBelongsToUser) WithContext(ctx context.Context) *commentMentionBelongsToUser {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a commentMentionBelongsToUser) Session(session *gorm.Session) *commentMentionBelongsToUser {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a commentMentionBelongsToUser) Model(m *models.CommentMention) *commentMentionBelongsToUserTx {
+	return &commentMentionBelongsToUserTx{a.db.Model(m).Association(a.Name())}
+}
+
+type commentMentionBelongsToUserTx struct{ tx *gorm.Association }
+
+func (a commentMentionBelongsToUserTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a commentMentionBelongsToUserTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a commentMentionBelongsToUserTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a commentMentionBelongsToUserTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a commentMentionBelongsToUserTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a commentMentionBelongsToUserTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type commentMentionDo struct{ gen.DO }
+
+type ICommentMentionDo interface {
+	gen.SubQuery
+	Debug() ICommentMentionDo
+	WithContext(ctx context.Context) ICommentMentionDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ICommentMentionDo
+	WriteDB() ICommentMentionDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ICommentMentionDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ICommentMentionDo
+	Not(conds ...gen.Condition) ICommentMentionDo
+	Or(conds ...gen.Condition) ICommentMentionDo
+	Select(conds ...field.Expr) ICommentMentionDo
+	Where(conds ...gen.Condition) ICommentMentionDo
+	Order(conds ...field.Expr) ICommentMentionDo
+	Distinct(cols ...field.Expr) ICommentMentionDo
+	Omit(cols ...field.Expr) ICommentMentionDo
+	Join(table schema.Tabler, on ...field.Expr) ICommentMentionDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ICommentMentionDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ICommentMentionDo
+	Group(cols ...field.Expr) ICommentMentionDo
+	Having(conds ...gen.Condition) ICommentMentionDo
+	Limit(limit int) ICommentMentionDo
+	Offset(offset int) ICommentMentionDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ICommentMentionDo
+	Unscoped() ICommentMentionDo
+	Create(values ...*models.CommentMention) error
+	CreateInBatches(values []*models.CommentMention, batchSize int) error
+	Save(values ...*models.CommentMention) error
+	First() (*models.CommentMention, error)
+	Take() (*models.CommentMention, error)
+	Last() (*models.CommentMention, error)
+	Find() ([]*models.CommentMention, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.CommentMention, err error)
+	FindInBatches(result *[]*models.CommentMention, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.CommentMention) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ICommentMentionDo
+	Assign(attrs ...field.AssignExpr) ICommentMentionDo
+	Joins(fields ...field.RelationField) ICommentMentionDo
+	Preload(fields ...field.RelationField) ICommentMentionDo
+	FirstOrInit() (*models.CommentMention, error)
+	FirstOrCreate() (*models.CommentMention, error)
+	FindByPage(offset int, limit int) (result []*models.CommentMention, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ICommentMentionDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c commentMentionDo) Debug() ICommentMentionDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c commentMentionDo) WithContext(ctx context.Context) ICommentMentionDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c commentMentionDo) ReadDB() ICommentMentionDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c commentMentionDo) WriteDB() ICommentMentionDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c commentMentionDo) Session(config *gorm.Session) ICommentMentionDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c commentMentionDo) Clauses(conds ...clause.Expression) ICommentMentionDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c commentMentionDo) Returning(value interface{}, columns ...string) ICommentMentionDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c commentMentionDo) Not(conds ...gen.Condition) ICommentMentionDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c commentMentionDo) Or(conds ...gen.Condition) ICommentMentionDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c commentMentionDo) Select(conds ...field.Expr) ICommentMentionDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c commentMentionDo) Where(conds ...gen.Condition) ICommentMentionDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c commentMentionDo) Order(conds ...field.Expr) ICommentMentionDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c commentMentionDo) Distinct(cols ...field.Expr) ICommentMentionDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c commentMentionDo) Omit(cols ...field.Expr) ICommentMentionDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c commentMentionDo) Join(table schema.Tabler, on ...field.Expr) ICommentMentionDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c commentMentionDo) LeftJoin(table schema.Tabler, on ...field.Expr) ICommentMentionDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c commentMentionDo) RightJoin(table schema.Tabler, on ...field.Expr) ICommentMentionDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c commentMentionDo) Group(cols ...field.Expr) ICommentMentionDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c commentMentionDo) Having(conds ...gen.Condition) ICommentMentionDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c commentMentionDo) Limit(limit int) ICommentMentionDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c commentMentionDo) Offset(offset int) ICommentMentionDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c commentMentionDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ICommentMentionDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c commentMentionDo) Unscoped() ICommentMentionDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c commentMentionDo) Create(values ...*models.CommentMention) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c commentMentionDo) CreateInBatches(values []*models.CommentMention, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c commentMentionDo) Save(values ...*models.CommentMention) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c commentMentionDo) First() (*models.CommentMention, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommentMention), nil
+	}
+}
+
+func (c commentMentionDo) Take() (*models.CommentMention, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommentMention), nil
+	}
+}
+
+func (c commentMentionDo) Last() (*models.CommentMention, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommentMention), nil
+	}
+}
+
+func (c commentMentionDo) Find() ([]*models.CommentMention, error) {
+	result, err := c.DO.Find()
+	return result.([]*models.CommentMention), err
+}
+
+func (c commentMentionDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.CommentMention, err error) {
+	buf := make([]*models.CommentMention, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c commentMentionDo) FindInBatches(result *[]*models.CommentMention, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c commentMentionDo) Attrs(attrs ...field.AssignExpr) ICommentMentionDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c commentMentionDo) Assign(attrs ...field.AssignExpr) ICommentMentionDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c commentMentionDo) Joins(fields ...field.RelationField) ICommentMentionDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c commentMentionDo) Preload(fields ...field.RelationField) ICommentMentionDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c commentMentionDo) FirstOrInit() (*models.CommentMention, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommentMention), nil
+	}
+}
+
+func (c commentMentionDo) FirstOrCreate() (*models.CommentMention, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.CommentMention), nil
+	}
+}
+
+func (c commentMentionDo) FindByPage(offset int, limit int) (result []*models.CommentMention, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c commentMentionDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c commentMentionDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c commentMentionDo) Delete(models ...*models.CommentMention) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *commentMentionDo) withDO(do gen.Dao) *commentMentionDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
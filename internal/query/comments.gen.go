@@ -0,0 +1,1248 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newComment(db *gorm.DB, opts ...gen.DOOption) comment {
+	_comment := comment{}
+
+	_comment.commentDo.UseDB(db, opts...)
+	_comment.commentDo.UseModel(&models.Comment{})
+
+	tableName := _comment.commentDo.TableName()
+	_comment.ALL = field.NewAsterisk(tableName)
+	_comment.ID = field.NewInt(tableName, "id")
+	_comment.CreatedAt = field.NewTime(tableName, "created_at")
+	_comment.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_comment.DeletedAt = field.NewField(tableName, "deleted_at")
+	_comment.TaskID = field.NewInt(tableName, "task_id")
+	_comment.AuthorID = field.NewInt(tableName, "author_id")
+	_comment.ParentCommentID = field.NewInt(tableName, "parent_comment_id")
+	_comment.Body = field.NewString(tableName, "body")
+	_comment.EditedAt = field.NewTime(tableName, "edited_at")
+	_comment.Replies = commentHasManyReplies{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Replies", "models.Comment"),
+		Task: struct {
+			field.RelationField
+			Assignee struct {
+				field.RelationField
+				CurrentProject struct {
+					field.RelationField
+					Manager struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+					Sprints struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					TeamMembers struct {
+						field.RelationField
+					}
+					Members struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						User struct {
+							field.RelationField
+						}
+					}
+				}
+				ManagedProjects struct {
+					field.RelationField
+				}
+				AssignedTasks struct {
+					field.RelationField
+				}
+			}
+			Project struct {
+				field.RelationField
+			}
+			Sprint struct {
+				field.RelationField
+			}
+			Subtasks struct {
+				field.RelationField
+			}
+			TaskLabels struct {
+				field.RelationField
+				Label struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Replies.Task", "models.Task"),
+			Assignee: struct {
+				field.RelationField
+				CurrentProject struct {
+					field.RelationField
+					Manager struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+					Sprints struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					TeamMembers struct {
+						field.RelationField
+					}
+					Members struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						User struct {
+							field.RelationField
+						}
+					}
+				}
+				ManagedProjects struct {
+					field.RelationField
+				}
+				AssignedTasks struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Replies.Task.Assignee", "models.User"),
+				CurrentProject: struct {
+					field.RelationField
+					Manager struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+					Sprints struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					TeamMembers struct {
+						field.RelationField
+					}
+					Members struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						User struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Replies.Task.Assignee.CurrentProject", "models.Project"),
+					Manager: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Replies.Task.Assignee.CurrentProject.Manager", "models.User"),
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Replies.Task.Assignee.CurrentProject.Tasks", "models.Task"),
+					},
+					Sprints: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Replies.Task.Assignee.CurrentProject.Sprints", "models.Sprint"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Replies.Task.Assignee.CurrentProject.Sprints.Project", "models.Project"),
+						},
+						Report: struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Replies.Task.Assignee.CurrentProject.Sprints.Report", "models.SprintReport"),
+							CompletedBy: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Replies.Task.Assignee.CurrentProject.Sprints.Report.CompletedBy", "models.User"),
+							},
+							NextSprint: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Replies.Task.Assignee.CurrentProject.Sprints.Report.NextSprint", "models.Sprint"),
+							},
+							Tasks: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Replies.Task.Assignee.CurrentProject.Sprints.Report.Tasks", "models.SprintReportTask"),
+							},
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Replies.Task.Assignee.CurrentProject.Sprints.Tasks", "models.Task"),
+						},
+					},
+					TeamMembers: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Replies.Task.Assignee.CurrentProject.TeamMembers", "models.User"),
+					},
+					Members: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						User struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Replies.Task.Assignee.CurrentProject.Members", "models.ProjectMember"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Replies.Task.Assignee.CurrentProject.Members.Project", "models.Project"),
+						},
+						User: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Replies.Task.Assignee.CurrentProject.Members.User", "models.User"),
+						},
+					},
+				},
+				ManagedProjects: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Replies.Task.Assignee.ManagedProjects", "models.Project"),
+				},
+				AssignedTasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Replies.Task.Assignee.AssignedTasks", "models.Task"),
+				},
+			},
+			Project: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Replies.Task.Project", "models.Project"),
+			},
+			Sprint: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Replies.Task.Sprint", "models.Sprint"),
+			},
+			Subtasks: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Replies.Task.Subtasks", "models.Task"),
+			},
+			TaskLabels: struct {
+				field.RelationField
+				Label struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Replies.Task.TaskLabels", "models.TaskLabel"),
+				Label: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Replies.Task.TaskLabels.Label", "models.Label"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Replies.Task.TaskLabels.Label.Project", "models.Project"),
+					},
+				},
+			},
+		},
+		Author: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Replies.Author", "models.User"),
+		},
+		Replies: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Replies.Replies", "models.Comment"),
+		},
+		Edits: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Replies.Edits", "models.CommentEdit"),
+		},
+		Mentions: struct {
+			field.RelationField
+			User struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Replies.Mentions", "models.CommentMention"),
+			User: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Replies.Mentions.User", "models.User"),
+			},
+		},
+	}
+
+	_comment.Edits = commentHasManyEdits{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Edits", "models.CommentEdit"),
+	}
+
+	_comment.Mentions = commentHasManyMentions{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Mentions", "models.CommentMention"),
+	}
+
+	_comment.Task = commentBelongsToTask{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Task", "models.Task"),
+	}
+
+	_comment.Author = commentBelongsToAuthor{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Author", "models.User"),
+	}
+
+	_comment.fillFieldMap()
+
+	return _comment
+}
+
+type comment struct {
+	commentDo commentDo
+
+	ALL             field.Asterisk
+	ID              field.Int
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	DeletedAt       field.Field
+	TaskID          field.Int
+	AuthorID        field.Int
+	ParentCommentID field.Int
+	Body            field.String
+	EditedAt        field.Time
+	Replies         commentHasManyReplies
+
+	Edits commentHasManyEdits
+
+	Mentions commentHasManyMentions
+
+	Task commentBelongsToTask
+
+	Author commentBelongsToAuthor
+
+	fieldMap map[string]field.Expr
+}
+
+func (c comment) Table(newTableName string) *comment {
+	c.commentDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c comment) As(alias string) *comment {
+	c.commentDo.DO = *(c.commentDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *comment) updateTableName(table string) *comment {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewInt(table, "id")
+	c.CreatedAt = field.NewTime(table, "created_at")
+	c.UpdatedAt = field.NewTime(table, "updated_at")
+	c.DeletedAt = field.NewField(table, "deleted_at")
+	c.TaskID = field.NewInt(table, "task_id")
+	c.AuthorID = field.NewInt(table, "author_id")
+	c.ParentCommentID = field.NewInt(table, "parent_comment_id")
+	c.Body = field.NewString(table, "body")
+	c.EditedAt = field.NewTime(table, "edited_at")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *comment) WithContext(ctx context.Context) ICommentDo { return c.commentDo.WithContext(ctx) }
+
+func (c comment) TableName() string { return c.commentDo.TableName() }
+
+func (c comment) Alias() string { return c.commentDo.Alias() }
+
+func (c comment) Columns(cols ...field.Expr) gen.Columns { return c.commentDo.Columns(cols...) }
+
+func (c *comment) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *comment) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 14)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["created_at"] = c.CreatedAt
+	c.fieldMap["updated_at"] = c.UpdatedAt
+	c.fieldMap["deleted_at"] = c.DeletedAt
+	c.fieldMap["task_id"] = c.TaskID
+	c.fieldMap["author_id"] = c.AuthorID
+	c.fieldMap["parent_comment_id"] = c.ParentCommentID
+	c.fieldMap["body"] = c.Body
+	c.fieldMap["edited_at"] = c.EditedAt
+
+}
+
+func (c comment) clone(db *gorm.DB) comment {
+	c.commentDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c comment) replaceDB(db *gorm.DB) comment {
+	c.commentDo.ReplaceDB(db)
+	return c
+}
+
+type commentHasManyReplies struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Task struct {
+		field.RelationField
+		Assignee struct {
+			field.RelationField
+			CurrentProject struct {
+				field.RelationField
+				Manager struct {
+					field.RelationField
+				}
+				Tasks struct {
+					field.RelationField
+				}
+				Sprints struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				TeamMembers struct {
+					field.RelationField
+				}
+				Members struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}
+			}
+			ManagedProjects struct {
+				field.RelationField
+			}
+			AssignedTasks struct {
+				field.RelationField
+			}
+		}
+		Project struct {
+			field.RelationField
+		}
+		Sprint struct {
+			field.RelationField
+		}
+		Subtasks struct {
+			field.RelationField
+		}
+		TaskLabels struct {
+			field.RelationField
+			Label struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+			}
+		}
+	}
+	Author struct {
+		field.RelationField
+	}
+	Replies struct {
+		field.RelationField
+	}
+	Edits struct {
+		field.RelationField
+	}
+	Mentions struct {
+		field.RelationField
+		User struct {
+			field.RelationField
+		}
+	}
+}
+
+func (a commentHasManyReplies) Where(conds ...field.Expr) *commentHasManyReplies {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a commentHasManyReplies) WithContext(ctx context.Context) *commentHasManyReplies {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a commentHasManyReplies) Session(session *gorm.Session) *commentHasManyReplies {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a commentHasManyReplies) Model(m *models.Comment) *commentHasManyRepliesTx {
+	return &commentHasManyRepliesTx{a.db.Model(m).Association(a.Name())}
+}
+
+type commentHasManyRepliesTx struct{ tx *gorm.Association }
+
+func (a commentHasManyRepliesTx) Find() (result []*models.Comment, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a commentHasManyRepliesTx) Append(values ...*models.Comment) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a commentHasManyRepliesTx) Replace(values ...*models.Comment) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a commentHasManyRepliesTx) Delete(values ...*models.Comment) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a commentHasManyRepliesTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a commentHasManyRepliesTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type commentHasManyEdits struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a commentHasManyEdits) Where(conds ...field.Expr) *commentHasManyEdits {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a commentHasManyEdits) WithContext(ctx context.Context) *commentHasManyEdits {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a commentHasManyEdits) Session(session *gorm.Session) *commentHasManyEdits {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a commentHasManyEdits) Model(m *models.Comment) *commentHasManyEditsTx {
+	return &commentHasManyEditsTx{a.db.Model(m).Association(a.Name())}
+}
+
+type commentHasManyEditsTx struct{ tx *gorm.Association }
+
+func (a commentHasManyEditsTx) Find() (result []*models.CommentEdit, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a commentHasManyEditsTx) Append(values ...*models.CommentEdit) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a commentHasManyEditsTx) Replace(values ...*models.CommentEdit) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a commentHasManyEditsTx) Delete(values ...*models.CommentEdit) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a commentHasManyEditsTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a commentHasManyEditsTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type commentHasManyMentions struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a commentHasManyMentions) Where(conds ...field.Expr) *commentHasManyMentions {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a commentHasManyMentions) WithContext(ctx context.Context) *commentHasManyMentions {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a commentHasManyMentions) Session(session *gorm.Session) *commentHasManyMentions {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a commentHasManyMentions) Model(m *models.Comment) *commentHasManyMentionsTx {
+	return &commentHasManyMentionsTx{a.db.Model(m).Association(a.Name())}
+}
+
+type commentHasManyMentionsTx struct{ tx *gorm.Association }
+
+func (a commentHasManyMentionsTx) Find() (result []*models.CommentMention, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a commentHasManyMentionsTx) Append(values ...*models.CommentMention) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a commentHasManyMentionsTx) Replace(values ...*models.CommentMention) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a commentHasManyMentionsTx) Delete(values ...*models.CommentMention) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a commentHasManyMentionsTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a commentHasManyMentionsTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type commentBelongsToTask struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a commentBelongsToTask) Where(conds ...field.Expr) *commentBelongsToTask {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a commentBelongsToTask) WithContext(ctx context.Context) *commentBelongsToTask {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a commentBelongsToTask) Session(session *gorm.Session) *commentBelongsToTask {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a commentBelongsToTask) Model(m *models.Comment) *commentBelongsToTaskTx {
+	return &commentBelongsToTaskTx{a.db.Model(m).Association(a.Name())}
+}
+
+type commentBelongsToTaskTx struct{ tx *gorm.Association }
+
+func (a commentBelongsToTaskTx) Find() (result *models.Task, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a commentBelongsToTaskTx) Append(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a commentBelongsToTaskTx) Replace(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a commentBelongsToTaskTx) Delete(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a commentBelongsToTaskTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a commentBelongsToTaskTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type commentBelongsToAuthor struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a commentBelongsToAuthor) Where(conds ...field.Expr) *commentBelongsToAuthor {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a commentBelongsToAuthor) WithContext(ctx context.Context) *commentBelongsToAuthor {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a commentBelongsToAuthor) Session(session *gorm.Session) *commentBelongsToAuthor {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a commentBelongsToAuthor) Model(m *models.Comment) *commentBelongsToAuthorTx {
+	return &commentBelongsToAuthorTx{a.db.Model(m).Association(a.Name())}
+}
+
+type commentBelongsToAuthorTx struct{ tx *gorm.Association }
+
+func (a commentBelongsToAuthorTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a commentBelongsToAuthorTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a commentBelongsToAuthorTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a commentBelongsToAuthorTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a commentBelongsToAuthorTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a commentBelongsToAuthorTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type commentDo struct{ gen.DO }
+
+type ICommentDo interface {
+	gen.SubQuery
+	Debug() ICommentDo
+	WithContext(ctx context.Context) ICommentDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ICommentDo
+	WriteDB() ICommentDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ICommentDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ICommentDo
+	Not(conds ...gen.Condition) ICommentDo
+	Or(conds ...gen.Condition) ICommentDo
+	Select(conds ...field.Expr) ICommentDo
+	Where(conds ...gen.Condition) ICommentDo
+	Order(conds ...field.Expr) ICommentDo
+	Distinct(cols ...field.Expr) ICommentDo
+	Omit(cols ...field.Expr) ICommentDo
+	Join(table schema.Tabler, on ...field.Expr) ICommentDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ICommentDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ICommentDo
+	Group(cols ...field.Expr) ICommentDo
+	Having(conds ...gen.Condition) ICommentDo
+	Limit(limit int) ICommentDo
+	Offset(offset int) ICommentDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ICommentDo
+	Unscoped() ICommentDo
+	Create(values ...*models.Comment) error
+	CreateInBatches(values []*models.Comment, batchSize int) error
+	Save(values ...*models.Comment) error
+	First() (*models.Comment, error)
+	Take() (*models.Comment, error)
+	Last() (*models.Comment, error)
+	Find() ([]*models.Comment, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.Comment, err error)
+	FindInBatches(result *[]*models.Comment, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.Comment) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ICommentDo
+	Assign(attrs ...field.AssignExpr) ICommentDo
+	Joins(fields ...field.RelationField) ICommentDo
+	Preload(fields ...field.RelationField) ICommentDo
+	FirstOrInit() (*models.Comment, error)
+	FirstOrCreate() (*models.Comment, error)
+	FindByPage(offset int, limit int) (result []*models.Comment, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ICommentDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c commentDo) Debug() ICommentDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c commentDo) WithContext(ctx context.Context) ICommentDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c commentDo) ReadDB() ICommentDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c commentDo) WriteDB() ICommentDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c commentDo) Session(config *gorm.Session) ICommentDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c commentDo) Clauses(conds ...clause.Expression) ICommentDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c commentDo) Returning(value interface{}, columns ...string) ICommentDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c commentDo) Not(conds ...gen.Condition) ICommentDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c commentDo) Or(conds ...gen.Condition) ICommentDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c commentDo) Select(conds ...field.Expr) ICommentDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c commentDo) Where(conds ...gen.Condition) ICommentDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c commentDo) Order(conds ...field.Expr) ICommentDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c commentDo) Distinct(cols ...field.Expr) ICommentDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c commentDo) Omit(cols ...field.Expr) ICommentDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c commentDo) Join(table schema.Tabler, on ...field.Expr) ICommentDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c commentDo) LeftJoin(table schema.Tabler, on ...field.Expr) ICommentDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c commentDo) RightJoin(table schema.Tabler, on ...field.Expr) ICommentDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c commentDo) Group(cols ...field.Expr) ICommentDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c commentDo) Having(conds ...gen.Condition) ICommentDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c commentDo) Limit(limit int) ICommentDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c commentDo) Offset(offset int) ICommentDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c commentDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ICommentDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c commentDo) Unscoped() ICommentDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c commentDo) Create(values ...*models.Comment) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c commentDo) CreateInBatches(values []*models.Comment, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c commentDo) Save(values ...*models.Comment) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c commentDo) First() (*models.Comment, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Comment), nil
+	}
+}
+
+func (c commentDo) Take() (*models.Comment, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Comment), nil
+	}
+}
+
+func (c commentDo) Last() (*models.Comment, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Comment), nil
+	}
+}
+
+func (c commentDo) Find() ([]*models.Comment, error) {
+	result, err := c.DO.Find()
+	return result.([]*models.Comment), err
+}
+
+func (c commentDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.Comment, err error) {
+	buf := make([]*models.Comment, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c commentDo) FindInBatches(result *[]*models.Comment, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c commentDo) Attrs(attrs ...field.AssignExpr) ICommentDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c commentDo) Assign(attrs ...field.AssignExpr) ICommentDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c commentDo) Joins(fields ...field.RelationField) ICommentDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c commentDo) Preload(fields ...field.RelationField) ICommentDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c commentDo) FirstOrInit() (*models.Comment, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Comment), nil
+	}
+}
+
+func (c commentDo) FirstOrCreate() (*models.Comment, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Comment), nil
+	}
+}
+
+func (c commentDo) FindByPage(offset int, limit int) (result []*models.Comment, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c commentDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c commentDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c commentDo) Delete(models ...*models.Comment) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *commentDo) withDO(do gen.Dao) *commentDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
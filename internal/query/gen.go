@@ -16,49 +16,149 @@ import (
 )
 
 var (
-	Q       = new(Query)
-	Project *project
-	Sprint  *sprint
-	Task    *task
-	User    *user
+	Q                  = new(Query)
+	ActivityChange     *activityChange
+	ActivityLog        *activityLog
+	Attachment         *attachment
+	Comment            *comment
+	CommentEdit        *commentEdit
+	CommentMention     *commentMention
+	Label              *label
+	Notification       *notification
+	OutboundEmail      *outboundEmail
+	Project            *project
+	ProjectMember      *projectMember
+	SavedView          *savedView
+	Sprint             *sprint
+	SprintReport       *sprintReport
+	SprintReportTask   *sprintReportTask
+	Task               *task
+	TaskLabel          *taskLabel
+	TaskLink           *taskLink
+	User               *user
+	Webhook            *webhook
+	WebhookDelivery    *webhookDelivery
+	WipLimit           *wipLimit
+	WorkflowTransition *workflowTransition
+	Worklog            *worklog
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	ActivityChange = &Q.ActivityChange
+	ActivityLog = &Q.ActivityLog
+	Attachment = &Q.Attachment
+	Comment = &Q.Comment
+	CommentEdit = &Q.CommentEdit
+	CommentMention = &Q.CommentMention
+	Label = &Q.Label
+	Notification = &Q.Notification
+	OutboundEmail = &Q.OutboundEmail
 	Project = &Q.Project
+	ProjectMember = &Q.ProjectMember
+	SavedView = &Q.SavedView
 	Sprint = &Q.Sprint
+	SprintReport = &Q.SprintReport
+	SprintReportTask = &Q.SprintReportTask
 	Task = &Q.Task
+	TaskLabel = &Q.TaskLabel
+	TaskLink = &Q.TaskLink
 	User = &Q.User
+	Webhook = &Q.Webhook
+	WebhookDelivery = &Q.WebhookDelivery
+	WipLimit = &Q.WipLimit
+	WorkflowTransition = &Q.WorkflowTransition
+	Worklog = &Q.Worklog
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:      db,
-		Project: newProject(db, opts...),
-		Sprint:  newSprint(db, opts...),
-		Task:    newTask(db, opts...),
-		User:    newUser(db, opts...),
+		db:                 db,
+		ActivityChange:     newActivityChange(db, opts...),
+		ActivityLog:        newActivityLog(db, opts...),
+		Attachment:         newAttachment(db, opts...),
+		Comment:            newComment(db, opts...),
+		CommentEdit:        newCommentEdit(db, opts...),
+		CommentMention:     newCommentMention(db, opts...),
+		Label:              newLabel(db, opts...),
+		Notification:       newNotification(db, opts...),
+		OutboundEmail:      newOutboundEmail(db, opts...),
+		Project:            newProject(db, opts...),
+		ProjectMember:      newProjectMember(db, opts...),
+		SavedView:          newSavedView(db, opts...),
+		Sprint:             newSprint(db, opts...),
+		SprintReport:       newSprintReport(db, opts...),
+		SprintReportTask:   newSprintReportTask(db, opts...),
+		Task:               newTask(db, opts...),
+		TaskLabel:          newTaskLabel(db, opts...),
+		TaskLink:           newTaskLink(db, opts...),
+		User:               newUser(db, opts...),
+		Webhook:            newWebhook(db, opts...),
+		WebhookDelivery:    newWebhookDelivery(db, opts...),
+		WipLimit:           newWipLimit(db, opts...),
+		WorkflowTransition: newWorkflowTransition(db, opts...),
+		Worklog:            newWorklog(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	Project project
-	Sprint  sprint
-	Task    task
-	User    user
+	ActivityChange     activityChange
+	ActivityLog        activityLog
+	Attachment         attachment
+	Comment            comment
+	CommentEdit        commentEdit
+	CommentMention     commentMention
+	Label              label
+	Notification       notification
+	OutboundEmail      outboundEmail
+	Project            project
+	ProjectMember      projectMember
+	SavedView          savedView
+	Sprint             sprint
+	SprintReport       sprintReport
+	SprintReportTask   sprintReportTask
+	Task               task
+	TaskLabel          taskLabel
+	TaskLink           taskLink
+	User               user
+	Webhook            webhook
+	WebhookDelivery    webhookDelivery
+	WipLimit           wipLimit
+	WorkflowTransition workflowTransition
+	Worklog            worklog
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:      db,
-		Project: q.Project.clone(db),
-		Sprint:  q.Sprint.clone(db),
-		Task:    q.Task.clone(db),
-		User:    q.User.clone(db),
+		db:                 db,
+		ActivityChange:     q.ActivityChange.clone(db),
+		ActivityLog:        q.ActivityLog.clone(db),
+		Attachment:         q.Attachment.clone(db),
+		Comment:            q.Comment.clone(db),
+		CommentEdit:        q.CommentEdit.clone(db),
+		CommentMention:     q.CommentMention.clone(db),
+		Label:              q.Label.clone(db),
+		Notification:       q.Notification.clone(db),
+		OutboundEmail:      q.OutboundEmail.clone(db),
+		Project:            q.Project.clone(db),
+		ProjectMember:      q.ProjectMember.clone(db),
+		SavedView:          q.SavedView.clone(db),
+		Sprint:             q.Sprint.clone(db),
+		SprintReport:       q.SprintReport.clone(db),
+		SprintReportTask:   q.SprintReportTask.clone(db),
+		Task:               q.Task.clone(db),
+		TaskLabel:          q.TaskLabel.clone(db),
+		TaskLink:           q.TaskLink.clone(db),
+		User:               q.User.clone(db),
+		Webhook:            q.Webhook.clone(db),
+		WebhookDelivery:    q.WebhookDelivery.clone(db),
+		WipLimit:           q.WipLimit.clone(db),
+		WorkflowTransition: q.WorkflowTransition.clone(db),
+		Worklog:            q.Worklog.clone(db),
 	}
 }
 
@@ -72,27 +172,87 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:      db,
-		Project: q.Project.replaceDB(db),
-		Sprint:  q.Sprint.replaceDB(db),
-		Task:    q.Task.replaceDB(db),
-		User:    q.User.replaceDB(db),
+		db:                 db,
+		ActivityChange:     q.ActivityChange.replaceDB(db),
+		ActivityLog:        q.ActivityLog.replaceDB(db),
+		Attachment:         q.Attachment.replaceDB(db),
+		Comment:            q.Comment.replaceDB(db),
+		CommentEdit:        q.CommentEdit.replaceDB(db),
+		CommentMention:     q.CommentMention.replaceDB(db),
+		Label:              q.Label.replaceDB(db),
+		Notification:       q.Notification.replaceDB(db),
+		OutboundEmail:      q.OutboundEmail.replaceDB(db),
+		Project:            q.Project.replaceDB(db),
+		ProjectMember:      q.ProjectMember.replaceDB(db),
+		SavedView:          q.SavedView.replaceDB(db),
+		Sprint:             q.Sprint.replaceDB(db),
+		SprintReport:       q.SprintReport.replaceDB(db),
+		SprintReportTask:   q.SprintReportTask.replaceDB(db),
+		Task:               q.Task.replaceDB(db),
+		TaskLabel:          q.TaskLabel.replaceDB(db),
+		TaskLink:           q.TaskLink.replaceDB(db),
+		User:               q.User.replaceDB(db),
+		Webhook:            q.Webhook.replaceDB(db),
+		WebhookDelivery:    q.WebhookDelivery.replaceDB(db),
+		WipLimit:           q.WipLimit.replaceDB(db),
+		WorkflowTransition: q.WorkflowTransition.replaceDB(db),
+		Worklog:            q.Worklog.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	Project IProjectDo
-	Sprint  ISprintDo
-	Task    ITaskDo
-	User    IUserDo
+	ActivityChange     IActivityChangeDo
+	ActivityLog        IActivityLogDo
+	Attachment         IAttachmentDo
+	Comment            ICommentDo
+	CommentEdit        ICommentEditDo
+	CommentMention     ICommentMentionDo
+	Label              ILabelDo
+	Notification       INotificationDo
+	OutboundEmail      IOutboundEmailDo
+	Project            IProjectDo
+	ProjectMember      IProjectMemberDo
+	SavedView          ISavedViewDo
+	Sprint             ISprintDo
+	SprintReport       ISprintReportDo
+	SprintReportTask   ISprintReportTaskDo
+	Task               ITaskDo
+	TaskLabel          ITaskLabelDo
+	TaskLink           ITaskLinkDo
+	User               IUserDo
+	Webhook            IWebhookDo
+	WebhookDelivery    IWebhookDeliveryDo
+	WipLimit           IWipLimitDo
+	WorkflowTransition IWorkflowTransitionDo
+	Worklog            IWorklogDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		Project: q.Project.WithContext(ctx),
-		Sprint:  q.Sprint.WithContext(ctx),
-		Task:    q.Task.WithContext(ctx),
-		User:    q.User.WithContext(ctx),
+		ActivityChange:     q.ActivityChange.WithContext(ctx),
+		ActivityLog:        q.ActivityLog.WithContext(ctx),
+		Attachment:         q.Attachment.WithContext(ctx),
+		Comment:            q.Comment.WithContext(ctx),
+		CommentEdit:        q.CommentEdit.WithContext(ctx),
+		CommentMention:     q.CommentMention.WithContext(ctx),
+		Label:              q.Label.WithContext(ctx),
+		Notification:       q.Notification.WithContext(ctx),
+		OutboundEmail:      q.OutboundEmail.WithContext(ctx),
+		Project:            q.Project.WithContext(ctx),
+		ProjectMember:      q.ProjectMember.WithContext(ctx),
+		SavedView:          q.SavedView.WithContext(ctx),
+		Sprint:             q.Sprint.WithContext(ctx),
+		SprintReport:       q.SprintReport.WithContext(ctx),
+		SprintReportTask:   q.SprintReportTask.WithContext(ctx),
+		Task:               q.Task.WithContext(ctx),
+		TaskLabel:          q.TaskLabel.WithContext(ctx),
+		TaskLink:           q.TaskLink.WithContext(ctx),
+		User:               q.User.WithContext(ctx),
+		Webhook:            q.Webhook.WithContext(ctx),
+		WebhookDelivery:    q.WebhookDelivery.WithContext(ctx),
+		WipLimit:           q.WipLimit.WithContext(ctx),
+		WorkflowTransition: q.WorkflowTransition.WithContext(ctx),
+		Worklog:            q.Worklog.WithContext(ctx),
 	}
 }
 
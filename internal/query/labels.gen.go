@@ -0,0 +1,800 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newLabel(db *gorm.DB, opts ...gen.DOOption) label {
+	_label := label{}
+
+	_label.labelDo.UseDB(db, opts...)
+	_label.labelDo.UseModel(&models.Label{})
+
+	tableName := _label.labelDo.TableName()
+	_label.ALL = field.NewAsterisk(tableName)
+	_label.ID = field.NewInt(tableName, "id")
+	_label.CreatedAt = field.NewTime(tableName, "created_at")
+	_label.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_label.ProjectID = field.NewInt(tableName, "project_id")
+	_label.Name = field.NewString(tableName, "name")
+	_label.Color = field.NewString(tableName, "color")
+	_label.Project = labelBelongsToProject{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Project", "models.Project"),
+		Manager: struct {
+			field.RelationField
+			CurrentProject struct {
+				field.RelationField
+			}
+			ManagedProjects struct {
+				field.RelationField
+			}
+			AssignedTasks struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Project.Manager", "models.User"),
+			CurrentProject: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Manager.CurrentProject", "models.Project"),
+			},
+			ManagedProjects: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Manager.ManagedProjects", "models.Project"),
+			},
+			AssignedTasks: struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Project.Manager.AssignedTasks", "models.Task"),
+				Assignee: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Assignee", "models.User"),
+				},
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Project", "models.Project"),
+				},
+				Sprint: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint", "models.Sprint"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Tasks", "models.Task"),
+					},
+				},
+				Subtasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Subtasks", "models.Task"),
+				},
+				TaskLabels: struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels", "models.TaskLabel"),
+					Label: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels.Label", "models.Label"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels.Label.Project", "models.Project"),
+						},
+					},
+				},
+			},
+		},
+		Tasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.Tasks", "models.Task"),
+		},
+		Sprints: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.Sprints", "models.Sprint"),
+		},
+		TeamMembers: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.TeamMembers", "models.User"),
+		},
+		Members: struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Project.Members", "models.ProjectMember"),
+			Project: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Members.Project", "models.Project"),
+			},
+			User: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Members.User", "models.User"),
+			},
+		},
+	}
+
+	_label.fillFieldMap()
+
+	return _label
+}
+
+type label struct {
+	labelDo labelDo
+
+	ALL       field.Asterisk
+	ID        field.Int
+	CreatedAt field.Time
+	UpdatedAt field.Time
+	ProjectID field.Int
+	Name      field.String
+	Color     field.String
+	Project   labelBelongsToProject
+
+	fieldMap map[string]field.Expr
+}
+
+func (l label) Table(newTableName string) *label {
+	l.labelDo.UseTable(newTableName)
+	return l.updateTableName(newTableName)
+}
+
+func (l label) As(alias string) *label {
+	l.labelDo.DO = *(l.labelDo.As(alias).(*gen.DO))
+	return l.updateTableName(alias)
+}
+
+func (l *label) updateTableName(table string) *label {
+	l.ALL = field.NewAsterisk(table)
+	l.ID = field.NewInt(table, "id")
+	l.CreatedAt = field.NewTime(table, "created_at")
+	l.UpdatedAt = field.NewTime(table, "updated_at")
+	l.ProjectID = field.NewInt(table, "project_id")
+	l.Name = field.NewString(table, "name")
+	l.Color = field.NewString(table, "color")
+
+	l.fillFieldMap()
+
+	return l
+}
+
+func (l *label) WithContext(ctx context.Context) ILabelDo { return l.labelDo.WithContext(ctx) }
+
+func (l label) TableName() string { return l.labelDo.TableName() }
+
+func (l label) Alias() string { return l.labelDo.Alias() }
+
+func (l label) Columns(cols ...field.Expr) gen.Columns { return l.labelDo.Columns(cols...) }
+
+func (l *label) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := l.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (l *label) fillFieldMap() {
+	l.fieldMap = make(map[string]field.Expr, 7)
+	l.fieldMap["id"] = l.ID
+	l.fieldMap["created_at"] = l.CreatedAt
+	l.fieldMap["updated_at"] = l.UpdatedAt
+	l.fieldMap["project_id"] = l.ProjectID
+	l.fieldMap["name"] = l.Name
+	l.fieldMap["color"] = l.Color
+
+}
+
+func (l label) clone(db *gorm.DB) label {
+	l.labelDo.ReplaceConnPool(db.Statement.ConnPool)
+	return l
+}
+
+func (l label) replaceDB(db *gorm.DB) label {
+	l.labelDo.ReplaceDB(db)
+	return l
+}
+
+type labelBelongsToProject struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Manager struct {
+		field.RelationField
+		CurrentProject struct {
+			field.RelationField
+		}
+		ManagedProjects struct {
+			field.RelationField
+		}
+		AssignedTasks struct {
+			field.RelationField
+			Assignee struct {
+				field.RelationField
+			}
+			Project struct {
+				field.RelationField
+			}
+			Sprint struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Tasks struct {
+					field.RelationField
+				}
+			}
+			Subtasks struct {
+				field.RelationField
+			}
+			TaskLabels struct {
+				field.RelationField
+				Label struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+	}
+	Tasks struct {
+		field.RelationField
+	}
+	Sprints struct {
+		field.RelationField
+	}
+	TeamMembers struct {
+		field.RelationField
+	}
+	Members struct {
+		field.RelationField
+		Project struct {
+			field.RelationField
+		}
+		User struct {
+			field.RelationField
+		}
+	}
+}
+
+func (a labelBelongsToProject) Where(conds ...field.Expr) *labelBelongsToProject {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a labelBelongsToProject) WithContext(ctx context.Context) *labelBelongsToProject {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a labelBelongsToProject) Session(session *gorm.Session) *labelBelongsToProject {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a labelBelongsToProject) Model(m *models.Label) *labelBelongsToProjectTx {
+	return &labelBelongsToProjectTx{a.db.Model(m).Association(a.Name())}
+}
+
+type labelBelongsToProjectTx struct{ tx *gorm.Association }
+
+func (a labelBelongsToProjectTx) Find() (result *models.Project, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a labelBelongsToProjectTx) Append(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a labelBelongsToProjectTx) Replace(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a labelBelongsToProjectTx) Delete(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a labelBelongsToProjectTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a labelBelongsToProjectTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type labelDo struct{ gen.DO }
+
+type ILabelDo interface {
+	gen.SubQuery
+	Debug() ILabelDo
+	WithContext(ctx context.Context) ILabelDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ILabelDo
+	WriteDB() ILabelDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ILabelDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ILabelDo
+	Not(conds ...gen.Condition) ILabelDo
+	Or(conds ...gen.Condition) ILabelDo
+	Select(conds ...field.Expr) ILabelDo
+	Where(conds ...gen.Condition) ILabelDo
+	Order(conds ...field.Expr) ILabelDo
+	Distinct(cols ...field.Expr) ILabelDo
+	Omit(cols ...field.Expr) ILabelDo
+	Join(table schema.Tabler, on ...field.Expr) ILabelDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ILabelDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ILabelDo
+	Group(cols ...field.Expr) ILabelDo
+	Having(conds ...gen.Condition) ILabelDo
+	Limit(limit int) ILabelDo
+	Offset(offset int) ILabelDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ILabelDo
+	Unscoped() ILabelDo
+	Create(values ...*models.Label) error
+	CreateInBatches(values []*models.Label, batchSize int) error
+	Save(values ...*models.Label) error
+	First() (*models.Label, error)
+	Take() (*models.Label, error)
+	Last() (*models.Label, error)
+	Find() ([]*models.Label, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.Label, err error)
+	FindInBatches(result *[]*models.Label, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.Label) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ILabelDo
+	Assign(attrs ...field.AssignExpr) ILabelDo
+	Joins(fields ...field.RelationField) ILabelDo
+	Preload(fields ...field.RelationField) ILabelDo
+	FirstOrInit() (*models.Label, error)
+	FirstOrCreate() (*models.Label, error)
+	FindByPage(offset int, limit int) (result []*models.Label, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ILabelDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (l labelDo) Debug() ILabelDo {
+	return l.withDO(l.DO.Debug())
+}
+
+func (l labelDo) WithContext(ctx context.Context) ILabelDo {
+	return l.withDO(l.DO.WithContext(ctx))
+}
+
+func (l labelDo) ReadDB() ILabelDo {
+	return l.Clauses(dbresolver.Read)
+}
+
+func (l labelDo) WriteDB() ILabelDo {
+	return l.Clauses(dbresolver.Write)
+}
+
+func (l labelDo) Session(config *gorm.Session) ILabelDo {
+	return l.withDO(l.DO.Session(config))
+}
+
+func (l labelDo) Clauses(conds ...clause.Expression) ILabelDo {
+	return l.withDO(l.DO.Clauses(conds...))
+}
+
+func (l labelDo) Returning(value interface{}, columns ...string) ILabelDo {
+	return l.withDO(l.DO.Returning(value, columns...))
+}
+
+func (l labelDo) Not(conds ...gen.Condition) ILabelDo {
+	return l.withDO(l.DO.Not(conds...))
+}
+
+func (l labelDo) Or(conds ...gen.Condition) ILabelDo {
+	return l.withDO(l.DO.Or(conds...))
+}
+
+func (l labelDo) Select(conds ...field.Expr) ILabelDo {
+	return l.withDO(l.DO.Select(conds...))
+}
+
+func (l labelDo) Where(conds ...gen.Condition) ILabelDo {
+	return l.withDO(l.DO.Where(conds...))
+}
+
+func (l labelDo) Order(conds ...field.Expr) ILabelDo {
+	return l.withDO(l.DO.Order(conds...))
+}
+
+func (l labelDo) Distinct(cols ...field.Expr) ILabelDo {
+	return l.withDO(l.DO.Distinct(cols...))
+}
+
+func (l labelDo) Omit(cols ...field.Expr) ILabelDo {
+	return l.withDO(l.DO.Omit(cols...))
+}
+
+func (l labelDo) Join(table schema.Tabler, on ...field.Expr) ILabelDo {
+	return l.withDO(l.DO.Join(table, on...))
+}
+
+func (l labelDo) LeftJoin(table schema.Tabler, on ...field.Expr) ILabelDo {
+	return l.withDO(l.DO.LeftJoin(table, on...))
+}
+
+func (l labelDo) RightJoin(table schema.Tabler, on ...field.Expr) ILabelDo {
+	return l.withDO(l.DO.RightJoin(table, on...))
+}
+
+func (l labelDo) Group(cols ...field.Expr) ILabelDo {
+	return l.withDO(l.DO.Group(cols...))
+}
+
+func (l labelDo) Having(conds ...gen.Condition) ILabelDo {
+	return l.withDO(l.DO.Having(conds...))
+}
+
+func (l labelDo) Limit(limit int) ILabelDo {
+	return l.withDO(l.DO.Limit(limit))
+}
+
+func (l labelDo) Offset(offset int) ILabelDo {
+	return l.withDO(l.DO.Offset(offset))
+}
+
+func (l labelDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ILabelDo {
+	return l.withDO(l.DO.Scopes(funcs...))
+}
+
+func (l labelDo) Unscoped() ILabelDo {
+	return l.withDO(l.DO.Unscoped())
+}
+
+func (l labelDo) Create(values ...*models.Label) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return l.DO.Create(values)
+}
+
+func (l labelDo) CreateInBatches(values []*models.Label, batchSize int) error {
+	return l.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (l labelDo) Save(values ...*models.Label) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return l.DO.Save(values)
+}
+
+func (l labelDo) First() (*models.Label, error) {
+	if result, err := l.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Label), nil
+	}
+}
+
+func (l labelDo) Take() (*models.Label, error) {
+	if result, err := l.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Label), nil
+	}
+}
+
+func (l labelDo) Last() (*models.Label, error) {
+	if result, err := l.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Label), nil
+	}
+}
+
+func (l labelDo) Find() ([]*models.Label, error) {
+	result, err := l.DO.Find()
+	return result.([]*models.Label), err
+}
+
+func (l labelDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.Label, err error) {
+	buf := make([]*models.Label, 0, batchSize)
+	err = l.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (l labelDo) FindInBatches(result *[]*models.Label, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return l.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (l labelDo) Attrs(attrs ...field.AssignExpr) ILabelDo {
+	return l.withDO(l.DO.Attrs(attrs...))
+}
+
+func (l labelDo) Assign(attrs ...field.AssignExpr) ILabelDo {
+	return l.withDO(l.DO.Assign(attrs...))
+}
+
+func (l labelDo) Joins(fields ...field.RelationField) ILabelDo {
+	for _, _f := range fields {
+		l = *l.withDO(l.DO.Joins(_f))
+	}
+	return &l
+}
+
+func (l labelDo) Preload(fields ...field.RelationField) ILabelDo {
+	for _, _f := range fields {
+		l = *l.withDO(l.DO.Preload(_f))
+	}
+	return &l
+}
+
+func (l labelDo) FirstOrInit() (*models.Label, error) {
+	if result, err := l.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Label), nil
+	}
+}
+
+func (l labelDo) FirstOrCreate() (*models.Label, error) {
+	if result, err := l.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Label), nil
+	}
+}
+
+func (l labelDo) FindByPage(offset int, limit int) (result []*models.Label, count int64, err error) {
+	result, err = l.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = l.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (l labelDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = l.Count()
+	if err != nil {
+		return
+	}
+
+	err = l.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (l labelDo) Scan(result interface{}) (err error) {
+	return l.DO.Scan(result)
+}
+
+func (l labelDo) Delete(models ...*models.Label) (result gen.ResultInfo, err error) {
+	return l.DO.Delete(models)
+}
+
+func (l *labelDo) withDO(do gen.Dao) *labelDo {
+	l.DO = *do.(*gen.DO)
+	return l
+}
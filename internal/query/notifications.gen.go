@@ -0,0 +1,1065 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newNotification(db *gorm.DB, opts ...gen.DOOption) notification {
+	_notification := notification{}
+
+	_notification.notificationDo.UseDB(db, opts...)
+	_notification.notificationDo.UseModel(&models.Notification{})
+
+	tableName := _notification.notificationDo.TableName()
+	_notification.ALL = field.NewAsterisk(tableName)
+	_notification.ID = field.NewInt(tableName, "id")
+	_notification.CreatedAt = field.NewTime(tableName, "created_at")
+	_notification.UserID = field.NewInt(tableName, "user_id")
+	_notification.ReadAt = field.NewTime(tableName, "read_at")
+	_notification.Type = field.NewString(tableName, "type")
+	_notification.ProjectID = field.NewInt(tableName, "project_id")
+	_notification.TaskID = field.NewInt(tableName, "task_id")
+	_notification.ActorID = field.NewInt(tableName, "actor_id")
+	_notification.Message = field.NewString(tableName, "message")
+	_notification.User = notificationBelongsToUser{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("User", "models.User"),
+		CurrentProject: struct {
+			field.RelationField
+			Manager struct {
+				field.RelationField
+			}
+			Tasks struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			Sprints struct {
+				field.RelationField
+			}
+			TeamMembers struct {
+				field.RelationField
+			}
+			Members struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("User.CurrentProject", "models.Project"),
+			Manager: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.Manager", "models.User"),
+			},
+			Tasks: struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.Tasks", "models.Task"),
+				Assignee: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.Assignee", "models.User"),
+				},
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.Project", "models.Project"),
+				},
+				Sprint: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint", "models.Sprint"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Tasks", "models.Task"),
+					},
+				},
+				Subtasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.Subtasks", "models.Task"),
+				},
+				TaskLabels: struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.TaskLabels", "models.TaskLabel"),
+					Label: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("User.CurrentProject.Tasks.TaskLabels.Label", "models.Label"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("User.CurrentProject.Tasks.TaskLabels.Label.Project", "models.Project"),
+						},
+					},
+				},
+			},
+			Sprints: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.Sprints", "models.Sprint"),
+			},
+			TeamMembers: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.TeamMembers", "models.User"),
+			},
+			Members: struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.Members", "models.ProjectMember"),
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Members.Project", "models.Project"),
+				},
+				User: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Members.User", "models.User"),
+				},
+			},
+		},
+		ManagedProjects: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("User.ManagedProjects", "models.Project"),
+		},
+		AssignedTasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("User.AssignedTasks", "models.Task"),
+		},
+	}
+
+	_notification.Project = notificationBelongsToProject{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Project", "models.Project"),
+	}
+
+	_notification.Task = notificationBelongsToTask{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Task", "models.Task"),
+	}
+
+	_notification.Actor = notificationBelongsToActor{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Actor", "models.User"),
+	}
+
+	_notification.fillFieldMap()
+
+	return _notification
+}
+
+type notification struct {
+	notificationDo notificationDo
+
+	ALL       field.Asterisk
+	ID        field.Int
+	CreatedAt field.Time
+	UserID    field.Int
+	ReadAt    field.Time
+	Type      field.String
+	ProjectID field.Int
+	TaskID    field.Int
+	ActorID   field.Int
+	Message   field.String
+	User      notificationBelongsToUser
+
+	Project notificationBelongsToProject
+
+	Task notificationBelongsToTask
+
+	Actor notificationBelongsToActor
+
+	fieldMap map[string]field.Expr
+}
+
+func (n notification) Table(newTableName string) *notification {
+	n.notificationDo.UseTable(newTableName)
+	return n.updateTableName(newTableName)
+}
+
+func (n notification) As(alias string) *notification {
+	n.notificationDo.DO = *(n.notificationDo.As(alias).(*gen.DO))
+	return n.updateTableName(alias)
+}
+
+func (n *notification) updateTableName(table string) *notification {
+	n.ALL = field.NewAsterisk(table)
+	n.ID = field.NewInt(table, "id")
+	n.CreatedAt = field.NewTime(table, "created_at")
+	n.UserID = field.NewInt(table, "user_id")
+	n.ReadAt = field.NewTime(table, "read_at")
+	n.Type = field.NewString(table, "type")
+	n.ProjectID = field.NewInt(table, "project_id")
+	n.TaskID = field.NewInt(table, "task_id")
+	n.ActorID = field.NewInt(table, "actor_id")
+	n.Message = field.NewString(table, "message")
+
+	n.fillFieldMap()
+
+	return n
+}
+
+func (n *notification) WithContext(ctx context.Context) INotificationDo {
+	return n.notificationDo.WithContext(ctx)
+}
+
+func (n notification) TableName() string { return n.notificationDo.TableName() }
+
+func (n notification) Alias() string { return n.notificationDo.Alias() }
+
+func (n notification) Columns(cols ...field.Expr) gen.Columns {
+	return n.notificationDo.Columns(cols...)
+}
+
+func (n *notification) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := n.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (n *notification) fillFieldMap() {
+	n.fieldMap = make(map[string]field.Expr, 13)
+	n.fieldMap["id"] = n.ID
+	n.fieldMap["created_at"] = n.CreatedAt
+	n.fieldMap["user_id"] = n.UserID
+	n.fieldMap["read_at"] = n.ReadAt
+	n.fieldMap["type"] = n.Type
+	n.fieldMap["project_id"] = n.ProjectID
+	n.fieldMap["task_id"] = n.TaskID
+	n.fieldMap["actor_id"] = n.ActorID
+	n.fieldMap["message"] = n.Message
+
+}
+
+func (n notification) clone(db *gorm.DB) notification {
+	n.notificationDo.ReplaceConnPool(db.Statement.ConnPool)
+	return n
+}
+
+func (n notification) replaceDB(db *gorm.DB) notification {
+	n.notificationDo.ReplaceDB(db)
+	return n
+}
+
+type notificationBelongsToUser struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	CurrentProject struct {
+		field.RelationField
+		Manager struct {
+			field.RelationField
+		}
+		Tasks struct {
+			field.RelationField
+			Assignee struct {
+				field.RelationField
+			}
+			Project struct {
+				field.RelationField
+			}
+			Sprint struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Tasks struct {
+					field.RelationField
+				}
+			}
+			Subtasks struct {
+				field.RelationField
+			}
+			TaskLabels struct {
+				field.RelationField
+				Label struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+		Sprints struct {
+			field.RelationField
+		}
+		TeamMembers struct {
+			field.RelationField
+		}
+		Members struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+		}
+	}
+	ManagedProjects struct {
+		field.RelationField
+	}
+	AssignedTasks struct {
+		field.RelationField
+	}
+}
+
+func (a notificationBelongsToUser) Where(conds ...field.Expr) *notificationBelongsToUser {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a notificationBelongsToUser) WithContext(ctx context.Context) *notificationBelongsToUser {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a notificationBelongsToUser) Session(session *gorm.Session) *notificationBelongsToUser {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a notificationBelongsToUser) Model(m *models.Notification) *notificationBelongsToUserTx {
+	return &notificationBelongsToUserTx{a.db.Model(m).Association(a.Name())}
+}
+
+type notificationBelongsToUserTx struct{ tx *gorm.Association }
+
+func (a notificationBelongsToUserTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a notificationBelongsToUserTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a notificationBelongsToUserTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a notificationBelongsToUserTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a notificationBelongsToUserTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a notificationBelongsToUserTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type notificationBelongsToProject struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a notificationBelongsToProject) Where(conds ...field.Expr) *notificationBelongsToProject {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a notificationBelongsToProject) WithContext(ctx context.Context) *notificationBelongsToProject {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a notificationBelongsToProject) Session(session *gorm.Session) *notificationBelongsToProject {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a notificationBelongsToProject) Model(m *models.Notification) *notificationBelongsToProjectTx {
+	return &notificationBelongsToProjectTx{a.db.Model(m).Association(a.Name())}
+}
+
+type notificationBelongsToProjectTx struct{ tx *gorm.Association }
+
+func (a notificationBelongsToProjectTx) Find() (result *models.Project, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a notificationBelongsToProjectTx) Append(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a notificationBelongsToProjectTx) Replace(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a notificationBelongsToProjectTx) Delete(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a notificationBelongsToProjectTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a notificationBelongsToProjectTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type notificationBelongsToTask struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a notificationBelongsToTask) Where(conds ...field.Expr) *notificationBelongsToTask {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a notificationBelongsToTask) WithContext(ctx context.Context) *notificationBelongsToTask {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a notificationBelongsToTask) Session(session *gorm.Session) *notificationBelongsToTask {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a notificationBelongsToTask) Model(m *models.Notification) *notificationBelongsToTaskTx {
+	return &notificationBelongsToTaskTx{a.db.Model(m).Association(a.Name())}
+}
+
+type notificationBelongsToTaskTx struct{ tx *gorm.Association }
+
+func (a notificationBelongsToTaskTx) Find() (result *models.Task, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a notificationBelongsToTaskTx) Append(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a notificationBelongsToTaskTx) Replace(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a notificationBelongsToTaskTx) Delete(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a notificationBelongsToTaskTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a notificationBelongsToTaskTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type notificationBelongsToActor struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a notificationBelongsToActor) Where(conds ...field.Expr) *notificationBelongsToActor {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a notificationBelongsToActor) WithContext(ctx context.Context) *notificationBelongsToActor {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a notificationBelongsToActor) Session(session *gorm.Session) *notificationBelongsToActor {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a notificationBelongsToActor) Model(m *models.Notification) *notificationBelongsToActorTx {
+	return &notificationBelongsToActorTx{a.db.Model(m).Association(a.Name())}
+}
+
+type notificationBelongsToActorTx struct{ tx *gorm.Association }
+
+func (a notificationBelongsToActorTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a notificationBelongsToActorTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a notificationBelongsToActorTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a notificationBelongsToActorTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a notificationBelongsToActorTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a notificationBelongsToActorTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type notificationDo struct{ gen.DO }
+
+type INotificationDo interface {
+	gen.SubQuery
+	Debug() INotificationDo
+	WithContext(ctx context.Context) INotificationDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() INotificationDo
+	WriteDB() INotificationDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) INotificationDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) INotificationDo
+	Not(conds ...gen.Condition) INotificationDo
+	Or(conds ...gen.Condition) INotificationDo
+	Select(conds ...field.Expr) INotificationDo
+	Where(conds ...gen.Condition) INotificationDo
+	Order(conds ...field.Expr) INotificationDo
+	Distinct(cols ...field.Expr) INotificationDo
+	Omit(cols ...field.Expr) INotificationDo
+	Join(table schema.Tabler, on ...field.Expr) INotificationDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) INotificationDo
+	RightJoin(table schema.Tabler, on ...field.Expr) INotificationDo
+	Group(cols ...field.Expr) INotificationDo
+	Having(conds ...gen.Condition) INotificationDo
+	Limit(limit int) INotificationDo
+	Offset(offset int) INotificationDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) INotificationDo
+	Unscoped() INotificationDo
+	Create(values ...*models.Notification) error
+	CreateInBatches(values []*models.Notification, batchSize int) error
+	Save(values ...*models.Notification) error
+	First() (*models.Notification, error)
+	Take() (*models.Notification, error)
+	Last() (*models.Notification, error)
+	Find() ([]*models.Notification, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.Notification, err error)
+	FindInBatches(result *[]*models.Notification, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.Notification) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) INotificationDo
+	Assign(attrs ...field.AssignExpr) INotificationDo
+	Joins(fields ...field.RelationField) INotificationDo
+	Preload(fields ...field.RelationField) INotificationDo
+	FirstOrInit() (*models.Notification, error)
+	FirstOrCreate() (*models.Notification, error)
+	FindByPage(offset int, limit int) (result []*models.Notification, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) INotificationDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (n notificationDo) Debug() INotificationDo {
+	return n.withDO(n.DO.Debug())
+}
+
+func (n notificationDo) WithContext(ctx context.Context) INotificationDo {
+	return n.withDO(n.DO.WithContext(ctx))
+}
+
+func (n notificationDo) ReadDB() INotificationDo {
+	return n.Clauses(dbresolver.Read)
+}
+
+func (n notificationDo) WriteDB() INotificationDo {
+	return n.Clauses(dbresolver.Write)
+}
+
+func (n notificationDo) Session(config *gorm.Session) INotificationDo {
+	return n.withDO(n.DO.Session(config))
+}
+
+func (n notificationDo) Clauses(conds ...clause.Expression) INotificationDo {
+	return n.withDO(n.DO.Clauses(conds...))
+}
+
+func (n notificationDo) Returning(value interface{}, columns ...string) INotificationDo {
+	return n.withDO(n.DO.Returning(value, columns...))
+}
+
+func (n notificationDo) Not(conds ...gen.Condition) INotificationDo {
+	return n.withDO(n.DO.Not(conds...))
+}
+
+func (n notificationDo) Or(conds ...gen.Condition) INotificationDo {
+	return n.withDO(n.DO.Or(conds...))
+}
+
+func (n notificationDo) Select(conds ...field.Expr) INotificationDo {
+	return n.withDO(n.DO.Select(conds...))
+}
+
+func (n notificationDo) Where(conds ...gen.Condition) INotificationDo {
+	return n.withDO(n.DO.Where(conds...))
+}
+
+func (n notificationDo) Order(conds ...field.Expr) INotificationDo {
+	return n.withDO(n.DO.Order(conds...))
+}
+
+func (n notificationDo) Distinct(cols ...field.Expr) INotificationDo {
+	return n.withDO(n.DO.Distinct(cols...))
+}
+
+func (n notificationDo) Omit(cols ...field.Expr) INotificationDo {
+	return n.withDO(n.DO.Omit(cols...))
+}
+
+func (n notificationDo) Join(table schema.Tabler, on ...field.Expr) INotificationDo {
+	return n.withDO(n.DO.Join(table, on...))
+}
+
+func (n notificationDo) LeftJoin(table schema.Tabler, on ...field.Expr) INotificationDo {
+	return n.withDO(n.DO.LeftJoin(table, on...))
+}
+
+func (n notificationDo) RightJoin(table schema.Tabler, on ...field.Expr) INotificationDo {
+	return n.withDO(n.DO.RightJoin(table, on...))
+}
+
+func (n notificationDo) Group(cols ...field.Expr) INotificationDo {
+	return n.withDO(n.DO.Group(cols...))
+}
+
+func (n notificationDo) Having(conds ...gen.Condition) INotificationDo {
+	return n.withDO(n.DO.Having(conds...))
+}
+
+func (n notificationDo) Limit(limit int) INotificationDo {
+	return n.withDO(n.DO.Limit(limit))
+}
+
+func (n notificationDo) Offset(offset int) INotificationDo {
+	return n.withDO(n.DO.Offset(offset))
+}
+
+func (n notificationDo) Scopes(funcs ...func(gen.Dao) gen.Dao) INotificationDo {
+	return n.withDO(n.DO.Scopes(funcs...))
+}
+
+func (n notificationDo) Unscoped() INotificationDo {
+	return n.withDO(n.DO.Unscoped())
+}
+
+func (n notificationDo) Create(values ...*models.Notification) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return n.DO.Create(values)
+}
+
+func (n notificationDo) CreateInBatches(values []*models.Notification, batchSize int) error {
+	return n.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (n notificationDo) Save(values ...*models.Notification) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return n.DO.Save(values)
+}
+
+func (n notificationDo) First() (*models.Notification, error) {
+	if result, err := n.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Notification), nil
+	}
+}
+
+func (n notificationDo) Take() (*models.Notification, error) {
+	if result, err := n.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Notification), nil
+	}
+}
+
+func (n notificationDo) Last() (*models.Notification, error) {
+	if result, err := n.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Notification), nil
+	}
+}
+
+func (n notificationDo) Find() ([]*models.Notification, error) {
+	result, err := n.DO.Find()
+	return result.([]*models.Notification), err
+}
+
+func (n notificationDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.Notification, err error) {
+	buf := make([]*models.Notification, 0, batchSize)
+	err = n.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (n notificationDo) FindInBatches(result *[]*models.Notification, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return n.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (n notificationDo) Attrs(attrs ...field.AssignExpr) INotificationDo {
+	return n.withDO(n.DO.Attrs(attrs...))
+}
+
+func (n notificationDo) Assign(attrs ...field.AssignExpr) INotificationDo {
+	return n.withDO(n.DO.Assign(attrs...))
+}
+
+func (n notificationDo) Joins(fields ...field.RelationField) INotificationDo {
+	for _, _f := range fields {
+		n = *n.withDO(n.DO.Joins(_f))
+	}
+	return &n
+}
+
+func (n notificationDo) Preload(fields ...field.RelationField) INotificationDo {
+	for _, _f := range fields {
+		n = *n.withDO(n.DO.Preload(_f))
+	}
+	return &n
+}
+
+func (n notificationDo) FirstOrInit() (*models.Notification, error) {
+	if result, err := n.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Notification), nil
+	}
+}
+
+func (n notificationDo) FirstOrCreate() (*models.Notification, error) {
+	if result, err := n.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Notification), nil
+	}
+}
+
+func (n notificationDo) FindByPage(offset int, limit int) (result []*models.Notification, count int64, err error) {
+	result, err = n.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = n.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (n notificationDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = n.Count()
+	if err != nil {
+		return
+	}
+
+	err = n.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (n notificationDo) Scan(result interface{}) (err error) {
+	return n.DO.Scan(result)
+}
+
+func (n notificationDo) Delete(models ...*models.Notification) (result gen.ResultInfo, err error) {
+	return n.DO.Delete(models)
+}
+
+func (n *notificationDo) withDO(do gen.Dao) *notificationDo {
+	n.DO = *do.(*gen.DO)
+	return n
+}
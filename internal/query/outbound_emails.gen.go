@@ -0,0 +1,848 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newOutboundEmail(db *gorm.DB, opts ...gen.DOOption) outboundEmail {
+	_outboundEmail := outboundEmail{}
+
+	_outboundEmail.outboundEmailDo.UseDB(db, opts...)
+	_outboundEmail.outboundEmailDo.UseModel(&models.OutboundEmail{})
+
+	tableName := _outboundEmail.outboundEmailDo.TableName()
+	_outboundEmail.ALL = field.NewAsterisk(tableName)
+	_outboundEmail.ID = field.NewInt(tableName, "id")
+	_outboundEmail.CreatedAt = field.NewTime(tableName, "created_at")
+	_outboundEmail.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_outboundEmail.UserID = field.NewInt(tableName, "user_id")
+	_outboundEmail.To = field.NewString(tableName, "recipient")
+	_outboundEmail.Subject = field.NewString(tableName, "subject")
+	_outboundEmail.HTMLBody = field.NewString(tableName, "html_body")
+	_outboundEmail.Template = field.NewString(tableName, "template")
+	_outboundEmail.Status = field.NewString(tableName, "status")
+	_outboundEmail.Attempts = field.NewInt(tableName, "attempts")
+	_outboundEmail.NextAttemptAt = field.NewTime(tableName, "next_attempt_at")
+	_outboundEmail.LastAttemptAt = field.NewTime(tableName, "last_attempt_at")
+	_outboundEmail.SentAt = field.NewTime(tableName, "sent_at")
+	_outboundEmail.Error = field.NewString(tableName, "error")
+	_outboundEmail.User = outboundEmailBelongsToUser{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("User", "models.User"),
+		CurrentProject: struct {
+			field.RelationField
+			Manager struct {
+				field.RelationField
+			}
+			Tasks struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			Sprints struct {
+				field.RelationField
+			}
+			TeamMembers struct {
+				field.RelationField
+			}
+			Members struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("User.CurrentProject", "models.Project"),
+			Manager: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.Manager", "models.User"),
+			},
+			Tasks: struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.Tasks", "models.Task"),
+				Assignee: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.Assignee", "models.User"),
+				},
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.Project", "models.Project"),
+				},
+				Sprint: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint", "models.Sprint"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("User.CurrentProject.Tasks.Sprint.Tasks", "models.Task"),
+					},
+				},
+				Subtasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.Subtasks", "models.Task"),
+				},
+				TaskLabels: struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Tasks.TaskLabels", "models.TaskLabel"),
+					Label: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("User.CurrentProject.Tasks.TaskLabels.Label", "models.Label"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("User.CurrentProject.Tasks.TaskLabels.Label.Project", "models.Project"),
+						},
+					},
+				},
+			},
+			Sprints: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.Sprints", "models.Sprint"),
+			},
+			TeamMembers: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.TeamMembers", "models.User"),
+			},
+			Members: struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("User.CurrentProject.Members", "models.ProjectMember"),
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Members.Project", "models.Project"),
+				},
+				User: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("User.CurrentProject.Members.User", "models.User"),
+				},
+			},
+		},
+		ManagedProjects: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("User.ManagedProjects", "models.Project"),
+		},
+		AssignedTasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("User.AssignedTasks", "models.Task"),
+		},
+	}
+
+	_outboundEmail.fillFieldMap()
+
+	return _outboundEmail
+}
+
+type outboundEmail struct {
+	outboundEmailDo outboundEmailDo
+
+	ALL           field.Asterisk
+	ID            field.Int
+	CreatedAt     field.Time
+	UpdatedAt     field.Time
+	UserID        field.Int
+	To            field.String
+	Subject       field.String
+	HTMLBody      field.String
+	Template      field.String
+	Status        field.String
+	Attempts      field.Int
+	NextAttemptAt field.Time
+	LastAttemptAt field.Time
+	SentAt        field.Time
+	Error         field.String
+	User          outboundEmailBelongsToUser
+
+	fieldMap map[string]field.Expr
+}
+
+func (o outboundEmail) Table(newTableName string) *outboundEmail {
+	o.outboundEmailDo.UseTable(newTableName)
+	return o.updateTableName(newTableName)
+}
+
+func (o outboundEmail) As(alias string) *outboundEmail {
+	o.outboundEmailDo.DO = *(o.outboundEmailDo.As(alias).(*gen.DO))
+	return o.updateTableName(alias)
+}
+
+func (o *outboundEmail) updateTableName(table string) *outboundEmail {
+	o.ALL = field.NewAsterisk(table)
+	o.ID = field.NewInt(table, "id")
+	o.CreatedAt = field.NewTime(table, "created_at")
+	o.UpdatedAt = field.NewTime(table, "updated_at")
+	o.UserID = field.NewInt(table, "user_id")
+	o.To = field.NewString(table, "recipient")
+	o.Subject = field.NewString(table, "subject")
+	o.HTMLBody = field.NewString(table, "html_body")
+	o.Template = field.NewString(table, "template")
+	o.Status = field.NewString(table, "status")
+	o.Attempts = field.NewInt(table, "attempts")
+	o.NextAttemptAt = field.NewTime(table, "next_attempt_at")
+	o.LastAttemptAt = field.NewTime(table, "last_attempt_at")
+	o.SentAt = field.NewTime(table, "sent_at")
+	o.Error = field.NewString(table, "error")
+
+	o.fillFieldMap()
+
+	return o
+}
+
+func (o *outboundEmail) WithContext(ctx context.Context) IOutboundEmailDo {
+	return o.outboundEmailDo.WithContext(ctx)
+}
+
+func (o outboundEmail) TableName() string { return o.outboundEmailDo.TableName() }
+
+func (o outboundEmail) Alias() string { return o.outboundEmailDo.Alias() }
+
+func (o outboundEmail) Columns(cols ...field.Expr) gen.Columns {
+	return o.outboundEmailDo.Columns(cols...)
+}
+
+func (o *outboundEmail) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := o.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (o *outboundEmail) fillFieldMap() {
+	o.fieldMap = make(map[string]field.Expr, 15)
+	o.fieldMap["id"] = o.ID
+	o.fieldMap["created_at"] = o.CreatedAt
+	o.fieldMap["updated_at"] = o.UpdatedAt
+	o.fieldMap["user_id"] = o.UserID
+	o.fieldMap["recipient"] = o.To
+	o.fieldMap["subject"] = o.Subject
+	o.fieldMap["html_body"] = o.HTMLBody
+	o.fieldMap["template"] = o.Template
+	o.fieldMap["status"] = o.Status
+	o.fieldMap["attempts"] = o.Attempts
+	o.fieldMap["next_attempt_at"] = o.NextAttemptAt
+	o.fieldMap["last_attempt_at"] = o.LastAttemptAt
+	o.fieldMap["sent_at"] = o.SentAt
+	o.fieldMap["error"] = o.Error
+
+}
+
+func (o outboundEmail) clone(db *gorm.DB) outboundEmail {
+	o.outboundEmailDo.ReplaceConnPool(db.Statement.ConnPool)
+	return o
+}
+
+func (o outboundEmail) replaceDB(db *gorm.DB) outboundEmail {
+	o.outboundEmailDo.ReplaceDB(db)
+	return o
+}
+
+type outboundEmailBelongsToUser struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	CurrentProject struct {
+		field.RelationField
+		Manager struct {
+			field.RelationField
+		}
+		Tasks struct {
+			field.RelationField
+			Assignee struct {
+				field.RelationField
+			}
+			Project struct {
+				field.RelationField
+			}
+			Sprint struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Tasks struct {
+					field.RelationField
+				}
+			}
+			Subtasks struct {
+				field.RelationField
+			}
+			TaskLabels struct {
+				field.RelationField
+				Label struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+		Sprints struct {
+			field.RelationField
+		}
+		TeamMembers struct {
+			field.RelationField
+		}
+		Members struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+		}
+	}
+	ManagedProjects struct {
+		field.RelationField
+	}
+	AssignedTasks struct {
+		field.RelationField
+	}
+}
+
+func (a outboundEmailBelongsToUser) Where(conds ...field.Expr) *outboundEmailBelongsToUser {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a outboundEmailBelongsToUser) WithContext(ctx context.Context) *outboundEmailBelongsToUser {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a outboundEmailBelongsToUser) Session(session *gorm.Session) *outboundEmailBelongsToUser {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a outboundEmailBelongsToUser) Model(m *models.OutboundEmail) *outboundEmailBelongsToUserTx {
+	return &outboundEmailBelongsToUserTx{a.db.Model(m).Association(a.Name())}
+}
+
+type outboundEmailBelongsToUserTx struct{ tx *gorm.Association }
+
+func (a outboundEmailBelongsToUserTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a outboundEmailBelongsToUserTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a outboundEmailBelongsToUserTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a outboundEmailBelongsToUserTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a outboundEmailBelongsToUserTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a outboundEmailBelongsToUserTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type outboundEmailDo struct{ gen.DO }
+
+type IOutboundEmailDo interface {
+	gen.SubQuery
+	Debug() IOutboundEmailDo
+	WithContext(ctx context.Context) IOutboundEmailDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IOutboundEmailDo
+	WriteDB() IOutboundEmailDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IOutboundEmailDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IOutboundEmailDo
+	Not(conds ...gen.Condition) IOutboundEmailDo
+	Or(conds ...gen.Condition) IOutboundEmailDo
+	Select(conds ...field.Expr) IOutboundEmailDo
+	Where(conds ...gen.Condition) IOutboundEmailDo
+	Order(conds ...field.Expr) IOutboundEmailDo
+	Distinct(cols ...field.Expr) IOutboundEmailDo
+	Omit(cols ...field.Expr) IOutboundEmailDo
+	Join(table schema.Tabler, on ...field.Expr) IOutboundEmailDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IOutboundEmailDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IOutboundEmailDo
+	Group(cols ...field.Expr) IOutboundEmailDo
+	Having(conds ...gen.Condition) IOutboundEmailDo
+	Limit(limit int) IOutboundEmailDo
+	Offset(offset int) IOutboundEmailDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IOutboundEmailDo
+	Unscoped() IOutboundEmailDo
+	Create(values ...*models.OutboundEmail) error
+	CreateInBatches(values []*models.OutboundEmail, batchSize int) error
+	Save(values ...*models.OutboundEmail) error
+	First() (*models.OutboundEmail, error)
+	Take() (*models.OutboundEmail, error)
+	Last() (*models.OutboundEmail, error)
+	Find() ([]*models.OutboundEmail, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.OutboundEmail, err error)
+	FindInBatches(result *[]*models.OutboundEmail, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.OutboundEmail) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IOutboundEmailDo
+	Assign(attrs ...field.AssignExpr) IOutboundEmailDo
+	Joins(fields ...field.RelationField) IOutboundEmailDo
+	Preload(fields ...field.RelationField) IOutboundEmailDo
+	FirstOrInit() (*models.OutboundEmail, error)
+	FirstOrCreate() (*models.OutboundEmail, error)
+	FindByPage(offset int, limit int) (result []*models.OutboundEmail, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IOutboundEmailDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (o outboundEmailDo) Debug() IOutboundEmailDo {
+	return o.withDO(o.DO.Debug())
+}
+
+func (o outboundEmailDo) WithContext(ctx context.Context) IOutboundEmailDo {
+	return o.withDO(o.DO.WithContext(ctx))
+}
+
+func (o outboundEmailDo) ReadDB() IOutboundEmailDo {
+	return o.Clauses(dbresolver.Read)
+}
+
+func (o outboundEmailDo) WriteDB() IOutboundEmailDo {
+	return o.Clauses(dbresolver.Write)
+}
+
+func (o outboundEmailDo) Session(config *gorm.Session) IOutboundEmailDo {
+	return o.withDO(o.DO.Session(config))
+}
+
+func (o outboundEmailDo) Clauses(conds ...clause.Expression) IOutboundEmailDo {
+	return o.withDO(o.DO.Clauses(conds...))
+}
+
+func (o outboundEmailDo) Returning(value interface{}, columns ...string) IOutboundEmailDo {
+	return o.withDO(o.DO.Returning(value, columns...))
+}
+
+func (o outboundEmailDo) Not(conds ...gen.Condition) IOutboundEmailDo {
+	return o.withDO(o.DO.Not(conds...))
+}
+
+func (o outboundEmailDo) Or(conds ...gen.Condition) IOutboundEmailDo {
+	return o.withDO(o.DO.Or(conds...))
+}
+
+func (o outboundEmailDo) Select(conds ...field.Expr) IOutboundEmailDo {
+	return o.withDO(o.DO.Select(conds...))
+}
+
+func (o outboundEmailDo) Where(conds ...gen.Condition) IOutboundEmailDo {
+	return o.withDO(o.DO.Where(conds...))
+}
+
+func (o outboundEmailDo) Order(conds ...field.Expr) IOutboundEmailDo {
+	return o.withDO(o.DO.Order(conds...))
+}
+
+func (o outboundEmailDo) Distinct(cols ...field.Expr) IOutboundEmailDo {
+	return o.withDO(o.DO.Distinct(cols...))
+}
+
+func (o outboundEmailDo) Omit(cols ...field.Expr) IOutboundEmailDo {
+	return o.withDO(o.DO.Omit(cols...))
+}
+
+func (o outboundEmailDo) Join(table schema.Tabler, on ...field.Expr) IOutboundEmailDo {
+	return o.withDO(o.DO.Join(table, on...))
+}
+
+func (o outboundEmailDo) LeftJoin(table schema.Tabler, on ...field.Expr) IOutboundEmailDo {
+	return o.withDO(o.DO.LeftJoin(table, on...))
+}
+
+func (o outboundEmailDo) RightJoin(table schema.Tabler, on ...field.Expr) IOutboundEmailDo {
+	return o.withDO(o.DO.RightJoin(table, on...))
+}
+
+func (o outboundEmailDo) Group(cols ...field.Expr) IOutboundEmailDo {
+	return o.withDO(o.DO.Group(cols...))
+}
+
+func (o outboundEmailDo) Having(conds ...gen.Condition) IOutboundEmailDo {
+	return o.withDO(o.DO.Having(conds...))
+}
+
+func (o outboundEmailDo) Limit(limit int) IOutboundEmailDo {
+	return o.withDO(o.DO.Limit(limit))
+}
+
+func (o outboundEmailDo) Offset(offset int) IOutboundEmailDo {
+	return o.withDO(o.DO.Offset(offset))
+}
+
+func (o outboundEmailDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IOutboundEmailDo {
+	return o.withDO(o.DO.Scopes(funcs...))
+}
+
+func (o outboundEmailDo) Unscoped() IOutboundEmailDo {
+	return o.withDO(o.DO.Unscoped())
+}
+
+func (o outboundEmailDo) Create(values ...*models.OutboundEmail) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return o.DO.Create(values)
+}
+
+func (o outboundEmailDo) CreateInBatches(values []*models.OutboundEmail, batchSize int) error {
+	return o.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (o outboundEmailDo) Save(values ...*models.OutboundEmail) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return o.DO.Save(values)
+}
+
+func (o outboundEmailDo) First() (*models.OutboundEmail, error) {
+	if result, err := o.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.OutboundEmail), nil
+	}
+}
+
+func (o outboundEmailDo) Take() (*models.OutboundEmail, error) {
+	if result, err := o.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.OutboundEmail), nil
+	}
+}
+
+func (o outboundEmailDo) Last() (*models.OutboundEmail, error) {
+	if result, err := o.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.OutboundEmail), nil
+	}
+}
+
+func (o outboundEmailDo) Find() ([]*models.OutboundEmail, error) {
+	result, err := o.DO.Find()
+	return result.([]*models.OutboundEmail), err
+}
+
+func (o outboundEmailDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.OutboundEmail, err error) {
+	buf := make([]*models.OutboundEmail, 0, batchSize)
+	err = o.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (o outboundEmailDo) FindInBatches(result *[]*models.OutboundEmail, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return o.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (o outboundEmailDo) Attrs(attrs ...field.AssignExpr) IOutboundEmailDo {
+	return o.withDO(o.DO.Attrs(attrs...))
+}
+
+func (o outboundEmailDo) Assign(attrs ...field.AssignExpr) IOutboundEmailDo {
+	return o.withDO(o.DO.Assign(attrs...))
+}
+
+func (o outboundEmailDo) Joins(fields ...field.RelationField) IOutboundEmailDo {
+	for _, _f := range fields {
+		o = *o.withDO(o.DO.Joins(_f))
+	}
+	return &o
+}
+
+func (o outboundEmailDo) Preload(fields ...field.RelationField) IOutboundEmailDo {
+	for _, _f := range fields {
+		o = *o.withDO(o.DO.Preload(_f))
+	}
+	return &o
+}
+
+func (o outboundEmailDo) FirstOrInit() (*models.OutboundEmail, error) {
+	if result, err := o.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.OutboundEmail), nil
+	}
+}
+
+func (o outboundEmailDo) FirstOrCreate() (*models.OutboundEmail, error) {
+	if result, err := o.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.OutboundEmail), nil
+	}
+}
+
+func (o outboundEmailDo) FindByPage(offset int, limit int) (result []*models.OutboundEmail, count int64, err error) {
+	result, err = o.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = o.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (o outboundEmailDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = o.Count()
+	if err != nil {
+		return
+	}
+
+	err = o.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (o outboundEmailDo) Scan(result interface{}) (err error) {
+	return o.DO.Scan(result)
+}
+
+func (o outboundEmailDo) Delete(models ...*models.OutboundEmail) (result gen.ResultInfo, err error) {
+	return o.DO.Delete(models)
+}
+
+func (o *outboundEmailDo) withDO(do gen.Dao) *outboundEmailDo {
+	o.DO = *do.(*gen.DO)
+	return o
+}
@@ -0,0 +1,883 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newProjectMember(db *gorm.DB, opts ...gen.DOOption) projectMember {
+	_projectMember := projectMember{}
+
+	_projectMember.projectMemberDo.UseDB(db, opts...)
+	_projectMember.projectMemberDo.UseModel(&models.ProjectMember{})
+
+	tableName := _projectMember.projectMemberDo.TableName()
+	_projectMember.ALL = field.NewAsterisk(tableName)
+	_projectMember.ID = field.NewInt(tableName, "id")
+	_projectMember.CreatedAt = field.NewTime(tableName, "created_at")
+	_projectMember.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_projectMember.ProjectID = field.NewInt(tableName, "project_id")
+	_projectMember.UserID = field.NewInt(tableName, "user_id")
+	_projectMember.Role = field.NewString(tableName, "role")
+	_projectMember.Project = projectMemberBelongsToProject{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Project", "models.Project"),
+		Manager: struct {
+			field.RelationField
+			CurrentProject struct {
+				field.RelationField
+			}
+			ManagedProjects struct {
+				field.RelationField
+			}
+			AssignedTasks struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Project.Manager", "models.User"),
+			CurrentProject: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Manager.CurrentProject", "models.Project"),
+			},
+			ManagedProjects: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Manager.ManagedProjects", "models.Project"),
+			},
+			AssignedTasks: struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Project.Manager.AssignedTasks", "models.Task"),
+				Assignee: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Assignee", "models.User"),
+				},
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Project", "models.Project"),
+				},
+				Sprint: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint", "models.Sprint"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Tasks", "models.Task"),
+					},
+				},
+				Subtasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Subtasks", "models.Task"),
+				},
+				TaskLabels: struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels", "models.TaskLabel"),
+					Label: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels.Label", "models.Label"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels.Label.Project", "models.Project"),
+						},
+					},
+				},
+			},
+		},
+		Tasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.Tasks", "models.Task"),
+		},
+		Sprints: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.Sprints", "models.Sprint"),
+		},
+		TeamMembers: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.TeamMembers", "models.User"),
+		},
+		Members: struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Project.Members", "models.ProjectMember"),
+			Project: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Members.Project", "models.Project"),
+			},
+			User: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Members.User", "models.User"),
+			},
+		},
+	}
+
+	_projectMember.User = projectMemberBelongsToUser{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("User", "models.User"),
+	}
+
+	_projectMember.fillFieldMap()
+
+	return _projectMember
+}
+
+type projectMember struct {
+	projectMemberDo projectMemberDo
+
+	ALL       field.Asterisk
+	ID        field.Int
+	CreatedAt field.Time
+	UpdatedAt field.Time
+	ProjectID field.Int
+	UserID    field.Int
+	Role      field.String
+	Project   projectMemberBelongsToProject
+
+	User projectMemberBelongsToUser
+
+	fieldMap map[string]field.Expr
+}
+
+func (p projectMember) Table(newTableName string) *projectMember {
+	p.projectMemberDo.UseTable(newTableName)
+	return p.updateTableName(newTableName)
+}
+
+func (p projectMember) As(alias string) *projectMember {
+	p.projectMemberDo.DO = *(p.projectMemberDo.As(alias).(*gen.DO))
+	return p.updateTableName(alias)
+}
+
+func (p *projectMember) updateTableName(table string) *projectMember {
+	p.ALL = field.NewAsterisk(table)
+	p.ID = field.NewInt(table, "id")
+	p.CreatedAt = field.NewTime(table, "created_at")
+	p.UpdatedAt = field.NewTime(table, "updated_at")
+	p.ProjectID = field.NewInt(table, "project_id")
+	p.UserID = field.NewInt(table, "user_id")
+	p.Role = field.NewString(table, "role")
+
+	p.fillFieldMap()
+
+	return p
+}
+
+func (p *projectMember) WithContext(ctx context.Context) IProjectMemberDo {
+	return p.projectMemberDo.WithContext(ctx)
+}
+
+func (p projectMember) TableName() string { return p.projectMemberDo.TableName() }
+
+func (p projectMember) Alias() string { return p.projectMemberDo.Alias() }
+
+func (p projectMember) Columns(cols ...field.Expr) gen.Columns {
+	return p.projectMemberDo.Columns(cols...)
+}
+
+func (p *projectMember) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := p.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (p *projectMember) fillFieldMap() {
+	p.fieldMap = make(map[string]field.Expr, 8)
+	p.fieldMap["id"] = p.ID
+	p.fieldMap["created_at"] = p.CreatedAt
+	p.fieldMap["updated_at"] = p.UpdatedAt
+	p.fieldMap["project_id"] = p.ProjectID
+	p.fieldMap["user_id"] = p.UserID
+	p.fieldMap["role"] = p.Role
+
+}
+
+func (p projectMember) clone(db *gorm.DB) projectMember {
+	p.projectMemberDo.ReplaceConnPool(db.Statement.ConnPool)
+	return p
+}
+
+func (p projectMember) replaceDB(db *gorm.DB) projectMember {
+	p.projectMemberDo.ReplaceDB(db)
+	return p
+}
+
+type projectMemberBelongsToProject struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Manager struct {
+		field.RelationField
+		CurrentProject struct {
+			field.RelationField
+		}
+		ManagedProjects struct {
+			field.RelationField
+		}
+		AssignedTasks struct {
+			field.RelationField
+			Assignee struct {
+				field.RelationField
+			}
+			Project struct {
+				field.RelationField
+			}
+			Sprint struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Tasks struct {
+					field.RelationField
+				}
+			}
+			Subtasks struct {
+				field.RelationField
+			}
+			TaskLabels struct {
+				field.RelationField
+				Label struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+	}
+	Tasks struct {
+		field.RelationField
+	}
+	Sprints struct {
+		field.RelationField
+	}
+	TeamMembers struct {
+		field.RelationField
+	}
+	Members struct {
+		field.RelationField
+		Project struct {
+			field.RelationField
+		}
+		User struct {
+			field.RelationField
+		}
+	}
+}
+
+func (a projectMemberBelongsToProject) Where(conds ...field.Expr) *projectMemberBelongsToProject {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a projectMemberBelongsToProject) WithContext(ctx context.Context) *projectMemberBelongsToProject {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a projectMemberBelongsToProject) Session(session *gorm.Session) *projectMemberBelongsToProject {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a projectMemberBelongsToProject) Model(m *models.ProjectMember) *projectMemberBelongsToProjectTx {
+	return &projectMemberBelongsToProjectTx{a.db.Model(m).Association(a.Name())}
+}
+
+type projectMemberBelongsToProjectTx struct{ tx *gorm.Association }
+
+func (a projectMemberBelongsToProjectTx) Find() (result *models.Project, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a projectMemberBelongsToProjectTx) Append(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a projectMemberBelongsToProjectTx) Replace(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a projectMemberBelongsToProjectTx) Delete(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a projectMemberBelongsToProjectTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a projectMemberBelongsToProjectTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type projectMemberBelongsToUser struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a projectMemberBelongsToUser) Where(conds ...field.Expr) *projectMemberBelongsToUser {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a projectMemberBelongsToUser) WithContext(ctx context.Context) *projectMemberBelongsToUser {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a projectMemberBelongsToUser) Session(session *gorm.Session) *projectMemberBelongsToUser {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a projectMemberBelongsToUser) Model(m *models.ProjectMember) *projectMemberBelongsToUserTx {
+	return &projectMemberBelongsToUserTx{a.db.Model(m).Association(a.Name())}
+}
+
+type projectMemberBelongsToUserTx struct{ tx *gorm.Association }
+
+func (a projectMemberBelongsToUserTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a projectMemberBelongsToUserTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a projectMemberBelongsToUserTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a projectMemberBelongsToUserTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a projectMemberBelongsToUserTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a projectMemberBelongsToUserTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type projectMemberDo struct{ gen.DO }
+
+type IProjectMemberDo interface {
+	gen.SubQuery
+	Debug() IProjectMemberDo
+	WithContext(ctx context.Context) IProjectMemberDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IProjectMemberDo
+	WriteDB() IProjectMemberDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IProjectMemberDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IProjectMemberDo
+	Not(conds ...gen.Condition) IProjectMemberDo
+	Or(conds ...gen.Condition) IProjectMemberDo
+	Select(conds ...field.Expr) IProjectMemberDo
+	Where(conds ...gen.Condition) IProjectMemberDo
+	Order(conds ...field.Expr) IProjectMemberDo
+	Distinct(cols ...field.Expr) IProjectMemberDo
+	Omit(cols ...field.Expr) IProjectMemberDo
+	Join(table schema.Tabler, on ...field.Expr) IProjectMemberDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IProjectMemberDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IProjectMemberDo
+	Group(cols ...field.Expr) IProjectMemberDo
+	Having(conds ...gen.Condition) IProjectMemberDo
+	Limit(limit int) IProjectMemberDo
+	Offset(offset int) IProjectMemberDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IProjectMemberDo
+	Unscoped() IProjectMemberDo
+	Create(values ...*models.ProjectMember) error
+	CreateInBatches(values []*models.ProjectMember, batchSize int) error
+	Save(values ...*models.ProjectMember) error
+	First() (*models.ProjectMember, error)
+	Take() (*models.ProjectMember, error)
+	Last() (*models.ProjectMember, error)
+	Find() ([]*models.ProjectMember, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.ProjectMember, err error)
+	FindInBatches(result *[]*models.ProjectMember, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.ProjectMember) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IProjectMemberDo
+	Assign(attrs ...field.AssignExpr) IProjectMemberDo
+	Joins(fields ...field.RelationField) IProjectMemberDo
+	Preload(fields ...field.RelationField) IProjectMemberDo
+	FirstOrInit() (*models.ProjectMember, error)
+	FirstOrCreate() (*models.ProjectMember, error)
+	FindByPage(offset int, limit int) (result []*models.ProjectMember, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IProjectMemberDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (p projectMemberDo) Debug() IProjectMemberDo {
+	return p.withDO(p.DO.Debug())
+}
+
+func (p projectMemberDo) WithContext(ctx context.Context) IProjectMemberDo {
+	return p.withDO(p.DO.WithContext(ctx))
+}
+
+func (p projectMemberDo) ReadDB() IProjectMemberDo {
+	return p.Clauses(dbresolver.Read)
+}
+
+func (p projectMemberDo) WriteDB() IProjectMemberDo {
+	return p.Clauses(dbresolver.Write)
+}
+
+func (p projectMemberDo) Session(config *gorm.Session) IProjectMemberDo {
+	return p.withDO(p.DO.Session(config))
+}
+
+func (p projectMemberDo) Clauses(conds ...clause.Expression) IProjectMemberDo {
+	return p.withDO(p.DO.Clauses(conds...))
+}
+
+func (p projectMemberDo) Returning(value interface{}, columns ...string) IProjectMemberDo {
+	return p.withDO(p.DO.Returning(value, columns...))
+}
+
+func (p projectMemberDo) Not(conds ...gen.Condition) IProjectMemberDo {
+	return p.withDO(p.DO.Not(conds...))
+}
+
+func (p projectMemberDo) Or(conds ...gen.Condition) IProjectMemberDo {
+	return p.withDO(p.DO.Or(conds...))
+}
+
+func (p projectMemberDo) Select(conds ...field.Expr) IProjectMemberDo {
+	return p.withDO(p.DO.Select(conds...))
+}
+
+func (p projectMemberDo) Where(conds ...gen.Condition) IProjectMemberDo {
+	return p.withDO(p.DO.Where(conds...))
+}
+
+func (p projectMemberDo) Order(conds ...field.Expr) IProjectMemberDo {
+	return p.withDO(p.DO.Order(conds...))
+}
+
+func (p projectMemberDo) Distinct(cols ...field.Expr) IProjectMemberDo {
+	return p.withDO(p.DO.Distinct(cols...))
+}
+
+func (p projectMemberDo) Omit(cols ...field.Expr) IProjectMemberDo {
+	return p.withDO(p.DO.Omit(cols...))
+}
+
+func (p projectMemberDo) Join(table schema.Tabler, on ...field.Expr) IProjectMemberDo {
+	return p.withDO(p.DO.Join(table, on...))
+}
+
+func (p projectMemberDo) LeftJoin(table schema.Tabler, on ...field.Expr) IProjectMemberDo {
+	return p.withDO(p.DO.LeftJoin(table, on...))
+}
+
+func (p projectMemberDo) RightJoin(table schema.Tabler, on ...field.Expr) IProjectMemberDo {
+	return p.withDO(p.DO.RightJoin(table, on...))
+}
+
+func (p projectMemberDo) Group(cols ...field.Expr) IProjectMemberDo {
+	return p.withDO(p.DO.Group(cols...))
+}
+
+func (p projectMemberDo) Having(conds ...gen.Condition) IProjectMemberDo {
+	return p.withDO(p.DO.Having(conds...))
+}
+
+func (p projectMemberDo) Limit(limit int) IProjectMemberDo {
+	return p.withDO(p.DO.Limit(limit))
+}
+
+func (p projectMemberDo) Offset(offset int) IProjectMemberDo {
+	return p.withDO(p.DO.Offset(offset))
+}
+
+func (p projectMemberDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IProjectMemberDo {
+	return p.withDO(p.DO.Scopes(funcs...))
+}
+
+func (p projectMemberDo) Unscoped() IProjectMemberDo {
+	return p.withDO(p.DO.Unscoped())
+}
+
+func (p projectMemberDo) Create(values ...*models.ProjectMember) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return p.DO.Create(values)
+}
+
+func (p projectMemberDo) CreateInBatches(values []*models.ProjectMember, batchSize int) error {
+	return p.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (p projectMemberDo) Save(values ...*models.ProjectMember) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return p.DO.Save(values)
+}
+
+func (p projectMemberDo) First() (*models.ProjectMember, error) {
+	if result, err := p.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ProjectMember), nil
+	}
+}
+
+func (p projectMemberDo) Take() (*models.ProjectMember, error) {
+	if result, err := p.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ProjectMember), nil
+	}
+}
+
+func (p projectMemberDo) Last() (*models.ProjectMember, error) {
+	if result, err := p.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ProjectMember), nil
+	}
+}
+
+func (p projectMemberDo) Find() ([]*models.ProjectMember, error) {
+	result, err := p.DO.Find()
+	return result.([]*models.ProjectMember), err
+}
+
+func (p projectMemberDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.ProjectMember, err error) {
+	buf := make([]*models.ProjectMember, 0, batchSize)
+	err = p.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (p projectMemberDo) FindInBatches(result *[]*models.ProjectMember, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return p.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (p projectMemberDo) Attrs(attrs ...field.AssignExpr) IProjectMemberDo {
+	return p.withDO(p.DO.Attrs(attrs...))
+}
+
+func (p projectMemberDo) Assign(attrs ...field.AssignExpr) IProjectMemberDo {
+	return p.withDO(p.DO.Assign(attrs...))
+}
+
+func (p projectMemberDo) Joins(fields ...field.RelationField) IProjectMemberDo {
+	for _, _f := range fields {
+		p = *p.withDO(p.DO.Joins(_f))
+	}
+	return &p
+}
+
+func (p projectMemberDo) Preload(fields ...field.RelationField) IProjectMemberDo {
+	for _, _f := range fields {
+		p = *p.withDO(p.DO.Preload(_f))
+	}
+	return &p
+}
+
+func (p projectMemberDo) FirstOrInit() (*models.ProjectMember, error) {
+	if result, err := p.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ProjectMember), nil
+	}
+}
+
+func (p projectMemberDo) FirstOrCreate() (*models.ProjectMember, error) {
+	if result, err := p.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.ProjectMember), nil
+	}
+}
+
+func (p projectMemberDo) FindByPage(offset int, limit int) (result []*models.ProjectMember, count int64, err error) {
+	result, err = p.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = p.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (p projectMemberDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = p.Count()
+	if err != nil {
+		return
+	}
+
+	err = p.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (p projectMemberDo) Scan(result interface{}) (err error) {
+	return p.DO.Scan(result)
+}
+
+func (p projectMemberDo) Delete(models ...*models.ProjectMember) (result gen.ResultInfo, err error) {
+	return p.DO.Delete(models)
+}
+
+func (p *projectMemberDo) withDO(do gen.Dao) *projectMemberDo {
+	p.DO = *do.(*gen.DO)
+	return p
+}
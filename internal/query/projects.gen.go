@@ -56,6 +56,18 @@ func newProject(db *gorm.DB, opts ...gen.DOOption) project {
 					Project struct {
 						field.RelationField
 					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
 					Tasks struct {
 						field.RelationField
 					}
@@ -63,6 +75,15 @@ func newProject(db *gorm.DB, opts ...gen.DOOption) project {
 				TeamMembers struct {
 					field.RelationField
 				}
+				Members struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}
 			}
 			ManagedProjects struct {
 				field.RelationField
@@ -85,6 +106,18 @@ func newProject(db *gorm.DB, opts ...gen.DOOption) project {
 					Project struct {
 						field.RelationField
 					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
 					Tasks struct {
 						field.RelationField
 					}
@@ -92,6 +125,15 @@ func newProject(db *gorm.DB, opts ...gen.DOOption) project {
 				TeamMembers struct {
 					field.RelationField
 				}
+				Members struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}
 			}{
 				RelationField: field.NewRelation("Tasks.Assignee.CurrentProject", "models.Project"),
 				Manager: struct {
@@ -109,6 +151,18 @@ func newProject(db *gorm.DB, opts ...gen.DOOption) project {
 					Project struct {
 						field.RelationField
 					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
 					Tasks struct {
 						field.RelationField
 					}
@@ -119,6 +173,35 @@ func newProject(db *gorm.DB, opts ...gen.DOOption) project {
 					}{
 						RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.Sprints.Project", "models.Project"),
 					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.Sprints.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.Sprints.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.Sprints.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.Sprints.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
 					Tasks: struct {
 						field.RelationField
 					}{
@@ -130,6 +213,27 @@ func newProject(db *gorm.DB, opts ...gen.DOOption) project {
 				}{
 					RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.TeamMembers", "models.User"),
 				},
+				Members: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.Members", "models.ProjectMember"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.Members.Project", "models.Project"),
+					},
+					User: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.Members.User", "models.User"),
+					},
+				},
 			},
 			ManagedProjects: struct {
 				field.RelationField
@@ -152,6 +256,35 @@ func newProject(db *gorm.DB, opts ...gen.DOOption) project {
 		}{
 			RelationField: field.NewRelation("Tasks.Sprint", "models.Sprint"),
 		},
+		Subtasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Tasks.Subtasks", "models.Task"),
+		},
+		TaskLabels: struct {
+			field.RelationField
+			Label struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Tasks.TaskLabels", "models.TaskLabel"),
+			Label: struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Tasks.TaskLabels.Label", "models.Label"),
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Tasks.TaskLabels.Label.Project", "models.Project"),
+				},
+			},
+		},
 	}
 
 	_project.Sprints = projectHasManySprints{
@@ -166,6 +299,12 @@ func newProject(db *gorm.DB, opts ...gen.DOOption) project {
 		RelationField: field.NewRelation("TeamMembers", "models.User"),
 	}
 
+	_project.Members = projectHasManyMembers{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Members", "models.ProjectMember"),
+	}
+
 	_project.Manager = projectBelongsToManager{
 		db: db.Session(&gorm.Session{}),
 
@@ -197,6 +336,8 @@ type project struct {
 
 	TeamMembers projectHasManyTeamMembers
 
+	Members projectHasManyMembers
+
 	Manager projectBelongsToManager
 
 	fieldMap map[string]field.Expr
@@ -248,7 +389,7 @@ func (p *project) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (p *project) fillFieldMap() {
-	p.fieldMap = make(map[string]field.Expr, 14)
+	p.fieldMap = make(map[string]field.Expr, 15)
 	p.fieldMap["id"] = p.ID
 	p.fieldMap["created_at"] = p.CreatedAt
 	p.fieldMap["updated_at"] = p.UpdatedAt
@@ -292,6 +433,18 @@ type projectHasManyTasks struct {
 				Project struct {
 					field.RelationField
 				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
 				Tasks struct {
 					field.RelationField
 				}
@@ -299,6 +452,15 @@ type projectHasManyTasks struct {
 			TeamMembers struct {
 				field.RelationField
 			}
+			Members struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}
 		}
 		ManagedProjects struct {
 			field.RelationField
@@ -313,6 +475,18 @@ type projectHasManyTasks struct {
 	Sprint struct {
 		field.RelationField
 	}
+	Subtasks struct {
+		field.RelationField
+	}
+	TaskLabels struct {
+		field.RelationField
+		Label struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+		}
+	}
 }
 
 func (a projectHasManyTasks) Where(conds ...field.Expr) *projectHasManyTasks {
@@ -522,6 +696,77 @@ func (a projectHasManyTeamMembersTx) Count() int64 {
 	return a.tx.Count()
 }
 
+type projectHasManyMembers struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a projectHasManyMembers) Where(conds ...field.Expr) *projectHasManyMembers {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a projectHasManyMembers) WithContext(ctx context.Context) *projectHasManyMembers {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a projectHasManyMembers) Session(session *gorm.Session) *projectHasManyMembers {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a projectHasManyMembers) Model(m *models.Project) *projectHasManyMembersTx {
+	return &projectHasManyMembersTx{a.db.Model(m).Association(a.Name())}
+}
+
+type projectHasManyMembersTx struct{ tx *gorm.Association }
+
+func (a projectHasManyMembersTx) Find() (result []*models.ProjectMember, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a projectHasManyMembersTx) Append(values ...*models.ProjectMember) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a projectHasManyMembersTx) Replace(values ...*models.ProjectMember) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a projectHasManyMembersTx) Delete(values ...*models.ProjectMember) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a projectHasManyMembersTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a projectHasManyMembersTx) Count() int64 {
+	return a.tx.Count()
+}
+
 type projectBelongsToManager struct {
 	db *gorm.DB
 
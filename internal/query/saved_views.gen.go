@@ -0,0 +1,897 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newSavedView(db *gorm.DB, opts ...gen.DOOption) savedView {
+	_savedView := savedView{}
+
+	_savedView.savedViewDo.UseDB(db, opts...)
+	_savedView.savedViewDo.UseModel(&models.SavedView{})
+
+	tableName := _savedView.savedViewDo.TableName()
+	_savedView.ALL = field.NewAsterisk(tableName)
+	_savedView.ID = field.NewInt(tableName, "id")
+	_savedView.CreatedAt = field.NewTime(tableName, "created_at")
+	_savedView.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_savedView.OwnerID = field.NewInt(tableName, "owner_id")
+	_savedView.ProjectID = field.NewInt(tableName, "project_id")
+	_savedView.Name = field.NewString(tableName, "name")
+	_savedView.Filter = field.NewString(tableName, "filter")
+	_savedView.Owner = savedViewBelongsToOwner{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Owner", "models.User"),
+		CurrentProject: struct {
+			field.RelationField
+			Manager struct {
+				field.RelationField
+			}
+			Tasks struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			Sprints struct {
+				field.RelationField
+			}
+			TeamMembers struct {
+				field.RelationField
+			}
+			Members struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Owner.CurrentProject", "models.Project"),
+			Manager: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Owner.CurrentProject.Manager", "models.User"),
+			},
+			Tasks: struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Owner.CurrentProject.Tasks", "models.Task"),
+				Assignee: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Owner.CurrentProject.Tasks.Assignee", "models.User"),
+				},
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Owner.CurrentProject.Tasks.Project", "models.Project"),
+				},
+				Sprint: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Owner.CurrentProject.Tasks.Sprint", "models.Sprint"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Owner.CurrentProject.Tasks.Sprint.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Owner.CurrentProject.Tasks.Sprint.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Owner.CurrentProject.Tasks.Sprint.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Owner.CurrentProject.Tasks.Sprint.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Owner.CurrentProject.Tasks.Sprint.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Owner.CurrentProject.Tasks.Sprint.Tasks", "models.Task"),
+					},
+				},
+				Subtasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Owner.CurrentProject.Tasks.Subtasks", "models.Task"),
+				},
+				TaskLabels: struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Owner.CurrentProject.Tasks.TaskLabels", "models.TaskLabel"),
+					Label: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Owner.CurrentProject.Tasks.TaskLabels.Label", "models.Label"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Owner.CurrentProject.Tasks.TaskLabels.Label.Project", "models.Project"),
+						},
+					},
+				},
+			},
+			Sprints: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Owner.CurrentProject.Sprints", "models.Sprint"),
+			},
+			TeamMembers: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Owner.CurrentProject.TeamMembers", "models.User"),
+			},
+			Members: struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Owner.CurrentProject.Members", "models.ProjectMember"),
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Owner.CurrentProject.Members.Project", "models.Project"),
+				},
+				User: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Owner.CurrentProject.Members.User", "models.User"),
+				},
+			},
+		},
+		ManagedProjects: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Owner.ManagedProjects", "models.Project"),
+		},
+		AssignedTasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Owner.AssignedTasks", "models.Task"),
+		},
+	}
+
+	_savedView.Project = savedViewBelongsToProject{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Project", "models.Project"),
+	}
+
+	_savedView.fillFieldMap()
+
+	return _savedView
+}
+
+type savedView struct {
+	savedViewDo savedViewDo
+
+	ALL       field.Asterisk
+	ID        field.Int
+	CreatedAt field.Time
+	UpdatedAt field.Time
+	OwnerID   field.Int
+	ProjectID field.Int
+	Name      field.String
+	Filter    field.String
+	Owner     savedViewBelongsToOwner
+
+	Project savedViewBelongsToProject
+
+	fieldMap map[string]field.Expr
+}
+
+func (s savedView) Table(newTableName string) *savedView {
+	s.savedViewDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s savedView) As(alias string) *savedView {
+	s.savedViewDo.DO = *(s.savedViewDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *savedView) updateTableName(table string) *savedView {
+	s.ALL = field.NewAsterisk(table)
+	s.ID = field.NewInt(table, "id")
+	s.CreatedAt = field.NewTime(table, "created_at")
+	s.UpdatedAt = field.NewTime(table, "updated_at")
+	s.OwnerID = field.NewInt(table, "owner_id")
+	s.ProjectID = field.NewInt(table, "project_id")
+	s.Name = field.NewString(table, "name")
+	s.Filter = field.NewString(table, "filter")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *savedView) WithContext(ctx context.Context) ISavedViewDo {
+	return s.savedViewDo.WithContext(ctx)
+}
+
+func (s savedView) TableName() string { return s.savedViewDo.TableName() }
+
+func (s savedView) Alias() string { return s.savedViewDo.Alias() }
+
+func (s savedView) Columns(cols ...field.Expr) gen.Columns { return s.savedViewDo.Columns(cols...) }
+
+func (s *savedView) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *savedView) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 9)
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["created_at"] = s.CreatedAt
+	s.fieldMap["updated_at"] = s.UpdatedAt
+	s.fieldMap["owner_id"] = s.OwnerID
+	s.fieldMap["project_id"] = s.ProjectID
+	s.fieldMap["name"] = s.Name
+	s.fieldMap["filter"] = s.Filter
+
+}
+
+func (s savedView) clone(db *gorm.DB) savedView {
+	s.savedViewDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s savedView) replaceDB(db *gorm.DB) savedView {
+	s.savedViewDo.ReplaceDB(db)
+	return s
+}
+
+type savedViewBelongsToOwner struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	CurrentProject struct {
+		field.RelationField
+		Manager struct {
+			field.RelationField
+		}
+		Tasks struct {
+			field.RelationField
+			Assignee struct {
+				field.RelationField
+			}
+			Project struct {
+				field.RelationField
+			}
+			Sprint struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Tasks struct {
+					field.RelationField
+				}
+			}
+			Subtasks struct {
+				field.RelationField
+			}
+			TaskLabels struct {
+				field.RelationField
+				Label struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+		Sprints struct {
+			field.RelationField
+		}
+		TeamMembers struct {
+			field.RelationField
+		}
+		Members struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+		}
+	}
+	ManagedProjects struct {
+		field.RelationField
+	}
+	AssignedTasks struct {
+		field.RelationField
+	}
+}
+
+func (a savedViewBelongsToOwner) Where(conds ...field.Expr) *savedViewBelongsToOwner {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a savedViewBelongsToOwner) WithContext(ctx context.Context) *savedViewBelongsToOwner {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a savedViewBelongsToOwner) Session(session *gorm.Session) *savedViewBelongsToOwner {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a savedViewBelongsToOwner) Model(m *models.SavedView) *savedViewBelongsToOwnerTx {
+	return &savedViewBelongsToOwnerTx{a.db.Model(m).Association(a.Name())}
+}
+
+type savedViewBelongsToOwnerTx struct{ tx *gorm.Association }
+
+func (a savedViewBelongsToOwnerTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a savedViewBelongsToOwnerTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a savedViewBelongsToOwnerTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a savedViewBelongsToOwnerTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a savedViewBelongsToOwnerTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a savedViewBelongsToOwnerTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type savedViewBelongsToProject struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a savedViewBelongsToProject) Where(conds ...field.Expr) *savedViewBelongsToProject {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a savedViewBelongsToProject) WithContext(ctx context.Context) *savedViewBelongsToProject {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a savedViewBelongsToProject) Session(session *gorm.Session) *savedViewBelongsToProject {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a savedViewBelongsToProject) Model(m *models.SavedView) *savedViewBelongsToProjectTx {
+	return &savedViewBelongsToProjectTx{a.db.Model(m).Association(a.Name())}
+}
+
+type savedViewBelongsToProjectTx struct{ tx *gorm.Association }
+
+func (a savedViewBelongsToProjectTx) Find() (result *models.Project, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a savedViewBelongsToProjectTx) Append(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a savedViewBelongsToProjectTx) Replace(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a savedViewBelongsToProjectTx) Delete(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a savedViewBelongsToProjectTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a savedViewBelongsToProjectTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type savedViewDo struct{ gen.DO }
+
+type ISavedViewDo interface {
+	gen.SubQuery
+	Debug() ISavedViewDo
+	WithContext(ctx context.Context) ISavedViewDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ISavedViewDo
+	WriteDB() ISavedViewDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ISavedViewDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ISavedViewDo
+	Not(conds ...gen.Condition) ISavedViewDo
+	Or(conds ...gen.Condition) ISavedViewDo
+	Select(conds ...field.Expr) ISavedViewDo
+	Where(conds ...gen.Condition) ISavedViewDo
+	Order(conds ...field.Expr) ISavedViewDo
+	Distinct(cols ...field.Expr) ISavedViewDo
+	Omit(cols ...field.Expr) ISavedViewDo
+	Join(table schema.Tabler, on ...field.Expr) ISavedViewDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ISavedViewDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ISavedViewDo
+	Group(cols ...field.Expr) ISavedViewDo
+	Having(conds ...gen.Condition) ISavedViewDo
+	Limit(limit int) ISavedViewDo
+	Offset(offset int) ISavedViewDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ISavedViewDo
+	Unscoped() ISavedViewDo
+	Create(values ...*models.SavedView) error
+	CreateInBatches(values []*models.SavedView, batchSize int) error
+	Save(values ...*models.SavedView) error
+	First() (*models.SavedView, error)
+	Take() (*models.SavedView, error)
+	Last() (*models.SavedView, error)
+	Find() ([]*models.SavedView, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.SavedView, err error)
+	FindInBatches(result *[]*models.SavedView, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.SavedView) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ISavedViewDo
+	Assign(attrs ...field.AssignExpr) ISavedViewDo
+	Joins(fields ...field.RelationField) ISavedViewDo
+	Preload(fields ...field.RelationField) ISavedViewDo
+	FirstOrInit() (*models.SavedView, error)
+	FirstOrCreate() (*models.SavedView, error)
+	FindByPage(offset int, limit int) (result []*models.SavedView, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ISavedViewDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (s savedViewDo) Debug() ISavedViewDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s savedViewDo) WithContext(ctx context.Context) ISavedViewDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s savedViewDo) ReadDB() ISavedViewDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s savedViewDo) WriteDB() ISavedViewDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s savedViewDo) Session(config *gorm.Session) ISavedViewDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s savedViewDo) Clauses(conds ...clause.Expression) ISavedViewDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s savedViewDo) Returning(value interface{}, columns ...string) ISavedViewDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s savedViewDo) Not(conds ...gen.Condition) ISavedViewDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s savedViewDo) Or(conds ...gen.Condition) ISavedViewDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s savedViewDo) Select(conds ...field.Expr) ISavedViewDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s savedViewDo) Where(conds ...gen.Condition) ISavedViewDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s savedViewDo) Order(conds ...field.Expr) ISavedViewDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s savedViewDo) Distinct(cols ...field.Expr) ISavedViewDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s savedViewDo) Omit(cols ...field.Expr) ISavedViewDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s savedViewDo) Join(table schema.Tabler, on ...field.Expr) ISavedViewDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s savedViewDo) LeftJoin(table schema.Tabler, on ...field.Expr) ISavedViewDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s savedViewDo) RightJoin(table schema.Tabler, on ...field.Expr) ISavedViewDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s savedViewDo) Group(cols ...field.Expr) ISavedViewDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s savedViewDo) Having(conds ...gen.Condition) ISavedViewDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s savedViewDo) Limit(limit int) ISavedViewDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s savedViewDo) Offset(offset int) ISavedViewDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s savedViewDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ISavedViewDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s savedViewDo) Unscoped() ISavedViewDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s savedViewDo) Create(values ...*models.SavedView) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s savedViewDo) CreateInBatches(values []*models.SavedView, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s savedViewDo) Save(values ...*models.SavedView) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s savedViewDo) First() (*models.SavedView, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SavedView), nil
+	}
+}
+
+func (s savedViewDo) Take() (*models.SavedView, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SavedView), nil
+	}
+}
+
+func (s savedViewDo) Last() (*models.SavedView, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SavedView), nil
+	}
+}
+
+func (s savedViewDo) Find() ([]*models.SavedView, error) {
+	result, err := s.DO.Find()
+	return result.([]*models.SavedView), err
+}
+
+func (s savedViewDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.SavedView, err error) {
+	buf := make([]*models.SavedView, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s savedViewDo) FindInBatches(result *[]*models.SavedView, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s savedViewDo) Attrs(attrs ...field.AssignExpr) ISavedViewDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s savedViewDo) Assign(attrs ...field.AssignExpr) ISavedViewDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s savedViewDo) Joins(fields ...field.RelationField) ISavedViewDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s savedViewDo) Preload(fields ...field.RelationField) ISavedViewDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s savedViewDo) FirstOrInit() (*models.SavedView, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SavedView), nil
+	}
+}
+
+func (s savedViewDo) FirstOrCreate() (*models.SavedView, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SavedView), nil
+	}
+}
+
+func (s savedViewDo) FindByPage(offset int, limit int) (result []*models.SavedView, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s savedViewDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s savedViewDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s savedViewDo) Delete(models ...*models.SavedView) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *savedViewDo) withDO(do gen.Dao) *savedViewDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
@@ -0,0 +1,416 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newSprintReportTask(db *gorm.DB, opts ...gen.DOOption) sprintReportTask {
+	_sprintReportTask := sprintReportTask{}
+
+	_sprintReportTask.sprintReportTaskDo.UseDB(db, opts...)
+	_sprintReportTask.sprintReportTaskDo.UseModel(&models.SprintReportTask{})
+
+	tableName := _sprintReportTask.sprintReportTaskDo.TableName()
+	_sprintReportTask.ALL = field.NewAsterisk(tableName)
+	_sprintReportTask.ID = field.NewInt(tableName, "id")
+	_sprintReportTask.SprintReportID = field.NewInt(tableName, "sprint_report_id")
+	_sprintReportTask.TaskID = field.NewInt(tableName, "task_id")
+	_sprintReportTask.Title = field.NewString(tableName, "title")
+	_sprintReportTask.Status = field.NewString(tableName, "status")
+	_sprintReportTask.Outcome = field.NewString(tableName, "outcome")
+	_sprintReportTask.StoryPoints = field.NewInt(tableName, "story_points")
+
+	_sprintReportTask.fillFieldMap()
+
+	return _sprintReportTask
+}
+
+type sprintReportTask struct {
+	sprintReportTaskDo sprintReportTaskDo
+
+	ALL            field.Asterisk
+	ID             field.Int
+	SprintReportID field.Int
+	TaskID         field.Int
+	Title          field.String
+	Status         field.String
+	Outcome        field.String
+	StoryPoints    field.Int
+
+	fieldMap map[string]field.Expr
+}
+
+func (s sprintReportTask) Table(newTableName string) *sprintReportTask {
+	s.sprintReportTaskDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s sprintReportTask) As(alias string) *sprintReportTask {
+	s.sprintReportTaskDo.DO = *(s.sprintReportTaskDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *sprintReportTask) updateTableName(table string) *sprintReportTask {
+	s.ALL = field.NewAsterisk(table)
+	s.ID = field.NewInt(table, "id")
+	s.SprintReportID = field.NewInt(table, "sprint_report_id")
+	s.TaskID = field.NewInt(table, "task_id")
+	s.Title = field.NewString(table, "title")
+	s.Status = field.NewString(table, "status")
+	s.Outcome = field.NewString(table, "outcome")
+	s.StoryPoints = field.NewInt(table, "story_points")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *sprintReportTask) WithContext(ctx context.Context) ISprintReportTaskDo {
+	return s.sprintReportTaskDo.WithContext(ctx)
+}
+
+func (s sprintReportTask) TableName() string { return s.sprintReportTaskDo.TableName() }
+
+func (s sprintReportTask) Alias() string { return s.sprintReportTaskDo.Alias() }
+
+func (s sprintReportTask) Columns(cols ...field.Expr) gen.Columns {
+	return s.sprintReportTaskDo.Columns(cols...)
+}
+
+func (s *sprintReportTask) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *sprintReportTask) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 7)
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["sprint_report_id"] = s.SprintReportID
+	s.fieldMap["task_id"] = s.TaskID
+	s.fieldMap["title"] = s.Title
+	s.fieldMap["status"] = s.Status
+	s.fieldMap["outcome"] = s.Outcome
+	s.fieldMap["story_points"] = s.StoryPoints
+}
+
+func (s sprintReportTask) clone(db *gorm.DB) sprintReportTask {
+	s.sprintReportTaskDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s sprintReportTask) replaceDB(db *gorm.DB) sprintReportTask {
+	s.sprintReportTaskDo.ReplaceDB(db)
+	return s
+}
+
+type sprintReportTaskDo struct{ gen.DO }
+
+type ISprintReportTaskDo interface {
+	gen.SubQuery
+	Debug() ISprintReportTaskDo
+	WithContext(ctx context.Context) ISprintReportTaskDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ISprintReportTaskDo
+	WriteDB() ISprintReportTaskDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ISprintReportTaskDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ISprintReportTaskDo
+	Not(conds ...gen.Condition) ISprintReportTaskDo
+	Or(conds ...gen.Condition) ISprintReportTaskDo
+	Select(conds ...field.Expr) ISprintReportTaskDo
+	Where(conds ...gen.Condition) ISprintReportTaskDo
+	Order(conds ...field.Expr) ISprintReportTaskDo
+	Distinct(cols ...field.Expr) ISprintReportTaskDo
+	Omit(cols ...field.Expr) ISprintReportTaskDo
+	Join(table schema.Tabler, on ...field.Expr) ISprintReportTaskDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ISprintReportTaskDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ISprintReportTaskDo
+	Group(cols ...field.Expr) ISprintReportTaskDo
+	Having(conds ...gen.Condition) ISprintReportTaskDo
+	Limit(limit int) ISprintReportTaskDo
+	Offset(offset int) ISprintReportTaskDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ISprintReportTaskDo
+	Unscoped() ISprintReportTaskDo
+	Create(values ...*models.SprintReportTask) error
+	CreateInBatches(values []*models.SprintReportTask, batchSize int) error
+	Save(values ...*models.SprintReportTask) error
+	First() (*models.SprintReportTask, error)
+	Take() (*models.SprintReportTask, error)
+	Last() (*models.SprintReportTask, error)
+	Find() ([]*models.SprintReportTask, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.SprintReportTask, err error)
+	FindInBatches(result *[]*models.SprintReportTask, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.SprintReportTask) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ISprintReportTaskDo
+	Assign(attrs ...field.AssignExpr) ISprintReportTaskDo
+	Joins(fields ...field.RelationField) ISprintReportTaskDo
+	Preload(fields ...field.RelationField) ISprintReportTaskDo
+	FirstOrInit() (*models.SprintReportTask, error)
+	FirstOrCreate() (*models.SprintReportTask, error)
+	FindByPage(offset int, limit int) (result []*models.SprintReportTask, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ISprintReportTaskDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (s sprintReportTaskDo) Debug() ISprintReportTaskDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s sprintReportTaskDo) WithContext(ctx context.Context) ISprintReportTaskDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s sprintReportTaskDo) ReadDB() ISprintReportTaskDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s sprintReportTaskDo) WriteDB() ISprintReportTaskDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s sprintReportTaskDo) Session(config *gorm.Session) ISprintReportTaskDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s sprintReportTaskDo) Clauses(conds ...clause.Expression) ISprintReportTaskDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s sprintReportTaskDo) Returning(value interface{}, columns ...string) ISprintReportTaskDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s sprintReportTaskDo) Not(conds ...gen.Condition) ISprintReportTaskDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s sprintReportTaskDo) Or(conds ...gen.Condition) ISprintReportTaskDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s sprintReportTaskDo) Select(conds ...field.Expr) ISprintReportTaskDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s sprintReportTaskDo) Where(conds ...gen.Condition) ISprintReportTaskDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s sprintReportTaskDo) Order(conds ...field.Expr) ISprintReportTaskDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s sprintReportTaskDo) Distinct(cols ...field.Expr) ISprintReportTaskDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s sprintReportTaskDo) Omit(cols ...field.Expr) ISprintReportTaskDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s sprintReportTaskDo) Join(table schema.Tabler, on ...field.Expr) ISprintReportTaskDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s sprintReportTaskDo) LeftJoin(table schema.Tabler, on ...field.Expr) ISprintReportTaskDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s sprintReportTaskDo) RightJoin(table schema.Tabler, on ...field.Expr) ISprintReportTaskDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s sprintReportTaskDo) Group(cols ...field.Expr) ISprintReportTaskDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s sprintReportTaskDo) Having(conds ...gen.Condition) ISprintReportTaskDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s sprintReportTaskDo) Limit(limit int) ISprintReportTaskDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s sprintReportTaskDo) Offset(offset int) ISprintReportTaskDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s sprintReportTaskDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ISprintReportTaskDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s sprintReportTaskDo) Unscoped() ISprintReportTaskDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s sprintReportTaskDo) Create(values ...*models.SprintReportTask) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s sprintReportTaskDo) CreateInBatches(values []*models.SprintReportTask, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s sprintReportTaskDo) Save(values ...*models.SprintReportTask) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s sprintReportTaskDo) First() (*models.SprintReportTask, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SprintReportTask), nil
+	}
+}
+
+func (s sprintReportTaskDo) Take() (*models.SprintReportTask, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SprintReportTask), nil
+	}
+}
+
+func (s sprintReportTaskDo) Last() (*models.SprintReportTask, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SprintReportTask), nil
+	}
+}
+
+func (s sprintReportTaskDo) Find() ([]*models.SprintReportTask, error) {
+	result, err := s.DO.Find()
+	return result.([]*models.SprintReportTask), err
+}
+
+func (s sprintReportTaskDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.SprintReportTask, err error) {
+	buf := make([]*models.SprintReportTask, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s sprintReportTaskDo) FindInBatches(result *[]*models.SprintReportTask, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s sprintReportTaskDo) Attrs(attrs ...field.AssignExpr) ISprintReportTaskDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s sprintReportTaskDo) Assign(attrs ...field.AssignExpr) ISprintReportTaskDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s sprintReportTaskDo) Joins(fields ...field.RelationField) ISprintReportTaskDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s sprintReportTaskDo) Preload(fields ...field.RelationField) ISprintReportTaskDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s sprintReportTaskDo) FirstOrInit() (*models.SprintReportTask, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SprintReportTask), nil
+	}
+}
+
+func (s sprintReportTaskDo) FirstOrCreate() (*models.SprintReportTask, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SprintReportTask), nil
+	}
+}
+
+func (s sprintReportTaskDo) FindByPage(offset int, limit int) (result []*models.SprintReportTask, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s sprintReportTaskDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s sprintReportTaskDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s sprintReportTaskDo) Delete(models ...*models.SprintReportTask) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *sprintReportTaskDo) withDO(do gen.Dao) *sprintReportTaskDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
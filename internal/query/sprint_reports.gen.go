@@ -0,0 +1,986 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newSprintReport(db *gorm.DB, opts ...gen.DOOption) sprintReport {
+	_sprintReport := sprintReport{}
+
+	_sprintReport.sprintReportDo.UseDB(db, opts...)
+	_sprintReport.sprintReportDo.UseModel(&models.SprintReport{})
+
+	tableName := _sprintReport.sprintReportDo.TableName()
+	_sprintReport.ALL = field.NewAsterisk(tableName)
+	_sprintReport.ID = field.NewInt(tableName, "id")
+	_sprintReport.CreatedAt = field.NewTime(tableName, "created_at")
+	_sprintReport.SprintID = field.NewInt(tableName, "sprint_id")
+	_sprintReport.ProjectID = field.NewInt(tableName, "project_id")
+	_sprintReport.CompletedByID = field.NewInt(tableName, "completed_by_id")
+	_sprintReport.CarryOver = field.NewString(tableName, "carry_over")
+	_sprintReport.NextSprintID = field.NewInt(tableName, "next_sprint_id")
+	_sprintReport.CompletedCount = field.NewInt(tableName, "completed_count")
+	_sprintReport.CarriedOverCount = field.NewInt(tableName, "carried_over_count")
+	_sprintReport.Tasks = sprintReportHasManyTasks{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Tasks", "models.SprintReportTask"),
+	}
+
+	_sprintReport.CompletedBy = sprintReportBelongsToCompletedBy{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("CompletedBy", "models.User"),
+		CurrentProject: struct {
+			field.RelationField
+			Manager struct {
+				field.RelationField
+			}
+			Tasks struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			Sprints struct {
+				field.RelationField
+			}
+			TeamMembers struct {
+				field.RelationField
+			}
+			Members struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("CompletedBy.CurrentProject", "models.Project"),
+			Manager: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("CompletedBy.CurrentProject.Manager", "models.User"),
+			},
+			Tasks: struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("CompletedBy.CurrentProject.Tasks", "models.Task"),
+				Assignee: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("CompletedBy.CurrentProject.Tasks.Assignee", "models.User"),
+				},
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("CompletedBy.CurrentProject.Tasks.Project", "models.Project"),
+				},
+				Sprint: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("CompletedBy.CurrentProject.Tasks.Sprint", "models.Sprint"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("CompletedBy.CurrentProject.Tasks.Sprint.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("CompletedBy.CurrentProject.Tasks.Sprint.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("CompletedBy.CurrentProject.Tasks.Sprint.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("CompletedBy.CurrentProject.Tasks.Sprint.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("CompletedBy.CurrentProject.Tasks.Sprint.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("CompletedBy.CurrentProject.Tasks.Sprint.Tasks", "models.Task"),
+					},
+				},
+				Subtasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("CompletedBy.CurrentProject.Tasks.Subtasks", "models.Task"),
+				},
+				TaskLabels: struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("CompletedBy.CurrentProject.Tasks.TaskLabels", "models.TaskLabel"),
+					Label: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("CompletedBy.CurrentProject.Tasks.TaskLabels.Label", "models.Label"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("CompletedBy.CurrentProject.Tasks.TaskLabels.Label.Project", "models.Project"),
+						},
+					},
+				},
+			},
+			Sprints: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("CompletedBy.CurrentProject.Sprints", "models.Sprint"),
+			},
+			TeamMembers: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("CompletedBy.CurrentProject.TeamMembers", "models.User"),
+			},
+			Members: struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("CompletedBy.CurrentProject.Members", "models.ProjectMember"),
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("CompletedBy.CurrentProject.Members.Project", "models.Project"),
+				},
+				User: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("CompletedBy.CurrentProject.Members.User", "models.User"),
+				},
+			},
+		},
+		ManagedProjects: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("CompletedBy.ManagedProjects", "models.Project"),
+		},
+		AssignedTasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("CompletedBy.AssignedTasks", "models.Task"),
+		},
+	}
+
+	_sprintReport.NextSprint = sprintReportBelongsToNextSprint{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("NextSprint", "models.Sprint"),
+	}
+
+	_sprintReport.fillFieldMap()
+
+	return _sprintReport
+}
+
+type sprintReport struct {
+	sprintReportDo sprintReportDo
+
+	ALL              field.Asterisk
+	ID               field.Int
+	CreatedAt        field.Time
+	SprintID         field.Int
+	ProjectID        field.Int
+	CompletedByID    field.Int
+	CarryOver        field.String
+	NextSprintID     field.Int
+	CompletedCount   field.Int
+	CarriedOverCount field.Int
+	Tasks            sprintReportHasManyTasks
+
+	CompletedBy sprintReportBelongsToCompletedBy
+
+	NextSprint sprintReportBelongsToNextSprint
+
+	fieldMap map[string]field.Expr
+}
+
+func (s sprintReport) Table(newTableName string) *sprintReport {
+	s.sprintReportDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s sprintReport) As(alias string) *sprintReport {
+	s.sprintReportDo.DO = *(s.sprintReportDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *sprintReport) updateTableName(table string) *sprintReport {
+	s.ALL = field.NewAsterisk(table)
+	s.ID = field.NewInt(table, "id")
+	s.CreatedAt = field.NewTime(table, "created_at")
+	s.SprintID = field.NewInt(table, "sprint_id")
+	s.ProjectID = field.NewInt(table, "project_id")
+	s.CompletedByID = field.NewInt(table, "completed_by_id")
+	s.CarryOver = field.NewString(table, "carry_over")
+	s.NextSprintID = field.NewInt(table, "next_sprint_id")
+	s.CompletedCount = field.NewInt(table, "completed_count")
+	s.CarriedOverCount = field.NewInt(table, "carried_over_count")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *sprintReport) WithContext(ctx context.Context) ISprintReportDo {
+	return s.sprintReportDo.WithContext(ctx)
+}
+
+func (s sprintReport) TableName() string { return s.sprintReportDo.TableName() }
+
+func (s sprintReport) Alias() string { return s.sprintReportDo.Alias() }
+
+func (s sprintReport) Columns(cols ...field.Expr) gen.Columns {
+	return s.sprintReportDo.Columns(cols...)
+}
+
+func (s *sprintReport) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *sprintReport) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 12)
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["created_at"] = s.CreatedAt
+	s.fieldMap["sprint_id"] = s.SprintID
+	s.fieldMap["project_id"] = s.ProjectID
+	s.fieldMap["completed_by_id"] = s.CompletedByID
+	s.fieldMap["carry_over"] = s.CarryOver
+	s.fieldMap["next_sprint_id"] = s.NextSprintID
+	s.fieldMap["completed_count"] = s.CompletedCount
+	s.fieldMap["carried_over_count"] = s.CarriedOverCount
+
+}
+
+func (s sprintReport) clone(db *gorm.DB) sprintReport {
+	s.sprintReportDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s sprintReport) replaceDB(db *gorm.DB) sprintReport {
+	s.sprintReportDo.ReplaceDB(db)
+	return s
+}
+
+type sprintReportHasManyTasks struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a sprintReportHasManyTasks) Where(conds ...field.Expr) *sprintReportHasManyTasks {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a sprintReportHasManyTasks) WithContext(ctx context.Context) *sprintReportHasManyTasks {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a sprintReportHasManyTasks) Session(session *gorm.Session) *sprintReportHasManyTasks {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a sprintReportHasManyTasks) Model(m *models.SprintReport) *sprintReportHasManyTasksTx {
+	return &sprintReportHasManyTasksTx{a.db.Model(m).Association(a.Name())}
+}
+
+type sprintReportHasManyTasksTx struct{ tx *gorm.Association }
+
+func (a sprintReportHasManyTasksTx) Find() (result []*models.SprintReportTask, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a sprintReportHasManyTasksTx) Append(values ...*models.SprintReportTask) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a sprintReportHasManyTasksTx) Replace(values ...*models.SprintReportTask) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a sprintReportHasManyTasksTx) Delete(values ...*models.SprintReportTask) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a sprintReportHasManyTasksTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a sprintReportHasManyTasksTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type sprintReportBelongsToCompletedBy struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	CurrentProject struct {
+		field.RelationField
+		Manager struct {
+			field.RelationField
+		}
+		Tasks struct {
+			field.RelationField
+			Assignee struct {
+				field.RelationField
+			}
+			Project struct {
+				field.RelationField
+			}
+			Sprint struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Tasks struct {
+					field.RelationField
+				}
+			}
+			Subtasks struct {
+				field.RelationField
+			}
+			TaskLabels struct {
+				field.RelationField
+				Label struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+		Sprints struct {
+			field.RelationField
+		}
+		TeamMembers struct {
+			field.RelationField
+		}
+		Members struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+		}
+	}
+	ManagedProjects struct {
+		field.RelationField
+	}
+	AssignedTasks struct {
+		field.RelationField
+	}
+}
+
+func (a sprintReportBelongsToCompletedBy) Where(conds ...field.Expr) *sprintReportBelongsToCompletedBy {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a sprintReportBelongsToCompletedBy) WithContext(ctx context.Context) *sprintReportBelongsToCompletedBy {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a sprintReportBelongsToCompletedBy) Session(session *gorm.Session) *sprintReportBelongsToCompletedBy {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a sprintReportBelongsToCompletedBy) Model(m *models.SprintReport) *sprintReportBelongsToCompletedByTx {
+	return &sprintReportBelongsToCompletedByTx{a.db.Model(m).Association(a.Name())}
+}
+
+type sprintReportBelongsToCompletedByTx struct{ tx *gorm.Association }
+
+func (a sprintReportBelongsToCompletedByTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a sprintReportBelongsToCompletedByTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a sprintReportBelongsToCompletedByTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a sprintReportBelongsToCompletedByTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a sprintReportBelongsToCompletedByTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a sprintReportBelongsToCompletedByTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type sprintReportBelongsToNextSprint struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a sprintReportBelongsToNextSprint) Where(conds ...field.Expr) *sprintReportBelongsToNextSprint {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a sprintReportBelongsToNextSprint) WithContext(ctx context.Context) *sprintReportBelongsToNextSprint {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a sprintReportBelongsToNextSprint) Session(session *gorm.Session) *sprintReportBelongsToNextSprint {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a sprintReportBelongsToNextSprint) Model(m *models.SprintReport) *sprintReportBelongsToNextSprintTx {
+	return &sprintReportBelongsToNextSprintTx{a.db.Model(m).Association(a.Name())}
+}
+
+type sprintReportBelongsToNextSprintTx struct{ tx *gorm.Association }
+
+func (a sprintReportBelongsToNextSprintTx) Find() (result *models.Sprint, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a sprintReportBelongsToNextSprintTx) Append(values ...*models.Sprint) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a sprintReportBelongsToNextSprintTx) Replace(values ...*models.Sprint) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a sprintReportBelongsToNextSprintTx) Delete(values ...*models.Sprint) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a sprintReportBelongsToNextSprintTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a sprintReportBelongsToNextSprintTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type sprintReportDo struct{ gen.DO }
+
+type ISprintReportDo interface {
+	gen.SubQuery
+	Debug() ISprintReportDo
+	WithContext(ctx context.Context) ISprintReportDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ISprintReportDo
+	WriteDB() ISprintReportDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ISprintReportDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ISprintReportDo
+	Not(conds ...gen.Condition) ISprintReportDo
+	Or(conds ...gen.Condition) ISprintReportDo
+	Select(conds ...field.Expr) ISprintReportDo
+	Where(conds ...gen.Condition) ISprintReportDo
+	Order(conds ...field.Expr) ISprintReportDo
+	Distinct(cols ...field.Expr) ISprintReportDo
+	Omit(cols ...field.Expr) ISprintReportDo
+	Join(table schema.Tabler, on ...field.Expr) ISprintReportDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ISprintReportDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ISprintReportDo
+	Group(cols ...field.Expr) ISprintReportDo
+	Having(conds ...gen.Condition) ISprintReportDo
+	Limit(limit int) ISprintReportDo
+	Offset(offset int) ISprintReportDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ISprintReportDo
+	Unscoped() ISprintReportDo
+	Create(values ...*models.SprintReport) error
+	CreateInBatches(values []*models.SprintReport, batchSize int) error
+	Save(values ...*models.SprintReport) error
+	First() (*models.SprintReport, error)
+	Take() (*models.SprintReport, error)
+	Last() (*models.SprintReport, error)
+	Find() ([]*models.SprintReport, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.SprintReport, err error)
+	FindInBatches(result *[]*models.SprintReport, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.SprintReport) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ISprintReportDo
+	Assign(attrs ...field.AssignExpr) ISprintReportDo
+	Joins(fields ...field.RelationField) ISprintReportDo
+	Preload(fields ...field.RelationField) ISprintReportDo
+	FirstOrInit() (*models.SprintReport, error)
+	FirstOrCreate() (*models.SprintReport, error)
+	FindByPage(offset int, limit int) (result []*models.SprintReport, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ISprintReportDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (s sprintReportDo) Debug() ISprintReportDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s sprintReportDo) WithContext(ctx context.Context) ISprintReportDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s sprintReportDo) ReadDB() ISprintReportDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s sprintReportDo) WriteDB() ISprintReportDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s sprintReportDo) Session(config *gorm.Session) ISprintReportDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s sprintReportDo) Clauses(conds ...clause.Expression) ISprintReportDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s sprintReportDo) Returning(value interface{}, columns ...string) ISprintReportDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s sprintReportDo) Not(conds ...gen.Condition) ISprintReportDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s sprintReportDo) Or(conds ...gen.Condition) ISprintReportDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s sprintReportDo) Select(conds ...field.Expr) ISprintReportDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s sprintReportDo) Where(conds ...gen.Condition) ISprintReportDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s sprintReportDo) Order(conds ...field.Expr) ISprintReportDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s sprintReportDo) Distinct(cols ...field.Expr) ISprintReportDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s sprintReportDo) Omit(cols ...field.Expr) ISprintReportDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s sprintReportDo) Join(table schema.Tabler, on ...field.Expr) ISprintReportDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s sprintReportDo) LeftJoin(table schema.Tabler, on ...field.Expr) ISprintReportDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s sprintReportDo) RightJoin(table schema.Tabler, on ...field.Expr) ISprintReportDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s sprintReportDo) Group(cols ...field.Expr) ISprintReportDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s sprintReportDo) Having(conds ...gen.Condition) ISprintReportDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s sprintReportDo) Limit(limit int) ISprintReportDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s sprintReportDo) Offset(offset int) ISprintReportDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s sprintReportDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ISprintReportDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s sprintReportDo) Unscoped() ISprintReportDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s sprintReportDo) Create(values ...*models.SprintReport) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s sprintReportDo) CreateInBatches(values []*models.SprintReport, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s sprintReportDo) Save(values ...*models.SprintReport) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s sprintReportDo) First() (*models.SprintReport, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SprintReport), nil
+	}
+}
+
+func (s sprintReportDo) Take() (*models.SprintReport, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SprintReport), nil
+	}
+}
+
+func (s sprintReportDo) Last() (*models.SprintReport, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SprintReport), nil
+	}
+}
+
+func (s sprintReportDo) Find() ([]*models.SprintReport, error) {
+	result, err := s.DO.Find()
+	return result.([]*models.SprintReport), err
+}
+
+func (s sprintReportDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.SprintReport, err error) {
+	buf := make([]*models.SprintReport, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s sprintReportDo) FindInBatches(result *[]*models.SprintReport, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s sprintReportDo) Attrs(attrs ...field.AssignExpr) ISprintReportDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s sprintReportDo) Assign(attrs ...field.AssignExpr) ISprintReportDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s sprintReportDo) Joins(fields ...field.RelationField) ISprintReportDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s sprintReportDo) Preload(fields ...field.RelationField) ISprintReportDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s sprintReportDo) FirstOrInit() (*models.SprintReport, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SprintReport), nil
+	}
+}
+
+func (s sprintReportDo) FirstOrCreate() (*models.SprintReport, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.SprintReport), nil
+	}
+}
+
+func (s sprintReportDo) FindByPage(offset int, limit int) (result []*models.SprintReport, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s sprintReportDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s sprintReportDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s sprintReportDo) Delete(models ...*models.SprintReport) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *sprintReportDo) withDO(do gen.Dao) *sprintReportDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
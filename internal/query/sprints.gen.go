@@ -36,11 +36,14 @@ func newSprint(db *gorm.DB, opts ...gen.DOOption) sprint {
 	_sprint.EndDate = field.NewTime(tableName, "end_date")
 	_sprint.ProjectID = field.NewInt(tableName, "project_id")
 	_sprint.Goal = field.NewString(tableName, "goal")
-	_sprint.Tasks = sprintHasManyTasks{
+	_sprint.Status = field.NewString(tableName, "status")
+	_sprint.StartedAt = field.NewTime(tableName, "started_at")
+	_sprint.CompletedAt = field.NewTime(tableName, "completed_at")
+	_sprint.Report = sprintHasOneReport{
 		db: db.Session(&gorm.Session{}),
 
-		RelationField: field.NewRelation("Tasks", "models.Task"),
-		Assignee: struct {
+		RelationField: field.NewRelation("Report", "models.SprintReport"),
+		CompletedBy: struct {
 			field.RelationField
 			CurrentProject struct {
 				field.RelationField
@@ -49,19 +52,52 @@ func newSprint(db *gorm.DB, opts ...gen.DOOption) sprint {
 				}
 				Tasks struct {
 					field.RelationField
-				}
-				Sprints struct {
-					field.RelationField
+					Assignee struct {
+						field.RelationField
+					}
 					Project struct {
 						field.RelationField
 					}
-					Tasks struct {
+					Sprint struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Subtasks struct {
+						field.RelationField
+					}
+					TaskLabels struct {
 						field.RelationField
+						Label struct {
+							field.RelationField
+							Project struct {
+								field.RelationField
+							}
+						}
 					}
 				}
+				Sprints struct {
+					field.RelationField
+				}
 				TeamMembers struct {
 					field.RelationField
 				}
+				Members struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}
 			}
 			ManagedProjects struct {
 				field.RelationField
@@ -70,7 +106,7 @@ func newSprint(db *gorm.DB, opts ...gen.DOOption) sprint {
 				field.RelationField
 			}
 		}{
-			RelationField: field.NewRelation("Tasks.Assignee", "models.User"),
+			RelationField: field.NewRelation("Report.CompletedBy", "models.User"),
 			CurrentProject: struct {
 				field.RelationField
 				Manager struct {
@@ -78,81 +114,223 @@ func newSprint(db *gorm.DB, opts ...gen.DOOption) sprint {
 				}
 				Tasks struct {
 					field.RelationField
-				}
-				Sprints struct {
-					field.RelationField
+					Assignee struct {
+						field.RelationField
+					}
 					Project struct {
 						field.RelationField
 					}
-					Tasks struct {
+					Sprint struct {
 						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
 					}
+					Subtasks struct {
+						field.RelationField
+					}
+					TaskLabels struct {
+						field.RelationField
+						Label struct {
+							field.RelationField
+							Project struct {
+								field.RelationField
+							}
+						}
+					}
+				}
+				Sprints struct {
+					field.RelationField
 				}
 				TeamMembers struct {
 					field.RelationField
 				}
+				Members struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}
 			}{
-				RelationField: field.NewRelation("Tasks.Assignee.CurrentProject", "models.Project"),
+				RelationField: field.NewRelation("Report.CompletedBy.CurrentProject", "models.Project"),
 				Manager: struct {
 					field.RelationField
 				}{
-					RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.Manager", "models.User"),
+					RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Manager", "models.User"),
 				},
 				Tasks: struct {
 					field.RelationField
-				}{
-					RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.Tasks", "models.Task"),
-				},
-				Sprints: struct {
-					field.RelationField
+					Assignee struct {
+						field.RelationField
+					}
 					Project struct {
 						field.RelationField
 					}
-					Tasks struct {
+					Sprint struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Subtasks struct {
 						field.RelationField
 					}
+					TaskLabels struct {
+						field.RelationField
+						Label struct {
+							field.RelationField
+							Project struct {
+								field.RelationField
+							}
+						}
+					}
 				}{
-					RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.Sprints", "models.Sprint"),
+					RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Tasks", "models.Task"),
+					Assignee: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Tasks.Assignee", "models.User"),
+					},
 					Project: struct {
 						field.RelationField
 					}{
-						RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.Sprints.Project", "models.Project"),
+						RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Tasks.Project", "models.Project"),
 					},
-					Tasks: struct {
+					Sprint: struct {
 						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
 					}{
-						RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.Sprints.Tasks", "models.Task"),
+						RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Tasks.Sprint", "models.Sprint"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Tasks.Sprint.Project", "models.Project"),
+						},
+						Report: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Tasks.Sprint.Report", "models.SprintReport"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Tasks.Sprint.Tasks", "models.Task"),
+						},
+					},
+					Subtasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Tasks.Subtasks", "models.Task"),
+					},
+					TaskLabels: struct {
+						field.RelationField
+						Label struct {
+							field.RelationField
+							Project struct {
+								field.RelationField
+							}
+						}
+					}{
+						RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Tasks.TaskLabels", "models.TaskLabel"),
+						Label: struct {
+							field.RelationField
+							Project struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Tasks.TaskLabels.Label", "models.Label"),
+							Project: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Tasks.TaskLabels.Label.Project", "models.Project"),
+							},
+						},
 					},
 				},
+				Sprints: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Sprints", "models.Sprint"),
+				},
 				TeamMembers: struct {
 					field.RelationField
 				}{
-					RelationField: field.NewRelation("Tasks.Assignee.CurrentProject.TeamMembers", "models.User"),
+					RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.TeamMembers", "models.User"),
+				},
+				Members: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Members", "models.ProjectMember"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Members.Project", "models.Project"),
+					},
+					User: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Report.CompletedBy.CurrentProject.Members.User", "models.User"),
+					},
 				},
 			},
 			ManagedProjects: struct {
 				field.RelationField
 			}{
-				RelationField: field.NewRelation("Tasks.Assignee.ManagedProjects", "models.Project"),
+				RelationField: field.NewRelation("Report.CompletedBy.ManagedProjects", "models.Project"),
 			},
 			AssignedTasks: struct {
 				field.RelationField
 			}{
-				RelationField: field.NewRelation("Tasks.Assignee.AssignedTasks", "models.Task"),
+				RelationField: field.NewRelation("Report.CompletedBy.AssignedTasks", "models.Task"),
 			},
 		},
-		Project: struct {
+		NextSprint: struct {
 			field.RelationField
 		}{
-			RelationField: field.NewRelation("Tasks.Project", "models.Project"),
+			RelationField: field.NewRelation("Report.NextSprint", "models.Sprint"),
 		},
-		Sprint: struct {
+		Tasks: struct {
 			field.RelationField
 		}{
-			RelationField: field.NewRelation("Tasks.Sprint", "models.Sprint"),
+			RelationField: field.NewRelation("Report.Tasks", "models.SprintReportTask"),
 		},
 	}
 
+	_sprint.Tasks = sprintHasManyTasks{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Tasks", "models.Task"),
+	}
+
 	_sprint.Project = sprintBelongsToProject{
 		db: db.Session(&gorm.Session{}),
 
@@ -167,17 +345,22 @@ func newSprint(db *gorm.DB, opts ...gen.DOOption) sprint {
 type sprint struct {
 	sprintDo sprintDo
 
-	ALL       field.Asterisk
-	ID        field.Int
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	DeletedAt field.Field
-	Name      field.String
-	StartDate field.Time
-	EndDate   field.Time
-	ProjectID field.Int
-	Goal      field.String
-	Tasks     sprintHasManyTasks
+	ALL         field.Asterisk
+	ID          field.Int
+	CreatedAt   field.Time
+	UpdatedAt   field.Time
+	DeletedAt   field.Field
+	Name        field.String
+	StartDate   field.Time
+	EndDate     field.Time
+	ProjectID   field.Int
+	Goal        field.String
+	Status      field.String
+	StartedAt   field.Time
+	CompletedAt field.Time
+	Report      sprintHasOneReport
+
+	Tasks sprintHasManyTasks
 
 	Project sprintBelongsToProject
 
@@ -205,6 +388,9 @@ func (s *sprint) updateTableName(table string) *sprint {
 	s.EndDate = field.NewTime(table, "end_date")
 	s.ProjectID = field.NewInt(table, "project_id")
 	s.Goal = field.NewString(table, "goal")
+	s.Status = field.NewString(table, "status")
+	s.StartedAt = field.NewTime(table, "started_at")
+	s.CompletedAt = field.NewTime(table, "completed_at")
 
 	s.fillFieldMap()
 
@@ -229,7 +415,7 @@ func (s *sprint) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *sprint) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 11)
+	s.fieldMap = make(map[string]field.Expr, 15)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
@@ -239,6 +425,9 @@ func (s *sprint) fillFieldMap() {
 	s.fieldMap["end_date"] = s.EndDate
 	s.fieldMap["project_id"] = s.ProjectID
 	s.fieldMap["goal"] = s.Goal
+	s.fieldMap["status"] = s.Status
+	s.fieldMap["started_at"] = s.StartedAt
+	s.fieldMap["completed_at"] = s.CompletedAt
 
 }
 
@@ -252,12 +441,12 @@ func (s sprint) replaceDB(db *gorm.DB) sprint {
 	return s
 }
 
-type sprintHasManyTasks struct {
+type sprintHasOneReport struct {
 	db *gorm.DB
 
 	field.RelationField
 
-	Assignee struct {
+	CompletedBy struct {
 		field.RelationField
 		CurrentProject struct {
 			field.RelationField
@@ -266,19 +455,52 @@ type sprintHasManyTasks struct {
 			}
 			Tasks struct {
 				field.RelationField
-			}
-			Sprints struct {
-				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
 				Project struct {
 					field.RelationField
 				}
-				Tasks struct {
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
 					field.RelationField
 				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			Sprints struct {
+				field.RelationField
 			}
 			TeamMembers struct {
 				field.RelationField
 			}
+			Members struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}
 		}
 		ManagedProjects struct {
 			field.RelationField
@@ -287,14 +509,85 @@ type sprintHasManyTasks struct {
 			field.RelationField
 		}
 	}
-	Project struct {
+	NextSprint struct {
 		field.RelationField
 	}
-	Sprint struct {
+	Tasks struct {
 		field.RelationField
 	}
 }
 
+func (a sprintHasOneReport) Where(conds ...field.Expr) *sprintHasOneReport {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a sprintHasOneReport) WithContext(ctx context.Context) *sprintHasOneReport {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a sprintHasOneReport) Session(session *gorm.Session) *sprintHasOneReport {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a sprintHasOneReport) Model(m *models.Sprint) *sprintHasOneReportTx {
+	return &sprintHasOneReportTx{a.db.Model(m).Association(a.Name())}
+}
+
+type sprintHasOneReportTx struct{ tx *gorm.Association }
+
+func (a sprintHasOneReportTx) Find() (result *models.SprintReport, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a sprintHasOneReportTx) Append(values ...*models.SprintReport) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a sprintHasOneReportTx) Replace(values ...*models.SprintReport) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a sprintHasOneReportTx) Delete(values ...*models.SprintReport) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a sprintHasOneReportTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a sprintHasOneReportTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type sprintHasManyTasks struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
 func (a sprintHasManyTasks) Where(conds ...field.Expr) *sprintHasManyTasks {
 	if len(conds) == 0 {
 		return &a
@@ -0,0 +1,848 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newTaskLabel(db *gorm.DB, opts ...gen.DOOption) taskLabel {
+	_taskLabel := taskLabel{}
+
+	_taskLabel.taskLabelDo.UseDB(db, opts...)
+	_taskLabel.taskLabelDo.UseModel(&models.TaskLabel{})
+
+	tableName := _taskLabel.taskLabelDo.TableName()
+	_taskLabel.ALL = field.NewAsterisk(tableName)
+	_taskLabel.ID = field.NewInt(tableName, "id")
+	_taskLabel.CreatedAt = field.NewTime(tableName, "created_at")
+	_taskLabel.TaskID = field.NewInt(tableName, "task_id")
+	_taskLabel.LabelID = field.NewInt(tableName, "label_id")
+	_taskLabel.Label = taskLabelBelongsToLabel{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Label", "models.Label"),
+		Project: struct {
+			field.RelationField
+			Manager struct {
+				field.RelationField
+				CurrentProject struct {
+					field.RelationField
+				}
+				ManagedProjects struct {
+					field.RelationField
+				}
+				AssignedTasks struct {
+					field.RelationField
+					Assignee struct {
+						field.RelationField
+					}
+					Project struct {
+						field.RelationField
+					}
+					Sprint struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Subtasks struct {
+						field.RelationField
+					}
+					TaskLabels struct {
+						field.RelationField
+						Label struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+			Tasks struct {
+				field.RelationField
+			}
+			Sprints struct {
+				field.RelationField
+			}
+			TeamMembers struct {
+				field.RelationField
+			}
+			Members struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Label.Project", "models.Project"),
+			Manager: struct {
+				field.RelationField
+				CurrentProject struct {
+					field.RelationField
+				}
+				ManagedProjects struct {
+					field.RelationField
+				}
+				AssignedTasks struct {
+					field.RelationField
+					Assignee struct {
+						field.RelationField
+					}
+					Project struct {
+						field.RelationField
+					}
+					Sprint struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Subtasks struct {
+						field.RelationField
+					}
+					TaskLabels struct {
+						field.RelationField
+						Label struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Label.Project.Manager", "models.User"),
+				CurrentProject: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Label.Project.Manager.CurrentProject", "models.Project"),
+				},
+				ManagedProjects: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Label.Project.Manager.ManagedProjects", "models.Project"),
+				},
+				AssignedTasks: struct {
+					field.RelationField
+					Assignee struct {
+						field.RelationField
+					}
+					Project struct {
+						field.RelationField
+					}
+					Sprint struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Subtasks struct {
+						field.RelationField
+					}
+					TaskLabels struct {
+						field.RelationField
+						Label struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Label.Project.Manager.AssignedTasks", "models.Task"),
+					Assignee: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Label.Project.Manager.AssignedTasks.Assignee", "models.User"),
+					},
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Label.Project.Manager.AssignedTasks.Project", "models.Project"),
+					},
+					Sprint: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Label.Project.Manager.AssignedTasks.Sprint", "models.Sprint"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Label.Project.Manager.AssignedTasks.Sprint.Project", "models.Project"),
+						},
+						Report: struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Label.Project.Manager.AssignedTasks.Sprint.Report", "models.SprintReport"),
+							CompletedBy: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Label.Project.Manager.AssignedTasks.Sprint.Report.CompletedBy", "models.User"),
+							},
+							NextSprint: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Label.Project.Manager.AssignedTasks.Sprint.Report.NextSprint", "models.Sprint"),
+							},
+							Tasks: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Label.Project.Manager.AssignedTasks.Sprint.Report.Tasks", "models.SprintReportTask"),
+							},
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Label.Project.Manager.AssignedTasks.Sprint.Tasks", "models.Task"),
+						},
+					},
+					Subtasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Label.Project.Manager.AssignedTasks.Subtasks", "models.Task"),
+					},
+					TaskLabels: struct {
+						field.RelationField
+						Label struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Label.Project.Manager.AssignedTasks.TaskLabels", "models.TaskLabel"),
+						Label: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Label.Project.Manager.AssignedTasks.TaskLabels.Label", "models.Label"),
+						},
+					},
+				},
+			},
+			Tasks: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Label.Project.Tasks", "models.Task"),
+			},
+			Sprints: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Label.Project.Sprints", "models.Sprint"),
+			},
+			TeamMembers: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Label.Project.TeamMembers", "models.User"),
+			},
+			Members: struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Label.Project.Members", "models.ProjectMember"),
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Label.Project.Members.Project", "models.Project"),
+				},
+				User: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Label.Project.Members.User", "models.User"),
+				},
+			},
+		},
+	}
+
+	_taskLabel.fillFieldMap()
+
+	return _taskLabel
+}
+
+type taskLabel struct {
+	taskLabelDo taskLabelDo
+
+	ALL       field.Asterisk
+	ID        field.Int
+	CreatedAt field.Time
+	TaskID    field.Int
+	LabelID   field.Int
+	Label     taskLabelBelongsToLabel
+
+	fieldMap map[string]field.Expr
+}
+
+func (t taskLabel) Table(newTableName string) *taskLabel {
+	t.taskLabelDo.UseTable(newTableName)
+	return t.updateTableName(newTableName)
+}
+
+func (t taskLabel) As(alias string) *taskLabel {
+	t.taskLabelDo.DO = *(t.taskLabelDo.As(alias).(*gen.DO))
+	return t.updateTableName(alias)
+}
+
+func (t *taskLabel) updateTableName(table string) *taskLabel {
+	t.ALL = field.NewAsterisk(table)
+	t.ID = field.NewInt(table, "id")
+	t.CreatedAt = field.NewTime(table, "created_at")
+	t.TaskID = field.NewInt(table, "task_id")
+	t.LabelID = field.NewInt(table, "label_id")
+
+	t.fillFieldMap()
+
+	return t
+}
+
+func (t *taskLabel) WithContext(ctx context.Context) ITaskLabelDo {
+	return t.taskLabelDo.WithContext(ctx)
+}
+
+func (t taskLabel) TableName() string { return t.taskLabelDo.TableName() }
+
+func (t taskLabel) Alias() string { return t.taskLabelDo.Alias() }
+
+func (t taskLabel) Columns(cols ...field.Expr) gen.Columns { return t.taskLabelDo.Columns(cols...) }
+
+func (t *taskLabel) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := t.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (t *taskLabel) fillFieldMap() {
+	t.fieldMap = make(map[string]field.Expr, 5)
+	t.fieldMap["id"] = t.ID
+	t.fieldMap["created_at"] = t.CreatedAt
+	t.fieldMap["task_id"] = t.TaskID
+	t.fieldMap["label_id"] = t.LabelID
+
+}
+
+func (t taskLabel) clone(db *gorm.DB) taskLabel {
+	t.taskLabelDo.ReplaceConnPool(db.Statement.ConnPool)
+	return t
+}
+
+func (t taskLabel) replaceDB(db *gorm.DB) taskLabel {
+	t.taskLabelDo.ReplaceDB(db)
+	return t
+}
+
+type taskLabelBelongsToLabel struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Project struct {
+		field.RelationField
+		Manager struct {
+			field.RelationField
+			CurrentProject struct {
+				field.RelationField
+			}
+			ManagedProjects struct {
+				field.RelationField
+			}
+			AssignedTasks struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+		Tasks struct {
+			field.RelationField
+		}
+		Sprints struct {
+			field.RelationField
+		}
+		TeamMembers struct {
+			field.RelationField
+		}
+		Members struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+		}
+	}
+}
+
+func (a taskLabelBelongsToLabel) Where(conds ...field.Expr) *taskLabelBelongsToLabel {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a taskLabelBelongsToLabel) WithContext(ctx context.Context) *taskLabelBelongsToLabel {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a taskLabelBelongsToLabel) Session(session *gorm.Session) *taskLabelBelongsToLabel {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a taskLabelBelongsToLabel) Model(m *models.TaskLabel) *taskLabelBelongsToLabelTx {
+	return &taskLabelBelongsToLabelTx{a.db.Model(m).Association(a.Name())}
+}
+
+type taskLabelBelongsToLabelTx struct{ tx *gorm.Association }
+
+func (a taskLabelBelongsToLabelTx) Find() (result *models.Label, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a taskLabelBelongsToLabelTx) Append(values ...*models.Label) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a taskLabelBelongsToLabelTx) Replace(values ...*models.Label) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a taskLabelBelongsToLabelTx) Delete(values ...*models.Label) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a taskLabelBelongsToLabelTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a taskLabelBelongsToLabelTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type taskLabelDo struct{ gen.DO }
+
+type ITaskLabelDo interface {
+	gen.SubQuery
+	Debug() ITaskLabelDo
+	WithContext(ctx context.Context) ITaskLabelDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ITaskLabelDo
+	WriteDB() ITaskLabelDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ITaskLabelDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ITaskLabelDo
+	Not(conds ...gen.Condition) ITaskLabelDo
+	Or(conds ...gen.Condition) ITaskLabelDo
+	Select(conds ...field.Expr) ITaskLabelDo
+	Where(conds ...gen.Condition) ITaskLabelDo
+	Order(conds ...field.Expr) ITaskLabelDo
+	Distinct(cols ...field.Expr) ITaskLabelDo
+	Omit(cols ...field.Expr) ITaskLabelDo
+	Join(table schema.Tabler, on ...field.Expr) ITaskLabelDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ITaskLabelDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ITaskLabelDo
+	Group(cols ...field.Expr) ITaskLabelDo
+	Having(conds ...gen.Condition) ITaskLabelDo
+	Limit(limit int) ITaskLabelDo
+	Offset(offset int) ITaskLabelDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ITaskLabelDo
+	Unscoped() ITaskLabelDo
+	Create(values ...*models.TaskLabel) error
+	CreateInBatches(values []*models.TaskLabel, batchSize int) error
+	Save(values ...*models.TaskLabel) error
+	First() (*models.TaskLabel, error)
+	Take() (*models.TaskLabel, error)
+	Last() (*models.TaskLabel, error)
+	Find() ([]*models.TaskLabel, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.TaskLabel, err error)
+	FindInBatches(result *[]*models.TaskLabel, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.TaskLabel) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ITaskLabelDo
+	Assign(attrs ...field.AssignExpr) ITaskLabelDo
+	Joins(fields ...field.RelationField) ITaskLabelDo
+	Preload(fields ...field.RelationField) ITaskLabelDo
+	FirstOrInit() (*models.TaskLabel, error)
+	FirstOrCreate() (*models.TaskLabel, error)
+	FindByPage(offset int, limit int) (result []*models.TaskLabel, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ITaskLabelDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (t taskLabelDo) Debug() ITaskLabelDo {
+	return t.withDO(t.DO.Debug())
+}
+
+func (t taskLabelDo) WithContext(ctx context.Context) ITaskLabelDo {
+	return t.withDO(t.DO.WithContext(ctx))
+}
+
+func (t taskLabelDo) ReadDB() ITaskLabelDo {
+	return t.Clauses(dbresolver.Read)
+}
+
+func (t taskLabelDo) WriteDB() ITaskLabelDo {
+	return t.Clauses(dbresolver.Write)
+}
+
+func (t taskLabelDo) Session(config *gorm.Session) ITaskLabelDo {
+	return t.withDO(t.DO.Session(config))
+}
+
+func (t taskLabelDo) Clauses(conds ...clause.Expression) ITaskLabelDo {
+	return t.withDO(t.DO.Clauses(conds...))
+}
+
+func (t taskLabelDo) Returning(value interface{}, columns ...string) ITaskLabelDo {
+	return t.withDO(t.DO.Returning(value, columns...))
+}
+
+func (t taskLabelDo) Not(conds ...gen.Condition) ITaskLabelDo {
+	return t.withDO(t.DO.Not(conds...))
+}
+
+func (t taskLabelDo) Or(conds ...gen.Condition) ITaskLabelDo {
+	return t.withDO(t.DO.Or(conds...))
+}
+
+func (t taskLabelDo) Select(conds ...field.Expr) ITaskLabelDo {
+	return t.withDO(t.DO.Select(conds...))
+}
+
+func (t taskLabelDo) Where(conds ...gen.Condition) ITaskLabelDo {
+	return t.withDO(t.DO.Where(conds...))
+}
+
+func (t taskLabelDo) Order(conds ...field.Expr) ITaskLabelDo {
+	return t.withDO(t.DO.Order(conds...))
+}
+
+func (t taskLabelDo) Distinct(cols ...field.Expr) ITaskLabelDo {
+	return t.withDO(t.DO.Distinct(cols...))
+}
+
+func (t taskLabelDo) Omit(cols ...field.Expr) ITaskLabelDo {
+	return t.withDO(t.DO.Omit(cols...))
+}
+
+func (t taskLabelDo) Join(table schema.Tabler, on ...field.Expr) ITaskLabelDo {
+	return t.withDO(t.DO.Join(table, on...))
+}
+
+func (t taskLabelDo) LeftJoin(table schema.Tabler, on ...field.Expr) ITaskLabelDo {
+	return t.withDO(t.DO.LeftJoin(table, on...))
+}
+
+func (t taskLabelDo) RightJoin(table schema.Tabler, on ...field.Expr) ITaskLabelDo {
+	return t.withDO(t.DO.RightJoin(table, on...))
+}
+
+func (t taskLabelDo) Group(cols ...field.Expr) ITaskLabelDo {
+	return t.withDO(t.DO.Group(cols...))
+}
+
+func (t taskLabelDo) Having(conds ...gen.Condition) ITaskLabelDo {
+	return t.withDO(t.DO.Having(conds...))
+}
+
+func (t taskLabelDo) Limit(limit int) ITaskLabelDo {
+	return t.withDO(t.DO.Limit(limit))
+}
+
+func (t taskLabelDo) Offset(offset int) ITaskLabelDo {
+	return t.withDO(t.DO.Offset(offset))
+}
+
+func (t taskLabelDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ITaskLabelDo {
+	return t.withDO(t.DO.Scopes(funcs...))
+}
+
+func (t taskLabelDo) Unscoped() ITaskLabelDo {
+	return t.withDO(t.DO.Unscoped())
+}
+
+func (t taskLabelDo) Create(values ...*models.TaskLabel) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return t.DO.Create(values)
+}
+
+func (t taskLabelDo) CreateInBatches(values []*models.TaskLabel, batchSize int) error {
+	return t.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (t taskLabelDo) Save(values ...*models.TaskLabel) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return t.DO.Save(values)
+}
+
+func (t taskLabelDo) First() (*models.TaskLabel, error) {
+	if result, err := t.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.TaskLabel), nil
+	}
+}
+
+func (t taskLabelDo) Take() (*models.TaskLabel, error) {
+	if result, err := t.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.TaskLabel), nil
+	}
+}
+
+func (t taskLabelDo) Last() (*models.TaskLabel, error) {
+	if result, err := t.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.TaskLabel), nil
+	}
+}
+
+func (t taskLabelDo) Find() ([]*models.TaskLabel, error) {
+	result, err := t.DO.Find()
+	return result.([]*models.TaskLabel), err
+}
+
+func (t taskLabelDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.TaskLabel, err error) {
+	buf := make([]*models.TaskLabel, 0, batchSize)
+	err = t.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (t taskLabelDo) FindInBatches(result *[]*models.TaskLabel, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return t.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (t taskLabelDo) Attrs(attrs ...field.AssignExpr) ITaskLabelDo {
+	return t.withDO(t.DO.Attrs(attrs...))
+}
+
+func (t taskLabelDo) Assign(attrs ...field.AssignExpr) ITaskLabelDo {
+	return t.withDO(t.DO.Assign(attrs...))
+}
+
+func (t taskLabelDo) Joins(fields ...field.RelationField) ITaskLabelDo {
+	for _, _f := range fields {
+		t = *t.withDO(t.DO.Joins(_f))
+	}
+	return &t
+}
+
+func (t taskLabelDo) Preload(fields ...field.RelationField) ITaskLabelDo {
+	for _, _f := range fields {
+		t = *t.withDO(t.DO.Preload(_f))
+	}
+	return &t
+}
+
+func (t taskLabelDo) FirstOrInit() (*models.TaskLabel, error) {
+	if result, err := t.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.TaskLabel), nil
+	}
+}
+
+func (t taskLabelDo) FirstOrCreate() (*models.TaskLabel, error) {
+	if result, err := t.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.TaskLabel), nil
+	}
+}
+
+func (t taskLabelDo) FindByPage(offset int, limit int) (result []*models.TaskLabel, count int64, err error) {
+	result, err = t.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = t.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (t taskLabelDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = t.Count()
+	if err != nil {
+		return
+	}
+
+	err = t.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (t taskLabelDo) Scan(result interface{}) (err error) {
+	return t.DO.Scan(result)
+}
+
+func (t taskLabelDo) Delete(models ...*models.TaskLabel) (result gen.ResultInfo, err error) {
+	return t.DO.Delete(models)
+}
+
+func (t *taskLabelDo) withDO(do gen.Dao) *taskLabelDo {
+	t.DO = *do.(*gen.DO)
+	return t
+}
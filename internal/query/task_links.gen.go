@@ -0,0 +1,879 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newTaskLink(db *gorm.DB, opts ...gen.DOOption) taskLink {
+	_taskLink := taskLink{}
+
+	_taskLink.taskLinkDo.UseDB(db, opts...)
+	_taskLink.taskLinkDo.UseModel(&models.TaskLink{})
+
+	tableName := _taskLink.taskLinkDo.TableName()
+	_taskLink.ALL = field.NewAsterisk(tableName)
+	_taskLink.ID = field.NewInt(tableName, "id")
+	_taskLink.CreatedAt = field.NewTime(tableName, "created_at")
+	_taskLink.SourceTaskID = field.NewInt(tableName, "source_task_id")
+	_taskLink.TargetTaskID = field.NewInt(tableName, "target_task_id")
+	_taskLink.Type = field.NewString(tableName, "type")
+	_taskLink.CreatedByID = field.NewInt(tableName, "created_by_id")
+	_taskLink.SourceTask = taskLinkBelongsToSourceTask{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("SourceTask", "models.Task"),
+		Assignee: struct {
+			field.RelationField
+			CurrentProject struct {
+				field.RelationField
+				Manager struct {
+					field.RelationField
+				}
+				Tasks struct {
+					field.RelationField
+				}
+				Sprints struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				TeamMembers struct {
+					field.RelationField
+				}
+				Members struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}
+			}
+			ManagedProjects struct {
+				field.RelationField
+			}
+			AssignedTasks struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("SourceTask.Assignee", "models.User"),
+			CurrentProject: struct {
+				field.RelationField
+				Manager struct {
+					field.RelationField
+				}
+				Tasks struct {
+					field.RelationField
+				}
+				Sprints struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				TeamMembers struct {
+					field.RelationField
+				}
+				Members struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("SourceTask.Assignee.CurrentProject", "models.Project"),
+				Manager: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("SourceTask.Assignee.CurrentProject.Manager", "models.User"),
+				},
+				Tasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("SourceTask.Assignee.CurrentProject.Tasks", "models.Task"),
+				},
+				Sprints: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("SourceTask.Assignee.CurrentProject.Sprints", "models.Sprint"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("SourceTask.Assignee.CurrentProject.Sprints.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("SourceTask.Assignee.CurrentProject.Sprints.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("SourceTask.Assignee.CurrentProject.Sprints.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("SourceTask.Assignee.CurrentProject.Sprints.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("SourceTask.Assignee.CurrentProject.Sprints.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("SourceTask.Assignee.CurrentProject.Sprints.Tasks", "models.Task"),
+					},
+				},
+				TeamMembers: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("SourceTask.Assignee.CurrentProject.TeamMembers", "models.User"),
+				},
+				Members: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("SourceTask.Assignee.CurrentProject.Members", "models.ProjectMember"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("SourceTask.Assignee.CurrentProject.Members.Project", "models.Project"),
+					},
+					User: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("SourceTask.Assignee.CurrentProject.Members.User", "models.User"),
+					},
+				},
+			},
+			ManagedProjects: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("SourceTask.Assignee.ManagedProjects", "models.Project"),
+			},
+			AssignedTasks: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("SourceTask.Assignee.AssignedTasks", "models.Task"),
+			},
+		},
+		Project: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("SourceTask.Project", "models.Project"),
+		},
+		Sprint: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("SourceTask.Sprint", "models.Sprint"),
+		},
+		Subtasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("SourceTask.Subtasks", "models.Task"),
+		},
+		TaskLabels: struct {
+			field.RelationField
+			Label struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("SourceTask.TaskLabels", "models.TaskLabel"),
+			Label: struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("SourceTask.TaskLabels.Label", "models.Label"),
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("SourceTask.TaskLabels.Label.Project", "models.Project"),
+				},
+			},
+		},
+	}
+
+	_taskLink.TargetTask = taskLinkBelongsToTargetTask{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("TargetTask", "models.Task"),
+	}
+
+	_taskLink.fillFieldMap()
+
+	return _taskLink
+}
+
+type taskLink struct {
+	taskLinkDo taskLinkDo
+
+	ALL          field.Asterisk
+	ID           field.Int
+	CreatedAt    field.Time
+	SourceTaskID field.Int
+	TargetTaskID field.Int
+	Type         field.String
+	CreatedByID  field.Int
+	SourceTask   taskLinkBelongsToSourceTask
+
+	TargetTask taskLinkBelongsToTargetTask
+
+	fieldMap map[string]field.Expr
+}
+
+func (t taskLink) Table(newTableName string) *taskLink {
+	t.taskLinkDo.UseTable(newTableName)
+	return t.updateTableName(newTableName)
+}
+
+func (t taskLink) As(alias string) *taskLink {
+	t.taskLinkDo.DO = *(t.taskLinkDo.As(alias).(*gen.DO))
+	return t.updateTableName(alias)
+}
+
+func (t *taskLink) updateTableName(table string) *taskLink {
+	t.ALL = field.NewAsterisk(table)
+	t.ID = field.NewInt(table, "id")
+	t.CreatedAt = field.NewTime(table, "created_at")
+	t.SourceTaskID = field.NewInt(table, "source_task_id")
+	t.TargetTaskID = field.NewInt(table, "target_task_id")
+	t.Type = field.NewString(table, "type")
+	t.CreatedByID = field.NewInt(table, "created_by_id")
+
+	t.fillFieldMap()
+
+	return t
+}
+
+func (t *taskLink) WithContext(ctx context.Context) ITaskLinkDo { return t.taskLinkDo.WithContext(ctx) }
+
+func (t taskLink) TableName() string { return t.taskLinkDo.TableName() }
+
+func (t taskLink) Alias() string { return t.taskLinkDo.Alias() }
+
+func (t taskLink) Columns(cols ...field.Expr) gen.Columns { return t.taskLinkDo.Columns(cols...) }
+
+func (t *taskLink) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := t.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (t *taskLink) fillFieldMap() {
+	t.fieldMap = make(map[string]field.Expr, 8)
+	t.fieldMap["id"] = t.ID
+	t.fieldMap["created_at"] = t.CreatedAt
+	t.fieldMap["source_task_id"] = t.SourceTaskID
+	t.fieldMap["target_task_id"] = t.TargetTaskID
+	t.fieldMap["type"] = t.Type
+	t.fieldMap["created_by_id"] = t.CreatedByID
+
+}
+
+func (t taskLink) clone(db *gorm.DB) taskLink {
+	t.taskLinkDo.ReplaceConnPool(db.Statement.ConnPool)
+	return t
+}
+
+func (t taskLink) replaceDB(db *gorm.DB) taskLink {
+	t.taskLinkDo.ReplaceDB(db)
+	return t
+}
+
+type taskLinkBelongsToSourceTask struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Assignee struct {
+		field.RelationField
+		CurrentProject struct {
+			field.RelationField
+			Manager struct {
+				field.RelationField
+			}
+			Tasks struct {
+				field.RelationField
+			}
+			Sprints struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Tasks struct {
+					field.RelationField
+				}
+			}
+			TeamMembers struct {
+				field.RelationField
+			}
+			Members struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}
+		}
+		ManagedProjects struct {
+			field.RelationField
+		}
+		AssignedTasks struct {
+			field.RelationField
+		}
+	}
+	Project struct {
+		field.RelationField
+	}
+	Sprint struct {
+		field.RelationField
+	}
+	Subtasks struct {
+		field.RelationField
+	}
+	TaskLabels struct {
+		field.RelationField
+		Label struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+		}
+	}
+}
+
+func (a taskLinkBelongsToSourceTask) Where(conds ...field.Expr) *taskLinkBelongsToSourceTask {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a taskLinkBelongsToSourceTask) WithContext(ctx context.Context) *taskLinkBelongsToSourceTask {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a taskLinkBelongsToSourceTask) Session(session *gorm.Session) *taskLinkBelongsToSourceTask {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a taskLinkBelongsToSourceTask) Model(m *models.TaskLink) *taskLinkBelongsToSourceTaskTx {
+	return &taskLinkBelongsToSourceTaskTx{a.db.Model(m).Association(a.Name())}
+}
+
+type taskLinkBelongsToSourceTaskTx struct{ tx *gorm.Association }
+
+func (a taskLinkBelongsToSourceTaskTx) Find() (result *models.Task, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a taskLinkBelongsToSourceTaskTx) Append(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a taskLinkBelongsToSourceTaskTx) Replace(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a taskLinkBelongsToSourceTaskTx) Delete(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a taskLinkBelongsToSourceTaskTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a taskLinkBelongsToSourceTaskTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type taskLinkBelongsToTargetTask struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a taskLinkBelongsToTargetTask) Where(conds ...field.Expr) *taskLinkBelongsToTargetTask {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a taskLinkBelongsToTargetTask) WithContext(ctx context.Context) *taskLinkBelongsToTargetTask {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a taskLinkBelongsToTargetTask) Session(session *gorm.Session) *taskLinkBelongsToTargetTask {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a taskLinkBelongsToTargetTask) Model(m *models.TaskLink) *taskLinkBelongsToTargetTaskTx {
+	return &taskLinkBelongsToTargetTaskTx{a.db.Model(m).Association(a.Name())}
+}
+
+type taskLinkBelongsToTargetTaskTx struct{ tx *gorm.Association }
+
+func (a taskLinkBelongsToTargetTaskTx) Find() (result *models.Task, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a taskLinkBelongsToTargetTaskTx) Append(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a taskLinkBelongsToTargetTaskTx) Replace(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a taskLinkBelongsToTargetTaskTx) Delete(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a taskLinkBelongsToTargetTaskTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a taskLinkBelongsToTargetTaskTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type taskLinkDo struct{ gen.DO }
+
+type ITaskLinkDo interface {
+	gen.SubQuery
+	Debug() ITaskLinkDo
+	WithContext(ctx context.Context) ITaskLinkDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ITaskLinkDo
+	WriteDB() ITaskLinkDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ITaskLinkDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ITaskLinkDo
+	Not(conds ...gen.Condition) ITaskLinkDo
+	Or(conds ...gen.Condition) ITaskLinkDo
+	Select(conds ...field.Expr) ITaskLinkDo
+	Where(conds ...gen.Condition) ITaskLinkDo
+	Order(conds ...field.Expr) ITaskLinkDo
+	Distinct(cols ...field.Expr) ITaskLinkDo
+	Omit(cols ...field.Expr) ITaskLinkDo
+	Join(table schema.Tabler, on ...field.Expr) ITaskLinkDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ITaskLinkDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ITaskLinkDo
+	Group(cols ...field.Expr) ITaskLinkDo
+	Having(conds ...gen.Condition) ITaskLinkDo
+	Limit(limit int) ITaskLinkDo
+	Offset(offset int) ITaskLinkDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ITaskLinkDo
+	Unscoped() ITaskLinkDo
+	Create(values ...*models.TaskLink) error
+	CreateInBatches(values []*models.TaskLink, batchSize int) error
+	Save(values ...*models.TaskLink) error
+	First() (*models.TaskLink, error)
+	Take() (*models.TaskLink, error)
+	Last() (*models.TaskLink, error)
+	Find() ([]*models.TaskLink, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.TaskLink, err error)
+	FindInBatches(result *[]*models.TaskLink, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.TaskLink) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ITaskLinkDo
+	Assign(attrs ...field.AssignExpr) ITaskLinkDo
+	Joins(fields ...field.RelationField) ITaskLinkDo
+	Preload(fields ...field.RelationField) ITaskLinkDo
+	FirstOrInit() (*models.TaskLink, error)
+	FirstOrCreate() (*models.TaskLink, error)
+	FindByPage(offset int, limit int) (result []*models.TaskLink, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ITaskLinkDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (t taskLinkDo) Debug() ITaskLinkDo {
+	return t.withDO(t.DO.Debug())
+}
+
+func (t taskLinkDo) WithContext(ctx context.Context) ITaskLinkDo {
+	return t.withDO(t.DO.WithContext(ctx))
+}
+
+func (t taskLinkDo) ReadDB() ITaskLinkDo {
+	return t.Clauses(dbresolver.Read)
+}
+
+func (t taskLinkDo) WriteDB() ITaskLinkDo {
+	return t.Clauses(dbresolver.Write)
+}
+
+func (t taskLinkDo) Session(config *gorm.Session) ITaskLinkDo {
+	return t.withDO(t.DO.Session(config))
+}
+
+func (t taskLinkDo) Clauses(conds ...clause.Expression) ITaskLinkDo {
+	return t.withDO(t.DO.Clauses(conds...))
+}
+
+func (t taskLinkDo) Returning(value interface{}, columns ...string) ITaskLinkDo {
+	return t.withDO(t.DO.Returning(value, columns...))
+}
+
+func (t taskLinkDo) Not(conds ...gen.Condition) ITaskLinkDo {
+	return t.withDO(t.DO.Not(conds...))
+}
+
+func (t taskLinkDo) Or(conds ...gen.Condition) ITaskLinkDo {
+	return t.withDO(t.DO.Or(conds...))
+}
+
+func (t taskLinkDo) Select(conds ...field.Expr) ITaskLinkDo {
+	return t.withDO(t.DO.Select(conds...))
+}
+
+func (t taskLinkDo) Where(conds ...gen.Condition) ITaskLinkDo {
+	return t.withDO(t.DO.Where(conds...))
+}
+
+func (t taskLinkDo) Order(conds ...field.Expr) ITaskLinkDo {
+	return t.withDO(t.DO.Order(conds...))
+}
+
+func (t taskLinkDo) Distinct(cols ...field.Expr) ITaskLinkDo {
+	return t.withDO(t.DO.Distinct(cols...))
+}
+
+func (t taskLinkDo) Omit(cols ...field.Expr) ITaskLinkDo {
+	return t.withDO(t.DO.Omit(cols...))
+}
+
+func (t taskLinkDo) Join(table schema.Tabler, on ...field.Expr) ITaskLinkDo {
+	return t.withDO(t.DO.Join(table, on...))
+}
+
+func (t taskLinkDo) LeftJoin(table schema.Tabler, on ...field.Expr) ITaskLinkDo {
+	return t.withDO(t.DO.LeftJoin(table, on...))
+}
+
+func (t taskLinkDo) RightJoin(table schema.Tabler, on ...field.Expr) ITaskLinkDo {
+	return t.withDO(t.DO.RightJoin(table, on...))
+}
+
+func (t taskLinkDo) Group(cols ...field.Expr) ITaskLinkDo {
+	return t.withDO(t.DO.Group(cols...))
+}
+
+func (t taskLinkDo) Having(conds ...gen.Condition) ITaskLinkDo {
+	return t.withDO(t.DO.Having(conds...))
+}
+
+func (t taskLinkDo) Limit(limit int) ITaskLinkDo {
+	return t.withDO(t.DO.Limit(limit))
+}
+
+func (t taskLinkDo) Offset(offset int) ITaskLinkDo {
+	return t.withDO(t.DO.Offset(offset))
+}
+
+func (t taskLinkDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ITaskLinkDo {
+	return t.withDO(t.DO.Scopes(funcs...))
+}
+
+func (t taskLinkDo) Unscoped() ITaskLinkDo {
+	return t.withDO(t.DO.Unscoped())
+}
+
+func (t taskLinkDo) Create(values ...*models.TaskLink) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return t.DO.Create(values)
+}
+
+func (t taskLinkDo) CreateInBatches(values []*models.TaskLink, batchSize int) error {
+	return t.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (t taskLinkDo) Save(values ...*models.TaskLink) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return t.DO.Save(values)
+}
+
+func (t taskLinkDo) First() (*models.TaskLink, error) {
+	if result, err := t.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.TaskLink), nil
+	}
+}
+
+func (t taskLinkDo) Take() (*models.TaskLink, error) {
+	if result, err := t.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.TaskLink), nil
+	}
+}
+
+func (t taskLinkDo) Last() (*models.TaskLink, error) {
+	if result, err := t.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.TaskLink), nil
+	}
+}
+
+func (t taskLinkDo) Find() ([]*models.TaskLink, error) {
+	result, err := t.DO.Find()
+	return result.([]*models.TaskLink), err
+}
+
+func (t taskLinkDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.TaskLink, err error) {
+	buf := make([]*models.TaskLink, 0, batchSize)
+	err = t.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (t taskLinkDo) FindInBatches(result *[]*models.TaskLink, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return t.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (t taskLinkDo) Attrs(attrs ...field.AssignExpr) ITaskLinkDo {
+	return t.withDO(t.DO.Attrs(attrs...))
+}
+
+func (t taskLinkDo) Assign(attrs ...field.AssignExpr) ITaskLinkDo {
+	return t.withDO(t.DO.Assign(attrs...))
+}
+
+func (t taskLinkDo) Joins(fields ...field.RelationField) ITaskLinkDo {
+	for _, _f := range fields {
+		t = *t.withDO(t.DO.Joins(_f))
+	}
+	return &t
+}
+
+func (t taskLinkDo) Preload(fields ...field.RelationField) ITaskLinkDo {
+	for _, _f := range fields {
+		t = *t.withDO(t.DO.Preload(_f))
+	}
+	return &t
+}
+
+func (t taskLinkDo) FirstOrInit() (*models.TaskLink, error) {
+	if result, err := t.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.TaskLink), nil
+	}
+}
+
+func (t taskLinkDo) FirstOrCreate() (*models.TaskLink, error) {
+	if result, err := t.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.TaskLink), nil
+	}
+}
+
+func (t taskLinkDo) FindByPage(offset int, limit int) (result []*models.TaskLink, count int64, err error) {
+	result, err = t.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = t.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (t taskLinkDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = t.Count()
+	if err != nil {
+		return
+	}
+
+	err = t.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (t taskLinkDo) Scan(result interface{}) (err error) {
+	return t.DO.Scan(result)
+}
+
+func (t taskLinkDo) Delete(models ...*models.TaskLink) (result gen.ResultInfo, err error) {
+	return t.DO.Delete(models)
+}
+
+func (t *taskLinkDo) withDO(do gen.Dao) *taskLinkDo {
+	t.DO = *do.(*gen.DO)
+	return t
+}
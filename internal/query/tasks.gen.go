@@ -39,118 +39,271 @@ func newTask(db *gorm.DB, opts ...gen.DOOption) task {
 	_task.Status = field.NewString(tableName, "status")
 	_task.Priority = field.NewString(tableName, "priority")
 	_task.DueDate = field.NewTime(tableName, "due_date")
-	_task.Assignee = taskBelongsToAssignee{
+	_task.ParentTaskID = field.NewInt(tableName, "parent_task_id")
+	_task.Rank = field.NewString(tableName, "rank")
+	_task.StoryPoints = field.NewInt(tableName, "story_points")
+	_task.OriginalEstimateMinutes = field.NewInt(tableName, "original_estimate_minutes")
+	_task.RemainingEstimateMinutes = field.NewInt(tableName, "remaining_estimate_minutes")
+	_task.Subtasks = taskHasManySubtasks{
 		db: db.Session(&gorm.Session{}),
 
-		RelationField: field.NewRelation("Assignee", "models.User"),
-		CurrentProject: struct {
+		RelationField: field.NewRelation("Subtasks", "models.Task"),
+		Assignee: struct {
 			field.RelationField
-			Manager struct {
-				field.RelationField
-			}
-			Tasks struct {
+			CurrentProject struct {
 				field.RelationField
-				Assignee struct {
+				Manager struct {
 					field.RelationField
 				}
-				Project struct {
+				Tasks struct {
 					field.RelationField
 				}
-				Sprint struct {
+				Sprints struct {
 					field.RelationField
 					Project struct {
 						field.RelationField
 					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
 					Tasks struct {
 						field.RelationField
 					}
 				}
+				TeamMembers struct {
+					field.RelationField
+				}
+				Members struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}
 			}
-			Sprints struct {
+			ManagedProjects struct {
 				field.RelationField
 			}
-			TeamMembers struct {
+			AssignedTasks struct {
 				field.RelationField
 			}
 		}{
-			RelationField: field.NewRelation("Assignee.CurrentProject", "models.Project"),
-			Manager: struct {
-				field.RelationField
-			}{
-				RelationField: field.NewRelation("Assignee.CurrentProject.Manager", "models.User"),
-			},
-			Tasks: struct {
+			RelationField: field.NewRelation("Subtasks.Assignee", "models.User"),
+			CurrentProject: struct {
 				field.RelationField
-				Assignee struct {
+				Manager struct {
 					field.RelationField
 				}
-				Project struct {
+				Tasks struct {
 					field.RelationField
 				}
-				Sprint struct {
+				Sprints struct {
 					field.RelationField
 					Project struct {
 						field.RelationField
 					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
 					Tasks struct {
 						field.RelationField
 					}
 				}
+				TeamMembers struct {
+					field.RelationField
+				}
+				Members struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}
 			}{
-				RelationField: field.NewRelation("Assignee.CurrentProject.Tasks", "models.Task"),
-				Assignee: struct {
+				RelationField: field.NewRelation("Subtasks.Assignee.CurrentProject", "models.Project"),
+				Manager: struct {
 					field.RelationField
 				}{
-					RelationField: field.NewRelation("Assignee.CurrentProject.Tasks.Assignee", "models.User"),
+					RelationField: field.NewRelation("Subtasks.Assignee.CurrentProject.Manager", "models.User"),
 				},
-				Project: struct {
+				Tasks: struct {
 					field.RelationField
 				}{
-					RelationField: field.NewRelation("Assignee.CurrentProject.Tasks.Project", "models.Project"),
+					RelationField: field.NewRelation("Subtasks.Assignee.CurrentProject.Tasks", "models.Task"),
 				},
-				Sprint: struct {
+				Sprints: struct {
 					field.RelationField
 					Project struct {
 						field.RelationField
 					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
 					Tasks struct {
 						field.RelationField
 					}
 				}{
-					RelationField: field.NewRelation("Assignee.CurrentProject.Tasks.Sprint", "models.Sprint"),
+					RelationField: field.NewRelation("Subtasks.Assignee.CurrentProject.Sprints", "models.Sprint"),
 					Project: struct {
 						field.RelationField
 					}{
-						RelationField: field.NewRelation("Assignee.CurrentProject.Tasks.Sprint.Project", "models.Project"),
+						RelationField: field.NewRelation("Subtasks.Assignee.CurrentProject.Sprints.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Subtasks.Assignee.CurrentProject.Sprints.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Subtasks.Assignee.CurrentProject.Sprints.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Subtasks.Assignee.CurrentProject.Sprints.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Subtasks.Assignee.CurrentProject.Sprints.Report.Tasks", "models.SprintReportTask"),
+						},
 					},
 					Tasks: struct {
 						field.RelationField
 					}{
-						RelationField: field.NewRelation("Assignee.CurrentProject.Tasks.Sprint.Tasks", "models.Task"),
+						RelationField: field.NewRelation("Subtasks.Assignee.CurrentProject.Sprints.Tasks", "models.Task"),
+					},
+				},
+				TeamMembers: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Subtasks.Assignee.CurrentProject.TeamMembers", "models.User"),
+				},
+				Members: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Subtasks.Assignee.CurrentProject.Members", "models.ProjectMember"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Subtasks.Assignee.CurrentProject.Members.Project", "models.Project"),
+					},
+					User: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Subtasks.Assignee.CurrentProject.Members.User", "models.User"),
 					},
 				},
 			},
-			Sprints: struct {
+			ManagedProjects: struct {
 				field.RelationField
 			}{
-				RelationField: field.NewRelation("Assignee.CurrentProject.Sprints", "models.Sprint"),
+				RelationField: field.NewRelation("Subtasks.Assignee.ManagedProjects", "models.Project"),
 			},
-			TeamMembers: struct {
+			AssignedTasks: struct {
 				field.RelationField
 			}{
-				RelationField: field.NewRelation("Assignee.CurrentProject.TeamMembers", "models.User"),
+				RelationField: field.NewRelation("Subtasks.Assignee.AssignedTasks", "models.Task"),
 			},
 		},
-		ManagedProjects: struct {
+		Project: struct {
 			field.RelationField
 		}{
-			RelationField: field.NewRelation("Assignee.ManagedProjects", "models.Project"),
+			RelationField: field.NewRelation("Subtasks.Project", "models.Project"),
 		},
-		AssignedTasks: struct {
+		Sprint: struct {
 			field.RelationField
 		}{
-			RelationField: field.NewRelation("Assignee.AssignedTasks", "models.Task"),
+			RelationField: field.NewRelation("Subtasks.Sprint", "models.Sprint"),
 		},
+		Subtasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Subtasks.Subtasks", "models.Task"),
+		},
+		TaskLabels: struct {
+			field.RelationField
+			Label struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Subtasks.TaskLabels", "models.TaskLabel"),
+			Label: struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Subtasks.TaskLabels.Label", "models.Label"),
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Subtasks.TaskLabels.Label.Project", "models.Project"),
+				},
+			},
+		},
+	}
+
+	_task.TaskLabels = taskHasManyTaskLabels{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("TaskLabels", "models.TaskLabel"),
+	}
+
+	_task.Assignee = taskBelongsToAssignee{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Assignee", "models.User"),
 	}
 
 	_task.Project = taskBelongsToProject{
@@ -173,20 +326,29 @@ func newTask(db *gorm.DB, opts ...gen.DOOption) task {
 type task struct {
 	taskDo taskDo
 
-	ALL         field.Asterisk
-	ID          field.Int
-	CreatedAt   field.Time
-	UpdatedAt   field.Time
-	DeletedAt   field.Field
-	Title       field.String
-	Description field.String
-	AssigneeID  field.Int
-	ProjectID   field.Int
-	SprintID    field.Int
-	Status      field.String
-	Priority    field.String
-	DueDate     field.Time
-	Assignee    taskBelongsToAssignee
+	ALL                      field.Asterisk
+	ID                       field.Int
+	CreatedAt                field.Time
+	UpdatedAt                field.Time
+	DeletedAt                field.Field
+	Title                    field.String
+	Description              field.String
+	AssigneeID               field.Int
+	ProjectID                field.Int
+	SprintID                 field.Int
+	Status                   field.String
+	Priority                 field.String
+	DueDate                  field.Time
+	ParentTaskID             field.Int
+	Rank                     field.String
+	StoryPoints              field.Int
+	OriginalEstimateMinutes  field.Int
+	RemainingEstimateMinutes field.Int
+	Subtasks                 taskHasManySubtasks
+
+	TaskLabels taskHasManyTaskLabels
+
+	Assignee taskBelongsToAssignee
 
 	Project taskBelongsToProject
 
@@ -219,6 +381,11 @@ func (t *task) updateTableName(table string) *task {
 	t.Status = field.NewString(table, "status")
 	t.Priority = field.NewString(table, "priority")
 	t.DueDate = field.NewTime(table, "due_date")
+	t.ParentTaskID = field.NewInt(table, "parent_task_id")
+	t.Rank = field.NewString(table, "rank")
+	t.StoryPoints = field.NewInt(table, "story_points")
+	t.OriginalEstimateMinutes = field.NewInt(table, "original_estimate_minutes")
+	t.RemainingEstimateMinutes = field.NewInt(table, "remaining_estimate_minutes")
 
 	t.fillFieldMap()
 
@@ -243,7 +410,7 @@ func (t *task) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (t *task) fillFieldMap() {
-	t.fieldMap = make(map[string]field.Expr, 15)
+	t.fieldMap = make(map[string]field.Expr, 22)
 	t.fieldMap["id"] = t.ID
 	t.fieldMap["created_at"] = t.CreatedAt
 	t.fieldMap["updated_at"] = t.UpdatedAt
@@ -256,6 +423,11 @@ func (t *task) fillFieldMap() {
 	t.fieldMap["status"] = t.Status
 	t.fieldMap["priority"] = t.Priority
 	t.fieldMap["due_date"] = t.DueDate
+	t.fieldMap["parent_task_id"] = t.ParentTaskID
+	t.fieldMap["rank"] = t.Rank
+	t.fieldMap["story_points"] = t.StoryPoints
+	t.fieldMap["original_estimate_minutes"] = t.OriginalEstimateMinutes
+	t.fieldMap["remaining_estimate_minutes"] = t.RemainingEstimateMinutes
 
 }
 
@@ -269,47 +441,222 @@ func (t task) replaceDB(db *gorm.DB) task {
 	return t
 }
 
-type taskBelongsToAssignee struct {
+type taskHasManySubtasks struct {
 	db *gorm.DB
 
 	field.RelationField
 
-	CurrentProject struct {
+	Assignee struct {
 		field.RelationField
-		Manager struct {
-			field.RelationField
-		}
-		Tasks struct {
+		CurrentProject struct {
 			field.RelationField
-			Assignee struct {
+			Manager struct {
 				field.RelationField
 			}
-			Project struct {
+			Tasks struct {
 				field.RelationField
 			}
-			Sprint struct {
+			Sprints struct {
 				field.RelationField
 				Project struct {
 					field.RelationField
 				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
 				Tasks struct {
 					field.RelationField
 				}
 			}
+			TeamMembers struct {
+				field.RelationField
+			}
+			Members struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}
 		}
-		Sprints struct {
+		ManagedProjects struct {
 			field.RelationField
 		}
-		TeamMembers struct {
+		AssignedTasks struct {
 			field.RelationField
 		}
 	}
-	ManagedProjects struct {
+	Project struct {
+		field.RelationField
+	}
+	Sprint struct {
+		field.RelationField
+	}
+	Subtasks struct {
 		field.RelationField
 	}
-	AssignedTasks struct {
+	TaskLabels struct {
 		field.RelationField
+		Label struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+		}
+	}
+}
+
+func (a taskHasManySubtasks) Where(conds ...field.Expr) *taskHasManySubtasks {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a taskHasManySubtasks) WithContext(ctx context.Context) *taskHasManySubtasks {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a taskHasManySubtasks) Session(session *gorm.Session) *taskHasManySubtasks {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a taskHasManySubtasks) Model(m *models.Task) *taskHasManySubtasksTx {
+	return &taskHasManySubtasksTx{a.db.Model(m).Association(a.Name())}
+}
+
+type taskHasManySubtasksTx struct{ tx *gorm.Association }
+
+func (a taskHasManySubtasksTx) Find() (result []*models.Task, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a taskHasManySubtasksTx) Append(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a taskHasManySubtasksTx) Replace(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a taskHasManySubtasksTx) Delete(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a taskHasManySubtasksTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a taskHasManySubtasksTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type taskHasManyTaskLabels struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a taskHasManyTaskLabels) Where(conds ...field.Expr) *taskHasManyTaskLabels {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
 	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a taskHasManyTaskLabels) WithContext(ctx context.Context) *taskHasManyTaskLabels {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a taskHasManyTaskLabels) Session(session *gorm.Session) *taskHasManyTaskLabels {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a taskHasManyTaskLabels) Model(m *models.Task) *taskHasManyTaskLabelsTx {
+	return &taskHasManyTaskLabelsTx{a.db.Model(m).Association(a.Name())}
+}
+
+type taskHasManyTaskLabelsTx struct{ tx *gorm.Association }
+
+func (a taskHasManyTaskLabelsTx) Find() (result []*models.TaskLabel, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a taskHasManyTaskLabelsTx) Append(values ...*models.TaskLabel) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a taskHasManyTaskLabelsTx) Replace(values ...*models.TaskLabel) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a taskHasManyTaskLabelsTx) Delete(values ...*models.TaskLabel) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a taskHasManyTaskLabelsTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a taskHasManyTaskLabelsTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type taskBelongsToAssignee struct {
+	db *gorm.DB
+
+	field.RelationField
 }
 
 func (a taskBelongsToAssignee) Where(conds ...field.Expr) *taskBelongsToAssignee {
@@ -37,6 +37,9 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 	_user.FirstName = field.NewString(tableName, "first_name")
 	_user.LastName = field.NewString(tableName, "last_name")
 	_user.CurrentProjectID = field.NewInt(tableName, "current_project_id")
+	_user.EmailOnAssignment = field.NewBool(tableName, "email_on_assignment")
+	_user.EmailOnDueSoon = field.NewBool(tableName, "email_on_due_soon")
+	_user.EmailOnSprintStart = field.NewBool(tableName, "email_on_sprint_start")
 	_user.ManagedProjects = userHasManyManagedProjects{
 		db: db.Session(&gorm.Session{}),
 
@@ -62,10 +65,34 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 					Project struct {
 						field.RelationField
 					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
 					Tasks struct {
 						field.RelationField
 					}
 				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
 			}
 		}{
 			RelationField: field.NewRelation("ManagedProjects.Manager", "models.User"),
@@ -92,10 +119,34 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 					Project struct {
 						field.RelationField
 					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
 					Tasks struct {
 						field.RelationField
 					}
 				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
 			}{
 				RelationField: field.NewRelation("ManagedProjects.Manager.AssignedTasks", "models.Task"),
 				Assignee: struct {
@@ -113,6 +164,18 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 					Project struct {
 						field.RelationField
 					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
 					Tasks struct {
 						field.RelationField
 					}
@@ -123,12 +186,70 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 					}{
 						RelationField: field.NewRelation("ManagedProjects.Manager.AssignedTasks.Sprint.Project", "models.Project"),
 					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("ManagedProjects.Manager.AssignedTasks.Sprint.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("ManagedProjects.Manager.AssignedTasks.Sprint.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("ManagedProjects.Manager.AssignedTasks.Sprint.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("ManagedProjects.Manager.AssignedTasks.Sprint.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
 					Tasks: struct {
 						field.RelationField
 					}{
 						RelationField: field.NewRelation("ManagedProjects.Manager.AssignedTasks.Sprint.Tasks", "models.Task"),
 					},
 				},
+				Subtasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("ManagedProjects.Manager.AssignedTasks.Subtasks", "models.Task"),
+				},
+				TaskLabels: struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("ManagedProjects.Manager.AssignedTasks.TaskLabels", "models.TaskLabel"),
+					Label: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("ManagedProjects.Manager.AssignedTasks.TaskLabels.Label", "models.Label"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("ManagedProjects.Manager.AssignedTasks.TaskLabels.Label.Project", "models.Project"),
+						},
+					},
+				},
 			},
 		},
 		Tasks: struct {
@@ -146,6 +267,27 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 		}{
 			RelationField: field.NewRelation("ManagedProjects.TeamMembers", "models.User"),
 		},
+		Members: struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("ManagedProjects.Members", "models.ProjectMember"),
+			Project: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("ManagedProjects.Members.Project", "models.Project"),
+			},
+			User: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("ManagedProjects.Members.User", "models.User"),
+			},
+		},
 	}
 
 	_user.AssignedTasks = userHasManyAssignedTasks{
@@ -168,18 +310,21 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 type user struct {
 	userDo userDo
 
-	ALL              field.Asterisk
-	ID               field.Int
-	CreatedAt        field.Time
-	UpdatedAt        field.Time
-	DeletedAt        field.Field
-	Email            field.String
-	Password         field.String
-	Role             field.String
-	FirstName        field.String
-	LastName         field.String
-	CurrentProjectID field.Int
-	ManagedProjects  userHasManyManagedProjects
+	ALL                field.Asterisk
+	ID                 field.Int
+	CreatedAt          field.Time
+	UpdatedAt          field.Time
+	DeletedAt          field.Field
+	Email              field.String
+	Password           field.String
+	Role               field.String
+	FirstName          field.String
+	LastName           field.String
+	CurrentProjectID   field.Int
+	EmailOnAssignment  field.Bool
+	EmailOnDueSoon     field.Bool
+	EmailOnSprintStart field.Bool
+	ManagedProjects    userHasManyManagedProjects
 
 	AssignedTasks userHasManyAssignedTasks
 
@@ -210,6 +355,9 @@ func (u *user) updateTableName(table string) *user {
 	u.FirstName = field.NewString(table, "first_name")
 	u.LastName = field.NewString(table, "last_name")
 	u.CurrentProjectID = field.NewInt(table, "current_project_id")
+	u.EmailOnAssignment = field.NewBool(table, "email_on_assignment")
+	u.EmailOnDueSoon = field.NewBool(table, "email_on_due_soon")
+	u.EmailOnSprintStart = field.NewBool(table, "email_on_sprint_start")
 
 	u.fillFieldMap()
 
@@ -234,7 +382,7 @@ func (u *user) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (u *user) fillFieldMap() {
-	u.fieldMap = make(map[string]field.Expr, 13)
+	u.fieldMap = make(map[string]field.Expr, 16)
 	u.fieldMap["id"] = u.ID
 	u.fieldMap["created_at"] = u.CreatedAt
 	u.fieldMap["updated_at"] = u.UpdatedAt
@@ -245,6 +393,9 @@ func (u *user) fillFieldMap() {
 	u.fieldMap["first_name"] = u.FirstName
 	u.fieldMap["last_name"] = u.LastName
 	u.fieldMap["current_project_id"] = u.CurrentProjectID
+	u.fieldMap["email_on_assignment"] = u.EmailOnAssignment
+	u.fieldMap["email_on_due_soon"] = u.EmailOnDueSoon
+	u.fieldMap["email_on_sprint_start"] = u.EmailOnSprintStart
 
 }
 
@@ -284,10 +435,34 @@ type userHasManyManagedProjects struct {
 				Project struct {
 					field.RelationField
 				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
 				Tasks struct {
 					field.RelationField
 				}
 			}
+			Subtasks struct {
+				field.RelationField
+			}
+			TaskLabels struct {
+				field.RelationField
+				Label struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}
+			}
 		}
 	}
 	Tasks struct {
@@ -299,6 +474,15 @@ type userHasManyManagedProjects struct {
 	TeamMembers struct {
 		field.RelationField
 	}
+	Members struct {
+		field.RelationField
+		Project struct {
+			field.RelationField
+		}
+		User struct {
+			field.RelationField
+		}
+	}
 }
 
 func (a userHasManyManagedProjects) Where(conds ...field.Expr) *userHasManyManagedProjects {
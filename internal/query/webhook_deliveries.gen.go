@@ -0,0 +1,929 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newWebhookDelivery(db *gorm.DB, opts ...gen.DOOption) webhookDelivery {
+	_webhookDelivery := webhookDelivery{}
+
+	_webhookDelivery.webhookDeliveryDo.UseDB(db, opts...)
+	_webhookDelivery.webhookDeliveryDo.UseModel(&models.WebhookDelivery{})
+
+	tableName := _webhookDelivery.webhookDeliveryDo.TableName()
+	_webhookDelivery.ALL = field.NewAsterisk(tableName)
+	_webhookDelivery.ID = field.NewInt(tableName, "id")
+	_webhookDelivery.CreatedAt = field.NewTime(tableName, "created_at")
+	_webhookDelivery.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_webhookDelivery.WebhookID = field.NewInt(tableName, "webhook_id")
+	_webhookDelivery.EventID = field.NewString(tableName, "event_id")
+	_webhookDelivery.EventType = field.NewString(tableName, "event_type")
+	_webhookDelivery.Payload = field.NewString(tableName, "payload")
+	_webhookDelivery.RedeliveryOfID = field.NewInt(tableName, "redelivery_of_id")
+	_webhookDelivery.Status = field.NewString(tableName, "status")
+	_webhookDelivery.Attempts = field.NewInt(tableName, "attempts")
+	_webhookDelivery.NextAttemptAt = field.NewTime(tableName, "next_attempt_at")
+	_webhookDelivery.LastAttemptAt = field.NewTime(tableName, "last_attempt_at")
+	_webhookDelivery.ResponseStatus = field.NewInt(tableName, "response_status")
+	_webhookDelivery.ResponseBody = field.NewString(tableName, "response_body")
+	_webhookDelivery.Error = field.NewString(tableName, "error")
+	_webhookDelivery.DurationMs = field.NewInt(tableName, "duration_ms")
+	_webhookDelivery.Webhook = webhookDeliveryBelongsToWebhook{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Webhook", "models.Webhook"),
+		Project: struct {
+			field.RelationField
+			Manager struct {
+				field.RelationField
+				CurrentProject struct {
+					field.RelationField
+				}
+				ManagedProjects struct {
+					field.RelationField
+				}
+				AssignedTasks struct {
+					field.RelationField
+					Assignee struct {
+						field.RelationField
+					}
+					Project struct {
+						field.RelationField
+					}
+					Sprint struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Subtasks struct {
+						field.RelationField
+					}
+					TaskLabels struct {
+						field.RelationField
+						Label struct {
+							field.RelationField
+							Project struct {
+								field.RelationField
+							}
+						}
+					}
+				}
+			}
+			Tasks struct {
+				field.RelationField
+			}
+			Sprints struct {
+				field.RelationField
+			}
+			TeamMembers struct {
+				field.RelationField
+			}
+			Members struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Webhook.Project", "models.Project"),
+			Manager: struct {
+				field.RelationField
+				CurrentProject struct {
+					field.RelationField
+				}
+				ManagedProjects struct {
+					field.RelationField
+				}
+				AssignedTasks struct {
+					field.RelationField
+					Assignee struct {
+						field.RelationField
+					}
+					Project struct {
+						field.RelationField
+					}
+					Sprint struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Subtasks struct {
+						field.RelationField
+					}
+					TaskLabels struct {
+						field.RelationField
+						Label struct {
+							field.RelationField
+							Project struct {
+								field.RelationField
+							}
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Webhook.Project.Manager", "models.User"),
+				CurrentProject: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Webhook.Project.Manager.CurrentProject", "models.Project"),
+				},
+				ManagedProjects: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Webhook.Project.Manager.ManagedProjects", "models.Project"),
+				},
+				AssignedTasks: struct {
+					field.RelationField
+					Assignee struct {
+						field.RelationField
+					}
+					Project struct {
+						field.RelationField
+					}
+					Sprint struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Subtasks struct {
+						field.RelationField
+					}
+					TaskLabels struct {
+						field.RelationField
+						Label struct {
+							field.RelationField
+							Project struct {
+								field.RelationField
+							}
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Webhook.Project.Manager.AssignedTasks", "models.Task"),
+					Assignee: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Webhook.Project.Manager.AssignedTasks.Assignee", "models.User"),
+					},
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Webhook.Project.Manager.AssignedTasks.Project", "models.Project"),
+					},
+					Sprint: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+						Report struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Webhook.Project.Manager.AssignedTasks.Sprint", "models.Sprint"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Webhook.Project.Manager.AssignedTasks.Sprint.Project", "models.Project"),
+						},
+						Report: struct {
+							field.RelationField
+							CompletedBy struct {
+								field.RelationField
+							}
+							NextSprint struct {
+								field.RelationField
+							}
+							Tasks struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Webhook.Project.Manager.AssignedTasks.Sprint.Report", "models.SprintReport"),
+							CompletedBy: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Webhook.Project.Manager.AssignedTasks.Sprint.Report.CompletedBy", "models.User"),
+							},
+							NextSprint: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Webhook.Project.Manager.AssignedTasks.Sprint.Report.NextSprint", "models.Sprint"),
+							},
+							Tasks: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Webhook.Project.Manager.AssignedTasks.Sprint.Report.Tasks", "models.SprintReportTask"),
+							},
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Webhook.Project.Manager.AssignedTasks.Sprint.Tasks", "models.Task"),
+						},
+					},
+					Subtasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Webhook.Project.Manager.AssignedTasks.Subtasks", "models.Task"),
+					},
+					TaskLabels: struct {
+						field.RelationField
+						Label struct {
+							field.RelationField
+							Project struct {
+								field.RelationField
+							}
+						}
+					}{
+						RelationField: field.NewRelation("Webhook.Project.Manager.AssignedTasks.TaskLabels", "models.TaskLabel"),
+						Label: struct {
+							field.RelationField
+							Project struct {
+								field.RelationField
+							}
+						}{
+							RelationField: field.NewRelation("Webhook.Project.Manager.AssignedTasks.TaskLabels.Label", "models.Label"),
+							Project: struct {
+								field.RelationField
+							}{
+								RelationField: field.NewRelation("Webhook.Project.Manager.AssignedTasks.TaskLabels.Label.Project", "models.Project"),
+							},
+						},
+					},
+				},
+			},
+			Tasks: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Webhook.Project.Tasks", "models.Task"),
+			},
+			Sprints: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Webhook.Project.Sprints", "models.Sprint"),
+			},
+			TeamMembers: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Webhook.Project.TeamMembers", "models.User"),
+			},
+			Members: struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Webhook.Project.Members", "models.ProjectMember"),
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Webhook.Project.Members.Project", "models.Project"),
+				},
+				User: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Webhook.Project.Members.User", "models.User"),
+				},
+			},
+		},
+		CreatedBy: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Webhook.CreatedBy", "models.User"),
+		},
+	}
+
+	_webhookDelivery.fillFieldMap()
+
+	return _webhookDelivery
+}
+
+type webhookDelivery struct {
+	webhookDeliveryDo webhookDeliveryDo
+
+	ALL            field.Asterisk
+	ID             field.Int
+	CreatedAt      field.Time
+	UpdatedAt      field.Time
+	WebhookID      field.Int
+	EventID        field.String
+	EventType      field.String
+	Payload        field.String
+	RedeliveryOfID field.Int
+	Status         field.String
+	Attempts       field.Int
+	NextAttemptAt  field.Time
+	LastAttemptAt  field.Time
+	ResponseStatus field.Int
+	ResponseBody   field.String
+	Error          field.String
+	DurationMs     field.Int
+	Webhook        webhookDeliveryBelongsToWebhook
+
+	fieldMap map[string]field.Expr
+}
+
+func (w webhookDelivery) Table(newTableName string) *webhookDelivery {
+	w.webhookDeliveryDo.UseTable(newTableName)
+	return w.updateTableName(newTableName)
+}
+
+func (w webhookDelivery) As(alias string) *webhookDelivery {
+	w.webhookDeliveryDo.DO = *(w.webhookDeliveryDo.As(alias).(*gen.DO))
+	return w.updateTableName(alias)
+}
+
+func (w *webhookDelivery) updateTableName(table string) *webhookDelivery {
+	w.ALL = field.NewAsterisk(table)
+	w.ID = field.NewInt(table, "id")
+	w.CreatedAt = field.NewTime(table, "created_at")
+	w.UpdatedAt = field.NewTime(table, "updated_at")
+	w.WebhookID = field.NewInt(table, "webhook_id")
+	w.EventID = field.NewString(table, "event_id")
+	w.EventType = field.NewString(table, "event_type")
+	w.Payload = field.NewString(table, "payload")
+	w.RedeliveryOfID = field.NewInt(table, "redelivery_of_id")
+	w.Status = field.NewString(table, "status")
+	w.Attempts = field.NewInt(table, "attempts")
+	w.NextAttemptAt = field.NewTime(table, "next_attempt_at")
+	w.LastAttemptAt = field.NewTime(table, "last_attempt_at")
+	w.ResponseStatus = field.NewInt(table, "response_status")
+	w.ResponseBody = field.NewString(table, "response_body")
+	w.Error = field.NewString(table, "error")
+	w.DurationMs = field.NewInt(table, "duration_ms")
+
+	w.fillFieldMap()
+
+	return w
+}
+
+func (w *webhookDelivery) WithContext(ctx context.Context) IWebhookDeliveryDo {
+	return w.webhookDeliveryDo.WithContext(ctx)
+}
+
+func (w webhookDelivery) TableName() string { return w.webhookDeliveryDo.TableName() }
+
+func (w webhookDelivery) Alias() string { return w.webhookDeliveryDo.Alias() }
+
+func (w webhookDelivery) Columns(cols ...field.Expr) gen.Columns {
+	return w.webhookDeliveryDo.Columns(cols...)
+}
+
+func (w *webhookDelivery) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := w.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (w *webhookDelivery) fillFieldMap() {
+	w.fieldMap = make(map[string]field.Expr, 17)
+	w.fieldMap["id"] = w.ID
+	w.fieldMap["created_at"] = w.CreatedAt
+	w.fieldMap["updated_at"] = w.UpdatedAt
+	w.fieldMap["webhook_id"] = w.WebhookID
+	w.fieldMap["event_id"] = w.EventID
+	w.fieldMap["event_type"] = w.EventType
+	w.fieldMap["payload"] = w.Payload
+	w.fieldMap["redelivery_of_id"] = w.RedeliveryOfID
+	w.fieldMap["status"] = w.Status
+	w.fieldMap["attempts"] = w.Attempts
+	w.fieldMap["next_attempt_at"] = w.NextAttemptAt
+	w.fieldMap["last_attempt_at"] = w.LastAttemptAt
+	w.fieldMap["response_status"] = w.ResponseStatus
+	w.fieldMap["response_body"] = w.ResponseBody
+	w.fieldMap["error"] = w.Error
+	w.fieldMap["duration_ms"] = w.DurationMs
+
+}
+
+func (w webhookDelivery) clone(db *gorm.DB) webhookDelivery {
+	w.webhookDeliveryDo.ReplaceConnPool(db.Statement.ConnPool)
+	return w
+}
+
+func (w webhookDelivery) replaceDB(db *gorm.DB) webhookDelivery {
+	w.webhookDeliveryDo.ReplaceDB(db)
+	return w
+}
+
+type webhookDeliveryBelongsToWebhook struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Project struct {
+		field.RelationField
+		Manager struct {
+			field.RelationField
+			CurrentProject struct {
+				field.RelationField
+			}
+			ManagedProjects struct {
+				field.RelationField
+			}
+			AssignedTasks struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+		}
+		Tasks struct {
+			field.RelationField
+		}
+		Sprints struct {
+			field.RelationField
+		}
+		TeamMembers struct {
+			field.RelationField
+		}
+		Members struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+		}
+	}
+	CreatedBy struct {
+		field.RelationField
+	}
+}
+
+func (a webhookDeliveryBelongsToWebhook) Where(conds ...field.Expr) *webhookDeliveryBelongsToWebhook {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a webhookDeliveryBelongsToWebhook) WithContext(ctx context.Context) *webhookDeliveryBelongsToWebhook {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a webhookDeliveryBelongsToWebhook) Session(session *gorm.Session) *webhookDeliveryBelongsToWebhook {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a webhookDeliveryBelongsToWebhook) Model(m *models.WebhookDelivery) *webhookDeliveryBelongsToWebhookTx {
+	return &webhookDeliveryBelongsToWebhookTx{a.db.Model(m).Association(a.Name())}
+}
+
+type webhookDeliveryBelongsToWebhookTx struct{ tx *gorm.Association }
+
+func (a webhookDeliveryBelongsToWebhookTx) Find() (result *models.Webhook, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a webhookDeliveryBelongsToWebhookTx) Append(values ...*models.Webhook) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a webhookDeliveryBelongsToWebhookTx) Replace(values ...*models.Webhook) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a webhookDeliveryBelongsToWebhookTx) Delete(values ...*models.Webhook) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a webhookDeliveryBelongsToWebhookTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a webhookDeliveryBelongsToWebhookTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type webhookDeliveryDo struct{ gen.DO }
+
+type IWebhookDeliveryDo interface {
+	gen.SubQuery
+	Debug() IWebhookDeliveryDo
+	WithContext(ctx context.Context) IWebhookDeliveryDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IWebhookDeliveryDo
+	WriteDB() IWebhookDeliveryDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IWebhookDeliveryDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IWebhookDeliveryDo
+	Not(conds ...gen.Condition) IWebhookDeliveryDo
+	Or(conds ...gen.Condition) IWebhookDeliveryDo
+	Select(conds ...field.Expr) IWebhookDeliveryDo
+	Where(conds ...gen.Condition) IWebhookDeliveryDo
+	Order(conds ...field.Expr) IWebhookDeliveryDo
+	Distinct(cols ...field.Expr) IWebhookDeliveryDo
+	Omit(cols ...field.Expr) IWebhookDeliveryDo
+	Join(table schema.Tabler, on ...field.Expr) IWebhookDeliveryDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IWebhookDeliveryDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IWebhookDeliveryDo
+	Group(cols ...field.Expr) IWebhookDeliveryDo
+	Having(conds ...gen.Condition) IWebhookDeliveryDo
+	Limit(limit int) IWebhookDeliveryDo
+	Offset(offset int) IWebhookDeliveryDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IWebhookDeliveryDo
+	Unscoped() IWebhookDeliveryDo
+	Create(values ...*models.WebhookDelivery) error
+	CreateInBatches(values []*models.WebhookDelivery, batchSize int) error
+	Save(values ...*models.WebhookDelivery) error
+	First() (*models.WebhookDelivery, error)
+	Take() (*models.WebhookDelivery, error)
+	Last() (*models.WebhookDelivery, error)
+	Find() ([]*models.WebhookDelivery, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.WebhookDelivery, err error)
+	FindInBatches(result *[]*models.WebhookDelivery, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.WebhookDelivery) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IWebhookDeliveryDo
+	Assign(attrs ...field.AssignExpr) IWebhookDeliveryDo
+	Joins(fields ...field.RelationField) IWebhookDeliveryDo
+	Preload(fields ...field.RelationField) IWebhookDeliveryDo
+	FirstOrInit() (*models.WebhookDelivery, error)
+	FirstOrCreate() (*models.WebhookDelivery, error)
+	FindByPage(offset int, limit int) (result []*models.WebhookDelivery, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IWebhookDeliveryDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (w webhookDeliveryDo) Debug() IWebhookDeliveryDo {
+	return w.withDO(w.DO.Debug())
+}
+
+func (w webhookDeliveryDo) WithContext(ctx context.Context) IWebhookDeliveryDo {
+	return w.withDO(w.DO.WithContext(ctx))
+}
+
+func (w webhookDeliveryDo) ReadDB() IWebhookDeliveryDo {
+	return w.Clauses(dbresolver.Read)
+}
+
+func (w webhookDeliveryDo) WriteDB() IWebhookDeliveryDo {
+	return w.Clauses(dbresolver.Write)
+}
+
+func (w webhookDeliveryDo) Session(config *gorm.Session) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Session(config))
+}
+
+func (w webhookDeliveryDo) Clauses(conds ...clause.Expression) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Clauses(conds...))
+}
+
+func (w webhookDeliveryDo) Returning(value interface{}, columns ...string) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Returning(value, columns...))
+}
+
+func (w webhookDeliveryDo) Not(conds ...gen.Condition) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Not(conds...))
+}
+
+func (w webhookDeliveryDo) Or(conds ...gen.Condition) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Or(conds...))
+}
+
+func (w webhookDeliveryDo) Select(conds ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Select(conds...))
+}
+
+func (w webhookDeliveryDo) Where(conds ...gen.Condition) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Where(conds...))
+}
+
+func (w webhookDeliveryDo) Order(conds ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Order(conds...))
+}
+
+func (w webhookDeliveryDo) Distinct(cols ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Distinct(cols...))
+}
+
+func (w webhookDeliveryDo) Omit(cols ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Omit(cols...))
+}
+
+func (w webhookDeliveryDo) Join(table schema.Tabler, on ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Join(table, on...))
+}
+
+func (w webhookDeliveryDo) LeftJoin(table schema.Tabler, on ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.LeftJoin(table, on...))
+}
+
+func (w webhookDeliveryDo) RightJoin(table schema.Tabler, on ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.RightJoin(table, on...))
+}
+
+func (w webhookDeliveryDo) Group(cols ...field.Expr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Group(cols...))
+}
+
+func (w webhookDeliveryDo) Having(conds ...gen.Condition) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Having(conds...))
+}
+
+func (w webhookDeliveryDo) Limit(limit int) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Limit(limit))
+}
+
+func (w webhookDeliveryDo) Offset(offset int) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Offset(offset))
+}
+
+func (w webhookDeliveryDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Scopes(funcs...))
+}
+
+func (w webhookDeliveryDo) Unscoped() IWebhookDeliveryDo {
+	return w.withDO(w.DO.Unscoped())
+}
+
+func (w webhookDeliveryDo) Create(values ...*models.WebhookDelivery) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return w.DO.Create(values)
+}
+
+func (w webhookDeliveryDo) CreateInBatches(values []*models.WebhookDelivery, batchSize int) error {
+	return w.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (w webhookDeliveryDo) Save(values ...*models.WebhookDelivery) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return w.DO.Save(values)
+}
+
+func (w webhookDeliveryDo) First() (*models.WebhookDelivery, error) {
+	if result, err := w.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WebhookDelivery), nil
+	}
+}
+
+func (w webhookDeliveryDo) Take() (*models.WebhookDelivery, error) {
+	if result, err := w.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WebhookDelivery), nil
+	}
+}
+
+func (w webhookDeliveryDo) Last() (*models.WebhookDelivery, error) {
+	if result, err := w.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WebhookDelivery), nil
+	}
+}
+
+func (w webhookDeliveryDo) Find() ([]*models.WebhookDelivery, error) {
+	result, err := w.DO.Find()
+	return result.([]*models.WebhookDelivery), err
+}
+
+func (w webhookDeliveryDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.WebhookDelivery, err error) {
+	buf := make([]*models.WebhookDelivery, 0, batchSize)
+	err = w.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (w webhookDeliveryDo) FindInBatches(result *[]*models.WebhookDelivery, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return w.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (w webhookDeliveryDo) Attrs(attrs ...field.AssignExpr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Attrs(attrs...))
+}
+
+func (w webhookDeliveryDo) Assign(attrs ...field.AssignExpr) IWebhookDeliveryDo {
+	return w.withDO(w.DO.Assign(attrs...))
+}
+
+func (w webhookDeliveryDo) Joins(fields ...field.RelationField) IWebhookDeliveryDo {
+	for _, _f := range fields {
+		w = *w.withDO(w.DO.Joins(_f))
+	}
+	return &w
+}
+
+func (w webhookDeliveryDo) Preload(fields ...field.RelationField) IWebhookDeliveryDo {
+	for _, _f := range fields {
+		w = *w.withDO(w.DO.Preload(_f))
+	}
+	return &w
+}
+
+func (w webhookDeliveryDo) FirstOrInit() (*models.WebhookDelivery, error) {
+	if result, err := w.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WebhookDelivery), nil
+	}
+}
+
+func (w webhookDeliveryDo) FirstOrCreate() (*models.WebhookDelivery, error) {
+	if result, err := w.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WebhookDelivery), nil
+	}
+}
+
+func (w webhookDeliveryDo) FindByPage(offset int, limit int) (result []*models.WebhookDelivery, count int64, err error) {
+	result, err = w.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = w.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (w webhookDeliveryDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = w.Count()
+	if err != nil {
+		return
+	}
+
+	err = w.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (w webhookDeliveryDo) Scan(result interface{}) (err error) {
+	return w.DO.Scan(result)
+}
+
+func (w webhookDeliveryDo) Delete(models ...*models.WebhookDelivery) (result gen.ResultInfo, err error) {
+	return w.DO.Delete(models)
+}
+
+func (w *webhookDeliveryDo) withDO(do gen.Dao) *webhookDeliveryDo {
+	w.DO = *do.(*gen.DO)
+	return w
+}
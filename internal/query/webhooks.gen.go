@@ -0,0 +1,891 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newWebhook(db *gorm.DB, opts ...gen.DOOption) webhook {
+	_webhook := webhook{}
+
+	_webhook.webhookDo.UseDB(db, opts...)
+	_webhook.webhookDo.UseModel(&models.Webhook{})
+
+	tableName := _webhook.webhookDo.TableName()
+	_webhook.ALL = field.NewAsterisk(tableName)
+	_webhook.ID = field.NewInt(tableName, "id")
+	_webhook.CreatedAt = field.NewTime(tableName, "created_at")
+	_webhook.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_webhook.ProjectID = field.NewInt(tableName, "project_id")
+	_webhook.CreatedByID = field.NewInt(tableName, "created_by_id")
+	_webhook.URL = field.NewString(tableName, "url")
+	_webhook.Secret = field.NewString(tableName, "secret")
+	_webhook.Events = field.NewField(tableName, "events")
+	_webhook.Active = field.NewBool(tableName, "active")
+	_webhook.Project = webhookBelongsToProject{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Project", "models.Project"),
+		Manager: struct {
+			field.RelationField
+			CurrentProject struct {
+				field.RelationField
+			}
+			ManagedProjects struct {
+				field.RelationField
+			}
+			AssignedTasks struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Project.Manager", "models.User"),
+			CurrentProject: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Manager.CurrentProject", "models.Project"),
+			},
+			ManagedProjects: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Manager.ManagedProjects", "models.Project"),
+			},
+			AssignedTasks: struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Project.Manager.AssignedTasks", "models.Task"),
+				Assignee: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Assignee", "models.User"),
+				},
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Project", "models.Project"),
+				},
+				Sprint: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint", "models.Sprint"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Tasks", "models.Task"),
+					},
+				},
+				Subtasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Subtasks", "models.Task"),
+				},
+				TaskLabels: struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels", "models.TaskLabel"),
+					Label: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels.Label", "models.Label"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels.Label.Project", "models.Project"),
+						},
+					},
+				},
+			},
+		},
+		Tasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.Tasks", "models.Task"),
+		},
+		Sprints: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.Sprints", "models.Sprint"),
+		},
+		TeamMembers: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.TeamMembers", "models.User"),
+		},
+		Members: struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Project.Members", "models.ProjectMember"),
+			Project: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Members.Project", "models.Project"),
+			},
+			User: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Members.User", "models.User"),
+			},
+		},
+	}
+
+	_webhook.CreatedBy = webhookBelongsToCreatedBy{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("CreatedBy", "models.User"),
+	}
+
+	_webhook.fillFieldMap()
+
+	return _webhook
+}
+
+type webhook struct {
+	webhookDo webhookDo
+
+	ALL         field.Asterisk
+	ID          field.Int
+	CreatedAt   field.Time
+	UpdatedAt   field.Time
+	ProjectID   field.Int
+	CreatedByID field.Int
+	URL         field.String
+	Secret      field.String
+	Events      field.Field
+	Active      field.Bool
+	Project     webhookBelongsToProject
+
+	CreatedBy webhookBelongsToCreatedBy
+
+	fieldMap map[string]field.Expr
+}
+
+func (w webhook) Table(newTableName string) *webhook {
+	w.webhookDo.UseTable(newTableName)
+	return w.updateTableName(newTableName)
+}
+
+func (w webhook) As(alias string) *webhook {
+	w.webhookDo.DO = *(w.webhookDo.As(alias).(*gen.DO))
+	return w.updateTableName(alias)
+}
+
+func (w *webhook) updateTableName(table string) *webhook {
+	w.ALL = field.NewAsterisk(table)
+	w.ID = field.NewInt(table, "id")
+	w.CreatedAt = field.NewTime(table, "created_at")
+	w.UpdatedAt = field.NewTime(table, "updated_at")
+	w.ProjectID = field.NewInt(table, "project_id")
+	w.CreatedByID = field.NewInt(table, "created_by_id")
+	w.URL = field.NewString(table, "url")
+	w.Secret = field.NewString(table, "secret")
+	w.Events = field.NewField(table, "events")
+	w.Active = field.NewBool(table, "active")
+
+	w.fillFieldMap()
+
+	return w
+}
+
+func (w *webhook) WithContext(ctx context.Context) IWebhookDo { return w.webhookDo.WithContext(ctx) }
+
+func (w webhook) TableName() string { return w.webhookDo.TableName() }
+
+func (w webhook) Alias() string { return w.webhookDo.Alias() }
+
+func (w webhook) Columns(cols ...field.Expr) gen.Columns { return w.webhookDo.Columns(cols...) }
+
+func (w *webhook) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := w.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (w *webhook) fillFieldMap() {
+	w.fieldMap = make(map[string]field.Expr, 11)
+	w.fieldMap["id"] = w.ID
+	w.fieldMap["created_at"] = w.CreatedAt
+	w.fieldMap["updated_at"] = w.UpdatedAt
+	w.fieldMap["project_id"] = w.ProjectID
+	w.fieldMap["created_by_id"] = w.CreatedByID
+	w.fieldMap["url"] = w.URL
+	w.fieldMap["secret"] = w.Secret
+	w.fieldMap["events"] = w.Events
+	w.fieldMap["active"] = w.Active
+
+}
+
+func (w webhook) clone(db *gorm.DB) webhook {
+	w.webhookDo.ReplaceConnPool(db.Statement.ConnPool)
+	return w
+}
+
+func (w webhook) replaceDB(db *gorm.DB) webhook {
+	w.webhookDo.ReplaceDB(db)
+	return w
+}
+
+type webhookBelongsToProject struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Manager struct {
+		field.RelationField
+		CurrentProject struct {
+			field.RelationField
+		}
+		ManagedProjects struct {
+			field.RelationField
+		}
+		AssignedTasks struct {
+			field.RelationField
+			Assignee struct {
+				field.RelationField
+			}
+			Project struct {
+				field.RelationField
+			}
+			Sprint struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Tasks struct {
+					field.RelationField
+				}
+			}
+			Subtasks struct {
+				field.RelationField
+			}
+			TaskLabels struct {
+				field.RelationField
+				Label struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+	}
+	Tasks struct {
+		field.RelationField
+	}
+	Sprints struct {
+		field.RelationField
+	}
+	TeamMembers struct {
+		field.RelationField
+	}
+	Members struct {
+		field.RelationField
+		Project struct {
+			field.RelationField
+		}
+		User struct {
+			field.RelationField
+		}
+	}
+}
+
+func (a webhookBelongsToProject) Where(conds ...field.Expr) *webhookBelongsToProject {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a webhookBelongsToProject) WithContext(ctx context.Context) *webhookBelongsToProject {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a webhookBelongsToProject) Session(session *gorm.Session) *webhookBelongsToProject {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a webhookBelongsToProject) Model(m *models.Webhook) *webhookBelongsToProjectTx {
+	return &webhookBelongsToProjectTx{a.db.Model(m).Association(a.Name())}
+}
+
+type webhookBelongsToProjectTx struct{ tx *gorm.Association }
+
+func (a webhookBelongsToProjectTx) Find() (result *models.Project, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a webhookBelongsToProjectTx) Append(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a webhookBelongsToProjectTx) Replace(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a webhookBelongsToProjectTx) Delete(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a webhookBelongsToProjectTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a webhookBelongsToProjectTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type webhookBelongsToCreatedBy struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a webhookBelongsToCreatedBy) Where(conds ...field.Expr) *webhookBelongsToCreatedBy {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a webhookBelongsToCreatedBy) WithContext(ctx context.Context) *webhookBelongsToCreatedBy {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a webhookBelongsToCreatedBy) Session(session *gorm.Session) *webhookBelongsToCreatedBy {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a webhookBelongsToCreatedBy) Model(m *models.Webhook) *webhookBelongsToCreatedByTx {
+	return &webhookBelongsToCreatedByTx{a.db.Model(m).Association(a.Name())}
+}
+
+type webhookBelongsToCreatedByTx struct{ tx *gorm.Association }
+
+func (a webhookBelongsToCreatedByTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a webhookBelongsToCreatedByTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a webhookBelongsToCreatedByTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a webhookBelongsToCreatedByTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a webhookBelongsToCreatedByTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a webhookBelongsToCreatedByTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type webhookDo struct{ gen.DO }
+
+type IWebhookDo interface {
+	gen.SubQuery
+	Debug() IWebhookDo
+	WithContext(ctx context.Context) IWebhookDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IWebhookDo
+	WriteDB() IWebhookDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IWebhookDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IWebhookDo
+	Not(conds ...gen.Condition) IWebhookDo
+	Or(conds ...gen.Condition) IWebhookDo
+	Select(conds ...field.Expr) IWebhookDo
+	Where(conds ...gen.Condition) IWebhookDo
+	Order(conds ...field.Expr) IWebhookDo
+	Distinct(cols ...field.Expr) IWebhookDo
+	Omit(cols ...field.Expr) IWebhookDo
+	Join(table schema.Tabler, on ...field.Expr) IWebhookDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IWebhookDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IWebhookDo
+	Group(cols ...field.Expr) IWebhookDo
+	Having(conds ...gen.Condition) IWebhookDo
+	Limit(limit int) IWebhookDo
+	Offset(offset int) IWebhookDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IWebhookDo
+	Unscoped() IWebhookDo
+	Create(values ...*models.Webhook) error
+	CreateInBatches(values []*models.Webhook, batchSize int) error
+	Save(values ...*models.Webhook) error
+	First() (*models.Webhook, error)
+	Take() (*models.Webhook, error)
+	Last() (*models.Webhook, error)
+	Find() ([]*models.Webhook, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.Webhook, err error)
+	FindInBatches(result *[]*models.Webhook, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.Webhook) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IWebhookDo
+	Assign(attrs ...field.AssignExpr) IWebhookDo
+	Joins(fields ...field.RelationField) IWebhookDo
+	Preload(fields ...field.RelationField) IWebhookDo
+	FirstOrInit() (*models.Webhook, error)
+	FirstOrCreate() (*models.Webhook, error)
+	FindByPage(offset int, limit int) (result []*models.Webhook, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IWebhookDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (w webhookDo) Debug() IWebhookDo {
+	return w.withDO(w.DO.Debug())
+}
+
+func (w webhookDo) WithContext(ctx context.Context) IWebhookDo {
+	return w.withDO(w.DO.WithContext(ctx))
+}
+
+func (w webhookDo) ReadDB() IWebhookDo {
+	return w.Clauses(dbresolver.Read)
+}
+
+func (w webhookDo) WriteDB() IWebhookDo {
+	return w.Clauses(dbresolver.Write)
+}
+
+func (w webhookDo) Session(config *gorm.Session) IWebhookDo {
+	return w.withDO(w.DO.Session(config))
+}
+
+func (w webhookDo) Clauses(conds ...clause.Expression) IWebhookDo {
+	return w.withDO(w.DO.Clauses(conds...))
+}
+
+func (w webhookDo) Returning(value interface{}, columns ...string) IWebhookDo {
+	return w.withDO(w.DO.Returning(value, columns...))
+}
+
+func (w webhookDo) Not(conds ...gen.Condition) IWebhookDo {
+	return w.withDO(w.DO.Not(conds...))
+}
+
+func (w webhookDo) Or(conds ...gen.Condition) IWebhookDo {
+	return w.withDO(w.DO.Or(conds...))
+}
+
+func (w webhookDo) Select(conds ...field.Expr) IWebhookDo {
+	return w.withDO(w.DO.Select(conds...))
+}
+
+func (w webhookDo) Where(conds ...gen.Condition) IWebhookDo {
+	return w.withDO(w.DO.Where(conds...))
+}
+
+func (w webhookDo) Order(conds ...field.Expr) IWebhookDo {
+	return w.withDO(w.DO.Order(conds...))
+}
+
+func (w webhookDo) Distinct(cols ...field.Expr) IWebhookDo {
+	return w.withDO(w.DO.Distinct(cols...))
+}
+
+func (w webhookDo) Omit(cols ...field.Expr) IWebhookDo {
+	return w.withDO(w.DO.Omit(cols...))
+}
+
+func (w webhookDo) Join(table schema.Tabler, on ...field.Expr) IWebhookDo {
+	return w.withDO(w.DO.Join(table, on...))
+}
+
+func (w webhookDo) LeftJoin(table schema.Tabler, on ...field.Expr) IWebhookDo {
+	return w.withDO(w.DO.LeftJoin(table, on...))
+}
+
+func (w webhookDo) RightJoin(table schema.Tabler, on ...field.Expr) IWebhookDo {
+	return w.withDO(w.DO.RightJoin(table, on...))
+}
+
+func (w webhookDo) Group(cols ...field.Expr) IWebhookDo {
+	return w.withDO(w.DO.Group(cols...))
+}
+
+func (w webhookDo) Having(conds ...gen.Condition) IWebhookDo {
+	return w.withDO(w.DO.Having(conds...))
+}
+
+func (w webhookDo) Limit(limit int) IWebhookDo {
+	return w.withDO(w.DO.Limit(limit))
+}
+
+func (w webhookDo) Offset(offset int) IWebhookDo {
+	return w.withDO(w.DO.Offset(offset))
+}
+
+func (w webhookDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IWebhookDo {
+	return w.withDO(w.DO.Scopes(funcs...))
+}
+
+func (w webhookDo) Unscoped() IWebhookDo {
+	return w.withDO(w.DO.Unscoped())
+}
+
+func (w webhookDo) Create(values ...*models.Webhook) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return w.DO.Create(values)
+}
+
+func (w webhookDo) CreateInBatches(values []*models.Webhook, batchSize int) error {
+	return w.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (w webhookDo) Save(values ...*models.Webhook) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return w.DO.Save(values)
+}
+
+func (w webhookDo) First() (*models.Webhook, error) {
+	if result, err := w.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Webhook), nil
+	}
+}
+
+func (w webhookDo) Take() (*models.Webhook, error) {
+	if result, err := w.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Webhook), nil
+	}
+}
+
+func (w webhookDo) Last() (*models.Webhook, error) {
+	if result, err := w.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Webhook), nil
+	}
+}
+
+func (w webhookDo) Find() ([]*models.Webhook, error) {
+	result, err := w.DO.Find()
+	return result.([]*models.Webhook), err
+}
+
+func (w webhookDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.Webhook, err error) {
+	buf := make([]*models.Webhook, 0, batchSize)
+	err = w.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (w webhookDo) FindInBatches(result *[]*models.Webhook, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return w.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (w webhookDo) Attrs(attrs ...field.AssignExpr) IWebhookDo {
+	return w.withDO(w.DO.Attrs(attrs...))
+}
+
+func (w webhookDo) Assign(attrs ...field.AssignExpr) IWebhookDo {
+	return w.withDO(w.DO.Assign(attrs...))
+}
+
+func (w webhookDo) Joins(fields ...field.RelationField) IWebhookDo {
+	for _, _f := range fields {
+		w = *w.withDO(w.DO.Joins(_f))
+	}
+	return &w
+}
+
+func (w webhookDo) Preload(fields ...field.RelationField) IWebhookDo {
+	for _, _f := range fields {
+		w = *w.withDO(w.DO.Preload(_f))
+	}
+	return &w
+}
+
+func (w webhookDo) FirstOrInit() (*models.Webhook, error) {
+	if result, err := w.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Webhook), nil
+	}
+}
+
+func (w webhookDo) FirstOrCreate() (*models.Webhook, error) {
+	if result, err := w.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Webhook), nil
+	}
+}
+
+func (w webhookDo) FindByPage(offset int, limit int) (result []*models.Webhook, count int64, err error) {
+	result, err = w.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = w.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (w webhookDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = w.Count()
+	if err != nil {
+		return
+	}
+
+	err = w.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (w webhookDo) Scan(result interface{}) (err error) {
+	return w.DO.Scan(result)
+}
+
+func (w webhookDo) Delete(models ...*models.Webhook) (result gen.ResultInfo, err error) {
+	return w.DO.Delete(models)
+}
+
+func (w *webhookDo) withDO(do gen.Dao) *webhookDo {
+	w.DO = *do.(*gen.DO)
+	return w
+}
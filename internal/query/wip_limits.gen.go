@@ -0,0 +1,800 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newWipLimit(db *gorm.DB, opts ...gen.DOOption) wipLimit {
+	_wipLimit := wipLimit{}
+
+	_wipLimit.wipLimitDo.UseDB(db, opts...)
+	_wipLimit.wipLimitDo.UseModel(&models.WipLimit{})
+
+	tableName := _wipLimit.wipLimitDo.TableName()
+	_wipLimit.ALL = field.NewAsterisk(tableName)
+	_wipLimit.ID = field.NewInt(tableName, "id")
+	_wipLimit.CreatedAt = field.NewTime(tableName, "created_at")
+	_wipLimit.ProjectID = field.NewInt(tableName, "project_id")
+	_wipLimit.Status = field.NewString(tableName, "status")
+	_wipLimit.MaxTasks = field.NewInt(tableName, "max_tasks")
+	_wipLimit.MaxTasksPerAssignee = field.NewInt(tableName, "max_tasks_per_assignee")
+	_wipLimit.Project = wipLimitBelongsToProject{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Project", "models.Project"),
+		Manager: struct {
+			field.RelationField
+			CurrentProject struct {
+				field.RelationField
+			}
+			ManagedProjects struct {
+				field.RelationField
+			}
+			AssignedTasks struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Project.Manager", "models.User"),
+			CurrentProject: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Manager.CurrentProject", "models.Project"),
+			},
+			ManagedProjects: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Manager.ManagedProjects", "models.Project"),
+			},
+			AssignedTasks: struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Project.Manager.AssignedTasks", "models.Task"),
+				Assignee: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Assignee", "models.User"),
+				},
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Project", "models.Project"),
+				},
+				Sprint: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint", "models.Sprint"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Tasks", "models.Task"),
+					},
+				},
+				Subtasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Subtasks", "models.Task"),
+				},
+				TaskLabels: struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels", "models.TaskLabel"),
+					Label: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels.Label", "models.Label"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels.Label.Project", "models.Project"),
+						},
+					},
+				},
+			},
+		},
+		Tasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.Tasks", "models.Task"),
+		},
+		Sprints: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.Sprints", "models.Sprint"),
+		},
+		TeamMembers: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.TeamMembers", "models.User"),
+		},
+		Members: struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Project.Members", "models.ProjectMember"),
+			Project: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Members.Project", "models.Project"),
+			},
+			User: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Members.User", "models.User"),
+			},
+		},
+	}
+
+	_wipLimit.fillFieldMap()
+
+	return _wipLimit
+}
+
+type wipLimit struct {
+	wipLimitDo wipLimitDo
+
+	ALL                 field.Asterisk
+	ID                  field.Int
+	CreatedAt           field.Time
+	ProjectID           field.Int
+	Status              field.String
+	MaxTasks            field.Int
+	MaxTasksPerAssignee field.Int
+	Project             wipLimitBelongsToProject
+
+	fieldMap map[string]field.Expr
+}
+
+func (w wipLimit) Table(newTableName string) *wipLimit {
+	w.wipLimitDo.UseTable(newTableName)
+	return w.updateTableName(newTableName)
+}
+
+func (w wipLimit) As(alias string) *wipLimit {
+	w.wipLimitDo.DO = *(w.wipLimitDo.As(alias).(*gen.DO))
+	return w.updateTableName(alias)
+}
+
+func (w *wipLimit) updateTableName(table string) *wipLimit {
+	w.ALL = field.NewAsterisk(table)
+	w.ID = field.NewInt(table, "id")
+	w.CreatedAt = field.NewTime(table, "created_at")
+	w.ProjectID = field.NewInt(table, "project_id")
+	w.Status = field.NewString(table, "status")
+	w.MaxTasks = field.NewInt(table, "max_tasks")
+	w.MaxTasksPerAssignee = field.NewInt(table, "max_tasks_per_assignee")
+
+	w.fillFieldMap()
+
+	return w
+}
+
+func (w *wipLimit) WithContext(ctx context.Context) IWipLimitDo { return w.wipLimitDo.WithContext(ctx) }
+
+func (w wipLimit) TableName() string { return w.wipLimitDo.TableName() }
+
+func (w wipLimit) Alias() string { return w.wipLimitDo.Alias() }
+
+func (w wipLimit) Columns(cols ...field.Expr) gen.Columns { return w.wipLimitDo.Columns(cols...) }
+
+func (w *wipLimit) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := w.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (w *wipLimit) fillFieldMap() {
+	w.fieldMap = make(map[string]field.Expr, 7)
+	w.fieldMap["id"] = w.ID
+	w.fieldMap["created_at"] = w.CreatedAt
+	w.fieldMap["project_id"] = w.ProjectID
+	w.fieldMap["status"] = w.Status
+	w.fieldMap["max_tasks"] = w.MaxTasks
+	w.fieldMap["max_tasks_per_assignee"] = w.MaxTasksPerAssignee
+
+}
+
+func (w wipLimit) clone(db *gorm.DB) wipLimit {
+	w.wipLimitDo.ReplaceConnPool(db.Statement.ConnPool)
+	return w
+}
+
+func (w wipLimit) replaceDB(db *gorm.DB) wipLimit {
+	w.wipLimitDo.ReplaceDB(db)
+	return w
+}
+
+type wipLimitBelongsToProject struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Manager struct {
+		field.RelationField
+		CurrentProject struct {
+			field.RelationField
+		}
+		ManagedProjects struct {
+			field.RelationField
+		}
+		AssignedTasks struct {
+			field.RelationField
+			Assignee struct {
+				field.RelationField
+			}
+			Project struct {
+				field.RelationField
+			}
+			Sprint struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Tasks struct {
+					field.RelationField
+				}
+			}
+			Subtasks struct {
+				field.RelationField
+			}
+			TaskLabels struct {
+				field.RelationField
+				Label struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+	}
+	Tasks struct {
+		field.RelationField
+	}
+	Sprints struct {
+		field.RelationField
+	}
+	TeamMembers struct {
+		field.RelationField
+	}
+	Members struct {
+		field.RelationField
+		Project struct {
+			field.RelationField
+		}
+		User struct {
+			field.RelationField
+		}
+	}
+}
+
+func (a wipLimitBelongsToProject) Where(conds ...field.Expr) *wipLimitBelongsToProject {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a wipLimitBelongsToProject) WithContext(ctx context.Context) *wipLimitBelongsToProject {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a wipLimitBelongsToProject) Session(session *gorm.Session) *wipLimitBelongsToProject {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a wipLimitBelongsToProject) Model(m *models.WipLimit) *wipLimitBelongsToProjectTx {
+	return &wipLimitBelongsToProjectTx{a.db.Model(m).Association(a.Name())}
+}
+
+type wipLimitBelongsToProjectTx struct{ tx *gorm.Association }
+
+func (a wipLimitBelongsToProjectTx) Find() (result *models.Project, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a wipLimitBelongsToProjectTx) Append(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a wipLimitBelongsToProjectTx) Replace(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a wipLimitBelongsToProjectTx) Delete(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a wipLimitBelongsToProjectTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a wipLimitBelongsToProjectTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type wipLimitDo struct{ gen.DO }
+
+type IWipLimitDo interface {
+	gen.SubQuery
+	Debug() IWipLimitDo
+	WithContext(ctx context.Context) IWipLimitDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IWipLimitDo
+	WriteDB() IWipLimitDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IWipLimitDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IWipLimitDo
+	Not(conds ...gen.Condition) IWipLimitDo
+	Or(conds ...gen.Condition) IWipLimitDo
+	Select(conds ...field.Expr) IWipLimitDo
+	Where(conds ...gen.Condition) IWipLimitDo
+	Order(conds ...field.Expr) IWipLimitDo
+	Distinct(cols ...field.Expr) IWipLimitDo
+	Omit(cols ...field.Expr) IWipLimitDo
+	Join(table schema.Tabler, on ...field.Expr) IWipLimitDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IWipLimitDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IWipLimitDo
+	Group(cols ...field.Expr) IWipLimitDo
+	Having(conds ...gen.Condition) IWipLimitDo
+	Limit(limit int) IWipLimitDo
+	Offset(offset int) IWipLimitDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IWipLimitDo
+	Unscoped() IWipLimitDo
+	Create(values ...*models.WipLimit) error
+	CreateInBatches(values []*models.WipLimit, batchSize int) error
+	Save(values ...*models.WipLimit) error
+	First() (*models.WipLimit, error)
+	Take() (*models.WipLimit, error)
+	Last() (*models.WipLimit, error)
+	Find() ([]*models.WipLimit, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.WipLimit, err error)
+	FindInBatches(result *[]*models.WipLimit, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.WipLimit) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IWipLimitDo
+	Assign(attrs ...field.AssignExpr) IWipLimitDo
+	Joins(fields ...field.RelationField) IWipLimitDo
+	Preload(fields ...field.RelationField) IWipLimitDo
+	FirstOrInit() (*models.WipLimit, error)
+	FirstOrCreate() (*models.WipLimit, error)
+	FindByPage(offset int, limit int) (result []*models.WipLimit, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IWipLimitDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (w wipLimitDo) Debug() IWipLimitDo {
+	return w.withDO(w.DO.Debug())
+}
+
+func (w wipLimitDo) WithContext(ctx context.Context) IWipLimitDo {
+	return w.withDO(w.DO.WithContext(ctx))
+}
+
+func (w wipLimitDo) ReadDB() IWipLimitDo {
+	return w.Clauses(dbresolver.Read)
+}
+
+func (w wipLimitDo) WriteDB() IWipLimitDo {
+	return w.Clauses(dbresolver.Write)
+}
+
+func (w wipLimitDo) Session(config *gorm.Session) IWipLimitDo {
+	return w.withDO(w.DO.Session(config))
+}
+
+func (w wipLimitDo) Clauses(conds ...clause.Expression) IWipLimitDo {
+	return w.withDO(w.DO.Clauses(conds...))
+}
+
+func (w wipLimitDo) Returning(value interface{}, columns ...string) IWipLimitDo {
+	return w.withDO(w.DO.Returning(value, columns...))
+}
+
+func (w wipLimitDo) Not(conds ...gen.Condition) IWipLimitDo {
+	return w.withDO(w.DO.Not(conds...))
+}
+
+func (w wipLimitDo) Or(conds ...gen.Condition) IWipLimitDo {
+	return w.withDO(w.DO.Or(conds...))
+}
+
+func (w wipLimitDo) Select(conds ...field.Expr) IWipLimitDo {
+	return w.withDO(w.DO.Select(conds...))
+}
+
+func (w wipLimitDo) Where(conds ...gen.Condition) IWipLimitDo {
+	return w.withDO(w.DO.Where(conds...))
+}
+
+func (w wipLimitDo) Order(conds ...field.Expr) IWipLimitDo {
+	return w.withDO(w.DO.Order(conds...))
+}
+
+func (w wipLimitDo) Distinct(cols ...field.Expr) IWipLimitDo {
+	return w.withDO(w.DO.Distinct(cols...))
+}
+
+func (w wipLimitDo) Omit(cols ...field.Expr) IWipLimitDo {
+	return w.withDO(w.DO.Omit(cols...))
+}
+
+func (w wipLimitDo) Join(table schema.Tabler, on ...field.Expr) IWipLimitDo {
+	return w.withDO(w.DO.Join(table, on...))
+}
+
+func (w wipLimitDo) LeftJoin(table schema.Tabler, on ...field.Expr) IWipLimitDo {
+	return w.withDO(w.DO.LeftJoin(table, on...))
+}
+
+func (w wipLimitDo) RightJoin(table schema.Tabler, on ...field.Expr) IWipLimitDo {
+	return w.withDO(w.DO.RightJoin(table, on...))
+}
+
+func (w wipLimitDo) Group(cols ...field.Expr) IWipLimitDo {
+	return w.withDO(w.DO.Group(cols...))
+}
+
+func (w wipLimitDo) Having(conds ...gen.Condition) IWipLimitDo {
+	return w.withDO(w.DO.Having(conds...))
+}
+
+func (w wipLimitDo) Limit(limit int) IWipLimitDo {
+	return w.withDO(w.DO.Limit(limit))
+}
+
+func (w wipLimitDo) Offset(offset int) IWipLimitDo {
+	return w.withDO(w.DO.Offset(offset))
+}
+
+func (w wipLimitDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IWipLimitDo {
+	return w.withDO(w.DO.Scopes(funcs...))
+}
+
+func (w wipLimitDo) Unscoped() IWipLimitDo {
+	return w.withDO(w.DO.Unscoped())
+}
+
+func (w wipLimitDo) Create(values ...*models.WipLimit) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return w.DO.Create(values)
+}
+
+func (w wipLimitDo) CreateInBatches(values []*models.WipLimit, batchSize int) error {
+	return w.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (w wipLimitDo) Save(values ...*models.WipLimit) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return w.DO.Save(values)
+}
+
+func (w wipLimitDo) First() (*models.WipLimit, error) {
+	if result, err := w.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WipLimit), nil
+	}
+}
+
+func (w wipLimitDo) Take() (*models.WipLimit, error) {
+	if result, err := w.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WipLimit), nil
+	}
+}
+
+func (w wipLimitDo) Last() (*models.WipLimit, error) {
+	if result, err := w.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WipLimit), nil
+	}
+}
+
+func (w wipLimitDo) Find() ([]*models.WipLimit, error) {
+	result, err := w.DO.Find()
+	return result.([]*models.WipLimit), err
+}
+
+func (w wipLimitDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.WipLimit, err error) {
+	buf := make([]*models.WipLimit, 0, batchSize)
+	err = w.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (w wipLimitDo) FindInBatches(result *[]*models.WipLimit, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return w.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (w wipLimitDo) Attrs(attrs ...field.AssignExpr) IWipLimitDo {
+	return w.withDO(w.DO.Attrs(attrs...))
+}
+
+func (w wipLimitDo) Assign(attrs ...field.AssignExpr) IWipLimitDo {
+	return w.withDO(w.DO.Assign(attrs...))
+}
+
+func (w wipLimitDo) Joins(fields ...field.RelationField) IWipLimitDo {
+	for _, _f := range fields {
+		w = *w.withDO(w.DO.Joins(_f))
+	}
+	return &w
+}
+
+func (w wipLimitDo) Preload(fields ...field.RelationField) IWipLimitDo {
+	for _, _f := range fields {
+		w = *w.withDO(w.DO.Preload(_f))
+	}
+	return &w
+}
+
+func (w wipLimitDo) FirstOrInit() (*models.WipLimit, error) {
+	if result, err := w.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WipLimit), nil
+	}
+}
+
+func (w wipLimitDo) FirstOrCreate() (*models.WipLimit, error) {
+	if result, err := w.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WipLimit), nil
+	}
+}
+
+func (w wipLimitDo) FindByPage(offset int, limit int) (result []*models.WipLimit, count int64, err error) {
+	result, err = w.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = w.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (w wipLimitDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = w.Count()
+	if err != nil {
+		return
+	}
+
+	err = w.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (w wipLimitDo) Scan(result interface{}) (err error) {
+	return w.DO.Scan(result)
+}
+
+func (w wipLimitDo) Delete(models ...*models.WipLimit) (result gen.ResultInfo, err error) {
+	return w.DO.Delete(models)
+}
+
+func (w *wipLimitDo) withDO(do gen.Dao) *wipLimitDo {
+	w.DO = *do.(*gen.DO)
+	return w
+}
@@ -0,0 +1,804 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newWorkflowTransition(db *gorm.DB, opts ...gen.DOOption) workflowTransition {
+	_workflowTransition := workflowTransition{}
+
+	_workflowTransition.workflowTransitionDo.UseDB(db, opts...)
+	_workflowTransition.workflowTransitionDo.UseModel(&models.WorkflowTransition{})
+
+	tableName := _workflowTransition.workflowTransitionDo.TableName()
+	_workflowTransition.ALL = field.NewAsterisk(tableName)
+	_workflowTransition.ID = field.NewInt(tableName, "id")
+	_workflowTransition.CreatedAt = field.NewTime(tableName, "created_at")
+	_workflowTransition.ProjectID = field.NewInt(tableName, "project_id")
+	_workflowTransition.FromStatus = field.NewString(tableName, "from_status")
+	_workflowTransition.ToStatus = field.NewString(tableName, "to_status")
+	_workflowTransition.Role = field.NewString(tableName, "role")
+	_workflowTransition.Project = workflowTransitionBelongsToProject{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Project", "models.Project"),
+		Manager: struct {
+			field.RelationField
+			CurrentProject struct {
+				field.RelationField
+			}
+			ManagedProjects struct {
+				field.RelationField
+			}
+			AssignedTasks struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Project.Manager", "models.User"),
+			CurrentProject: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Manager.CurrentProject", "models.Project"),
+			},
+			ManagedProjects: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Manager.ManagedProjects", "models.Project"),
+			},
+			AssignedTasks: struct {
+				field.RelationField
+				Assignee struct {
+					field.RelationField
+				}
+				Project struct {
+					field.RelationField
+				}
+				Sprint struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Subtasks struct {
+					field.RelationField
+				}
+				TaskLabels struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Project.Manager.AssignedTasks", "models.Task"),
+				Assignee: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Assignee", "models.User"),
+				},
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Project", "models.Project"),
+				},
+				Sprint: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint", "models.Sprint"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.Sprint.Tasks", "models.Task"),
+					},
+				},
+				Subtasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.Subtasks", "models.Task"),
+				},
+				TaskLabels: struct {
+					field.RelationField
+					Label struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}
+				}{
+					RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels", "models.TaskLabel"),
+					Label: struct {
+						field.RelationField
+						Project struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels.Label", "models.Label"),
+						Project: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Project.Manager.AssignedTasks.TaskLabels.Label.Project", "models.Project"),
+						},
+					},
+				},
+			},
+		},
+		Tasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.Tasks", "models.Task"),
+		},
+		Sprints: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.Sprints", "models.Sprint"),
+		},
+		TeamMembers: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Project.TeamMembers", "models.User"),
+		},
+		Members: struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+			User struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Project.Members", "models.ProjectMember"),
+			Project: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Members.Project", "models.Project"),
+			},
+			User: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Project.Members.User", "models.User"),
+			},
+		},
+	}
+
+	_workflowTransition.fillFieldMap()
+
+	return _workflowTransition
+}
+
+type workflowTransition struct {
+	workflowTransitionDo workflowTransitionDo
+
+	ALL        field.Asterisk
+	ID         field.Int
+	CreatedAt  field.Time
+	ProjectID  field.Int
+	FromStatus field.String
+	ToStatus   field.String
+	Role       field.String
+	Project    workflowTransitionBelongsToProject
+
+	fieldMap map[string]field.Expr
+}
+
+func (w workflowTransition) Table(newTableName string) *workflowTransition {
+	w.workflowTransitionDo.UseTable(newTableName)
+	return w.updateTableName(newTableName)
+}
+
+func (w workflowTransition) As(alias string) *workflowTransition {
+	w.workflowTransitionDo.DO = *(w.workflowTransitionDo.As(alias).(*gen.DO))
+	return w.updateTableName(alias)
+}
+
+func (w *workflowTransition) updateTableName(table string) *workflowTransition {
+	w.ALL = field.NewAsterisk(table)
+	w.ID = field.NewInt(table, "id")
+	w.CreatedAt = field.NewTime(table, "created_at")
+	w.ProjectID = field.NewInt(table, "project_id")
+	w.FromStatus = field.NewString(table, "from_status")
+	w.ToStatus = field.NewString(table, "to_status")
+	w.Role = field.NewString(table, "role")
+
+	w.fillFieldMap()
+
+	return w
+}
+
+func (w *workflowTransition) WithContext(ctx context.Context) IWorkflowTransitionDo {
+	return w.workflowTransitionDo.WithContext(ctx)
+}
+
+func (w workflowTransition) TableName() string { return w.workflowTransitionDo.TableName() }
+
+func (w workflowTransition) Alias() string { return w.workflowTransitionDo.Alias() }
+
+func (w workflowTransition) Columns(cols ...field.Expr) gen.Columns {
+	return w.workflowTransitionDo.Columns(cols...)
+}
+
+func (w *workflowTransition) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := w.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (w *workflowTransition) fillFieldMap() {
+	w.fieldMap = make(map[string]field.Expr, 7)
+	w.fieldMap["id"] = w.ID
+	w.fieldMap["created_at"] = w.CreatedAt
+	w.fieldMap["project_id"] = w.ProjectID
+	w.fieldMap["from_status"] = w.FromStatus
+	w.fieldMap["to_status"] = w.ToStatus
+	w.fieldMap["role"] = w.Role
+
+}
+
+func (w workflowTransition) clone(db *gorm.DB) workflowTransition {
+	w.workflowTransitionDo.ReplaceConnPool(db.Statement.ConnPool)
+	return w
+}
+
+func (w workflowTransition) replaceDB(db *gorm.DB) workflowTransition {
+	w.workflowTransitionDo.ReplaceDB(db)
+	return w
+}
+
+type workflowTransitionBelongsToProject struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Manager struct {
+		field.RelationField
+		CurrentProject struct {
+			field.RelationField
+		}
+		ManagedProjects struct {
+			field.RelationField
+		}
+		AssignedTasks struct {
+			field.RelationField
+			Assignee struct {
+				field.RelationField
+			}
+			Project struct {
+				field.RelationField
+			}
+			Sprint struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Tasks struct {
+					field.RelationField
+				}
+			}
+			Subtasks struct {
+				field.RelationField
+			}
+			TaskLabels struct {
+				field.RelationField
+				Label struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+				}
+			}
+		}
+	}
+	Tasks struct {
+		field.RelationField
+	}
+	Sprints struct {
+		field.RelationField
+	}
+	TeamMembers struct {
+		field.RelationField
+	}
+	Members struct {
+		field.RelationField
+		Project struct {
+			field.RelationField
+		}
+		User struct {
+			field.RelationField
+		}
+	}
+}
+
+func (a workflowTransitionBelongsToProject) Where(conds ...field.Expr) *workflowTransitionBelongsToProject {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a workflowTransitionBelongsToProject) WithContext(ctx context.Context) *workflowTransitionBelongsToProject {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a workflowTransitionBelongsToProject) Session(session *gorm.Session) *workflowTransitionBelongsToProject {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a workflowTransitionBelongsToProject) Model(m *models.WorkflowTransition) *workflowTransitionBelongsToProjectTx {
+	return &workflowTransitionBelongsToProjectTx{a.db.Model(m).Association(a.Name())}
+}
+
+type workflowTransitionBelongsToProjectTx struct{ tx *gorm.Association }
+
+func (a workflowTransitionBelongsToProjectTx) Find() (result *models.Project, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a workflowTransitionBelongsToProjectTx) Append(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a workflowTransitionBelongsToProjectTx) Replace(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a workflowTransitionBelongsToProjectTx) Delete(values ...*models.Project) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a workflowTransitionBelongsToProjectTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a workflowTransitionBelongsToProjectTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type workflowTransitionDo struct{ gen.DO }
+
+type IWorkflowTransitionDo interface {
+	gen.SubQuery
+	Debug() IWorkflowTransitionDo
+	WithContext(ctx context.Context) IWorkflowTransitionDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IWorkflowTransitionDo
+	WriteDB() IWorkflowTransitionDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IWorkflowTransitionDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IWorkflowTransitionDo
+	Not(conds ...gen.Condition) IWorkflowTransitionDo
+	Or(conds ...gen.Condition) IWorkflowTransitionDo
+	Select(conds ...field.Expr) IWorkflowTransitionDo
+	Where(conds ...gen.Condition) IWorkflowTransitionDo
+	Order(conds ...field.Expr) IWorkflowTransitionDo
+	Distinct(cols ...field.Expr) IWorkflowTransitionDo
+	Omit(cols ...field.Expr) IWorkflowTransitionDo
+	Join(table schema.Tabler, on ...field.Expr) IWorkflowTransitionDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IWorkflowTransitionDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IWorkflowTransitionDo
+	Group(cols ...field.Expr) IWorkflowTransitionDo
+	Having(conds ...gen.Condition) IWorkflowTransitionDo
+	Limit(limit int) IWorkflowTransitionDo
+	Offset(offset int) IWorkflowTransitionDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IWorkflowTransitionDo
+	Unscoped() IWorkflowTransitionDo
+	Create(values ...*models.WorkflowTransition) error
+	CreateInBatches(values []*models.WorkflowTransition, batchSize int) error
+	Save(values ...*models.WorkflowTransition) error
+	First() (*models.WorkflowTransition, error)
+	Take() (*models.WorkflowTransition, error)
+	Last() (*models.WorkflowTransition, error)
+	Find() ([]*models.WorkflowTransition, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.WorkflowTransition, err error)
+	FindInBatches(result *[]*models.WorkflowTransition, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.WorkflowTransition) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IWorkflowTransitionDo
+	Assign(attrs ...field.AssignExpr) IWorkflowTransitionDo
+	Joins(fields ...field.RelationField) IWorkflowTransitionDo
+	Preload(fields ...field.RelationField) IWorkflowTransitionDo
+	FirstOrInit() (*models.WorkflowTransition, error)
+	FirstOrCreate() (*models.WorkflowTransition, error)
+	FindByPage(offset int, limit int) (result []*models.WorkflowTransition, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IWorkflowTransitionDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (w workflowTransitionDo) Debug() IWorkflowTransitionDo {
+	return w.withDO(w.DO.Debug())
+}
+
+func (w workflowTransitionDo) WithContext(ctx context.Context) IWorkflowTransitionDo {
+	return w.withDO(w.DO.WithContext(ctx))
+}
+
+func (w workflowTransitionDo) ReadDB() IWorkflowTransitionDo {
+	return w.Clauses(dbresolver.Read)
+}
+
+func (w workflowTransitionDo) WriteDB() IWorkflowTransitionDo {
+	return w.Clauses(dbresolver.Write)
+}
+
+func (w workflowTransitionDo) Session(config *gorm.Session) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Session(config))
+}
+
+func (w workflowTransitionDo) Clauses(conds ...clause.Expression) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Clauses(conds...))
+}
+
+func (w workflowTransitionDo) Returning(value interface{}, columns ...string) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Returning(value, columns...))
+}
+
+func (w workflowTransitionDo) Not(conds ...gen.Condition) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Not(conds...))
+}
+
+func (w workflowTransitionDo) Or(conds ...gen.Condition) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Or(conds...))
+}
+
+func (w workflowTransitionDo) Select(conds ...field.Expr) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Select(conds...))
+}
+
+func (w workflowTransitionDo) Where(conds ...gen.Condition) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Where(conds...))
+}
+
+func (w workflowTransitionDo) Order(conds ...field.Expr) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Order(conds...))
+}
+
+func (w workflowTransitionDo) Distinct(cols ...field.Expr) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Distinct(cols...))
+}
+
+func (w workflowTransitionDo) Omit(cols ...field.Expr) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Omit(cols...))
+}
+
+func (w workflowTransitionDo) Join(table schema.Tabler, on ...field.Expr) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Join(table, on...))
+}
+
+func (w workflowTransitionDo) LeftJoin(table schema.Tabler, on ...field.Expr) IWorkflowTransitionDo {
+	return w.withDO(w.DO.LeftJoin(table, on...))
+}
+
+func (w workflowTransitionDo) RightJoin(table schema.Tabler, on ...field.Expr) IWorkflowTransitionDo {
+	return w.withDO(w.DO.RightJoin(table, on...))
+}
+
+func (w workflowTransitionDo) Group(cols ...field.Expr) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Group(cols...))
+}
+
+func (w workflowTransitionDo) Having(conds ...gen.Condition) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Having(conds...))
+}
+
+func (w workflowTransitionDo) Limit(limit int) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Limit(limit))
+}
+
+func (w workflowTransitionDo) Offset(offset int) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Offset(offset))
+}
+
+func (w workflowTransitionDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Scopes(funcs...))
+}
+
+func (w workflowTransitionDo) Unscoped() IWorkflowTransitionDo {
+	return w.withDO(w.DO.Unscoped())
+}
+
+func (w workflowTransitionDo) Create(values ...*models.WorkflowTransition) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return w.DO.Create(values)
+}
+
+func (w workflowTransitionDo) CreateInBatches(values []*models.WorkflowTransition, batchSize int) error {
+	return w.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (w workflowTransitionDo) Save(values ...*models.WorkflowTransition) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return w.DO.Save(values)
+}
+
+func (w workflowTransitionDo) First() (*models.WorkflowTransition, error) {
+	if result, err := w.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WorkflowTransition), nil
+	}
+}
+
+func (w workflowTransitionDo) Take() (*models.WorkflowTransition, error) {
+	if result, err := w.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WorkflowTransition), nil
+	}
+}
+
+func (w workflowTransitionDo) Last() (*models.WorkflowTransition, error) {
+	if result, err := w.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WorkflowTransition), nil
+	}
+}
+
+func (w workflowTransitionDo) Find() ([]*models.WorkflowTransition, error) {
+	result, err := w.DO.Find()
+	return result.([]*models.WorkflowTransition), err
+}
+
+func (w workflowTransitionDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.WorkflowTransition, err error) {
+	buf := make([]*models.WorkflowTransition, 0, batchSize)
+	err = w.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (w workflowTransitionDo) FindInBatches(result *[]*models.WorkflowTransition, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return w.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (w workflowTransitionDo) Attrs(attrs ...field.AssignExpr) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Attrs(attrs...))
+}
+
+func (w workflowTransitionDo) Assign(attrs ...field.AssignExpr) IWorkflowTransitionDo {
+	return w.withDO(w.DO.Assign(attrs...))
+}
+
+func (w workflowTransitionDo) Joins(fields ...field.RelationField) IWorkflowTransitionDo {
+	for _, _f := range fields {
+		w = *w.withDO(w.DO.Joins(_f))
+	}
+	return &w
+}
+
+func (w workflowTransitionDo) Preload(fields ...field.RelationField) IWorkflowTransitionDo {
+	for _, _f := range fields {
+		w = *w.withDO(w.DO.Preload(_f))
+	}
+	return &w
+}
+
+func (w workflowTransitionDo) FirstOrInit() (*models.WorkflowTransition, error) {
+	if result, err := w.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WorkflowTransition), nil
+	}
+}
+
+func (w workflowTransitionDo) FirstOrCreate() (*models.WorkflowTransition, error) {
+	if result, err := w.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.WorkflowTransition), nil
+	}
+}
+
+func (w workflowTransitionDo) FindByPage(offset int, limit int) (result []*models.WorkflowTransition, count int64, err error) {
+	result, err = w.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = w.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (w workflowTransitionDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = w.Count()
+	if err != nil {
+		return
+	}
+
+	err = w.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (w workflowTransitionDo) Scan(result interface{}) (err error) {
+	return w.DO.Scan(result)
+}
+
+func (w workflowTransitionDo) Delete(models ...*models.WorkflowTransition) (result gen.ResultInfo, err error) {
+	return w.DO.Delete(models)
+}
+
+func (w *workflowTransitionDo) withDO(do gen.Dao) *workflowTransitionDo {
+	w.DO = *do.(*gen.DO)
+	return w
+}
@@ -0,0 +1,895 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package query
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+func newWorklog(db *gorm.DB, opts ...gen.DOOption) worklog {
+	_worklog := worklog{}
+
+	_worklog.worklogDo.UseDB(db, opts...)
+	_worklog.worklogDo.UseModel(&models.Worklog{})
+
+	tableName := _worklog.worklogDo.TableName()
+	_worklog.ALL = field.NewAsterisk(tableName)
+	_worklog.ID = field.NewInt(tableName, "id")
+	_worklog.CreatedAt = field.NewTime(tableName, "created_at")
+	_worklog.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_worklog.DeletedAt = field.NewField(tableName, "deleted_at")
+	_worklog.TaskID = field.NewInt(tableName, "task_id")
+	_worklog.UserID = field.NewInt(tableName, "user_id")
+	_worklog.ProjectID = field.NewInt(tableName, "project_id")
+	_worklog.StartedAt = field.NewTime(tableName, "started_at")
+	_worklog.DurationMinutes = field.NewInt(tableName, "duration_minutes")
+	_worklog.Note = field.NewString(tableName, "note")
+	_worklog.Task = worklogBelongsToTask{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Task", "models.Task"),
+		Assignee: struct {
+			field.RelationField
+			CurrentProject struct {
+				field.RelationField
+				Manager struct {
+					field.RelationField
+				}
+				Tasks struct {
+					field.RelationField
+				}
+				Sprints struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				TeamMembers struct {
+					field.RelationField
+				}
+				Members struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}
+			}
+			ManagedProjects struct {
+				field.RelationField
+			}
+			AssignedTasks struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Task.Assignee", "models.User"),
+			CurrentProject: struct {
+				field.RelationField
+				Manager struct {
+					field.RelationField
+				}
+				Tasks struct {
+					field.RelationField
+				}
+				Sprints struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				TeamMembers struct {
+					field.RelationField
+				}
+				Members struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}
+			}{
+				RelationField: field.NewRelation("Task.Assignee.CurrentProject", "models.Project"),
+				Manager: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Task.Assignee.CurrentProject.Manager", "models.User"),
+				},
+				Tasks: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Task.Assignee.CurrentProject.Tasks", "models.Task"),
+				},
+				Sprints: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					Report struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Task.Assignee.CurrentProject.Sprints", "models.Sprint"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Task.Assignee.CurrentProject.Sprints.Project", "models.Project"),
+					},
+					Report: struct {
+						field.RelationField
+						CompletedBy struct {
+							field.RelationField
+						}
+						NextSprint struct {
+							field.RelationField
+						}
+						Tasks struct {
+							field.RelationField
+						}
+					}{
+						RelationField: field.NewRelation("Task.Assignee.CurrentProject.Sprints.Report", "models.SprintReport"),
+						CompletedBy: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Task.Assignee.CurrentProject.Sprints.Report.CompletedBy", "models.User"),
+						},
+						NextSprint: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Task.Assignee.CurrentProject.Sprints.Report.NextSprint", "models.Sprint"),
+						},
+						Tasks: struct {
+							field.RelationField
+						}{
+							RelationField: field.NewRelation("Task.Assignee.CurrentProject.Sprints.Report.Tasks", "models.SprintReportTask"),
+						},
+					},
+					Tasks: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Task.Assignee.CurrentProject.Sprints.Tasks", "models.Task"),
+					},
+				},
+				TeamMembers: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Task.Assignee.CurrentProject.TeamMembers", "models.User"),
+				},
+				Members: struct {
+					field.RelationField
+					Project struct {
+						field.RelationField
+					}
+					User struct {
+						field.RelationField
+					}
+				}{
+					RelationField: field.NewRelation("Task.Assignee.CurrentProject.Members", "models.ProjectMember"),
+					Project: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Task.Assignee.CurrentProject.Members.Project", "models.Project"),
+					},
+					User: struct {
+						field.RelationField
+					}{
+						RelationField: field.NewRelation("Task.Assignee.CurrentProject.Members.User", "models.User"),
+					},
+				},
+			},
+			ManagedProjects: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Task.Assignee.ManagedProjects", "models.Project"),
+			},
+			AssignedTasks: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Task.Assignee.AssignedTasks", "models.Task"),
+			},
+		},
+		Project: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Task.Project", "models.Project"),
+		},
+		Sprint: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Task.Sprint", "models.Sprint"),
+		},
+		Subtasks: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Task.Subtasks", "models.Task"),
+		},
+		TaskLabels: struct {
+			field.RelationField
+			Label struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+			}
+		}{
+			RelationField: field.NewRelation("Task.TaskLabels", "models.TaskLabel"),
+			Label: struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+			}{
+				RelationField: field.NewRelation("Task.TaskLabels.Label", "models.Label"),
+				Project: struct {
+					field.RelationField
+				}{
+					RelationField: field.NewRelation("Task.TaskLabels.Label.Project", "models.Project"),
+				},
+			},
+		},
+	}
+
+	_worklog.User = worklogBelongsToUser{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("User", "models.User"),
+	}
+
+	_worklog.fillFieldMap()
+
+	return _worklog
+}
+
+type worklog struct {
+	worklogDo worklogDo
+
+	ALL             field.Asterisk
+	ID              field.Int
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+	DeletedAt       field.Field
+	TaskID          field.Int
+	UserID          field.Int
+	ProjectID       field.Int
+	StartedAt       field.Time
+	DurationMinutes field.Int
+	Note            field.String
+	Task            worklogBelongsToTask
+
+	User worklogBelongsToUser
+
+	fieldMap map[string]field.Expr
+}
+
+func (w worklog) Table(newTableName string) *worklog {
+	w.worklogDo.UseTable(newTableName)
+	return w.updateTableName(newTableName)
+}
+
+func (w worklog) As(alias string) *worklog {
+	w.worklogDo.DO = *(w.worklogDo.As(alias).(*gen.DO))
+	return w.updateTableName(alias)
+}
+
+func (w *worklog) updateTableName(table string) *worklog {
+	w.ALL = field.NewAsterisk(table)
+	w.ID = field.NewInt(table, "id")
+	w.CreatedAt = field.NewTime(table, "created_at")
+	w.UpdatedAt = field.NewTime(table, "updated_at")
+	w.DeletedAt = field.NewField(table, "deleted_at")
+	w.TaskID = field.NewInt(table, "task_id")
+	w.UserID = field.NewInt(table, "user_id")
+	w.ProjectID = field.NewInt(table, "project_id")
+	w.StartedAt = field.NewTime(table, "started_at")
+	w.DurationMinutes = field.NewInt(table, "duration_minutes")
+	w.Note = field.NewString(table, "note")
+
+	w.fillFieldMap()
+
+	return w
+}
+
+func (w *worklog) WithContext(ctx context.Context) IWorklogDo { return w.worklogDo.WithContext(ctx) }
+
+func (w worklog) TableName() string { return w.worklogDo.TableName() }
+
+func (w worklog) Alias() string { return w.worklogDo.Alias() }
+
+func (w worklog) Columns(cols ...field.Expr) gen.Columns { return w.worklogDo.Columns(cols...) }
+
+func (w *worklog) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := w.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (w *worklog) fillFieldMap() {
+	w.fieldMap = make(map[string]field.Expr, 12)
+	w.fieldMap["id"] = w.ID
+	w.fieldMap["created_at"] = w.CreatedAt
+	w.fieldMap["updated_at"] = w.UpdatedAt
+	w.fieldMap["deleted_at"] = w.DeletedAt
+	w.fieldMap["task_id"] = w.TaskID
+	w.fieldMap["user_id"] = w.UserID
+	w.fieldMap["project_id"] = w.ProjectID
+	w.fieldMap["started_at"] = w.StartedAt
+	w.fieldMap["duration_minutes"] = w.DurationMinutes
+	w.fieldMap["note"] = w.Note
+
+}
+
+func (w worklog) clone(db *gorm.DB) worklog {
+	w.worklogDo.ReplaceConnPool(db.Statement.ConnPool)
+	return w
+}
+
+func (w worklog) replaceDB(db *gorm.DB) worklog {
+	w.worklogDo.ReplaceDB(db)
+	return w
+}
+
+type worklogBelongsToTask struct {
+	db *gorm.DB
+
+	field.RelationField
+
+	Assignee struct {
+		field.RelationField
+		CurrentProject struct {
+			field.RelationField
+			Manager struct {
+				field.RelationField
+			}
+			Tasks struct {
+				field.RelationField
+			}
+			Sprints struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				Report struct {
+					field.RelationField
+					CompletedBy struct {
+						field.RelationField
+					}
+					NextSprint struct {
+						field.RelationField
+					}
+					Tasks struct {
+						field.RelationField
+					}
+				}
+				Tasks struct {
+					field.RelationField
+				}
+			}
+			TeamMembers struct {
+				field.RelationField
+			}
+			Members struct {
+				field.RelationField
+				Project struct {
+					field.RelationField
+				}
+				User struct {
+					field.RelationField
+				}
+			}
+		}
+		ManagedProjects struct {
+			field.RelationField
+		}
+		AssignedTasks struct {
+			field.RelationField
+		}
+	}
+	Project struct {
+		field.RelationField
+	}
+	Sprint struct {
+		field.RelationField
+	}
+	Subtasks struct {
+		field.RelationField
+	}
+	TaskLabels struct {
+		field.RelationField
+		Label struct {
+			field.RelationField
+			Project struct {
+				field.RelationField
+			}
+		}
+	}
+}
+
+func (a worklogBelongsToTask) Where(conds ...field.Expr) *worklogBelongsToTask {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a worklogBelongsToTask) WithContext(ctx context.Context) *worklogBelongsToTask {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a worklogBelongsToTask) Session(session *gorm.Session) *worklogBelongsToTask {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a worklogBelongsToTask) Model(m *models.Worklog) *worklogBelongsToTaskTx {
+	return &worklogBelongsToTaskTx{a.db.Model(m).Association(a.Name())}
+}
+
+type worklogBelongsToTaskTx struct{ tx *gorm.Association }
+
+func (a worklogBelongsToTaskTx) Find() (result *models.Task, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a worklogBelongsToTaskTx) Append(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a worklogBelongsToTaskTx) Replace(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a worklogBelongsToTaskTx) Delete(values ...*models.Task) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a worklogBelongsToTaskTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a worklogBelongsToTaskTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type worklogBelongsToUser struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a worklogBelongsToUser) Where(conds ...field.Expr) *worklogBelongsToUser {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a worklogBelongsToUser) WithContext(ctx context.Context) *worklogBelongsToUser {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a worklogBelongsToUser) Session(session *gorm.Session) *worklogBelongsToUser {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a worklogBelongsToUser) Model(m *models.Worklog) *worklogBelongsToUserTx {
+	return &worklogBelongsToUserTx{a.db.Model(m).Association(a.Name())}
+}
+
+type worklogBelongsToUserTx struct{ tx *gorm.Association }
+
+func (a worklogBelongsToUserTx) Find() (result *models.User, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a worklogBelongsToUserTx) Append(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a worklogBelongsToUserTx) Replace(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a worklogBelongsToUserTx) Delete(values ...*models.User) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a worklogBelongsToUserTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a worklogBelongsToUserTx) Count() int64 {
+	return a.tx.Count()
+}
+
+type worklogDo struct{ gen.DO }
+
+type IWorklogDo interface {
+	gen.SubQuery
+	Debug() IWorklogDo
+	WithContext(ctx context.Context) IWorklogDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IWorklogDo
+	WriteDB() IWorklogDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IWorklogDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IWorklogDo
+	Not(conds ...gen.Condition) IWorklogDo
+	Or(conds ...gen.Condition) IWorklogDo
+	Select(conds ...field.Expr) IWorklogDo
+	Where(conds ...gen.Condition) IWorklogDo
+	Order(conds ...field.Expr) IWorklogDo
+	Distinct(cols ...field.Expr) IWorklogDo
+	Omit(cols ...field.Expr) IWorklogDo
+	Join(table schema.Tabler, on ...field.Expr) IWorklogDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IWorklogDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IWorklogDo
+	Group(cols ...field.Expr) IWorklogDo
+	Having(conds ...gen.Condition) IWorklogDo
+	Limit(limit int) IWorklogDo
+	Offset(offset int) IWorklogDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IWorklogDo
+	Unscoped() IWorklogDo
+	Create(values ...*models.Worklog) error
+	CreateInBatches(values []*models.Worklog, batchSize int) error
+	Save(values ...*models.Worklog) error
+	First() (*models.Worklog, error)
+	Take() (*models.Worklog, error)
+	Last() (*models.Worklog, error)
+	Find() ([]*models.Worklog, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.Worklog, err error)
+	FindInBatches(result *[]*models.Worklog, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*models.Worklog) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IWorklogDo
+	Assign(attrs ...field.AssignExpr) IWorklogDo
+	Joins(fields ...field.RelationField) IWorklogDo
+	Preload(fields ...field.RelationField) IWorklogDo
+	FirstOrInit() (*models.Worklog, error)
+	FirstOrCreate() (*models.Worklog, error)
+	FindByPage(offset int, limit int) (result []*models.Worklog, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IWorklogDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (w worklogDo) Debug() IWorklogDo {
+	return w.withDO(w.DO.Debug())
+}
+
+func (w worklogDo) WithContext(ctx context.Context) IWorklogDo {
+	return w.withDO(w.DO.WithContext(ctx))
+}
+
+func (w worklogDo) ReadDB() IWorklogDo {
+	return w.Clauses(dbresolver.Read)
+}
+
+func (w worklogDo) WriteDB() IWorklogDo {
+	return w.Clauses(dbresolver.Write)
+}
+
+func (w worklogDo) Session(config *gorm.Session) IWorklogDo {
+	return w.withDO(w.DO.Session(config))
+}
+
+func (w worklogDo) Clauses(conds ...clause.Expression) IWorklogDo {
+	return w.withDO(w.DO.Clauses(conds...))
+}
+
+func (w worklogDo) Returning(value interface{}, columns ...string) IWorklogDo {
+	return w.withDO(w.DO.Returning(value, columns...))
+}
+
+func (w worklogDo) Not(conds ...gen.Condition) IWorklogDo {
+	return w.withDO(w.DO.Not(conds...))
+}
+
+func (w worklogDo) Or(conds ...gen.Condition) IWorklogDo {
+	return w.withDO(w.DO.Or(conds...))
+}
+
+func (w worklogDo) Select(conds ...field.Expr) IWorklogDo {
+	return w.withDO(w.DO.Select(conds...))
+}
+
+func (w worklogDo) Where(conds ...gen.Condition) IWorklogDo {
+	return w.withDO(w.DO.Where(conds...))
+}
+
+func (w worklogDo) Order(conds ...field.Expr) IWorklogDo {
+	return w.withDO(w.DO.Order(conds...))
+}
+
+func (w worklogDo) Distinct(cols ...field.Expr) IWorklogDo {
+	return w.withDO(w.DO.Distinct(cols...))
+}
+
+func (w worklogDo) Omit(cols ...field.Expr) IWorklogDo {
+	return w.withDO(w.DO.Omit(cols...))
+}
+
+func (w worklogDo) Join(table schema.Tabler, on ...field.Expr) IWorklogDo {
+	return w.withDO(w.DO.Join(table, on...))
+}
+
+func (w worklogDo) LeftJoin(table schema.Tabler, on ...field.Expr) IWorklogDo {
+	return w.withDO(w.DO.LeftJoin(table, on...))
+}
+
+func (w worklogDo) RightJoin(table schema.Tabler, on ...field.Expr) IWorklogDo {
+	return w.withDO(w.DO.RightJoin(table, on...))
+}
+
+func (w worklogDo) Group(cols ...field.Expr) IWorklogDo {
+	return w.withDO(w.DO.Group(cols...))
+}
+
+func (w worklogDo) Having(conds ...gen.Condition) IWorklogDo {
+	return w.withDO(w.DO.Having(conds...))
+}
+
+func (w worklogDo) Limit(limit int) IWorklogDo {
+	return w.withDO(w.DO.Limit(limit))
+}
+
+func (w worklogDo) Offset(offset int) IWorklogDo {
+	return w.withDO(w.DO.Offset(offset))
+}
+
+func (w worklogDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IWorklogDo {
+	return w.withDO(w.DO.Scopes(funcs...))
+}
+
+func (w worklogDo) Unscoped() IWorklogDo {
+	return w.withDO(w.DO.Unscoped())
+}
+
+func (w worklogDo) Create(values ...*models.Worklog) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return w.DO.Create(values)
+}
+
+func (w worklogDo) CreateInBatches(values []*models.Worklog, batchSize int) error {
+	return w.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (w worklogDo) Save(values ...*models.Worklog) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return w.DO.Save(values)
+}
+
+func (w worklogDo) First() (*models.Worklog, error) {
+	if result, err := w.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Worklog), nil
+	}
+}
+
+func (w worklogDo) Take() (*models.Worklog, error) {
+	if result, err := w.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Worklog), nil
+	}
+}
+
+func (w worklogDo) Last() (*models.Worklog, error) {
+	if result, err := w.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Worklog), nil
+	}
+}
+
+func (w worklogDo) Find() ([]*models.Worklog, error) {
+	result, err := w.DO.Find()
+	return result.([]*models.Worklog), err
+}
+
+func (w worklogDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*models.Worklog, err error) {
+	buf := make([]*models.Worklog, 0, batchSize)
+	err = w.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (w worklogDo) FindInBatches(result *[]*models.Worklog, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return w.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (w worklogDo) Attrs(attrs ...field.AssignExpr) IWorklogDo {
+	return w.withDO(w.DO.Attrs(attrs...))
+}
+
+func (w worklogDo) Assign(attrs ...field.AssignExpr) IWorklogDo {
+	return w.withDO(w.DO.Assign(attrs...))
+}
+
+func (w worklogDo) Joins(fields ...field.RelationField) IWorklogDo {
+	for _, _f := range fields {
+		w = *w.withDO(w.DO.Joins(_f))
+	}
+	return &w
+}
+
+func (w worklogDo) Preload(fields ...field.RelationField) IWorklogDo {
+	for _, _f := range fields {
+		w = *w.withDO(w.DO.Preload(_f))
+	}
+	return &w
+}
+
+func (w worklogDo) FirstOrInit() (*models.Worklog, error) {
+	if result, err := w.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Worklog), nil
+	}
+}
+
+func (w worklogDo) FirstOrCreate() (*models.Worklog, error) {
+	if result, err := w.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*models.Worklog), nil
+	}
+}
+
+func (w worklogDo) FindByPage(offset int, limit int) (result []*models.Worklog, count int64, err error) {
+	result, err = w.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = w.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (w worklogDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = w.Count()
+	if err != nil {
+		return
+	}
+
+	err = w.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (w worklogDo) Scan(result interface{}) (err error) {
+	return w.DO.Scan(result)
+}
+
+func (w worklogDo) Delete(models ...*models.Worklog) (result gen.ResultInfo, err error) {
+	return w.DO.Delete(models)
+}
+
+func (w *worklogDo) withDO(do gen.Dao) *worklogDo {
+	w.DO = *do.(*gen.DO)
+	return w
+}
@@ -0,0 +1,90 @@
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"lqkhoi-go-http-api/internal/events"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// boardChannelPrefix starts the name of the Redis channel of each project,
+// followed by the project ID.
+const boardChannelPrefix = "board:project:"
+
+// Broker carries events between the instances of the API: an event published
+// on any instance reaches the hub of every instance, this one included.
+type Broker interface {
+	Publish(ctx context.Context, event events.Event) error
+	// Run delivers the events published by every instance to the hub until
+	// ctx is done.
+	Run(ctx context.Context)
+}
+
+type redisBroker struct {
+	client *redis.Client
+	hub    *Hub
+}
+
+// NewRedisBroker returns a Broker relaying events through Redis pub/sub.
+// Events published while an instance is disconnected from Redis are lost to
+// its clients, which reload the board when their stream reconnects.
+func NewRedisBroker(client *redis.Client, hub *Hub) Broker {
+	return &redisBroker{
+		client: client,
+		hub:    hub,
+	}
+}
+
+func (b *redisBroker) Publish(ctx context.Context, event events.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("cannot encode event %s: %w", event.ID, err)
+	}
+	if err := b.client.Publish(ctx, boardChannel(event.ProjectID), payload).Err(); err != nil {
+		return fmt.Errorf("cannot publish event %s: %w", event.ID, err)
+	}
+	return nil
+}
+
+func (b *redisBroker) Run(ctx context.Context) {
+	logger := utils.LoggerFromContext(ctx).With(
+		"component", "RedisBroker",
+		"method", "Run",
+	)
+
+	// The subscription reconnects by itself when the connection drops.
+	pubsub := b.client.PSubscribe(ctx, boardChannelPrefix+"*")
+	defer pubsub.Close()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+			var event events.Event
+			if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+				logger.Error("Failed to decode board event", "channel", message.Channel, "error", err)
+				continue
+			}
+			if projectID, err := strconv.Atoi(strings.TrimPrefix(message.Channel, boardChannelPrefix)); err != nil || projectID != event.ProjectID {
+				logger.Warn("Board event does not match its channel", "channel", message.Channel, "event_id", event.ID)
+				continue
+			}
+			b.hub.Broadcast(event)
+		}
+	}
+}
+
+func boardChannel(projectID int) string {
+	return boardChannelPrefix + strconv.Itoa(projectID)
+}
@@ -0,0 +1,87 @@
+// Package realtime pushes the events of a project to the clients watching
+// its board. A Broker carries the events between the instances of the API and
+// each instance fans them out to its own clients through a Hub.
+package realtime
+
+import (
+	"sync"
+
+	"lqkhoi-go-http-api/internal/events"
+)
+
+// Hub fans the events of a project out to the subscribers of the project
+// connected to this instance.
+type Hub struct {
+	bufferSize int
+
+	mu          sync.Mutex
+	subscribers map[int]map[chan events.Event]struct{}
+}
+
+// NewHub creates a hub whose subscribers each hold up to bufferSize events
+// they did not read yet.
+func NewHub(bufferSize int) *Hub {
+	return &Hub{
+		bufferSize:  bufferSize,
+		subscribers: make(map[int]map[chan events.Event]struct{}),
+	}
+}
+
+// Subscribe returns the events of the project broadcast from now on and a
+// function that ends the subscription. The channel is closed when the
+// subscription ends, including when the subscriber falls too far behind.
+func (h *Hub) Subscribe(projectID int) (<-chan events.Event, func()) {
+	ch := make(chan events.Event, h.bufferSize)
+
+	h.mu.Lock()
+	if h.subscribers[projectID] == nil {
+		h.subscribers[projectID] = make(map[chan events.Event]struct{})
+	}
+	h.subscribers[projectID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.remove(projectID, ch)
+	}
+}
+
+// Broadcast hands the event to the subscribers of its project without
+// blocking. A subscriber whose buffer is full is dropped rather than left
+// with a gap: its client reconnects and reloads the board.
+func (h *Hub) Broadcast(event events.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers[event.ProjectID] {
+		select {
+		case ch <- event:
+		default:
+			h.remove(event.ProjectID, ch)
+		}
+	}
+}
+
+// Subscribers returns the number of subscribers of the project.
+func (h *Hub) Subscribers(projectID int) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subscribers[projectID])
+}
+
+// remove ends a subscription once; h.mu must be held.
+func (h *Hub) remove(projectID int, ch chan events.Event) {
+	subscribers, ok := h.subscribers[projectID]
+	if !ok {
+		return
+	}
+	if _, ok := subscribers[ch]; !ok {
+		return
+	}
+	delete(subscribers, ch)
+	close(ch)
+	if len(subscribers) == 0 {
+		delete(h.subscribers, projectID)
+	}
+}
@@ -0,0 +1,55 @@
+package realtime
+
+import (
+	"testing"
+
+	"lqkhoi-go-http-api/internal/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub_Broadcast(t *testing.T) {
+	t.Run("subscribers of the project receive its events", func(t *testing.T) {
+		hub := NewHub(4)
+		first, unsubscribeFirst := hub.Subscribe(1)
+		defer unsubscribeFirst()
+		second, unsubscribeSecond := hub.Subscribe(1)
+		defer unsubscribeSecond()
+		other, unsubscribeOther := hub.Subscribe(2)
+		defer unsubscribeOther()
+
+		hub.Broadcast(events.Event{ID: "evt-1", Type: events.TaskCreated, ProjectID: 1})
+
+		require.Len(t, first, 1)
+		require.Len(t, second, 1)
+		assert.Empty(t, other)
+		assert.Equal(t, "evt-1", (<-first).ID)
+	})
+
+	t.Run("ending a subscription closes its channel", func(t *testing.T) {
+		hub := NewHub(4)
+		ch, unsubscribe := hub.Subscribe(1)
+
+		unsubscribe()
+		unsubscribe()
+
+		_, open := <-ch
+		assert.False(t, open)
+		assert.Zero(t, hub.Subscribers(1))
+	})
+
+	t.Run("subscriber falling behind is dropped", func(t *testing.T) {
+		hub := NewHub(1)
+		ch, unsubscribe := hub.Subscribe(1)
+		defer unsubscribe()
+
+		hub.Broadcast(events.Event{ID: "evt-1", ProjectID: 1})
+		hub.Broadcast(events.Event{ID: "evt-2", ProjectID: 1})
+
+		assert.Equal(t, "evt-1", (<-ch).ID)
+		_, open := <-ch
+		assert.False(t, open)
+		assert.Zero(t, hub.Subscribers(1))
+	})
+}
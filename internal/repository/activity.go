@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/query"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+type ActivityRepository interface {
+	Create(ctx context.Context, activity *models.ActivityLog) (*models.ActivityLog, error)
+	FindByProjectID(ctx context.Context, projectID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error)
+	FindByEntity(ctx context.Context, entityType models.ActivityEntityType, entityID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error)
+	FindFieldChanges(ctx context.Context, entityType models.ActivityEntityType, entityIDs []int, field string) ([]*models.ActivityLog, error)
+}
+
+type activityRepository struct {
+	db *gorm.DB
+	q  *query.Query
+	*GenericRepository[*models.ActivityLog, int]
+}
+
+func NewActivityRepository(db *gorm.DB) ActivityRepository {
+	genericRepo := NewGenericRepository[*models.ActivityLog, int](
+		db,
+		"ActivityLog",
+		nil,
+	)
+
+	return &activityRepository{
+		db:                db,
+		q:                 query.Use(db),
+		GenericRepository: genericRepo,
+	}
+}
+
+func (r *activityRepository) FindByProjectID(ctx context.Context, projectID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ActivityRepository",
+		"method", "FindByProjectID",
+		"project_id", projectID,
+	)
+	logger.Debug("Starting find activity of project process")
+
+	a := r.q.ActivityLog
+	activityQuery := a.WithContext(ctx).
+		Where(a.ProjectID.Eq(projectID)).
+		Preload(a.Actor).
+		Preload(a.Changes)
+
+	activities, pageInfo, err := findPage(ctx, r.db, activityQuery, &r.q.ActivityLog, page)
+	if err != nil {
+		logger.Error("Failed to find activity of project due to database error", "error", err)
+		return nil, nil, fmt.Errorf("database error finding activity for project %d: %w", projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found activity of project", "count", len(activities), "total", pageInfo.Total)
+	return activities, pageInfo, nil
+}
+
+func (r *activityRepository) FindByEntity(ctx context.Context, entityType models.ActivityEntityType, entityID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ActivityRepository",
+		"method", "FindByEntity",
+		"entity_type", entityType,
+		"entity_id", entityID,
+	)
+	logger.Debug("Starting find activity of entity process")
+
+	a := r.q.ActivityLog
+	activityQuery := a.WithContext(ctx).
+		Where(a.EntityType.Eq(string(entityType)), a.EntityID.Eq(entityID)).
+		Preload(a.Actor).
+		Preload(a.Changes)
+
+	activities, pageInfo, err := findPage(ctx, r.db, activityQuery, &r.q.ActivityLog, page)
+	if err != nil {
+		logger.Error("Failed to find activity of entity due to database error", "error", err)
+		return nil, nil, fmt.Errorf("database error finding activity for %s %d: %w", entityType, entityID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found activity of entity", "count", len(activities), "total", pageInfo.Total)
+	return activities, pageInfo, nil
+}
+
+// FindFieldChanges returns, oldest first, the activities of the given
+// entities that changed field. Only the change of that field is loaded.
+func (r *activityRepository) FindFieldChanges(ctx context.Context, entityType models.ActivityEntityType, entityIDs []int, field string) ([]*models.ActivityLog, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ActivityRepository",
+		"method", "FindFieldChanges",
+		"entity_type", entityType,
+		"field", field,
+	)
+	logger.Debug("Starting find field changes process", "entity_count", len(entityIDs))
+
+	if len(entityIDs) == 0 {
+		logger.Debug("No entity IDs provided, returning empty list")
+		return []*models.ActivityLog{}, nil
+	}
+
+	a := r.q.ActivityLog
+	ac := r.q.ActivityChange
+	activities, err := a.WithContext(ctx).
+		Where(a.EntityType.Eq(string(entityType)), a.EntityID.In(entityIDs...)).
+		Where(a.Columns(a.ID).In(ac.WithContext(ctx).Select(ac.ActivityLogID).Where(ac.Field.Eq(field)))).
+		Preload(a.Changes.On(ac.Field.Eq(field))).
+		Order(a.CreatedAt, a.ID).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find field changes due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding %s changes of %s: %w", field, entityType, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found field changes", "count", len(activities))
+	return activities, nil
+}
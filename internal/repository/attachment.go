@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/query"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment *models.Attachment) (*models.Attachment, error)
+	FindByID(ctx context.Context, id int) (*models.Attachment, error)
+	FindByTaskID(ctx context.Context, taskID int) ([]*models.Attachment, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type attachmentRepository struct {
+	db *gorm.DB
+	q  *query.Query
+	*GenericRepository[*models.Attachment, int]
+}
+
+func NewAttachmentRepository(db *gorm.DB) AttachmentRepository {
+	genericRepo := NewGenericRepository[*models.Attachment, int](
+		db,
+		"Attachment",
+		structs.ErrAttachmentNotExist,
+	)
+
+	return &attachmentRepository{
+		db:                db,
+		q:                 query.Use(db),
+		GenericRepository: genericRepo,
+	}
+}
+
+func (r *attachmentRepository) FindByID(ctx context.Context, id int) (*models.Attachment, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "AttachmentRepository",
+		"method", "FindByID",
+		"attachment_id", id,
+	)
+	logger.Debug("Starting find attachment by ID process")
+
+	a := r.q.Attachment
+	attachment, err := a.WithContext(ctx).
+		Where(a.ID.Eq(id)).
+		Preload(a.UploadedBy).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Warn("Attachment not found")
+			return nil, structs.ErrAttachmentNotExist
+		}
+		logger.Error("Failed to find attachment by ID due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully found attachment by ID")
+	return attachment, nil
+}
+
+// FindByTaskID returns the attachments of the task, newest first.
+func (r *attachmentRepository) FindByTaskID(ctx context.Context, taskID int) ([]*models.Attachment, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "AttachmentRepository",
+		"method", "FindByTaskID",
+		"task_id", taskID,
+	)
+	logger.Debug("Starting find attachments of task process")
+
+	a := r.q.Attachment
+	attachments, err := a.WithContext(ctx).
+		Where(a.TaskID.Eq(taskID)).
+		Preload(a.UploadedBy).
+		Order(a.CreatedAt.Desc(), a.ID.Desc()).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find attachments of task due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding attachments for task %d: %w", taskID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found attachments of task", "count", len(attachments))
+	return attachments, nil
+}
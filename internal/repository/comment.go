@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/query"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+)
+
+type CommentRepository interface {
+	Create(ctx context.Context, comment *models.Comment) (*models.Comment, error)
+	FindByID(ctx context.Context, id int) (*models.Comment, error)
+	FindRootsByTaskID(ctx context.Context, taskID int, page *dto.PageRequest) ([]*models.Comment, *dto.PageInfo, error)
+	FindByParentIDs(ctx context.Context, parentIDs []int) ([]*models.Comment, error)
+	FindEditsByCommentID(ctx context.Context, commentID int) ([]*models.CommentEdit, error)
+	UpdateBody(ctx context.Context, comment *models.Comment, editorID int, mentions []models.CommentMention) error
+	DeleteByIDs(ctx context.Context, ids []int) error
+}
+
+// mentionedUsers preloads the users behind the mentions of a comment; gen only
+// generates the nested relation on the self-referencing Replies field.
+var mentionedUsers = field.NewRelation("Mentions.User", "models.User")
+
+type commentRepository struct {
+	db *gorm.DB
+	q  *query.Query
+	*GenericRepository[*models.Comment, int]
+}
+
+func NewCommentRepository(db *gorm.DB) CommentRepository {
+	genericRepo := NewGenericRepository[*models.Comment, int](
+		db,
+		"Comment",
+		structs.ErrCommentNotExist,
+	)
+
+	return &commentRepository{
+		db:                db,
+		q:                 query.Use(db),
+		GenericRepository: genericRepo,
+	}
+}
+
+func (r *commentRepository) FindByID(ctx context.Context, id int) (*models.Comment, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentRepository",
+		"method", "FindByID",
+		"comment_id", id,
+	)
+	logger.Debug("Starting find comment by ID process")
+
+	c := r.q.Comment
+	comment, err := c.WithContext(ctx).
+		Where(c.ID.Eq(id)).
+		Preload(c.Author).
+		Preload(mentionedUsers).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Warn("Comment not found")
+			return nil, structs.ErrCommentNotExist
+		}
+		logger.Error("Failed to find comment by ID due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully found comment by ID")
+	return comment, nil
+}
+
+func (r *commentRepository) FindRootsByTaskID(ctx context.Context, taskID int, page *dto.PageRequest) ([]*models.Comment, *dto.PageInfo, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentRepository",
+		"method", "FindRootsByTaskID",
+		"task_id", taskID,
+	)
+	logger.Debug("Starting find top level comments of task process")
+
+	c := r.q.Comment
+	commentQuery := c.WithContext(ctx).
+		Where(c.TaskID.Eq(taskID), c.ParentCommentID.IsNull()).
+		Preload(c.Author).
+		Preload(mentionedUsers)
+
+	comments, pageInfo, err := findPage(ctx, r.db, commentQuery, &r.q.Comment, page)
+	if err != nil {
+		logger.Error("Failed to find comments of task due to database error", "error", err)
+		return nil, nil, fmt.Errorf("database error finding comments for task %d: %w", taskID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found comments of task", "count", len(comments), "total", pageInfo.Total)
+	return comments, pageInfo, nil
+}
+
+func (r *commentRepository) FindByParentIDs(ctx context.Context, parentIDs []int) ([]*models.Comment, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentRepository",
+		"method", "FindByParentIDs",
+		"parent_ids", parentIDs,
+	)
+	logger.Debug("Starting find replies by parent IDs process")
+
+	if len(parentIDs) == 0 {
+		logger.Debug("No parent IDs provided, returning empty list")
+		return []*models.Comment{}, nil
+	}
+
+	c := r.q.Comment
+	comments, err := c.WithContext(ctx).
+		Where(c.ParentCommentID.In(parentIDs...)).
+		Preload(c.Author).
+		Preload(mentionedUsers).
+		Order(c.ID).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find replies due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding replies: %w", structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found replies", "count", len(comments))
+	return comments, nil
+}
+
+func (r *commentRepository) FindEditsByCommentID(ctx context.Context, commentID int) ([]*models.CommentEdit, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentRepository",
+		"method", "FindEditsByCommentID",
+		"comment_id", commentID,
+	)
+	logger.Debug("Starting find comment edits process")
+
+	e := r.q.CommentEdit
+	edits, err := e.WithContext(ctx).
+		Where(e.CommentID.Eq(commentID)).
+		Order(e.ID).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find comment edits due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding edits of comment %d: %w", commentID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found comment edits", "count", len(edits))
+	return edits, nil
+}
+
+// UpdateBody stores the previous body of the comment as an edit, writes the
+// new body held by comment and replaces its mentions, all in one transaction.
+func (r *commentRepository) UpdateBody(ctx context.Context, comment *models.Comment, editorID int, mentions []models.CommentMention) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentRepository",
+		"method", "UpdateBody",
+		"comment_id", comment.ID,
+	)
+	logger.Debug("Starting update comment body process", "mention_count", len(mentions))
+
+	err := r.q.Transaction(func(tx *query.Query) error {
+		previous, err := tx.Comment.WithContext(ctx).Where(tx.Comment.ID.Eq(comment.ID)).First()
+		if err != nil {
+			return err
+		}
+
+		edit := &models.CommentEdit{
+			CommentID:    comment.ID,
+			EditorID:     editorID,
+			PreviousBody: previous.Body,
+		}
+		if err := tx.CommentEdit.WithContext(ctx).Create(edit); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if _, err := tx.Comment.WithContext(ctx).
+			Where(tx.Comment.ID.Eq(comment.ID)).
+			UpdateSimple(tx.Comment.Body.Value(comment.Body), tx.Comment.EditedAt.Value(now)); err != nil {
+			return err
+		}
+		comment.EditedAt = &now
+
+		if _, err := tx.CommentMention.WithContext(ctx).Where(tx.CommentMention.CommentID.Eq(comment.ID)).Delete(); err != nil {
+			return err
+		}
+		if len(mentions) == 0 {
+			return nil
+		}
+		newMentions := make([]*models.CommentMention, len(mentions))
+		for i := range mentions {
+			mentions[i].CommentID = comment.ID
+			newMentions[i] = &mentions[i]
+		}
+		return tx.CommentMention.WithContext(ctx).Create(newMentions...)
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Warn("Comment not found")
+			return structs.ErrCommentNotExist
+		}
+		logger.Error("Failed to update comment body due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully updated comment body")
+	return nil
+}
+
+func (r *commentRepository) DeleteByIDs(ctx context.Context, ids []int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentRepository",
+		"method", "DeleteByIDs",
+		"comment_ids", ids,
+	)
+	logger.Debug("Starting delete comments by IDs process")
+
+	if len(ids) == 0 {
+		logger.Debug("No comment IDs provided, skipping database call")
+		return nil
+	}
+
+	c := r.q.Comment
+	resultInfo, err := c.WithContext(ctx).Where(c.ID.In(ids...)).Delete()
+	if err != nil {
+		logger.Error("Failed to delete comments due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	if resultInfo.RowsAffected == 0 {
+		logger.Warn("Delete executed but no comment found with the given IDs")
+		return structs.ErrCommentNotExist
+	}
+
+	logger.Info("Successfully deleted comments", "rows_affected", resultInfo.RowsAffected)
+	return nil
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/query"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+type LabelRepository interface {
+	Create(ctx context.Context, label *models.Label) (*models.Label, error)
+	FindByID(ctx context.Context, id int) (*models.Label, error)
+	FindByIDs(ctx context.Context, ids []int) ([]*models.Label, error)
+	FindByProjectID(ctx context.Context, projectID int) ([]*models.Label, error)
+	FindByProjectAndName(ctx context.Context, projectID int, name string) (*models.Label, error)
+	Update(ctx context.Context, id int, updateMap map[string]any) error
+	Delete(ctx context.Context, id int) error
+	ReplaceTaskLabels(ctx context.Context, taskID int, labelIDs []int) error
+}
+
+type labelRepository struct {
+	db *gorm.DB
+	q  *query.Query
+	*GenericRepository[*models.Label, int]
+}
+
+func NewLabelRepository(db *gorm.DB) LabelRepository {
+	genericRepo := NewGenericRepository[*models.Label, int](
+		db,
+		"Label",
+		structs.ErrLabelNotExist,
+	)
+
+	return &labelRepository{
+		db:                db,
+		q:                 query.Use(db),
+		GenericRepository: genericRepo,
+	}
+}
+
+func (r *labelRepository) FindByIDs(ctx context.Context, ids []int) ([]*models.Label, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelRepository",
+		"method", "FindByIDs",
+	)
+	logger.Debug("Starting find labels by IDs process", "label_count", len(ids))
+
+	if len(ids) == 0 {
+		logger.Debug("No label IDs provided, returning empty list")
+		return []*models.Label{}, nil
+	}
+
+	l := r.q.Label
+	labels, err := l.WithContext(ctx).
+		Where(l.ID.In(ids...)).
+		Order(l.Name).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find labels due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding labels: %w", structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found labels", "count", len(labels))
+	return labels, nil
+}
+
+func (r *labelRepository) FindByProjectID(ctx context.Context, projectID int) ([]*models.Label, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelRepository",
+		"method", "FindByProjectID",
+		"project_id", projectID,
+	)
+	logger.Debug("Starting find labels of project process")
+
+	l := r.q.Label
+	labels, err := l.WithContext(ctx).
+		Where(l.ProjectID.Eq(projectID)).
+		Order(l.Name).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find labels of project due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding labels for project %d: %w", projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found labels of project", "count", len(labels))
+	return labels, nil
+}
+
+func (r *labelRepository) FindByProjectAndName(ctx context.Context, projectID int, name string) (*models.Label, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelRepository",
+		"method", "FindByProjectAndName",
+		"project_id", projectID,
+		"name", name,
+	)
+	logger.Debug("Starting find label by name process")
+
+	l := r.q.Label
+	label, err := l.WithContext(ctx).
+		Where(l.ProjectID.Eq(projectID), l.Name.Eq(name)).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Debug("Label not found")
+			return nil, structs.ErrLabelNotExist
+		}
+		logger.Error("Failed to find label by name due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	return label, nil
+}
+
+// Delete removes the label and detaches it from every task in one transaction.
+func (r *labelRepository) Delete(ctx context.Context, id int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelRepository",
+		"method", "Delete",
+		"label_id", id,
+	)
+	logger.Debug("Starting delete label process")
+
+	var rowsAffected int64
+	err := r.q.Transaction(func(tx *query.Query) error {
+		tl := tx.TaskLabel
+		if _, err := tl.WithContext(ctx).Where(tl.LabelID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+		l := tx.Label
+		resultInfo, err := l.WithContext(ctx).Where(l.ID.Eq(id)).Delete()
+		if err != nil {
+			return err
+		}
+		rowsAffected = resultInfo.RowsAffected
+		return nil
+	})
+	if err != nil {
+		logger.Error("Failed to delete label due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	if rowsAffected == 0 {
+		logger.Warn("Delete executed but no label found with the given ID")
+		return structs.ErrLabelNotExist
+	}
+
+	logger.Info("Successfully deleted label")
+	return nil
+}
+
+// ReplaceTaskLabels swaps the labels of the task for labelIDs in one
+// transaction. An empty list removes every label of the task.
+func (r *labelRepository) ReplaceTaskLabels(ctx context.Context, taskID int, labelIDs []int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelRepository",
+		"method", "ReplaceTaskLabels",
+		"task_id", taskID,
+	)
+	logger.Debug("Starting replace labels of task process", "label_ids", labelIDs)
+
+	err := r.q.Transaction(func(tx *query.Query) error {
+		tl := tx.TaskLabel
+		if _, err := tl.WithContext(ctx).Where(tl.TaskID.Eq(taskID)).Delete(); err != nil {
+			return err
+		}
+		if len(labelIDs) == 0 {
+			return nil
+		}
+		taskLabels := make([]*models.TaskLabel, len(labelIDs))
+		for i, labelID := range labelIDs {
+			taskLabels[i] = &models.TaskLabel{TaskID: taskID, LabelID: labelID}
+		}
+		return tl.WithContext(ctx).Create(taskLabels...)
+	})
+	if err != nil {
+		logger.Error("Failed to replace labels of task due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully replaced labels of task", "count", len(labelIDs))
+	return nil
+}
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lqkhoi-go-http-api/internal/repository (interfaces: ProjectMemberRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_project_member.go -package=mocks . ProjectMemberRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "lqkhoi-go-http-api/internal/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectMemberRepository is a mock of ProjectMemberRepository interface.
+type MockProjectMemberRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectMemberRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectMemberRepositoryMockRecorder is the mock recorder for MockProjectMemberRepository.
+type MockProjectMemberRepositoryMockRecorder struct {
+	mock *MockProjectMemberRepository
+}
+
+// NewMockProjectMemberRepository creates a new mock instance.
+func NewMockProjectMemberRepository(ctrl *gomock.Controller) *MockProjectMemberRepository {
+	mock := &MockProjectMemberRepository{ctrl: ctrl}
+	mock.recorder = &MockProjectMemberRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectMemberRepository) EXPECT() *MockProjectMemberRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockProjectMemberRepository) Create(ctx context.Context, member *models.ProjectMember) (*models.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, member)
+	ret0, _ := ret[0].(*models.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProjectMemberRepositoryMockRecorder) Create(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectMemberRepository)(nil).Create), ctx, member)
+}
+
+// CreateMany mocks base method.
+func (m *MockProjectMemberRepository) CreateMany(ctx context.Context, members []*models.ProjectMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMany", ctx, members)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMany indicates an expected call of CreateMany.
+func (mr *MockProjectMemberRepositoryMockRecorder) CreateMany(ctx, members any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMany", reflect.TypeOf((*MockProjectMemberRepository)(nil).CreateMany), ctx, members)
+}
+
+// DeleteByProjectAndUser mocks base method.
+func (m *MockProjectMemberRepository) DeleteByProjectAndUser(ctx context.Context, projectID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByProjectAndUser", ctx, projectID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByProjectAndUser indicates an expected call of DeleteByProjectAndUser.
+func (mr *MockProjectMemberRepositoryMockRecorder) DeleteByProjectAndUser(ctx, projectID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByProjectAndUser", reflect.TypeOf((*MockProjectMemberRepository)(nil).DeleteByProjectAndUser), ctx, projectID, userID)
+}
+
+// FindByProjectAndUser mocks base method.
+func (m *MockProjectMemberRepository) FindByProjectAndUser(ctx context.Context, projectID, userID int) (*models.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProjectAndUser", ctx, projectID, userID)
+	ret0, _ := ret[0].(*models.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProjectAndUser indicates an expected call of FindByProjectAndUser.
+func (mr *MockProjectMemberRepositoryMockRecorder) FindByProjectAndUser(ctx, projectID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProjectAndUser", reflect.TypeOf((*MockProjectMemberRepository)(nil).FindByProjectAndUser), ctx, projectID, userID)
+}
+
+// FindByProjectAndUsers mocks base method.
+func (m *MockProjectMemberRepository) FindByProjectAndUsers(ctx context.Context, projectID int, userIDs []int) ([]*models.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProjectAndUsers", ctx, projectID, userIDs)
+	ret0, _ := ret[0].([]*models.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProjectAndUsers indicates an expected call of FindByProjectAndUsers.
+func (mr *MockProjectMemberRepositoryMockRecorder) FindByProjectAndUsers(ctx, projectID, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProjectAndUsers", reflect.TypeOf((*MockProjectMemberRepository)(nil).FindByProjectAndUsers), ctx, projectID, userIDs)
+}
+
+// FindByProjectID mocks base method.
+func (m *MockProjectMemberRepository) FindByProjectID(ctx context.Context, projectID int) ([]*models.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByProjectID", ctx, projectID)
+	ret0, _ := ret[0].([]*models.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByProjectID indicates an expected call of FindByProjectID.
+func (mr *MockProjectMemberRepositoryMockRecorder) FindByProjectID(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByProjectID", reflect.TypeOf((*MockProjectMemberRepository)(nil).FindByProjectID), ctx, projectID)
+}
@@ -0,0 +1,206 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lqkhoi-go-http-api/internal/repository (interfaces: SprintRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_sprint.go -package=mocks . SprintRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	dto "lqkhoi-go-http-api/internal/dto"
+	models "lqkhoi-go-http-api/internal/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSprintRepository is a mock of SprintRepository interface.
+type MockSprintRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSprintRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSprintRepositoryMockRecorder is the mock recorder for MockSprintRepository.
+type MockSprintRepositoryMockRecorder struct {
+	mock *MockSprintRepository
+}
+
+// NewMockSprintRepository creates a new mock instance.
+func NewMockSprintRepository(ctrl *gomock.Controller) *MockSprintRepository {
+	mock := &MockSprintRepository{ctrl: ctrl}
+	mock.recorder = &MockSprintRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSprintRepository) EXPECT() *MockSprintRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockSprintRepository) Complete(ctx context.Context, sprintID int, report *models.SprintReport, carriedTaskIDs []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, sprintID, report, carriedTaskIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockSprintRepositoryMockRecorder) Complete(ctx, sprintID, report, carriedTaskIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockSprintRepository)(nil).Complete), ctx, sprintID, report, carriedTaskIDs)
+}
+
+// Create mocks base method.
+func (m *MockSprintRepository) Create(ctx context.Context, sprint *models.Sprint) (*models.Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, sprint)
+	ret0, _ := ret[0].(*models.Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSprintRepositoryMockRecorder) Create(ctx, sprint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSprintRepository)(nil).Create), ctx, sprint)
+}
+
+// Delete mocks base method.
+func (m *MockSprintRepository) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSprintRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSprintRepository)(nil).Delete), ctx, id)
+}
+
+// Find mocks base method.
+func (m *MockSprintRepository) Find(ctx context.Context, filter *dto.SprintFilter, page *dto.PageRequest) ([]*models.Sprint, *dto.PageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, filter, page)
+	ret0, _ := ret[0].([]*models.Sprint)
+	ret1, _ := ret[1].(*dto.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockSprintRepositoryMockRecorder) Find(ctx, filter, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockSprintRepository)(nil).Find), ctx, filter, page)
+}
+
+// FindActiveByProjectID mocks base method.
+func (m *MockSprintRepository) FindActiveByProjectID(ctx context.Context, projectID int) (*models.Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveByProjectID", ctx, projectID)
+	ret0, _ := ret[0].(*models.Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveByProjectID indicates an expected call of FindActiveByProjectID.
+func (mr *MockSprintRepositoryMockRecorder) FindActiveByProjectID(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveByProjectID", reflect.TypeOf((*MockSprintRepository)(nil).FindActiveByProjectID), ctx, projectID)
+}
+
+// FindByID mocks base method.
+func (m *MockSprintRepository) FindByID(ctx context.Context, id int) (*models.Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*models.Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockSprintRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockSprintRepository)(nil).FindByID), ctx, id)
+}
+
+// FindClosedByProjectID mocks base method.
+func (m *MockSprintRepository) FindClosedByProjectID(ctx context.Context, projectID int) ([]*models.Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindClosedByProjectID", ctx, projectID)
+	ret0, _ := ret[0].([]*models.Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindClosedByProjectID indicates an expected call of FindClosedByProjectID.
+func (mr *MockSprintRepositoryMockRecorder) FindClosedByProjectID(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindClosedByProjectID", reflect.TypeOf((*MockSprintRepository)(nil).FindClosedByProjectID), ctx, projectID)
+}
+
+// FindNextPlanned mocks base method.
+func (m *MockSprintRepository) FindNextPlanned(ctx context.Context, projectID int, after time.Time) (*models.Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNextPlanned", ctx, projectID, after)
+	ret0, _ := ret[0].(*models.Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNextPlanned indicates an expected call of FindNextPlanned.
+func (mr *MockSprintRepositoryMockRecorder) FindNextPlanned(ctx, projectID, after any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNextPlanned", reflect.TypeOf((*MockSprintRepository)(nil).FindNextPlanned), ctx, projectID, after)
+}
+
+// FindReportBySprintID mocks base method.
+func (m *MockSprintRepository) FindReportBySprintID(ctx context.Context, sprintID int) (*models.SprintReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReportBySprintID", ctx, sprintID)
+	ret0, _ := ret[0].(*models.SprintReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReportBySprintID indicates an expected call of FindReportBySprintID.
+func (mr *MockSprintRepositoryMockRecorder) FindReportBySprintID(ctx, sprintID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReportBySprintID", reflect.TypeOf((*MockSprintRepository)(nil).FindReportBySprintID), ctx, sprintID)
+}
+
+// Start mocks base method.
+func (m *MockSprintRepository) Start(ctx context.Context, sprintID int, startedAt time.Time, emails []*models.OutboundEmail) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, sprintID, startedAt, emails)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockSprintRepositoryMockRecorder) Start(ctx, sprintID, startedAt, emails any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockSprintRepository)(nil).Start), ctx, sprintID, startedAt, emails)
+}
+
+// Update mocks base method.
+func (m *MockSprintRepository) Update(ctx context.Context, id int, updateMap map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, updateMap)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSprintRepositoryMockRecorder) Update(ctx, id, updateMap any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSprintRepository)(nil).Update), ctx, id, updateMap)
+}
@@ -0,0 +1,327 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lqkhoi-go-http-api/internal/repository (interfaces: TaskRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_task.go -package=mocks . TaskRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	dto "lqkhoi-go-http-api/internal/dto"
+	models "lqkhoi-go-http-api/internal/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskRepository is a mock of TaskRepository interface.
+type MockTaskRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskRepositoryMockRecorder is the mock recorder for MockTaskRepository.
+type MockTaskRepositoryMockRecorder struct {
+	mock *MockTaskRepository
+}
+
+// NewMockTaskRepository creates a new mock instance.
+func NewMockTaskRepository(ctrl *gomock.Controller) *MockTaskRepository {
+	mock := &MockTaskRepository{ctrl: ctrl}
+	mock.recorder = &MockTaskRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskRepository) EXPECT() *MockTaskRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AssignTaskToUser mocks base method.
+func (m *MockTaskRepository) AssignTaskToUser(ctx context.Context, userID, taskID int, emails []*models.OutboundEmail) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignTaskToUser", ctx, userID, taskID, emails)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignTaskToUser indicates an expected call of AssignTaskToUser.
+func (mr *MockTaskRepositoryMockRecorder) AssignTaskToUser(ctx, userID, taskID, emails any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignTaskToUser", reflect.TypeOf((*MockTaskRepository)(nil).AssignTaskToUser), ctx, userID, taskID, emails)
+}
+
+// CountInBoardColumn mocks base method.
+func (m *MockTaskRepository) CountInBoardColumn(ctx context.Context, projectID int, sprintID *int, status models.TaskStatus, assigneeID *int, excludeID int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountInBoardColumn", ctx, projectID, sprintID, status, assigneeID, excludeID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountInBoardColumn indicates an expected call of CountInBoardColumn.
+func (mr *MockTaskRepositoryMockRecorder) CountInBoardColumn(ctx, projectID, sprintID, status, assigneeID, excludeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountInBoardColumn", reflect.TypeOf((*MockTaskRepository)(nil).CountInBoardColumn), ctx, projectID, sprintID, status, assigneeID, excludeID)
+}
+
+// CountOpenSubtasks mocks base method.
+func (m *MockTaskRepository) CountOpenSubtasks(ctx context.Context, parentID int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOpenSubtasks", ctx, parentID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOpenSubtasks indicates an expected call of CountOpenSubtasks.
+func (mr *MockTaskRepositoryMockRecorder) CountOpenSubtasks(ctx, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOpenSubtasks", reflect.TypeOf((*MockTaskRepository)(nil).CountOpenSubtasks), ctx, parentID)
+}
+
+// Create mocks base method.
+func (m *MockTaskRepository) Create(ctx context.Context, task *models.Task) (*models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, task)
+	ret0, _ := ret[0].(*models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTaskRepositoryMockRecorder) Create(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaskRepository)(nil).Create), ctx, task)
+}
+
+// Delete mocks base method.
+func (m *MockTaskRepository) Delete(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaskRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskRepository)(nil).Delete), ctx, id)
+}
+
+// DeleteByIDs mocks base method.
+func (m *MockTaskRepository) DeleteByIDs(ctx context.Context, ids []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByIDs", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByIDs indicates an expected call of DeleteByIDs.
+func (mr *MockTaskRepositoryMockRecorder) DeleteByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByIDs", reflect.TypeOf((*MockTaskRepository)(nil).DeleteByIDs), ctx, ids)
+}
+
+// Find mocks base method.
+func (m *MockTaskRepository) Find(ctx context.Context, filter *dto.TaskFilter, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, filter, page)
+	ret0, _ := ret[0].([]*models.Task)
+	ret1, _ := ret[1].(*dto.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockTaskRepositoryMockRecorder) Find(ctx, filter, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockTaskRepository)(nil).Find), ctx, filter, page)
+}
+
+// FindAssignedDueBetween mocks base method.
+func (m *MockTaskRepository) FindAssignedDueBetween(ctx context.Context, from, to time.Time) ([]*models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAssignedDueBetween", ctx, from, to)
+	ret0, _ := ret[0].([]*models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAssignedDueBetween indicates an expected call of FindAssignedDueBetween.
+func (mr *MockTaskRepositoryMockRecorder) FindAssignedDueBetween(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAssignedDueBetween", reflect.TypeOf((*MockTaskRepository)(nil).FindAssignedDueBetween), ctx, from, to)
+}
+
+// FindBacklogByProjectID mocks base method.
+func (m *MockTaskRepository) FindBacklogByProjectID(ctx context.Context, projectID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBacklogByProjectID", ctx, projectID, page)
+	ret0, _ := ret[0].([]*models.Task)
+	ret1, _ := ret[1].(*dto.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindBacklogByProjectID indicates an expected call of FindBacklogByProjectID.
+func (mr *MockTaskRepositoryMockRecorder) FindBacklogByProjectID(ctx, projectID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBacklogByProjectID", reflect.TypeOf((*MockTaskRepository)(nil).FindBacklogByProjectID), ctx, projectID, page)
+}
+
+// FindBoardColumn mocks base method.
+func (m *MockTaskRepository) FindBoardColumn(ctx context.Context, projectID int, sprintID *int, status models.TaskStatus) ([]*models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBoardColumn", ctx, projectID, sprintID, status)
+	ret0, _ := ret[0].([]*models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBoardColumn indicates an expected call of FindBoardColumn.
+func (mr *MockTaskRepositoryMockRecorder) FindBoardColumn(ctx, projectID, sprintID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBoardColumn", reflect.TypeOf((*MockTaskRepository)(nil).FindBoardColumn), ctx, projectID, sprintID, status)
+}
+
+// FindByID mocks base method.
+func (m *MockTaskRepository) FindByID(ctx context.Context, id int) (*models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockTaskRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTaskRepository)(nil).FindByID), ctx, id)
+}
+
+// FindByIDs mocks base method.
+func (m *MockTaskRepository) FindByIDs(ctx context.Context, ids []int) ([]*models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockTaskRepositoryMockRecorder) FindByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockTaskRepository)(nil).FindByIDs), ctx, ids)
+}
+
+// FindByParentIDs mocks base method.
+func (m *MockTaskRepository) FindByParentIDs(ctx context.Context, parentIDs []int) ([]*models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByParentIDs", ctx, parentIDs)
+	ret0, _ := ret[0].([]*models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByParentIDs indicates an expected call of FindByParentIDs.
+func (mr *MockTaskRepositoryMockRecorder) FindByParentIDs(ctx, parentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByParentIDs", reflect.TypeOf((*MockTaskRepository)(nil).FindByParentIDs), ctx, parentIDs)
+}
+
+// FindBySprintIDInRankOrder mocks base method.
+func (m *MockTaskRepository) FindBySprintIDInRankOrder(ctx context.Context, sprintID int) ([]*models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySprintIDInRankOrder", ctx, sprintID)
+	ret0, _ := ret[0].([]*models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySprintIDInRankOrder indicates an expected call of FindBySprintIDInRankOrder.
+func (mr *MockTaskRepositoryMockRecorder) FindBySprintIDInRankOrder(ctx, sprintID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySprintIDInRankOrder", reflect.TypeOf((*MockTaskRepository)(nil).FindBySprintIDInRankOrder), ctx, sprintID)
+}
+
+// FindTaskByUserID mocks base method.
+func (m *MockTaskRepository) FindTaskByUserID(ctx context.Context, userID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTaskByUserID", ctx, userID, page)
+	ret0, _ := ret[0].([]*models.Task)
+	ret1, _ := ret[1].(*dto.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTaskByUserID indicates an expected call of FindTaskByUserID.
+func (mr *MockTaskRepositoryMockRecorder) FindTaskByUserID(ctx, userID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTaskByUserID", reflect.TypeOf((*MockTaskRepository)(nil).FindTaskByUserID), ctx, userID, page)
+}
+
+// FindTasksByProjectID mocks base method.
+func (m *MockTaskRepository) FindTasksByProjectID(ctx context.Context, projectID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTasksByProjectID", ctx, projectID, page)
+	ret0, _ := ret[0].([]*models.Task)
+	ret1, _ := ret[1].(*dto.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTasksByProjectID indicates an expected call of FindTasksByProjectID.
+func (mr *MockTaskRepositoryMockRecorder) FindTasksByProjectID(ctx, projectID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTasksByProjectID", reflect.TypeOf((*MockTaskRepository)(nil).FindTasksByProjectID), ctx, projectID, page)
+}
+
+// MoveOnBoard mocks base method.
+func (m *MockTaskRepository) MoveOnBoard(ctx context.Context, id int, status models.TaskStatus, rank string, reranked map[int]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveOnBoard", ctx, id, status, rank, reranked)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveOnBoard indicates an expected call of MoveOnBoard.
+func (mr *MockTaskRepositoryMockRecorder) MoveOnBoard(ctx, id, status, rank, reranked any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveOnBoard", reflect.TypeOf((*MockTaskRepository)(nil).MoveOnBoard), ctx, id, status, rank, reranked)
+}
+
+// Update mocks base method.
+func (m *MockTaskRepository) Update(ctx context.Context, id int, updateMap map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, updateMap)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaskRepositoryMockRecorder) Update(ctx, id, updateMap any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskRepository)(nil).Update), ctx, id, updateMap)
+}
+
+// UpdateSprintByIDs mocks base method.
+func (m *MockTaskRepository) UpdateSprintByIDs(ctx context.Context, ids []int, sprintID *int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSprintByIDs", ctx, ids, sprintID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSprintByIDs indicates an expected call of UpdateSprintByIDs.
+func (mr *MockTaskRepositoryMockRecorder) UpdateSprintByIDs(ctx, ids, sprintID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSprintByIDs", reflect.TypeOf((*MockTaskRepository)(nil).UpdateSprintByIDs), ctx, ids, sprintID)
+}
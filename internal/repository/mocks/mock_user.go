@@ -11,6 +11,7 @@ package mocks
 
 import (
 	context "context"
+	dto "lqkhoi-go-http-api/internal/dto"
 	models "lqkhoi-go-http-api/internal/models"
 	reflect "reflect"
 
@@ -99,6 +100,21 @@ func (mr *MockUserRepositoryMockRecorder) FindByEmail(ctx, email any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockUserRepository)(nil).FindByEmail), ctx, email)
 }
 
+// FindByEmails mocks base method.
+func (m *MockUserRepository) FindByEmails(ctx context.Context, emails []string) ([]*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmails", ctx, emails)
+	ret0, _ := ret[0].([]*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmails indicates an expected call of FindByEmails.
+func (mr *MockUserRepositoryMockRecorder) FindByEmails(ctx, emails any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmails", reflect.TypeOf((*MockUserRepository)(nil).FindByEmails), ctx, emails)
+}
+
 // FindByID mocks base method.
 func (m *MockUserRepository) FindByID(ctx context.Context, id int) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -130,18 +146,19 @@ func (mr *MockUserRepositoryMockRecorder) FindByIDs(ctx, userIDs any) *gomock.Ca
 }
 
 // List mocks base method.
-func (m *MockUserRepository) List(ctx context.Context) ([]*models.User, error) {
+func (m *MockUserRepository) List(ctx context.Context, page *dto.PageRequest) ([]*models.User, *dto.PageInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx)
+	ret := m.ctrl.Call(m, "List", ctx, page)
 	ret0, _ := ret[0].([]*models.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*dto.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // List indicates an expected call of List.
-func (mr *MockUserRepositoryMockRecorder) List(ctx any) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) List(ctx, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), ctx, page)
 }
 
 // Update mocks base method.
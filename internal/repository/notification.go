@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/query"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+type NotificationRepository interface {
+	CreateMany(ctx context.Context, notifications []*models.Notification, emails []*models.OutboundEmail) error
+	FindByID(ctx context.Context, id int) (*models.Notification, error)
+	FindByUserID(ctx context.Context, userID int, unreadOnly bool, page *dto.PageRequest) ([]*models.Notification, *dto.PageInfo, error)
+	FindTaskNotificationsSince(ctx context.Context, notificationType models.NotificationType, taskIDs []int, since time.Time) ([]*models.Notification, error)
+	CountUnread(ctx context.Context, userID int) (int64, error)
+	MarkRead(ctx context.Context, id int, readAt time.Time) error
+	MarkAllRead(ctx context.Context, userID int, readAt time.Time) (int64, error)
+}
+
+type notificationRepository struct {
+	db *gorm.DB
+	q  *query.Query
+	*GenericRepository[*models.Notification, int]
+}
+
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	genericRepo := NewGenericRepository[*models.Notification, int](
+		db,
+		"Notification",
+		structs.ErrNotificationNotExist,
+	)
+
+	return &notificationRepository{
+		db:                db,
+		q:                 query.Use(db),
+		GenericRepository: genericRepo,
+	}
+}
+
+// CreateMany stores the notifications and queues emails in the outbox, in
+// one transaction.
+func (r *notificationRepository) CreateMany(ctx context.Context, notifications []*models.Notification, emails []*models.OutboundEmail) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "NotificationRepository",
+		"method", "CreateMany",
+	)
+
+	if len(notifications) == 0 && len(emails) == 0 {
+		return nil
+	}
+
+	err := r.q.Transaction(func(tx *query.Query) error {
+		if len(notifications) > 0 {
+			if err := tx.Notification.WithContext(ctx).Create(notifications...); err != nil {
+				return err
+			}
+		}
+		return createOutboundEmails(ctx, tx, emails)
+	})
+	if err != nil {
+		logger.Error("Failed to create notifications due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	logger.Debug("Successfully created notifications", "count", len(notifications), "email_count", len(emails))
+	return nil
+}
+
+func (r *notificationRepository) FindByID(ctx context.Context, id int) (*models.Notification, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "NotificationRepository",
+		"method", "FindByID",
+		"notification_id", id,
+	)
+	logger.Debug("Starting find notification by ID process")
+
+	n := r.q.Notification
+	notification, err := n.WithContext(ctx).
+		Where(n.ID.Eq(id)).
+		Preload(n.Actor).
+		Preload(n.Project).
+		Preload(n.Task).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Warn("Notification not found")
+			return nil, structs.ErrNotificationNotExist
+		}
+		logger.Error("Failed to find notification by ID due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully found notification by ID")
+	return notification, nil
+}
+
+// FindByUserID returns a page of the notifications of the user, only the
+// unread ones when unreadOnly is true.
+func (r *notificationRepository) FindByUserID(ctx context.Context, userID int, unreadOnly bool, page *dto.PageRequest) ([]*models.Notification, *dto.PageInfo, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "NotificationRepository",
+		"method", "FindByUserID",
+		"user_id", userID,
+		"unread_only", unreadOnly,
+	)
+	logger.Debug("Starting find notifications of user process")
+
+	n := r.q.Notification
+	notificationQuery := n.WithContext(ctx).
+		Where(n.UserID.Eq(userID)).
+		Preload(n.Actor).
+		Preload(n.Project).
+		Preload(n.Task)
+	if unreadOnly {
+		notificationQuery = notificationQuery.Where(n.ReadAt.IsNull())
+	}
+
+	notifications, pageInfo, err := findPage(ctx, r.db, notificationQuery, &r.q.Notification, page)
+	if err != nil {
+		logger.Error("Failed to find notifications of user due to database error", "error", err)
+		return nil, nil, fmt.Errorf("database error finding notifications for user %d: %w", userID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found notifications of user", "count", len(notifications), "total", pageInfo.Total)
+	return notifications, pageInfo, nil
+}
+
+// FindTaskNotificationsSince returns the notifications of the type about the
+// tasks created at or after since.
+func (r *notificationRepository) FindTaskNotificationsSince(ctx context.Context, notificationType models.NotificationType, taskIDs []int, since time.Time) ([]*models.Notification, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "NotificationRepository",
+		"method", "FindTaskNotificationsSince",
+		"type", notificationType,
+	)
+
+	if len(taskIDs) == 0 {
+		return []*models.Notification{}, nil
+	}
+
+	n := r.q.Notification
+	notifications, err := n.WithContext(ctx).
+		Where(
+			n.Type.Eq(string(notificationType)),
+			n.TaskID.In(taskIDs...),
+			n.CreatedAt.Gte(since),
+		).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find task notifications due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding %s notifications: %w", notificationType, structs.ErrDatabaseFail)
+	}
+	return notifications, nil
+}
+
+func (r *notificationRepository) CountUnread(ctx context.Context, userID int) (int64, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "NotificationRepository",
+		"method", "CountUnread",
+		"user_id", userID,
+	)
+
+	n := r.q.Notification
+	count, err := n.WithContext(ctx).Where(n.UserID.Eq(userID), n.ReadAt.IsNull()).Count()
+	if err != nil {
+		logger.Error("Failed to count unread notifications due to database error", "error", err)
+		return 0, fmt.Errorf("database error counting unread notifications for user %d: %w", userID, structs.ErrDatabaseFail)
+	}
+	return count, nil
+}
+
+// MarkRead sets the read time of the notification, unless it was already
+// read.
+func (r *notificationRepository) MarkRead(ctx context.Context, id int, readAt time.Time) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "NotificationRepository",
+		"method", "MarkRead",
+		"notification_id", id,
+	)
+
+	n := r.q.Notification
+	if _, err := n.WithContext(ctx).Where(n.ID.Eq(id), n.ReadAt.IsNull()).Update(n.ReadAt, readAt); err != nil {
+		logger.Error("Failed to mark notification read due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+	return nil
+}
+
+// MarkAllRead marks every unread notification of the user as read and
+// returns how many were.
+func (r *notificationRepository) MarkAllRead(ctx context.Context, userID int, readAt time.Time) (int64, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "NotificationRepository",
+		"method", "MarkAllRead",
+		"user_id", userID,
+	)
+
+	n := r.q.Notification
+	resultInfo, err := n.WithContext(ctx).Where(n.UserID.Eq(userID), n.ReadAt.IsNull()).Update(n.ReadAt, readAt)
+	if err != nil {
+		logger.Error("Failed to mark notifications read due to database error", "error", err)
+		return 0, structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully marked notifications read", "rows_affected", resultInfo.RowsAffected)
+	return resultInfo.RowsAffected, nil
+}
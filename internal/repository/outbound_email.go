@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/query"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+type OutboundEmailRepository interface {
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*models.OutboundEmail, error)
+	Claim(ctx context.Context, email *models.OutboundEmail, leaseUntil time.Time) (bool, error)
+	Update(ctx context.Context, id int, updateMap map[string]any) error
+}
+
+type outboundEmailRepository struct {
+	db *gorm.DB
+	q  *query.Query
+	*GenericRepository[*models.OutboundEmail, int]
+}
+
+func NewOutboundEmailRepository(db *gorm.DB) OutboundEmailRepository {
+	genericRepo := NewGenericRepository[*models.OutboundEmail, int](
+		db,
+		"OutboundEmail",
+		nil,
+	)
+
+	return &outboundEmailRepository{
+		db:                db,
+		q:                 query.Use(db),
+		GenericRepository: genericRepo,
+	}
+}
+
+// createOutboundEmails queues emails in the outbox within tx, the
+// transaction of the change the emails are about.
+func createOutboundEmails(ctx context.Context, tx *query.Query, emails []*models.OutboundEmail) error {
+	if len(emails) == 0 {
+		return nil
+	}
+	return tx.OutboundEmail.WithContext(ctx).Create(emails...)
+}
+
+// FindDue returns up to limit pending emails whose next attempt is due at
+// now, oldest first.
+func (r *outboundEmailRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*models.OutboundEmail, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "OutboundEmailRepository",
+		"method", "FindDue",
+	)
+
+	e := r.q.OutboundEmail
+	emails, err := e.WithContext(ctx).
+		Where(e.Status.Eq(string(models.EmailPending)), e.NextAttemptAt.Lte(now)).
+		Order(e.NextAttemptAt, e.ID).
+		Limit(limit).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find due emails due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	logger.Debug("Successfully found due emails", "count", len(emails))
+	return emails, nil
+}
+
+// Claim moves the next attempt of a due email to leaseUntil, unless another
+// worker did so first. It reports whether the email was claimed; a claimed
+// email is retried at leaseUntil if its attempt is never recorded.
+func (r *outboundEmailRepository) Claim(ctx context.Context, email *models.OutboundEmail, leaseUntil time.Time) (bool, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "OutboundEmailRepository",
+		"method", "Claim",
+		"email_id", email.ID,
+	)
+
+	if email.NextAttemptAt == nil {
+		return false, nil
+	}
+
+	e := r.q.OutboundEmail
+	resultInfo, err := e.WithContext(ctx).
+		Where(e.ID.Eq(email.ID), e.Status.Eq(string(models.EmailPending)), e.NextAttemptAt.Eq(*email.NextAttemptAt)).
+		Update(e.NextAttemptAt, leaseUntil)
+	if err != nil {
+		logger.Error("Failed to claim email due to database error", "error", err)
+		return false, structs.ErrDatabaseFail
+	}
+
+	return resultInfo.RowsAffected == 1, nil
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// pageableDo is the subset of a gorm-gen DAO needed to fetch a page.
+type pageableDo[D any, M any] interface {
+	Scopes(funcs ...func(gen.Dao) gen.Dao) D
+	Count() (int64, error)
+	Find() ([]M, error)
+}
+
+// sortableTable is implemented by every generated query table.
+type sortableTable interface {
+	GetFieldByName(fieldName string) (field.OrderExpr, bool)
+}
+
+var paginationSchemas sync.Map
+
+// findPage counts the rows matched by do and returns the page described by
+// page. A nil page returns every row. Rows are always ordered by id after the
+// sort field so that keyset cursors are stable.
+func findPage[D pageableDo[D, M], M models.Identifiable[int]](
+	ctx context.Context,
+	db *gorm.DB,
+	do D,
+	table sortableTable,
+	page *dto.PageRequest,
+) ([]M, *dto.PageInfo, error) {
+	if page == nil {
+		rows, err := do.Find()
+		if err != nil {
+			return nil, nil, err
+		}
+		return rows, &dto.PageInfo{Total: int64(len(rows)), Limit: len(rows)}, nil
+	}
+
+	sortExpr, ok := table.GetFieldByName(page.SortField)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: unknown sort field %q", structs.ErrInvalidPageRequest, page.SortField)
+	}
+	idExpr, _ := table.GetFieldByName("id")
+	if page.Cursor != nil && !page.Cursor.Matches(page) {
+		return nil, nil, fmt.Errorf("%w: cursor was issued for sort field %q, descending %t", structs.ErrInvalidPageRequest, page.Cursor.SortField, page.Cursor.SortDesc)
+	}
+
+	total, err := do.Count()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	do = do.Scopes(func(dao gen.Dao) gen.Dao {
+		if page.Cursor != nil {
+			dao = dao.Where(keysetCondition(dao.TableName(), page))
+		} else if page.Page > 1 {
+			dao = dao.Offset((page.Page - 1) * page.Limit)
+		}
+
+		orders := []field.Expr{sortExpr}
+		if page.SortDesc {
+			orders[0] = sortExpr.Desc()
+		}
+		if page.SortField != "id" {
+			if page.SortDesc {
+				orders = append(orders, idExpr.Desc())
+			} else {
+				orders = append(orders, idExpr)
+			}
+		}
+		// One extra row tells whether another page follows.
+		return dao.Order(orders...).Limit(page.Limit + 1)
+	})
+
+	rows, err := do.Find()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info := &dto.PageInfo{Total: total, Limit: page.Limit}
+	if page.Cursor == nil {
+		info.Page = page.Page
+	}
+	if len(rows) > page.Limit {
+		rows = rows[:page.Limit]
+		last := rows[len(rows)-1]
+		value, err := columnValue(ctx, db, last, page.SortField)
+		if err != nil {
+			return nil, nil, err
+		}
+		info.NextCursor = (&dto.Cursor{SortField: page.SortField, SortDesc: page.SortDesc, Value: value, ID: last.GetID()}).Encode()
+	}
+
+	return rows, info, nil
+}
+
+// keysetCondition selects the rows that sort after the cursor.
+func keysetCondition(table string, page *dto.PageRequest) field.Expr {
+	idColumn := field.NewField(table, "id")
+	lastID := cursorValue{int64(page.Cursor.ID)}
+	if page.SortField == "id" {
+		if page.SortDesc {
+			return idColumn.Lt(lastID)
+		}
+		return idColumn.Gt(lastID)
+	}
+
+	sortColumn := field.NewField(table, page.SortField)
+	lastValue := cursorValue{page.Cursor.Value}
+	if page.SortDesc {
+		return field.Or(sortColumn.Lt(lastValue), field.And(sortColumn.Eq(lastValue), idColumn.Lt(lastID)))
+	}
+	return field.Or(sortColumn.Gt(lastValue), field.And(sortColumn.Eq(lastValue), idColumn.Gt(lastID)))
+}
+
+// cursorValue passes a decoded cursor value to the driver as is.
+type cursorValue struct {
+	value any
+}
+
+func (v cursorValue) Value() (driver.Value, error) {
+	return v.value, nil
+}
+
+// columnValue reads the value of the given column from a model.
+func columnValue(ctx context.Context, db *gorm.DB, model any, column string) (any, error) {
+	s, err := schema.Parse(model, &paginationSchemas, db.NamingStrategy)
+	if err != nil {
+		return nil, err
+	}
+	f := s.LookUpField(column)
+	if f == nil {
+		return nil, fmt.Errorf("%w: unknown sort field %q", structs.ErrInvalidPageRequest, column)
+	}
+	value, _ := f.ValueOf(ctx, reflect.Indirect(reflect.ValueOf(model)))
+	return value, nil
+}
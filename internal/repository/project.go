@@ -14,9 +14,11 @@ import (
 	"gorm.io/gorm"
 )
 
+//go:generate mockgen -destination=./mocks/mock_project.go -package=mocks . ProjectRepository
+
 type ProjectRepository interface {
 	Create(ctx context.Context, project *models.Project) (*models.Project, error)
-	Find(ctx context.Context, filter dto.ProjectFilter) ([]*models.Project, error)
+	Find(ctx context.Context, filter dto.ProjectFilter, page *dto.PageRequest) ([]*models.Project, *dto.PageInfo, error)
 	FindByID(ctx context.Context, id int) (*models.Project, error)
 	Update(ctx context.Context, id int, updateMap map[string]any) error
 	Delete(ctx context.Context, id int) error
@@ -62,7 +64,7 @@ func NewProjectRepository(db *gorm.DB, cfg config.DateTimeConfig) ProjectReposit
 // 	return project, nil
 // }
 
-func (r *projectRepository) Find(ctx context.Context, filter dto.ProjectFilter) ([]*models.Project, error) {
+func (r *projectRepository) Find(ctx context.Context, filter dto.ProjectFilter, page *dto.PageRequest) ([]*models.Project, *dto.PageInfo, error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "ProjectRepository",
@@ -99,16 +101,16 @@ func (r *projectRepository) Find(ctx context.Context, filter dto.ProjectFilter)
 		projectQuery = projectQuery.Where(p.EndDate.Lte(*filter.EndDateBefore))
 	}
 
-	projects, err := projectQuery.Find()
+	projects, pageInfo, err := findPage(ctx, r.db, projectQuery, &r.q.Project, page)
 	if err != nil {
 		logger.Error("Error finding projects", "error", err)
-		return nil, fmt.Errorf("database error retrieving projects: %w", err)
+		return nil, nil, fmt.Errorf("database error retrieving projects: %w", err)
 	}
 
-	logger.Info("Successfully found projects", "count", len(projects))
+	logger.Info("Successfully found projects", "count", len(projects), "total", pageInfo.Total)
 	logger.Debug("Found projects details", "projects", projects) // could be large
 
-	return projects, nil
+	return projects, pageInfo, nil
 }
 
 // func (r *projectRepository) FindByID(ctx context.Context, id int) (*models.Project, error) {
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/query"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=./mocks/mock_project_member.go -package=mocks . ProjectMemberRepository
+
+type ProjectMemberRepository interface {
+	Create(ctx context.Context, member *models.ProjectMember) (*models.ProjectMember, error)
+	CreateMany(ctx context.Context, members []*models.ProjectMember) error
+	FindByProjectAndUser(ctx context.Context, projectID, userID int) (*models.ProjectMember, error)
+	FindByProjectAndUsers(ctx context.Context, projectID int, userIDs []int) ([]*models.ProjectMember, error)
+	FindByProjectID(ctx context.Context, projectID int) ([]*models.ProjectMember, error)
+	DeleteByProjectAndUser(ctx context.Context, projectID, userID int) error
+}
+
+type projectMemberRepository struct {
+	db *gorm.DB
+	q  *query.Query
+	*GenericRepository[*models.ProjectMember, int]
+}
+
+func NewProjectMemberRepository(db *gorm.DB) ProjectMemberRepository {
+	genericRepo := NewGenericRepository[*models.ProjectMember, int](
+		db,
+		"ProjectMember",
+		structs.ErrUserNotPartProject,
+	)
+
+	return &projectMemberRepository{
+		db:                db,
+		q:                 query.Use(db),
+		GenericRepository: genericRepo,
+	}
+}
+
+func (r *projectMemberRepository) CreateMany(ctx context.Context, members []*models.ProjectMember) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ProjectMemberRepository",
+		"method", "CreateMany",
+	)
+	logger.Debug("Starting create project members process", "member_count", len(members))
+
+	if len(members) == 0 {
+		logger.Debug("No members provided, skipping database call")
+		return nil
+	}
+
+	m := r.q.ProjectMember
+	if err := m.WithContext(ctx).Create(members...); err != nil {
+		logger.Error("Failed to create project members", "error", err)
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return structs.ErrDataViolateConstraint
+		}
+		return structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully created project members", "member_count", len(members))
+	return nil
+}
+
+func (r *projectMemberRepository) FindByProjectAndUser(ctx context.Context, projectID, userID int) (*models.ProjectMember, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ProjectMemberRepository",
+		"method", "FindByProjectAndUser",
+		"project_id", projectID,
+		"user_id", userID,
+	)
+	logger.Debug("Starting find project member process")
+
+	m := r.q.ProjectMember
+	member, err := m.WithContext(ctx).
+		Where(m.ProjectID.Eq(projectID), m.UserID.Eq(userID)).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Debug("User is not a member of the project")
+			return nil, structs.ErrUserNotPartProject
+		}
+		logger.Error("Failed to find project member due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	logger.Debug("Successfully found project member", "role", member.Role)
+	return member, nil
+}
+
+func (r *projectMemberRepository) FindByProjectAndUsers(ctx context.Context, projectID int, userIDs []int) ([]*models.ProjectMember, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ProjectMemberRepository",
+		"method", "FindByProjectAndUsers",
+		"project_id", projectID,
+		"user_ids", userIDs,
+	)
+	logger.Debug("Starting find project members by user IDs process")
+
+	if len(userIDs) == 0 {
+		logger.Debug("No user IDs provided, returning empty list")
+		return []*models.ProjectMember{}, nil
+	}
+
+	m := r.q.ProjectMember
+	members, err := m.WithContext(ctx).
+		Where(m.ProjectID.Eq(projectID), m.UserID.In(userIDs...)).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find project members due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding members of project %d: %w", projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Debug("Successfully found project members", "count", len(members))
+	return members, nil
+}
+
+func (r *projectMemberRepository) FindByProjectID(ctx context.Context, projectID int) ([]*models.ProjectMember, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ProjectMemberRepository",
+		"method", "FindByProjectID",
+		"project_id", projectID,
+	)
+	logger.Debug("Starting find project members by project ID process")
+
+	m := r.q.ProjectMember
+	members, err := m.WithContext(ctx).
+		Where(m.ProjectID.Eq(projectID)).
+		Preload(m.User).
+		Order(m.ID).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find project members due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding members of project %d: %w", projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found project members", "count", len(members))
+	return members, nil
+}
+
+func (r *projectMemberRepository) DeleteByProjectAndUser(ctx context.Context, projectID, userID int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ProjectMemberRepository",
+		"method", "DeleteByProjectAndUser",
+		"project_id", projectID,
+		"user_id", userID,
+	)
+	logger.Debug("Starting delete project member process")
+
+	m := r.q.ProjectMember
+	resultInfo, err := m.WithContext(ctx).
+		Where(m.ProjectID.Eq(projectID), m.UserID.Eq(userID)).
+		Delete()
+	if err != nil {
+		logger.Error("Failed to delete project member due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	if resultInfo.RowsAffected == 0 {
+		logger.Warn("Delete executed but user is not a member of the project")
+		return structs.ErrUserNotPartProject
+	}
+
+	logger.Info("Successfully deleted project member", "rows_affected", resultInfo.RowsAffected)
+	return nil
+}
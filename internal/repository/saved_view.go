@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/query"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+type SavedViewRepository interface {
+	Create(ctx context.Context, view *models.SavedView) (*models.SavedView, error)
+	FindByID(ctx context.Context, id int) (*models.SavedView, error)
+	FindVisibleToUser(ctx context.Context, userID int) ([]*models.SavedView, error)
+	Update(ctx context.Context, id int, updateMap map[string]any) error
+	Delete(ctx context.Context, id int) error
+}
+
+type savedViewRepository struct {
+	db *gorm.DB
+	q  *query.Query
+	*GenericRepository[*models.SavedView, int]
+}
+
+func NewSavedViewRepository(db *gorm.DB) SavedViewRepository {
+	genericRepo := NewGenericRepository[*models.SavedView, int](
+		db,
+		"SavedView",
+		structs.ErrSavedViewNotExist,
+	)
+
+	return &savedViewRepository{
+		db:                db,
+		q:                 query.Use(db),
+		GenericRepository: genericRepo,
+	}
+}
+
+func (r *savedViewRepository) FindByID(ctx context.Context, id int) (*models.SavedView, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SavedViewRepository",
+		"method", "FindByID",
+		"view_id", id,
+	)
+	logger.Debug("Starting find saved view by ID process")
+
+	v := r.q.SavedView
+	view, err := v.WithContext(ctx).
+		Where(v.ID.Eq(id)).
+		Preload(v.Owner).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Warn("Saved view not found")
+			return nil, structs.ErrSavedViewNotExist
+		}
+		logger.Error("Failed to find saved view by ID due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully found saved view by ID")
+	return view, nil
+}
+
+// FindVisibleToUser returns the views the user owns and the views shared to
+// the projects the user is a member of, ordered by name.
+func (r *savedViewRepository) FindVisibleToUser(ctx context.Context, userID int) ([]*models.SavedView, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SavedViewRepository",
+		"method", "FindVisibleToUser",
+		"user_id", userID,
+	)
+	logger.Debug("Starting find saved views visible to user process")
+
+	v := r.q.SavedView
+	pm := r.q.ProjectMember
+	memberProjects := pm.WithContext(ctx).Select(pm.ProjectID).Where(pm.UserID.Eq(userID))
+	views, err := v.WithContext(ctx).
+		Where(v.OwnerID.Eq(userID)).
+		Or(v.Columns(v.ProjectID).In(memberProjects)).
+		Preload(v.Owner).
+		Order(v.Name, v.ID).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find saved views due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding saved views for user %d: %w", userID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found saved views", "count", len(views))
+	return views, nil
+}
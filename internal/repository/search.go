@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+// searchHeadlineOptions configures the snippets of ts_headline.
+const searchHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=\" … \""
+
+// The source text of the snippets is HTML escaped before ts_headline wraps
+// the matches in <mark> tags, so the snippets can be rendered as HTML. The
+// escaped entities are tokens of their own to the parser and do not change
+// the matches.
+//
+// The search queries rank the rows matching the tsvector columns created by
+// the migration, keep the best ones the user may see, and only then build
+// their snippets, as ts_headline reads the whole text.
+const (
+	sqlSearchTasks = `
+	WITH query AS (SELECT websearch_to_tsquery('english', @query) AS q),
+	ranked AS (
+		SELECT t.id, t.project_id, t.title, t.description, t.status,
+			ts_rank(t.search_vector, query.q) AS rank
+		FROM tasks t, query
+		WHERE t.deleted_at IS NULL
+			AND t.search_vector @@ query.q
+			AND (t.assignee_id = @user_id OR EXISTS (
+				SELECT 1 FROM project_members pm
+				WHERE pm.project_id = t.project_id AND pm.user_id = @user_id))
+		ORDER BY rank DESC, t.id DESC
+		LIMIT @limit
+	)
+	SELECT r.id, r.project_id, p.name AS project_name, r.title, r.status, r.rank,
+		ts_headline('english',
+			replace(replace(replace(replace(replace(r.title || ' ' || coalesce(r.description, ''), '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;'),
+			query.q, @options) AS snippet
+	FROM ranked r
+	JOIN projects p ON p.id = r.project_id
+	CROSS JOIN query
+	ORDER BY r.rank DESC, r.id DESC
+	`
+
+	sqlSearchProjects = `
+	WITH query AS (SELECT websearch_to_tsquery('english', @query) AS q),
+	ranked AS (
+		SELECT p.id, p.name, p.description, p.status,
+			ts_rank(p.search_vector, query.q) AS rank
+		FROM projects p, query
+		WHERE p.deleted_at IS NULL
+			AND p.search_vector @@ query.q
+			AND EXISTS (
+				SELECT 1 FROM project_members pm
+				WHERE pm.project_id = p.id AND pm.user_id = @user_id)
+		ORDER BY rank DESC, p.id DESC
+		LIMIT @limit
+	)
+	SELECT r.id, r.name, r.status, r.rank,
+		ts_headline('english',
+			replace(replace(replace(replace(replace(r.name || ' ' || coalesce(r.description, ''), '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;'),
+			query.q, @options) AS snippet
+	FROM ranked r
+	CROSS JOIN query
+	ORDER BY r.rank DESC, r.id DESC
+	`
+)
+
+type SearchRepository interface {
+	SearchTasks(ctx context.Context, userID int, query string, limit int) ([]*models.TaskSearchResult, error)
+	SearchProjects(ctx context.Context, userID int, query string, limit int) ([]*models.ProjectSearchResult, error)
+}
+
+type searchRepository struct {
+	db *gorm.DB
+}
+
+func NewSearchRepository(db *gorm.DB) SearchRepository {
+	return &searchRepository{
+		db: db,
+	}
+}
+
+// SearchTasks returns the best ranked tasks matching query among the tasks of
+// the projects the user is a member of and the tasks assigned to them.
+func (r *searchRepository) SearchTasks(ctx context.Context, userID int, query string, limit int) ([]*models.TaskSearchResult, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SearchRepository",
+		"method", "SearchTasks",
+		"user_id", userID,
+	)
+	logger.Debug("Starting search tasks process", "query", query, "limit", limit)
+
+	results := make([]*models.TaskSearchResult, 0)
+	err := r.db.WithContext(ctx).
+		Raw(sqlSearchTasks, map[string]any{
+			"query":   query,
+			"user_id": userID,
+			"limit":   limit,
+			"options": searchHeadlineOptions,
+		}).
+		Scan(&results).Error
+	if err != nil {
+		logger.Error("Failed to search tasks due to database error", "error", err)
+		return nil, fmt.Errorf("database error searching tasks: %w", structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully searched tasks", "count", len(results))
+	return results, nil
+}
+
+// SearchProjects returns the best ranked projects matching query among the
+// projects the user is a member of.
+func (r *searchRepository) SearchProjects(ctx context.Context, userID int, query string, limit int) ([]*models.ProjectSearchResult, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SearchRepository",
+		"method", "SearchProjects",
+		"user_id", userID,
+	)
+	logger.Debug("Starting search projects process", "query", query, "limit", limit)
+
+	results := make([]*models.ProjectSearchResult, 0)
+	err := r.db.WithContext(ctx).
+		Raw(sqlSearchProjects, map[string]any{
+			"query":   query,
+			"user_id": userID,
+			"limit":   limit,
+			"options": searchHeadlineOptions,
+		}).
+		Scan(&results).Error
+	if err != nil {
+		logger.Error("Failed to search projects due to database error", "error", err)
+		return nil, fmt.Errorf("database error searching projects: %w", structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully searched projects", "count", len(results))
+	return results, nil
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"lqkhoi-go-http-api/internal/config"
 	"lqkhoi-go-http-api/internal/dto"
@@ -14,12 +16,20 @@ import (
 	"gorm.io/gorm"
 )
 
+//go:generate mockgen -destination=./mocks/mock_sprint.go -package=mocks . SprintRepository
+
 type SprintRepository interface {
 	Create(ctx context.Context, sprint *models.Sprint) (*models.Sprint, error)
 	FindByID(ctx context.Context, id int) (*models.Sprint, error)
-	Find(ctx context.Context, filter *dto.SprintFilter) ([]*models.Sprint, error)
+	Find(ctx context.Context, filter *dto.SprintFilter, page *dto.PageRequest) ([]*models.Sprint, *dto.PageInfo, error)
 	Update(ctx context.Context, id int, updateMap map[string]any) error
 	Delete(ctx context.Context, id int) error
+	FindActiveByProjectID(ctx context.Context, projectID int) (*models.Sprint, error)
+	FindNextPlanned(ctx context.Context, projectID int, after time.Time) (*models.Sprint, error)
+	Start(ctx context.Context, sprintID int, startedAt time.Time, emails []*models.OutboundEmail) error
+	Complete(ctx context.Context, sprintID int, report *models.SprintReport, carriedTaskIDs []int) error
+	FindReportBySprintID(ctx context.Context, sprintID int) (*models.SprintReport, error)
+	FindClosedByProjectID(ctx context.Context, projectID int) ([]*models.Sprint, error)
 }
 
 type sprintRepository struct {
@@ -63,37 +73,37 @@ func NewSprintRepository(db *gorm.DB, cfg config.DateTimeConfig) SprintRepositor
 // 	return sprint, nil
 // }
 
-// func (r *sprintRepository) FindByID(ctx context.Context, id int) (*models.Sprint, error) {
-// 	baseLogger := utils.LoggerFromContext(ctx)
-// 	logger := baseLogger.With(
-// 		"component", "SprintRepository",
-// 		"method", "FindByID",
-// 		"sprint_id", id,
-// 	)
-// 	logger.Debug("Starting find sprint by ID process")
+func (r *sprintRepository) FindByID(ctx context.Context, id int) (*models.Sprint, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintRepository",
+		"method", "FindByID",
+		"sprint_id", id,
+	)
+	logger.Debug("Starting find sprint by ID process")
 
-// 	s := r.q.Sprint
-// 	sprint, err := s.WithContext(ctx).
-// 		Where(s.ID.Eq(id)).
-// 		Preload(s.Tasks).
-// 		Preload(s.Project).
-// 		First()
+	s := r.q.Sprint
+	sprint, err := s.WithContext(ctx).
+		Where(s.ID.Eq(id)).
+		Preload(s.Tasks).
+		Preload(s.Project).
+		First()
 
-// 	if err != nil {
-// 		if errors.Is(err, gorm.ErrRecordNotFound) {
-// 			logger.Warn("Sprint not found")
-// 			return nil, structs.ErrSprintNotExist
-// 		}
-// 		logger.Error("Failed to find sprint by ID due to database error", "error", err)
-// 		return nil, structs.ErrDatabaseFail
-// 	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Warn("Sprint not found")
+			return nil, structs.ErrSprintNotExist
+		}
+		logger.Error("Failed to find sprint by ID due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
 
-// 	logger.Info("Successfully found sprint by ID")
-// 	logger.Debug("Successfully retrieved sprint with associations", "sprintID", sprint.ID, "taskCount", len(sprint.Tasks), "projectName", sprint.Project.Name) // Example using preloaded data
-// 	return sprint, nil
-// }
+	logger.Info("Successfully found sprint by ID")
+	logger.Debug("Successfully retrieved sprint with associations", "sprintID", sprint.ID, "taskCount", len(sprint.Tasks), "projectName", sprint.Project.Name)
+	return sprint, nil
+}
 
-func (r *sprintRepository) Find(ctx context.Context, filter *dto.SprintFilter) ([]*models.Sprint, error) {
+func (r *sprintRepository) Find(ctx context.Context, filter *dto.SprintFilter, page *dto.PageRequest) ([]*models.Sprint, *dto.PageInfo, error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "SprintRepository",
@@ -102,7 +112,7 @@ func (r *sprintRepository) Find(ctx context.Context, filter *dto.SprintFilter) (
 	logger.Debug("Starting find sprints process", "filter", filter)
 
 	s := r.q.Sprint
-	sprintQuery := s.WithContext(ctx)
+	sprintQuery := s.WithContext(ctx).Preload(s.Tasks)
 
 	if filter.ID != nil {
 		logger.Debug("Applying filter: ID", "sprint_id", *filter.ID)
@@ -126,16 +136,16 @@ func (r *sprintRepository) Find(ctx context.Context, filter *dto.SprintFilter) (
 		sprintQuery = sprintQuery.Where(s.EndDate.Lte(*filter.EndDateBefore))
 	}
 
-	sprints, err := sprintQuery.Find()
+	sprints, pageInfo, err := findPage(ctx, r.db, sprintQuery, &r.q.Sprint, page)
 	if err != nil {
 		logger.Error("Error finding sprints", "error", err)
-		return nil, fmt.Errorf("database error retrieving sprints: %w", err)
+		return nil, nil, fmt.Errorf("database error retrieving sprints: %w", err)
 	}
 
-	logger.Info("Successfully found sprints", "count", len(sprints))
+	logger.Info("Successfully found sprints", "count", len(sprints), "total", pageInfo.Total)
 	logger.Debug("Found sprints details", "sprints", sprints)
 
-	return sprints, nil
+	return sprints, pageInfo, nil
 }
 
 // func (r *sprintRepository) Update(ctx context.Context, id int, updateMap map[string]any) error {
@@ -189,3 +199,206 @@ func (r *sprintRepository) Find(ctx context.Context, filter *dto.SprintFilter) (
 // 	logger.Info("Successfully deleted sprint", "rows_affected", resultInfo.RowsAffected)
 // 	return nil
 // }
+
+func (r *sprintRepository) FindActiveByProjectID(ctx context.Context, projectID int) (*models.Sprint, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintRepository",
+		"method", "FindActiveByProjectID",
+		"project_id", projectID,
+	)
+	logger.Debug("Starting find active sprint of project process")
+
+	s := r.q.Sprint
+	sprint, err := s.WithContext(ctx).
+		Where(s.ProjectID.Eq(projectID), s.Status.Eq(string(models.SprintActive))).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Debug("Project has no active sprint")
+			return nil, structs.ErrSprintNotExist
+		}
+		logger.Error("Failed to find active sprint due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully found active sprint", "sprint_id", sprint.ID)
+	return sprint, nil
+}
+
+// FindNextPlanned returns the planned sprint of the project that starts
+// first, not before after.
+func (r *sprintRepository) FindNextPlanned(ctx context.Context, projectID int, after time.Time) (*models.Sprint, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintRepository",
+		"method", "FindNextPlanned",
+		"project_id", projectID,
+	)
+	logger.Debug("Starting find next planned sprint process", "after", after)
+
+	s := r.q.Sprint
+	sprint, err := s.WithContext(ctx).
+		Where(s.ProjectID.Eq(projectID), s.Status.Eq(string(models.SprintPlanned)), s.StartDate.Gte(after)).
+		Order(s.StartDate, s.ID).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Debug("Project has no planned sprint")
+			return nil, structs.ErrSprintNotExist
+		}
+		logger.Error("Failed to find next planned sprint due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully found next planned sprint", "sprint_id", sprint.ID)
+	return sprint, nil
+}
+
+// Start marks a planned sprint as active. The update is conditional on the
+// sprint still being planned so concurrent starts cannot both succeed.
+func (r *sprintRepository) Start(ctx context.Context, sprintID int, startedAt time.Time, emails []*models.OutboundEmail) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintRepository",
+		"method", "Start",
+		"sprint_id", sprintID,
+	)
+	logger.Debug("Starting start sprint process")
+
+	err := r.q.Transaction(func(tx *query.Query) error {
+		s := tx.Sprint
+		resultInfo, err := s.WithContext(ctx).
+			Where(s.ID.Eq(sprintID), s.Status.Eq(string(models.SprintPlanned))).
+			UpdateSimple(s.Status.Value(string(models.SprintActive)), s.StartedAt.Value(startedAt))
+		if err != nil {
+			return err
+		}
+		if resultInfo.RowsAffected == 0 {
+			return structs.ErrSprintNotPlanned
+		}
+
+		return createOutboundEmails(ctx, tx, emails)
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			logger.Warn("Another sprint of the project is already active")
+			return structs.ErrActiveSprintExists
+		}
+		if errors.Is(err, structs.ErrSprintNotPlanned) {
+			logger.Warn("Start executed but the sprint is no longer planned")
+			return err
+		}
+		logger.Error("Failed to start sprint due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully started sprint", "email_count", len(emails))
+	return nil
+}
+
+// Complete closes an active sprint, moves the carried over tasks to the next
+// sprint of the report (or to the backlog when it has none) and stores the
+// report, all in one transaction.
+func (r *sprintRepository) Complete(ctx context.Context, sprintID int, report *models.SprintReport, carriedTaskIDs []int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintRepository",
+		"method", "Complete",
+		"sprint_id", sprintID,
+	)
+	logger.Debug("Starting complete sprint process", "carried_task_ids", carriedTaskIDs)
+
+	err := r.q.Transaction(func(tx *query.Query) error {
+		s := tx.Sprint
+		resultInfo, err := s.WithContext(ctx).
+			Where(s.ID.Eq(sprintID), s.Status.Eq(string(models.SprintActive))).
+			UpdateSimple(s.Status.Value(string(models.SprintClosed)), s.CompletedAt.Value(time.Now()))
+		if err != nil {
+			return err
+		}
+		if resultInfo.RowsAffected == 0 {
+			return structs.ErrSprintNotActive
+		}
+
+		if len(carriedTaskIDs) > 0 {
+			var value any
+			if report.NextSprintID != nil {
+				value = *report.NextSprintID
+			}
+			t := tx.Task
+			if _, err := t.WithContext(ctx).Where(t.ID.In(carriedTaskIDs...)).Update(t.SprintID, value); err != nil {
+				return err
+			}
+		}
+
+		report.SprintID = sprintID
+		return tx.SprintReport.WithContext(ctx).Create(report)
+	})
+	if err != nil {
+		if errors.Is(err, structs.ErrSprintNotActive) {
+			logger.Warn("Complete executed but the sprint is no longer active")
+			return err
+		}
+		logger.Error("Failed to complete sprint due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully completed sprint", "report_id", report.ID)
+	return nil
+}
+
+func (r *sprintRepository) FindReportBySprintID(ctx context.Context, sprintID int) (*models.SprintReport, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintRepository",
+		"method", "FindReportBySprintID",
+		"sprint_id", sprintID,
+	)
+	logger.Debug("Starting find sprint report process")
+
+	sr := r.q.SprintReport
+	report, err := sr.WithContext(ctx).
+		Where(sr.SprintID.Eq(sprintID)).
+		Preload(sr.CompletedBy).
+		Preload(sr.Tasks).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Warn("Sprint report not found")
+			return nil, structs.ErrSprintReportNotExist
+		}
+		logger.Error("Failed to find sprint report due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully found sprint report", "report_id", report.ID)
+	return report, nil
+}
+
+// FindClosedByProjectID returns the closed sprints of the project in the
+// order they were completed, each with its report and report tasks.
+func (r *sprintRepository) FindClosedByProjectID(ctx context.Context, projectID int) ([]*models.Sprint, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintRepository",
+		"method", "FindClosedByProjectID",
+		"project_id", projectID,
+	)
+	logger.Debug("Starting find closed sprints of project process")
+
+	s := r.q.Sprint
+	sprints, err := s.WithContext(ctx).
+		Where(s.ProjectID.Eq(projectID), s.Status.Eq(string(models.SprintClosed))).
+		Preload(s.Report).
+		Preload(s.Report.Tasks).
+		Order(s.CompletedAt, s.ID).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find closed sprints due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding closed sprints of project %d: %w", projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found closed sprints", "count", len(sprints))
+	return sprints, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"lqkhoi-go-http-api/internal/config"
 	"lqkhoi-go-http-api/internal/dto"
@@ -12,20 +13,37 @@ import (
 	"lqkhoi-go-http-api/pkg/structs"
 	"lqkhoi-go-http-api/pkg/utils"
 
+	"gorm.io/gen/field"
 	"gorm.io/gorm"
 )
 
+//go:generate mockgen -destination=./mocks/mock_task.go -package=mocks . TaskRepository
+
 type TaskRepository interface {
 	Create(ctx context.Context, task *models.Task) (*models.Task, error)
-	AssignTaskToUser(ctx context.Context, userID, taskID int) error
-	Find(ctx context.Context, filter *dto.TaskFilter) ([]*models.Task, error)
+	AssignTaskToUser(ctx context.Context, userID, taskID int, emails []*models.OutboundEmail) error
+	Find(ctx context.Context, filter *dto.TaskFilter, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error)
 	FindByID(ctx context.Context, id int) (*models.Task, error)
 	Update(ctx context.Context, id int, updateMap map[string]any) error
-	FindTasksByProjectID(ctx context.Context, projectID int) ([]*models.Task, error)
-	FindTaskByUserID(ctx context.Context, userID int) ([]*models.Task, error)
+	FindTasksByProjectID(ctx context.Context, projectID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error)
+	FindTaskByUserID(ctx context.Context, userID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error)
+	FindBacklogByProjectID(ctx context.Context, projectID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error)
+	FindByParentIDs(ctx context.Context, parentIDs []int) ([]*models.Task, error)
+	FindByIDs(ctx context.Context, ids []int) ([]*models.Task, error)
+	FindAssignedDueBetween(ctx context.Context, from, to time.Time) ([]*models.Task, error)
+	FindBySprintIDInRankOrder(ctx context.Context, sprintID int) ([]*models.Task, error)
+	FindBoardColumn(ctx context.Context, projectID int, sprintID *int, status models.TaskStatus) ([]*models.Task, error)
+	MoveOnBoard(ctx context.Context, id int, status models.TaskStatus, rank string, reranked map[int]string) error
+	UpdateSprintByIDs(ctx context.Context, ids []int, sprintID *int) error
+	CountOpenSubtasks(ctx context.Context, parentID int) (int64, error)
+	CountInBoardColumn(ctx context.Context, projectID int, sprintID *int, status models.TaskStatus, assigneeID *int, excludeID int) (int64, error)
 	Delete(ctx context.Context, id int) error
+	DeleteByIDs(ctx context.Context, ids []int) error
 }
 
+// taskLabels loads the labels attached to a task through its TaskLabels.
+var taskLabels = field.NewRelation("TaskLabels.Label", "models.Label")
+
 type taskRepository struct {
 	db  *gorm.DB
 	cfg config.DateTimeConfig
@@ -67,37 +85,37 @@ func NewTaskRepository(db *gorm.DB, cfg config.DateTimeConfig) TaskRepository {
 // 	return task, nil
 // }
 
-// func (r *taskRepository) FindByID(ctx context.Context, id int) (*models.Task, error) {
-// 	baseLogger := utils.LoggerFromContext(ctx)
-// 	logger := baseLogger.With(
-// 		"component", "TaskRepository",
-// 		"method", "FindByID",
-// 		"task_id", id,
-// 	)
-// 	logger.Debug("Starting find task by ID process")
+func (r *taskRepository) FindByID(ctx context.Context, id int) (*models.Task, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskRepository",
+		"method", "FindByID",
+		"task_id", id,
+	)
+	logger.Debug("Starting find task by ID process")
 
-// 	s := r.q.Task
-// 	task, err := s.WithContext(ctx).
-// 		Where(s.ID.Eq(id)).
-// 		Preload(s.Assignee).
-// 		Preload(s.Project).
-// 		Preload(s.Sprint).
-// 		First()
-// 	if err != nil {
-// 		if errors.Is(err, gorm.ErrRecordNotFound) {
-// 			logger.Warn("Task not found")
-// 			return nil, structs.ErrTaskNotExist
-// 		}
-// 		logger.Error("Failed to find task by ID due to database error", "error", err)
-// 		return nil, structs.ErrDatabaseFail
-// 	}
+	s := r.q.Task
+	task, err := s.WithContext(ctx).
+		Where(s.ID.Eq(id)).
+		Preload(s.Assignee).
+		Preload(s.Project).
+		Preload(s.Sprint).
+		Preload(taskLabels).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Warn("Task not found")
+			return nil, structs.ErrTaskNotExist
+		}
+		logger.Error("Failed to find task by ID due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
 
-// 	logger.Info("Successfully found task by ID")
-// 	logger.Debug("Successfully retrieved task with associations", "task", task.ID, "sprintName", task.Sprint.Name, "projectName", task.Project.Name) // Example using preloaded data
-// 	return task, nil
-// }
+	logger.Info("Successfully found task by ID")
+	return task, nil
+}
 
-func (r *taskRepository) FindTasksByProjectID(ctx context.Context, projectID int) ([]*models.Task, error) {
+func (r *taskRepository) FindTasksByProjectID(ctx context.Context, projectID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "TaskRepository",
@@ -109,19 +127,45 @@ func (r *taskRepository) FindTasksByProjectID(ctx context.Context, projectID int
 
 	taskQuery := t.WithContext(ctx).
 		Where(t.ProjectID.Eq(projectID)).
-		Preload(t.Assignee)
+		Preload(t.Assignee).
+		Preload(taskLabels)
 
-	tasks, err := taskQuery.Find()
+	tasks, pageInfo, err := findPage(ctx, r.db, taskQuery, &r.q.Task, page)
 	if err != nil {
 		logger.Error("Failed to find tasks by project ID due to database error", "error", err)
-		return nil, fmt.Errorf("database error finding tasks for project %d: %w", projectID, structs.ErrDatabaseFail)
+		return nil, nil, fmt.Errorf("database error finding tasks for project %d: %w", projectID, structs.ErrDatabaseFail)
 	}
 
-	logger.Info("Successfully found tasks for project", "count", len(tasks))
-	return tasks, nil
+	logger.Info("Successfully found tasks for project", "count", len(tasks), "total", pageInfo.Total)
+	return tasks, pageInfo, nil
 }
 
-func (r *taskRepository) FindTaskByUserID(ctx context.Context, userID int) ([]*models.Task, error) {
+func (r *taskRepository) FindBacklogByProjectID(ctx context.Context, projectID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskRepository",
+		"method", "FindBacklogByProjectID",
+		"project_id", projectID,
+	)
+	logger.Debug("Starting find backlog tasks by project ID process")
+	t := r.q.Task
+
+	taskQuery := t.WithContext(ctx).
+		Where(t.ProjectID.Eq(projectID), t.SprintID.IsNull()).
+		Preload(t.Assignee).
+		Preload(taskLabels)
+
+	tasks, pageInfo, err := findPage(ctx, r.db, taskQuery, &r.q.Task, page)
+	if err != nil {
+		logger.Error("Failed to find backlog tasks due to database error", "error", err)
+		return nil, nil, fmt.Errorf("database error finding backlog for project %d: %w", projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found backlog tasks for project", "count", len(tasks), "total", pageInfo.Total)
+	return tasks, pageInfo, nil
+}
+
+func (r *taskRepository) FindTaskByUserID(ctx context.Context, userID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "TaskRepository",
@@ -132,18 +176,329 @@ func (r *taskRepository) FindTaskByUserID(ctx context.Context, userID int) ([]*m
 	t := r.q.Task
 	taskQuery := t.WithContext(ctx).
 		Where(t.AssigneeID.Eq(userID)).
-		Preload(t.Assignee)
-	tasks, err := taskQuery.Find()
+		Preload(t.Assignee).
+		Preload(taskLabels)
+	tasks, pageInfo, err := findPage(ctx, r.db, taskQuery, &r.q.Task, page)
 	if err != nil {
 		logger.Error("Failed to find tasks by user ID due to database error", "error", err)
-		return nil, fmt.Errorf("database error finding tasks for user %d: %w", userID, structs.ErrDatabaseFail)
+		return nil, nil, fmt.Errorf("database error finding tasks for user %d: %w", userID, structs.ErrDatabaseFail)
 	}
 
-	logger.Info("Successfully found tasks for user", "count", len(tasks))
+	logger.Info("Successfully found tasks for user", "count", len(tasks), "total", pageInfo.Total)
+	return tasks, pageInfo, nil
+}
+
+func (r *taskRepository) FindByIDs(ctx context.Context, ids []int) ([]*models.Task, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskRepository",
+		"method", "FindByIDs",
+	)
+	logger.Debug("Starting find tasks by IDs process", "task_count", len(ids))
+
+	if len(ids) == 0 {
+		logger.Debug("No task IDs provided, returning empty list")
+		return []*models.Task{}, nil
+	}
+
+	t := r.q.Task
+	tasks, err := t.WithContext(ctx).
+		Where(t.ID.In(ids...)).
+		Order(t.ID).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find tasks due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding tasks: %w", structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found tasks", "count", len(tasks))
+	return tasks, nil
+}
+
+// FindAssignedDueBetween returns the assigned tasks not done yet whose due
+// date is in [from, to).
+func (r *taskRepository) FindAssignedDueBetween(ctx context.Context, from, to time.Time) ([]*models.Task, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskRepository",
+		"method", "FindAssignedDueBetween",
+		"from", from,
+		"to", to,
+	)
+	logger.Debug("Starting find assigned tasks due between process")
+
+	t := r.q.Task
+	tasks, err := t.WithContext(ctx).
+		Where(
+			t.AssigneeID.IsNotNull(),
+			t.Status.Neq(string(models.DoneTask)),
+			t.DueDate.Gte(from),
+			t.DueDate.Lt(to),
+		).
+		Order(t.DueDate, t.ID).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find tasks due between due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding tasks due between %s and %s: %w", from, to, structs.ErrDatabaseFail)
+	}
+
+	logger.Debug("Successfully found assigned tasks due between", "count", len(tasks))
+	return tasks, nil
+}
+
+// FindBySprintIDInRankOrder returns the tasks of the sprint ordered by rank,
+// then by ID for tasks ranked alike.
+func (r *taskRepository) FindBySprintIDInRankOrder(ctx context.Context, sprintID int) ([]*models.Task, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskRepository",
+		"method", "FindBySprintIDInRankOrder",
+		"sprint_id", sprintID,
+	)
+	logger.Debug("Starting find tasks of sprint in rank order process")
+
+	t := r.q.Task
+	tasks, err := t.WithContext(ctx).
+		Where(t.SprintID.Eq(sprintID)).
+		Preload(t.Assignee).
+		Preload(taskLabels).
+		Order(t.Rank, t.ID).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find tasks of sprint due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding tasks of sprint %d: %w", sprintID, structs.ErrDatabaseFail)
+	}
+
+	logger.Debug("Successfully found tasks of sprint", "count", len(tasks))
 	return tasks, nil
 }
 
-func (r *taskRepository) Find(ctx context.Context, filter *dto.TaskFilter) ([]*models.Task, error) {
+// FindBoardColumn returns the tasks of a board column in rank order: the
+// tasks with the status in the sprint, or in the backlog of the project when
+// sprintID is nil.
+func (r *taskRepository) FindBoardColumn(ctx context.Context, projectID int, sprintID *int, status models.TaskStatus) ([]*models.Task, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskRepository",
+		"method", "FindBoardColumn",
+		"project_id", projectID,
+		"sprint_id", sprintID,
+		"status", status,
+	)
+
+	t := r.q.Task
+	columnQuery := t.WithContext(ctx).Where(t.ProjectID.Eq(projectID), t.Status.Eq(string(status)))
+	if sprintID != nil {
+		columnQuery = columnQuery.Where(t.SprintID.Eq(*sprintID))
+	} else {
+		columnQuery = columnQuery.Where(t.SprintID.IsNull())
+	}
+	tasks, err := columnQuery.Order(t.Rank, t.ID).Find()
+	if err != nil {
+		logger.Error("Failed to find board column due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding %s tasks of project %d: %w", status, projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Debug("Successfully found board column", "count", len(tasks))
+	return tasks, nil
+}
+
+// MoveOnBoard sets the status and rank of the task and the new ranks of the
+// reranked tasks, all in one transaction.
+func (r *taskRepository) MoveOnBoard(ctx context.Context, id int, status models.TaskStatus, rank string, reranked map[int]string) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskRepository",
+		"method", "MoveOnBoard",
+		"task_id", id,
+	)
+	logger.Debug("Starting move task on board process", "status", status, "rank", rank, "reranked", len(reranked))
+
+	err := r.q.Transaction(func(tx *query.Query) error {
+		t := tx.Task
+		resultInfo, err := t.WithContext(ctx).
+			Where(t.ID.Eq(id)).
+			UpdateSimple(t.Status.Value(string(status)), t.Rank.Value(rank))
+		if err != nil {
+			return err
+		}
+		if resultInfo.RowsAffected == 0 {
+			return structs.ErrTaskNotExist
+		}
+
+		for taskID, taskRank := range reranked {
+			if _, err := t.WithContext(ctx).Where(t.ID.Eq(taskID)).Update(t.Rank, taskRank); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, structs.ErrTaskNotExist) {
+			logger.Warn("Move executed but the task no longer exists")
+			return err
+		}
+		logger.Error("Failed to move task on board due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully moved task on board")
+	return nil
+}
+
+func (r *taskRepository) FindByParentIDs(ctx context.Context, parentIDs []int) ([]*models.Task, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskRepository",
+		"method", "FindByParentIDs",
+		"parent_ids", parentIDs,
+	)
+	logger.Debug("Starting find subtasks by parent IDs process")
+
+	if len(parentIDs) == 0 {
+		logger.Debug("No parent IDs provided, returning empty list")
+		return []*models.Task{}, nil
+	}
+
+	t := r.q.Task
+	tasks, err := t.WithContext(ctx).
+		Where(t.ParentTaskID.In(parentIDs...)).
+		Preload(t.Assignee).
+		Preload(taskLabels).
+		Order(t.ID).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find subtasks due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding subtasks: %w", structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found subtasks", "count", len(tasks))
+	return tasks, nil
+}
+
+func (r *taskRepository) UpdateSprintByIDs(ctx context.Context, ids []int, sprintID *int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskRepository",
+		"method", "UpdateSprintByIDs",
+		"task_ids", ids,
+	)
+	logger.Debug("Starting update sprint of tasks process", "sprint_id", sprintID)
+
+	if len(ids) == 0 {
+		logger.Debug("No task IDs provided, skipping database call")
+		return nil
+	}
+
+	var value any
+	if sprintID != nil {
+		value = *sprintID
+	}
+
+	t := r.q.Task
+	resultInfo, err := t.WithContext(ctx).Where(t.ID.In(ids...)).Update(t.SprintID, value)
+	if err != nil {
+		logger.Error("Failed to update sprint of tasks due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	if resultInfo.RowsAffected == 0 {
+		logger.Warn("Update executed but no task found with the given IDs")
+		return structs.ErrTaskNotExist
+	}
+
+	logger.Info("Successfully updated sprint of tasks", "rows_affected", resultInfo.RowsAffected)
+	return nil
+}
+
+func (r *taskRepository) CountOpenSubtasks(ctx context.Context, parentID int) (int64, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskRepository",
+		"method", "CountOpenSubtasks",
+		"parent_id", parentID,
+	)
+	logger.Debug("Starting count open subtasks process")
+
+	t := r.q.Task
+	count, err := t.WithContext(ctx).
+		Where(t.ParentTaskID.Eq(parentID), t.Status.Neq(string(models.DoneTask))).
+		Count()
+	if err != nil {
+		logger.Error("Failed to count open subtasks due to database error", "error", err)
+		return 0, fmt.Errorf("database error counting subtasks of task %d: %w", parentID, structs.ErrDatabaseFail)
+	}
+
+	logger.Debug("Counted open subtasks", "count", count)
+	return count, nil
+}
+
+// CountInBoardColumn counts the tasks of a board column, as FindBoardColumn
+// returns them, other than the task excludeID; only those assigned to
+// assigneeID when it is not nil.
+func (r *taskRepository) CountInBoardColumn(ctx context.Context, projectID int, sprintID *int, status models.TaskStatus, assigneeID *int, excludeID int) (int64, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskRepository",
+		"method", "CountInBoardColumn",
+		"project_id", projectID,
+		"sprint_id", sprintID,
+		"status", status,
+		"assignee_id", assigneeID,
+	)
+	logger.Debug("Starting count tasks in board column process")
+
+	t := r.q.Task
+	columnQuery := t.WithContext(ctx).Where(t.ProjectID.Eq(projectID), t.Status.Eq(string(status)), t.ID.Neq(excludeID))
+	if sprintID != nil {
+		columnQuery = columnQuery.Where(t.SprintID.Eq(*sprintID))
+	} else {
+		columnQuery = columnQuery.Where(t.SprintID.IsNull())
+	}
+	if assigneeID != nil {
+		columnQuery = columnQuery.Where(t.AssigneeID.Eq(*assigneeID))
+	}
+	count, err := columnQuery.Count()
+	if err != nil {
+		logger.Error("Failed to count tasks in board column due to database error", "error", err)
+		return 0, fmt.Errorf("database error counting %s tasks of project %d: %w", status, projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Debug("Counted tasks in board column", "count", count)
+	return count, nil
+}
+
+func (r *taskRepository) DeleteByIDs(ctx context.Context, ids []int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskRepository",
+		"method", "DeleteByIDs",
+		"task_ids", ids,
+	)
+	logger.Debug("Starting delete tasks by IDs process")
+
+	if len(ids) == 0 {
+		logger.Debug("No task IDs provided, skipping database call")
+		return nil
+	}
+
+	t := r.q.Task
+	resultInfo, err := t.WithContext(ctx).Where(t.ID.In(ids...)).Delete()
+	if err != nil {
+		logger.Error("Failed to delete tasks due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	if resultInfo.RowsAffected == 0 {
+		logger.Warn("Delete executed but no task found with the given IDs")
+		return structs.ErrTaskNotExist
+	}
+
+	logger.Info("Successfully deleted tasks", "rows_affected", resultInfo.RowsAffected)
+	return nil
+}
+
+func (r *taskRepository) Find(ctx context.Context, filter *dto.TaskFilter, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "TaskRepository",
@@ -152,7 +507,7 @@ func (r *taskRepository) Find(ctx context.Context, filter *dto.TaskFilter) ([]*m
 	logger.Debug("Starting find tasks process", "filter", filter)
 
 	t := r.q.Task
-	taskQuery := t.WithContext(ctx)
+	taskQuery := t.WithContext(ctx).Preload(taskLabels)
 
 	if filter.ID != nil {
 		logger.Debug("Applying filter: ID", "task_id", *filter.ID)
@@ -175,18 +530,53 @@ func (r *taskRepository) Find(ctx context.Context, filter *dto.TaskFilter) ([]*m
 		logger.Debug("Applying filter: DueDateBefore", "due_date", filter.DueDateBefore)
 		taskQuery = taskQuery.Where(t.DueDate.Lte(*filter.DueDateBefore))
 	}
+	if filter.StoryPointsMin != nil {
+		logger.Debug("Applying filter: StoryPointsMin", "story_points_min", *filter.StoryPointsMin)
+		taskQuery = taskQuery.Where(t.StoryPoints.Gte(*filter.StoryPointsMin))
+	}
+	if filter.StoryPointsMax != nil {
+		logger.Debug("Applying filter: StoryPointsMax", "story_points_max", *filter.StoryPointsMax)
+		taskQuery = taskQuery.Where(t.StoryPoints.Lte(*filter.StoryPointsMax))
+	}
+	if filter.Estimated != nil {
+		logger.Debug("Applying filter: Estimated", "estimated", *filter.Estimated)
+		if *filter.Estimated {
+			taskQuery = taskQuery.Where(t.StoryPoints.IsNotNull())
+		} else {
+			taskQuery = taskQuery.Where(t.StoryPoints.IsNull())
+		}
+	}
+	if len(filter.LabelIDs) > 0 {
+		logger.Debug("Applying filter: Labels", "label_ids", filter.LabelIDs, "match_all", filter.LabelMatchAll)
+		tl := r.q.TaskLabel
+		labeled := tl.WithContext(ctx).Select(tl.TaskID).Where(tl.LabelID.In(filter.LabelIDs...))
+		if filter.LabelMatchAll {
+			labeled = labeled.Group(tl.TaskID).Having(tl.LabelID.Distinct().Count().Eq(len(filter.LabelIDs)))
+		}
+		taskQuery = taskQuery.Where(t.Columns(t.ID).In(labeled))
+	}
+	if filter.MemberUserID != nil {
+		logger.Debug("Applying filter: MemberUserID", "member_user_id", *filter.MemberUserID)
+		pm := r.q.ProjectMember
+		memberProjects := pm.WithContext(ctx).Select(pm.ProjectID).Where(pm.UserID.Eq(*filter.MemberUserID))
+		taskQuery = taskQuery.Where(t.Columns(t.ProjectID).In(memberProjects))
+	}
+	if filter.Expression != nil {
+		logger.Debug("Applying filter: Expression", "expression", filter.Expression)
+		taskQuery = taskQuery.Where(r.expressionCondition(ctx, filter.Expression, filter.CurrentUserID))
+	}
 
-	tasks,err := taskQuery.Find()
+	tasks, pageInfo, err := findPage(ctx, r.db, taskQuery, &r.q.Task, page)
 	if err != nil {
 		logger.Error("Error finding tasks", "error", err)
-		return nil, fmt.Errorf("database error retrieving tasks: %w", err)
+		return nil, nil, fmt.Errorf("database error retrieving tasks: %w", err)
 	}
-	logger.Info("Successfully found tasks", "count", len(tasks))
+	logger.Info("Successfully found tasks", "count", len(tasks), "total", pageInfo.Total)
 	logger.Debug("Found tasks details", "tasks", tasks)
-	return tasks, nil
+	return tasks, pageInfo, nil
 }
 
-func (r *taskRepository) AssignTaskToUser(ctx context.Context, userID, taskID int) error {
+func (r *taskRepository) AssignTaskToUser(ctx context.Context, userID, taskID int, emails []*models.OutboundEmail) error {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "TaskRepository",
@@ -195,24 +585,32 @@ func (r *taskRepository) AssignTaskToUser(ctx context.Context, userID, taskID in
 		"task_id", taskID,
 	)
 	logger.Debug("Starting assign task to user process")
-	s := r.q.Task
-	task, err := s.WithContext(ctx).Where(s.ID.Eq(taskID)).First()
+	var rowsAffected int64
+	err := r.q.Transaction(func(tx *query.Query) error {
+		s := tx.Task
+		task, err := s.WithContext(ctx).Where(s.ID.Eq(taskID)).First()
+		if err != nil {
+			return err
+		}
+		task.AssigneeID = &userID
+		resultInfo, err := s.WithContext(ctx).Where(s.ID.Eq(taskID)).Updates(task)
+		if err != nil {
+			return err
+		}
+		rowsAffected = resultInfo.RowsAffected
+
+		return createOutboundEmails(ctx, tx, emails)
+	})
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			logger.Warn("Task not found")
 			return structs.ErrTaskNotExist
 		}
-		logger.Error("Failed to find task by ID due to database error", "error", err)
-		return structs.ErrDatabaseFail
-	}
-	task.AssigneeID = &userID
-	resultInfo, err := s.WithContext(ctx).Where(s.ID.Eq(taskID)).Updates(task)
-	if err != nil {
 		logger.Error("Failed to assign task to user due to database error", "error", err)
 		return structs.ErrDatabaseFail
 	}
 
-	logger.Info("Successfully updated sprint", "rows_affected", resultInfo.RowsAffected)
+	logger.Info("Successfully updated sprint", "rows_affected", rowsAffected)
 	return nil
 }
 
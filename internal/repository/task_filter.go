@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"lqkhoi-go-http-api/internal/filter"
+	"lqkhoi-go-http-api/internal/models"
+
+	"gorm.io/gen/field"
+)
+
+// likeEscaper escapes the wildcards of LIKE patterns with backslash, the
+// default escape character of Postgres.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// expressionCondition turns a parsed filter expression into a condition on
+// tasks. userID is the requesting user, who "me" stands for.
+func (r *taskRepository) expressionCondition(ctx context.Context, expr filter.Expr, userID int) field.Expr {
+	switch e := expr.(type) {
+	case filter.And:
+		conds := make([]field.Expr, len(e.Exprs))
+		for i, sub := range e.Exprs {
+			conds[i] = r.expressionCondition(ctx, sub, userID)
+		}
+		return field.And(conds...)
+	case filter.Or:
+		conds := make([]field.Expr, len(e.Exprs))
+		for i, sub := range e.Exprs {
+			conds[i] = r.expressionCondition(ctx, sub, userID)
+		}
+		return field.Or(conds...)
+	case filter.Not:
+		return field.Not(r.expressionCondition(ctx, e.Expr, userID))
+	case filter.Comparison:
+		return r.comparisonCondition(ctx, e, userID)
+	}
+	return field.EmptyExpr()
+}
+
+func (r *taskRepository) comparisonCondition(ctx context.Context, c filter.Comparison, userID int) field.Expr {
+	t := r.q.Task
+	switch c.Field {
+	case filter.FieldID:
+		return intCondition(t.ID, c.Op, intValues(c.Values, userID), false)
+	case filter.FieldProject:
+		return intCondition(t.ProjectID, c.Op, intValues(c.Values, userID), false)
+	case filter.FieldAssignee:
+		return intCondition(t.AssigneeID, c.Op, intValues(c.Values, userID), true)
+	case filter.FieldSprint:
+		return intCondition(t.SprintID, c.Op, intValues(c.Values, userID), true)
+	case filter.FieldParent:
+		return intCondition(t.ParentTaskID, c.Op, intValues(c.Values, userID), true)
+	case filter.FieldStoryPoints:
+		return intCondition(t.StoryPoints, c.Op, intValues(c.Values, userID), true)
+	case filter.FieldTitle:
+		return textCondition(t.Title, c.Op, c.Values[0].Text)
+	case filter.FieldDescription:
+		return textCondition(t.Description, c.Op, c.Values[0].Text)
+	case filter.FieldStatus:
+		return stringCondition(t.Status, c.Op, textValues(c.Values))
+	case filter.FieldPriority:
+		return priorityCondition(t.Priority, c.Op, textValues(c.Values))
+	case filter.FieldDue:
+		return dateCondition(t.DueDate, c.Op, c.Values, true)
+	case filter.FieldCreated:
+		return dateCondition(t.CreatedAt, c.Op, c.Values, false)
+	case filter.FieldUpdated:
+		return dateCondition(t.UpdatedAt, c.Op, c.Values, false)
+	case filter.FieldLabel:
+		return r.labelCondition(ctx, c.Op, intValues(c.Values, userID))
+	}
+	return field.EmptyExpr()
+}
+
+func intValues(values []filter.Value, userID int) []int {
+	ints := make([]int, len(values))
+	for i, value := range values {
+		ints[i] = value.Int
+		if value.Me {
+			ints[i] = userID
+		}
+	}
+	return ints
+}
+
+func textValues(values []filter.Value) []string {
+	texts := make([]string, len(values))
+	for i, value := range values {
+		texts[i] = value.Text
+	}
+	return texts
+}
+
+// orNull widens the negative comparisons of nullable columns to the rows
+// without a value, e.g. assignee != me also matches unassigned tasks.
+func orNull(cond field.Expr, isNull field.Expr, nullable bool) field.Expr {
+	if !nullable {
+		return cond
+	}
+	return field.Or(cond, isNull)
+}
+
+func intCondition(column field.Int, op filter.Operator, values []int, nullable bool) field.Expr {
+	switch op {
+	case filter.OpEq:
+		return column.Eq(values[0])
+	case filter.OpNe:
+		return orNull(column.Neq(values[0]), column.IsNull(), nullable)
+	case filter.OpLt:
+		return column.Lt(values[0])
+	case filter.OpLte:
+		return column.Lte(values[0])
+	case filter.OpGt:
+		return column.Gt(values[0])
+	case filter.OpGte:
+		return column.Gte(values[0])
+	case filter.OpIn:
+		return column.In(values...)
+	case filter.OpNotIn:
+		return orNull(column.NotIn(values...), column.IsNull(), nullable)
+	case filter.OpEmpty:
+		return column.IsNull()
+	case filter.OpNotEmpty:
+		return column.IsNotNull()
+	}
+	return field.EmptyExpr()
+}
+
+func stringCondition(column field.String, op filter.Operator, values []string) field.Expr {
+	switch op {
+	case filter.OpEq:
+		return column.Eq(values[0])
+	case filter.OpNe:
+		return column.Neq(values[0])
+	case filter.OpIn:
+		return column.In(values...)
+	case filter.OpNotIn:
+		return column.NotIn(values...)
+	}
+	return field.EmptyExpr()
+}
+
+// textCondition compares free text; ~ matches a case insensitive substring.
+func textCondition(column field.String, op filter.Operator, value string) field.Expr {
+	if op == filter.OpContains {
+		pattern := "%" + likeEscaper.Replace(strings.ToLower(value)) + "%"
+		return column.Lower().Like(pattern)
+	}
+	return stringCondition(column, op, []string{value})
+}
+
+// priorityCondition compares priorities by their order in
+// models.TaskPriorities, e.g. >= HIGH matches HIGH and CRITICAL.
+func priorityCondition(column field.String, op filter.Operator, values []string) field.Expr {
+	if op == filter.OpEq || op == filter.OpNe || op == filter.OpIn || op == filter.OpNotIn {
+		return stringCondition(column, op, values)
+	}
+
+	pivot := slices.Index(models.TaskPriorities, models.TaskPriority(values[0]))
+	matching := make([]string, 0, len(models.TaskPriorities))
+	for i, priority := range models.TaskPriorities {
+		if (op == filter.OpLt && i < pivot) || (op == filter.OpLte && i <= pivot) ||
+			(op == filter.OpGt && i > pivot) || (op == filter.OpGte && i >= pivot) {
+			matching = append(matching, string(priority))
+		}
+	}
+	if len(matching) == 0 {
+		return field.Not(field.Or(column.IsNotNull(), column.IsNull()))
+	}
+	return column.In(matching...)
+}
+
+// dateCondition compares the day of a timestamp: = matches the whole day and
+// < the days before it.
+func dateCondition(column field.Time, op filter.Operator, values []filter.Value, nullable bool) field.Expr {
+	if op == filter.OpEmpty {
+		return column.IsNull()
+	}
+	if op == filter.OpNotEmpty {
+		return column.IsNotNull()
+	}
+
+	start := values[0].Date
+	end := start.Add(24 * time.Hour)
+	switch op {
+	case filter.OpEq:
+		return field.And(column.Gte(start), column.Lt(end))
+	case filter.OpNe:
+		return orNull(field.Or(column.Lt(start), column.Gte(end)), column.IsNull(), nullable)
+	case filter.OpLt:
+		return column.Lt(start)
+	case filter.OpLte:
+		return column.Lt(end)
+	case filter.OpGt:
+		return column.Gte(end)
+	case filter.OpGte:
+		return column.Gte(start)
+	}
+	return field.EmptyExpr()
+}
+
+// labelCondition matches tasks by their labels: = and in match tasks with
+// any of the labels, != and not in tasks with none of them, and is empty
+// tasks without labels.
+func (r *taskRepository) labelCondition(ctx context.Context, op filter.Operator, labelIDs []int) field.Expr {
+	t := r.q.Task
+	tl := r.q.TaskLabel
+	labeled := tl.WithContext(ctx).Select(tl.TaskID)
+	if len(labelIDs) > 0 {
+		labeled = labeled.Where(tl.LabelID.In(labelIDs...))
+	}
+
+	switch op {
+	case filter.OpEq, filter.OpIn, filter.OpNotEmpty:
+		return t.Columns(t.ID).In(labeled)
+	case filter.OpNe, filter.OpNotIn, filter.OpEmpty:
+		return t.Columns(t.ID).NotIn(labeled)
+	}
+	return field.EmptyExpr()
+}
@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/query"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+type TaskLinkRepository interface {
+	Create(ctx context.Context, link *models.TaskLink) (*models.TaskLink, error)
+	FindByID(ctx context.Context, id int) (*models.TaskLink, error)
+	FindByTaskID(ctx context.Context, taskID int) ([]*models.TaskLink, error)
+	ExistsBetween(ctx context.Context, taskA, taskB int, linkType models.TaskLinkType) (bool, error)
+	FindBlockedTaskIDs(ctx context.Context, sourceIDs []int) ([]int, error)
+	FindOpenBlockers(ctx context.Context, taskID int) ([]*models.Task, error)
+	Delete(ctx context.Context, id int) error
+	DeleteByTaskIDs(ctx context.Context, taskIDs []int) error
+}
+
+type taskLinkRepository struct {
+	db *gorm.DB
+	q  *query.Query
+	*GenericRepository[*models.TaskLink, int]
+}
+
+func NewTaskLinkRepository(db *gorm.DB) TaskLinkRepository {
+	genericRepo := NewGenericRepository[*models.TaskLink, int](
+		db,
+		"TaskLink",
+		structs.ErrTaskLinkNotExist,
+	)
+
+	return &taskLinkRepository{
+		db:                db,
+		q:                 query.Use(db),
+		GenericRepository: genericRepo,
+	}
+}
+
+func (r *taskLinkRepository) FindByID(ctx context.Context, id int) (*models.TaskLink, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskLinkRepository",
+		"method", "FindByID",
+		"link_id", id,
+	)
+	logger.Debug("Starting find task link by ID process")
+
+	l := r.q.TaskLink
+	link, err := l.WithContext(ctx).
+		Where(l.ID.Eq(id)).
+		Preload(l.SourceTask).
+		Preload(l.TargetTask).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Warn("Task link not found")
+			return nil, structs.ErrTaskLinkNotExist
+		}
+		logger.Error("Failed to find task link by ID due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully found task link by ID")
+	return link, nil
+}
+
+// FindByTaskID returns the links the task is the source or the target of,
+// with both of their tasks.
+func (r *taskLinkRepository) FindByTaskID(ctx context.Context, taskID int) ([]*models.TaskLink, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskLinkRepository",
+		"method", "FindByTaskID",
+		"task_id", taskID,
+	)
+	logger.Debug("Starting find links of task process")
+
+	l := r.q.TaskLink
+	links, err := l.WithContext(ctx).
+		Where(l.SourceTaskID.Eq(taskID)).
+		Or(l.TargetTaskID.Eq(taskID)).
+		Preload(l.SourceTask).
+		Preload(l.TargetTask).
+		Order(l.ID).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find links of task due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding links for task %d: %w", taskID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found links of task", "count", len(links))
+	return links, nil
+}
+
+// ExistsBetween reports whether the two tasks are linked with linkType, in
+// either direction.
+func (r *taskLinkRepository) ExistsBetween(ctx context.Context, taskA, taskB int, linkType models.TaskLinkType) (bool, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskLinkRepository",
+		"method", "ExistsBetween",
+		"task_a", taskA,
+		"task_b", taskB,
+		"type", linkType,
+	)
+	logger.Debug("Starting check existing link process")
+
+	l := r.q.TaskLink
+	count, err := l.WithContext(ctx).
+		Where(l.Type.Eq(string(linkType))).
+		Where(
+			l.WithContext(ctx).Where(l.SourceTaskID.Eq(taskA), l.TargetTaskID.Eq(taskB)).
+				Or(l.SourceTaskID.Eq(taskB), l.TargetTaskID.Eq(taskA)),
+		).
+		Count()
+	if err != nil {
+		logger.Error("Failed to check existing link due to database error", "error", err)
+		return false, fmt.Errorf("database error checking link between tasks %d and %d: %w", taskA, taskB, structs.ErrDatabaseFail)
+	}
+
+	return count > 0, nil
+}
+
+// FindBlockedTaskIDs returns the IDs of the tasks blocked by any of sourceIDs.
+func (r *taskLinkRepository) FindBlockedTaskIDs(ctx context.Context, sourceIDs []int) ([]int, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskLinkRepository",
+		"method", "FindBlockedTaskIDs",
+		"source_ids", sourceIDs,
+	)
+	logger.Debug("Starting find blocked tasks process")
+
+	if len(sourceIDs) == 0 {
+		logger.Debug("No source IDs provided, returning empty list")
+		return []int{}, nil
+	}
+
+	l := r.q.TaskLink
+	var targetIDs []int
+	err := l.WithContext(ctx).
+		Where(l.SourceTaskID.In(sourceIDs...), l.Type.Eq(string(models.BlocksLink))).
+		Pluck(l.TargetTaskID, &targetIDs)
+	if err != nil {
+		logger.Error("Failed to find blocked tasks due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding blocked tasks: %w", structs.ErrDatabaseFail)
+	}
+
+	logger.Debug("Found blocked tasks", "count", len(targetIDs))
+	return targetIDs, nil
+}
+
+// FindOpenBlockers returns the tasks that block the task and are not done.
+func (r *taskLinkRepository) FindOpenBlockers(ctx context.Context, taskID int) ([]*models.Task, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskLinkRepository",
+		"method", "FindOpenBlockers",
+		"task_id", taskID,
+	)
+	logger.Debug("Starting find open blockers process")
+
+	t := r.q.Task
+	l := r.q.TaskLink
+	blockers, err := t.WithContext(ctx).
+		Join(l, l.SourceTaskID.EqCol(t.ID)).
+		Where(l.TargetTaskID.Eq(taskID), l.Type.Eq(string(models.BlocksLink)), t.Status.Neq(string(models.DoneTask))).
+		Order(t.ID).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find open blockers due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding blockers of task %d: %w", taskID, structs.ErrDatabaseFail)
+	}
+
+	logger.Debug("Found open blockers", "count", len(blockers))
+	return blockers, nil
+}
+
+// DeleteByTaskIDs removes every link from or to one of taskIDs.
+func (r *taskLinkRepository) DeleteByTaskIDs(ctx context.Context, taskIDs []int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskLinkRepository",
+		"method", "DeleteByTaskIDs",
+		"task_ids", taskIDs,
+	)
+	logger.Debug("Starting delete links of tasks process")
+
+	if len(taskIDs) == 0 {
+		logger.Debug("No task IDs provided, skipping database call")
+		return nil
+	}
+
+	l := r.q.TaskLink
+	resultInfo, err := l.WithContext(ctx).
+		Where(l.SourceTaskID.In(taskIDs...)).
+		Or(l.TargetTaskID.In(taskIDs...)).
+		Delete()
+	if err != nil {
+		logger.Error("Failed to delete links of tasks due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully deleted links of tasks", "rows_affected", resultInfo.RowsAffected)
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
+	"lqkhoi-go-http-api/internal/dto"
 	"lqkhoi-go-http-api/internal/models"
 	"lqkhoi-go-http-api/internal/query"
 	"lqkhoi-go-http-api/pkg/structs"
@@ -20,7 +22,8 @@ type UserRepository interface {
 	FindByID(ctx context.Context, id int) (*models.User, error)
 	FindByIDs(ctx context.Context, userIDs []int) ([]*models.User, error)
 	FindByEmail(ctx context.Context, email string) (*models.User, error)
-	List(ctx context.Context) ([]*models.User, error)
+	FindByEmails(ctx context.Context, emails []string) ([]*models.User, error)
+	List(ctx context.Context, page *dto.PageRequest) ([]*models.User, *dto.PageInfo, error)
 	Update(ctx context.Context, id int, updateMap map[string]any) error
 	Delete(ctx context.Context, id int) error
 	AssignUsersToProject(ctx context.Context, projectID int, userIDs []int) error
@@ -114,7 +117,7 @@ func (r *userRepository) FindByEmail(ctx context.Context, email string) (*models
 	return user, nil
 }
 
-func (r *userRepository) List(ctx context.Context) ([]*models.User, error) {
+func (r *userRepository) List(ctx context.Context, page *dto.PageRequest) ([]*models.User, *dto.PageInfo, error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "UserRepository",
@@ -122,15 +125,16 @@ func (r *userRepository) List(ctx context.Context) ([]*models.User, error) {
 	)
 	logger.Debug("Starting list users process")
 
-	users, err := r.q.User.WithContext(ctx).Find()
+	u := r.q.User
+	users, pageInfo, err := findPage(ctx, r.db, u.WithContext(ctx), &r.q.User, page)
 	if err != nil {
 		logger.Error("Failed to list users due to database error", "error", err)
-		return nil, fmt.Errorf("database error listing users: %w", err)
+		return nil, nil, fmt.Errorf("database error listing users: %w", err)
 	}
 
-	logger.Info("Successfully listed users", "count", len(users))
+	logger.Info("Successfully listed users", "count", len(users), "total", pageInfo.Total)
 	logger.Debug("Listed users details", "users", users)
-	return users, nil
+	return users, pageInfo, nil
 }
 
 // func (r *userRepository) Update(ctx context.Context, id int, updateMap map[string]any) error {
@@ -216,6 +220,39 @@ func (r *userRepository) FindByIDs(ctx context.Context, userIDs []int) ([]*model
 	return users, nil
 }
 
+// FindByEmails returns the users whose email matches one of emails, ignoring
+// case.
+func (r *userRepository) FindByEmails(ctx context.Context, emails []string) ([]*models.User, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "UserRepository",
+		"method", "FindByEmails",
+		"emails", emails,
+	)
+	logger.Debug("Fetching users by emails")
+
+	if len(emails) == 0 {
+		logger.Debug("No emails provided, returning empty list")
+		return []*models.User{}, nil
+	}
+
+	lowered := make([]string, len(emails))
+	for i, email := range emails {
+		lowered[i] = strings.ToLower(email)
+	}
+
+	u := r.q.User
+
+	users, err := u.WithContext(ctx).Where(u.Email.Lower().In(lowered...)).Find()
+	if err != nil {
+		logger.Error("Database query failed while fetching users by emails", "error", err)
+		return nil, err
+	}
+
+	logger.Debug("User query successful", "found_users_count", len(users))
+	return users, nil
+}
+
 func (r *userRepository) AssignUsersToProject(ctx context.Context, projectID int, userIDs []int) (err error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
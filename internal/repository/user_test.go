@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"lqkhoi-go-http-api/internal/dto"
 	"lqkhoi-go-http-api/internal/models"
 	"lqkhoi-go-http-api/pkg/structs"
 
@@ -134,6 +135,29 @@ func TestUserRepository_FindByEmail(t *testing.T) {
 	})
 }
 
+func TestUserRepository_FindByEmails(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewUserRepository(db)
+	ctx := context.Background()
+
+	// Seed users
+	jane, _ := repo.Create(ctx, &models.User{Email: "Jane.Doe@Example.com"})
+	repo.Create(ctx, &models.User{Email: "john@example.com"})
+
+	t.Run("emails match regardless of case", func(t *testing.T) {
+		users, err := repo.FindByEmails(ctx, []string{"jane.doe@example.com", "nobody@example.com"})
+		assert.NoError(t, err)
+		assert.Len(t, users, 1)
+		assert.Equal(t, jane.ID, users[0].ID)
+	})
+
+	t.Run("empty email list", func(t *testing.T) {
+		users, err := repo.FindByEmails(ctx, nil)
+		assert.NoError(t, err)
+		assert.Len(t, users, 0)
+	})
+}
+
 func TestUserRepository_List(t *testing.T) {
 	db := setupTestDB(t)
 	repo := NewUserRepository(db)
@@ -143,12 +167,71 @@ func TestUserRepository_List(t *testing.T) {
 		repo.Create(ctx, &models.User{Email: "user1@example.com"})
 		repo.Create(ctx, &models.User{Email: "user2@example.com"})
 
-		users, err := repo.List(ctx)
+		users, _, err := repo.List(ctx, nil)
 		assert.NoError(t, err)
 		assert.Len(t, users, 2)
 	})
 }
 
+func TestUserRepository_ListPaginated(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewUserRepository(db)
+	ctx := context.Background()
+
+	for _, email := range []string{"c@example.com", "a@example.com", "e@example.com", "b@example.com", "d@example.com"} {
+		repo.Create(ctx, &models.User{Email: email, Password: "secret", Role: "TEAM_MEMBER"})
+	}
+
+	emailsOf := func(users []*models.User) []string {
+		emails := make([]string, 0, len(users))
+		for _, u := range users {
+			emails = append(emails, u.Email)
+		}
+		return emails
+	}
+
+	t.Run("offset pages", func(t *testing.T) {
+		users, info, err := repo.List(ctx, &dto.PageRequest{Limit: 2, Page: 2, SortField: "email"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"c@example.com", "d@example.com"}, emailsOf(users))
+		assert.Equal(t, int64(5), info.Total)
+		assert.Equal(t, 2, info.Page)
+		assert.NotEmpty(t, info.NextCursor)
+	})
+
+	t.Run("cursor walks every row once", func(t *testing.T) {
+		page := &dto.PageRequest{Limit: 2, SortField: "email", SortDesc: true}
+		var seen []string
+		for {
+			users, info, err := repo.List(ctx, page)
+			assert.NoError(t, err)
+			seen = append(seen, emailsOf(users)...)
+			if info.NextCursor == "" {
+				break
+			}
+			cursor, err := dto.DecodeCursor(info.NextCursor)
+			assert.NoError(t, err)
+			page.Cursor = cursor
+		}
+		assert.Equal(t, []string{"e@example.com", "d@example.com", "c@example.com", "b@example.com", "a@example.com"}, seen)
+	})
+
+	t.Run("cursor of another sort direction", func(t *testing.T) {
+		_, info, err := repo.List(ctx, &dto.PageRequest{Limit: 2, SortField: "email", SortDesc: true})
+		assert.NoError(t, err)
+		cursor, err := dto.DecodeCursor(info.NextCursor)
+		assert.NoError(t, err)
+
+		_, _, err = repo.List(ctx, &dto.PageRequest{Limit: 2, SortField: "email", Cursor: cursor})
+		assert.ErrorIs(t, err, structs.ErrInvalidPageRequest)
+	})
+
+	t.Run("unknown sort field", func(t *testing.T) {
+		_, _, err := repo.List(ctx, &dto.PageRequest{Limit: 2, Page: 1, SortField: "password_hash"})
+		assert.ErrorIs(t, err, structs.ErrInvalidPageRequest)
+	})
+}
+
 func TestUserRepository_Update(t *testing.T) {
 	db := setupTestDB(t)
 	repo := NewUserRepository(db)
@@ -0,0 +1,273 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/query"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook *models.Webhook) (*models.Webhook, error)
+	FindByID(ctx context.Context, id int) (*models.Webhook, error)
+	FindByProjectID(ctx context.Context, projectID int) ([]*models.Webhook, error)
+	FindActiveByProjectID(ctx context.Context, projectID int) ([]*models.Webhook, error)
+	Update(ctx context.Context, id int, updateMap map[string]any) error
+	Delete(ctx context.Context, id int) error
+	CreateDeliveries(ctx context.Context, deliveries []*models.WebhookDelivery) error
+	FindDeliveryByID(ctx context.Context, id int) (*models.WebhookDelivery, error)
+	FindDeliveriesByWebhookID(ctx context.Context, webhookID int, page *dto.PageRequest) ([]*models.WebhookDelivery, *dto.PageInfo, error)
+	FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*models.WebhookDelivery, error)
+	ClaimDelivery(ctx context.Context, delivery *models.WebhookDelivery, leaseUntil time.Time) (bool, error)
+	UpdateDelivery(ctx context.Context, id int, updateMap map[string]any) error
+}
+
+type webhookRepository struct {
+	db *gorm.DB
+	q  *query.Query
+	*GenericRepository[*models.Webhook, int]
+}
+
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	genericRepo := NewGenericRepository[*models.Webhook, int](
+		db,
+		"Webhook",
+		structs.ErrWebhookNotExist,
+	)
+
+	return &webhookRepository{
+		db:                db,
+		q:                 query.Use(db),
+		GenericRepository: genericRepo,
+	}
+}
+
+func (r *webhookRepository) FindByProjectID(ctx context.Context, projectID int) ([]*models.Webhook, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookRepository",
+		"method", "FindByProjectID",
+		"project_id", projectID,
+	)
+	logger.Debug("Starting find webhooks of project process")
+
+	w := r.q.Webhook
+	webhooks, err := w.WithContext(ctx).
+		Where(w.ProjectID.Eq(projectID)).
+		Order(w.ID).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find webhooks of project due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding webhooks for project %d: %w", projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found webhooks of project", "count", len(webhooks))
+	return webhooks, nil
+}
+
+func (r *webhookRepository) FindActiveByProjectID(ctx context.Context, projectID int) ([]*models.Webhook, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookRepository",
+		"method", "FindActiveByProjectID",
+		"project_id", projectID,
+	)
+	logger.Debug("Starting find active webhooks of project process")
+
+	w := r.q.Webhook
+	webhooks, err := w.WithContext(ctx).
+		Where(w.ProjectID.Eq(projectID), w.Active.Is(true)).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find active webhooks due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding active webhooks for project %d: %w", projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Debug("Successfully found active webhooks of project", "count", len(webhooks))
+	return webhooks, nil
+}
+
+// Delete removes the webhook together with its delivery log in one
+// transaction.
+func (r *webhookRepository) Delete(ctx context.Context, id int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookRepository",
+		"method", "Delete",
+		"webhook_id", id,
+	)
+	logger.Debug("Starting delete webhook process")
+
+	var rowsAffected int64
+	err := r.q.Transaction(func(tx *query.Query) error {
+		d := tx.WebhookDelivery
+		if _, err := d.WithContext(ctx).Where(d.WebhookID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+		w := tx.Webhook
+		resultInfo, err := w.WithContext(ctx).Where(w.ID.Eq(id)).Delete()
+		if err != nil {
+			return err
+		}
+		rowsAffected = resultInfo.RowsAffected
+		return nil
+	})
+	if err != nil {
+		logger.Error("Failed to delete webhook due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	if rowsAffected == 0 {
+		logger.Warn("Delete executed but no webhook found with the given ID")
+		return structs.ErrWebhookNotExist
+	}
+
+	logger.Info("Successfully deleted webhook")
+	return nil
+}
+
+func (r *webhookRepository) CreateDeliveries(ctx context.Context, deliveries []*models.WebhookDelivery) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookRepository",
+		"method", "CreateDeliveries",
+	)
+	logger.Debug("Starting create webhook deliveries process", "delivery_count", len(deliveries))
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	d := r.q.WebhookDelivery
+	if err := d.WithContext(ctx).Create(deliveries...); err != nil {
+		logger.Error("Failed to create webhook deliveries due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully created webhook deliveries", "count", len(deliveries))
+	return nil
+}
+
+func (r *webhookRepository) FindDeliveryByID(ctx context.Context, id int) (*models.WebhookDelivery, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookRepository",
+		"method", "FindDeliveryByID",
+		"delivery_id", id,
+	)
+	logger.Debug("Starting find webhook delivery by ID process")
+
+	d := r.q.WebhookDelivery
+	delivery, err := d.WithContext(ctx).
+		Where(d.ID.Eq(id)).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Warn("Webhook delivery not found")
+			return nil, structs.ErrWebhookDeliveryNotExist
+		}
+		logger.Error("Failed to find webhook delivery due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	return delivery, nil
+}
+
+func (r *webhookRepository) FindDeliveriesByWebhookID(ctx context.Context, webhookID int, page *dto.PageRequest) ([]*models.WebhookDelivery, *dto.PageInfo, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookRepository",
+		"method", "FindDeliveriesByWebhookID",
+		"webhook_id", webhookID,
+	)
+	logger.Debug("Starting find deliveries of webhook process")
+
+	d := r.q.WebhookDelivery
+	deliveryQuery := d.WithContext(ctx).Where(d.WebhookID.Eq(webhookID))
+
+	deliveries, pageInfo, err := findPage(ctx, r.db, deliveryQuery, &r.q.WebhookDelivery, page)
+	if err != nil {
+		logger.Error("Failed to find deliveries of webhook due to database error", "error", err)
+		return nil, nil, fmt.Errorf("database error finding deliveries for webhook %d: %w", webhookID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found deliveries of webhook", "count", len(deliveries), "total", pageInfo.Total)
+	return deliveries, pageInfo, nil
+}
+
+// FindDueDeliveries returns up to limit pending deliveries whose next attempt
+// is due at now, oldest first, with their webhook.
+func (r *webhookRepository) FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookRepository",
+		"method", "FindDueDeliveries",
+	)
+
+	d := r.q.WebhookDelivery
+	deliveries, err := d.WithContext(ctx).
+		Where(d.Status.Eq(string(models.DeliveryPending)), d.NextAttemptAt.Lte(now)).
+		Preload(d.Webhook).
+		Order(d.NextAttemptAt, d.ID).
+		Limit(limit).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find due webhook deliveries due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	logger.Debug("Successfully found due webhook deliveries", "count", len(deliveries))
+	return deliveries, nil
+}
+
+// ClaimDelivery moves the next attempt of a due delivery to leaseUntil,
+// unless another worker did so first. It reports whether the delivery was
+// claimed; a claimed delivery is retried at leaseUntil if its attempt is
+// never recorded.
+func (r *webhookRepository) ClaimDelivery(ctx context.Context, delivery *models.WebhookDelivery, leaseUntil time.Time) (bool, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookRepository",
+		"method", "ClaimDelivery",
+		"delivery_id", delivery.ID,
+	)
+
+	if delivery.NextAttemptAt == nil {
+		return false, nil
+	}
+
+	d := r.q.WebhookDelivery
+	resultInfo, err := d.WithContext(ctx).
+		Where(d.ID.Eq(delivery.ID), d.Status.Eq(string(models.DeliveryPending)), d.NextAttemptAt.Eq(*delivery.NextAttemptAt)).
+		Update(d.NextAttemptAt, leaseUntil)
+	if err != nil {
+		logger.Error("Failed to claim webhook delivery due to database error", "error", err)
+		return false, structs.ErrDatabaseFail
+	}
+
+	return resultInfo.RowsAffected == 1, nil
+}
+
+func (r *webhookRepository) UpdateDelivery(ctx context.Context, id int, updateMap map[string]any) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookRepository",
+		"method", "UpdateDelivery",
+		"delivery_id", id,
+	)
+
+	d := r.q.WebhookDelivery
+	if _, err := d.WithContext(ctx).Where(d.ID.Eq(id)).Updates(updateMap); err != nil {
+		logger.Error("Failed to update webhook delivery due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/query"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+type WipLimitRepository interface {
+	FindByProjectID(ctx context.Context, projectID int) ([]*models.WipLimit, error)
+	FindByProjectIDAndStatus(ctx context.Context, projectID int, status models.TaskStatus) (*models.WipLimit, error)
+	ReplaceForProject(ctx context.Context, projectID int, limits []*models.WipLimit) error
+}
+
+type wipLimitRepository struct {
+	db *gorm.DB
+	q  *query.Query
+}
+
+func NewWipLimitRepository(db *gorm.DB) WipLimitRepository {
+	return &wipLimitRepository{
+		db: db,
+		q:  query.Use(db),
+	}
+}
+
+func (r *wipLimitRepository) FindByProjectID(ctx context.Context, projectID int) ([]*models.WipLimit, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WipLimitRepository",
+		"method", "FindByProjectID",
+		"project_id", projectID,
+	)
+	logger.Debug("Starting find WIP limits of project process")
+
+	w := r.q.WipLimit
+	limits, err := w.WithContext(ctx).
+		Where(w.ProjectID.Eq(projectID)).
+		Order(w.Status).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find WIP limits of project due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding WIP limits for project %d: %w", projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found WIP limits of project", "count", len(limits))
+	return limits, nil
+}
+
+// FindByProjectIDAndStatus returns the WIP limit of the project for the
+// status, or structs.ErrWipLimitNotExist when the status is not limited.
+func (r *wipLimitRepository) FindByProjectIDAndStatus(ctx context.Context, projectID int, status models.TaskStatus) (*models.WipLimit, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WipLimitRepository",
+		"method", "FindByProjectIDAndStatus",
+		"project_id", projectID,
+		"status", status,
+	)
+
+	w := r.q.WipLimit
+	limit, err := w.WithContext(ctx).
+		Where(w.ProjectID.Eq(projectID), w.Status.Eq(string(status))).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Debug("Status has no WIP limit")
+			return nil, structs.ErrWipLimitNotExist
+		}
+		logger.Error("Failed to find WIP limit due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding %s WIP limit for project %d: %w", status, projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Debug("Successfully found WIP limit", "wip_limit_id", limit.ID)
+	return limit, nil
+}
+
+// ReplaceForProject swaps all WIP limits of the project for limits in one
+// transaction. An empty list lifts every limit.
+func (r *wipLimitRepository) ReplaceForProject(ctx context.Context, projectID int, limits []*models.WipLimit) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WipLimitRepository",
+		"method", "ReplaceForProject",
+		"project_id", projectID,
+	)
+	logger.Debug("Starting replace WIP limits of project process", "count", len(limits))
+
+	err := r.q.Transaction(func(tx *query.Query) error {
+		w := tx.WipLimit
+		if _, err := w.WithContext(ctx).Where(w.ProjectID.Eq(projectID)).Delete(); err != nil {
+			return err
+		}
+		if len(limits) == 0 {
+			return nil
+		}
+		for _, l := range limits {
+			l.ID = 0
+			l.ProjectID = projectID
+		}
+		return w.WithContext(ctx).Create(limits...)
+	})
+	if err != nil {
+		logger.Error("Failed to replace WIP limits of project due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully replaced WIP limits of project")
+	return nil
+}
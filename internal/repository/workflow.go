@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/query"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+type WorkflowRepository interface {
+	FindByProjectID(ctx context.Context, projectID int) ([]*models.WorkflowTransition, error)
+	ReplaceForProject(ctx context.Context, projectID int, transitions []*models.WorkflowTransition) error
+}
+
+type workflowRepository struct {
+	db *gorm.DB
+	q  *query.Query
+}
+
+func NewWorkflowRepository(db *gorm.DB) WorkflowRepository {
+	return &workflowRepository{
+		db: db,
+		q:  query.Use(db),
+	}
+}
+
+func (r *workflowRepository) FindByProjectID(ctx context.Context, projectID int) ([]*models.WorkflowTransition, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorkflowRepository",
+		"method", "FindByProjectID",
+		"project_id", projectID,
+	)
+	logger.Debug("Starting find workflow of project process")
+
+	w := r.q.WorkflowTransition
+	transitions, err := w.WithContext(ctx).
+		Where(w.ProjectID.Eq(projectID)).
+		Order(w.FromStatus, w.ToStatus, w.Role).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find workflow of project due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding workflow for project %d: %w", projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found workflow of project", "count", len(transitions))
+	return transitions, nil
+}
+
+// ReplaceForProject swaps the whole workflow of the project for transitions
+// in one transaction. An empty list brings the project back to the default
+// workflow.
+func (r *workflowRepository) ReplaceForProject(ctx context.Context, projectID int, transitions []*models.WorkflowTransition) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorkflowRepository",
+		"method", "ReplaceForProject",
+		"project_id", projectID,
+	)
+	logger.Debug("Starting replace workflow of project process", "count", len(transitions))
+
+	err := r.q.Transaction(func(tx *query.Query) error {
+		w := tx.WorkflowTransition
+		if _, err := w.WithContext(ctx).Where(w.ProjectID.Eq(projectID)).Delete(); err != nil {
+			return err
+		}
+		if len(transitions) == 0 {
+			return nil
+		}
+		for _, t := range transitions {
+			t.ID = 0
+			t.ProjectID = projectID
+		}
+		return w.WithContext(ctx).Create(transitions...)
+	})
+	if err != nil {
+		logger.Error("Failed to replace workflow of project due to database error", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully replaced workflow of project")
+	return nil
+}
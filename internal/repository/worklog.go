@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/query"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gorm"
+)
+
+type WorklogRepository interface {
+	Create(ctx context.Context, worklog *models.Worklog) (*models.Worklog, error)
+	FindByID(ctx context.Context, id int) (*models.Worklog, error)
+	FindByTaskID(ctx context.Context, taskID int, page *dto.PageRequest) ([]*models.Worklog, *dto.PageInfo, error)
+	FindByUserInRange(ctx context.Context, userID int, from, to time.Time) ([]*models.Worklog, error)
+	SumByProjectInRange(ctx context.Context, projectID int, from, to time.Time) ([]models.WorklogTotal, error)
+	Update(ctx context.Context, id int, updateMap map[string]any) error
+	Delete(ctx context.Context, id int) error
+}
+
+type worklogRepository struct {
+	db *gorm.DB
+	q  *query.Query
+	*GenericRepository[*models.Worklog, int]
+}
+
+func NewWorklogRepository(db *gorm.DB) WorklogRepository {
+	genericRepo := NewGenericRepository[*models.Worklog, int](
+		db,
+		"Worklog",
+		structs.ErrWorklogNotExist,
+	)
+
+	return &worklogRepository{
+		db:                db,
+		q:                 query.Use(db),
+		GenericRepository: genericRepo,
+	}
+}
+
+func (r *worklogRepository) FindByID(ctx context.Context, id int) (*models.Worklog, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogRepository",
+		"method", "FindByID",
+		"worklog_id", id,
+	)
+	logger.Debug("Starting find worklog by ID process")
+
+	w := r.q.Worklog
+	worklog, err := w.WithContext(ctx).
+		Where(w.ID.Eq(id)).
+		Preload(w.User).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.Warn("Worklog not found")
+			return nil, structs.ErrWorklogNotExist
+		}
+		logger.Error("Failed to find worklog by ID due to database error", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully found worklog by ID")
+	return worklog, nil
+}
+
+func (r *worklogRepository) FindByTaskID(ctx context.Context, taskID int, page *dto.PageRequest) ([]*models.Worklog, *dto.PageInfo, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogRepository",
+		"method", "FindByTaskID",
+		"task_id", taskID,
+	)
+	logger.Debug("Starting find worklogs of task process")
+
+	w := r.q.Worklog
+	worklogQuery := w.WithContext(ctx).
+		Where(w.TaskID.Eq(taskID)).
+		Preload(w.User)
+
+	worklogs, pageInfo, err := findPage(ctx, r.db, worklogQuery, &r.q.Worklog, page)
+	if err != nil {
+		logger.Error("Failed to find worklogs of task due to database error", "error", err)
+		return nil, nil, fmt.Errorf("database error finding worklogs for task %d: %w", taskID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found worklogs of task", "count", len(worklogs), "total", pageInfo.Total)
+	return worklogs, pageInfo, nil
+}
+
+// FindByUserInRange returns the worklogs of the user started in [from, to),
+// oldest first, with their task.
+func (r *worklogRepository) FindByUserInRange(ctx context.Context, userID int, from, to time.Time) ([]*models.Worklog, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogRepository",
+		"method", "FindByUserInRange",
+		"user_id", userID,
+	)
+	logger.Debug("Starting find worklogs of user process", "from", from, "to", to)
+
+	w := r.q.Worklog
+	worklogs, err := w.WithContext(ctx).
+		Where(w.UserID.Eq(userID), w.StartedAt.Gte(from), w.StartedAt.Lt(to)).
+		Preload(w.Task).
+		Order(w.StartedAt, w.ID).
+		Find()
+	if err != nil {
+		logger.Error("Failed to find worklogs of user due to database error", "error", err)
+		return nil, fmt.Errorf("database error finding worklogs for user %d: %w", userID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully found worklogs of user", "count", len(worklogs))
+	return worklogs, nil
+}
+
+// SumByProjectInRange returns the minutes logged on the project per user for
+// worklogs started in [from, to).
+func (r *worklogRepository) SumByProjectInRange(ctx context.Context, projectID int, from, to time.Time) ([]models.WorklogTotal, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogRepository",
+		"method", "SumByProjectInRange",
+		"project_id", projectID,
+	)
+	logger.Debug("Starting sum worklogs of project process", "from", from, "to", to)
+
+	w := r.q.Worklog
+	var totals []models.WorklogTotal
+	err := w.WithContext(ctx).
+		Select(w.UserID, w.DurationMinutes.Sum().As("total_minutes")).
+		Where(w.ProjectID.Eq(projectID), w.StartedAt.Gte(from), w.StartedAt.Lt(to)).
+		Group(w.UserID).
+		Order(w.UserID).
+		Scan(&totals)
+	if err != nil {
+		logger.Error("Failed to sum worklogs of project due to database error", "error", err)
+		return nil, fmt.Errorf("database error summing worklogs for project %d: %w", projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully summed worklogs of project", "user_count", len(totals))
+	return totals, nil
+}
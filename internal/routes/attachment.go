@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"lqkhoi-go-http-api/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupAttachmentRoutes(prefixApp fiber.Router, h *handler.AttachmentHandler, lm fiber.Handler, am fiber.Handler, ul fiber.Handler) {
+	log := prefixApp.Group("/")
+	log.Use(lm)
+
+	authenticated := log.Group("/")
+	authenticated.Use(am)
+	authenticated.Get("/tasks/:taskId/attachments", h.ListAttachments)
+	authenticated.Post("/tasks/:taskId/attachments", ul, h.UploadAttachment)
+	authenticated.Get("/tasks/:taskId/attachments/:attachmentId", h.DownloadAttachment)
+	authenticated.Delete("/tasks/:taskId/attachments/:attachmentId", h.DeleteAttachment)
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"lqkhoi-go-http-api/internal/handler"
+	"lqkhoi-go-http-api/internal/middlewares"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetupBoardStreamRoutes must be called before the other routes are set up:
+// their authentication would otherwise reject the streams authenticated by
+// query parameter before they get here.
+func SetupBoardStreamRoutes(prefixApp fiber.Router, h *handler.BoardStreamHandler, lm fiber.Handler, am fiber.Handler) {
+	log := prefixApp.Group("/")
+	log.Use(lm)
+
+	log.Get("/projects/:projectId/events", middlewares.NewQueryTokenMiddleware(), am, h.StreamProjectEvents)
+}
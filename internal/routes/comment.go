@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"lqkhoi-go-http-api/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupCommentRoutes(prefixApp fiber.Router, h *handler.CommentHandler, lm fiber.Handler, am fiber.Handler) {
+	log := prefixApp.Group("/")
+	log.Use(lm)
+
+	authenticated := log.Group("/")
+	authenticated.Use(am)
+	authenticated.Get("/tasks/:taskId/comments", h.ListComments)
+	authenticated.Post("/tasks/:taskId/comments", h.CreateComment)
+	authenticated.Put("/tasks/:taskId/comments/:commentId", h.UpdateComment)
+	authenticated.Delete("/tasks/:taskId/comments/:commentId", h.DeleteComment)
+	authenticated.Get("/tasks/:taskId/comments/:commentId/history", h.ListCommentEdits)
+}
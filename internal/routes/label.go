@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"lqkhoi-go-http-api/internal/handler"
+	"lqkhoi-go-http-api/internal/middlewares"
+	"lqkhoi-go-http-api/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupLabelRoutes(prefixApp fiber.Router, h *handler.LabelHandler, lm fiber.Handler, am fiber.Handler) {
+	log := prefixApp.Group("/")
+	log.Use(lm)
+
+	authenticated := log.Group("/")
+	authenticated.Use(am)
+	authenticated.Get("/projects/:projectId/labels", h.ListLabels)
+
+	projectManagerOnly := authenticated.Group("/")
+	projectManagerOnly.Use(middlewares.RequireRoleIs(models.ProjectManager))
+	projectManagerOnly.Post("/projects/:projectId/labels", h.CreateLabel)
+	projectManagerOnly.Put("/projects/:projectId/labels/:labelId", h.UpdateLabel)
+	projectManagerOnly.Delete("/projects/:projectId/labels/:labelId", h.DeleteLabel)
+	projectManagerOnly.Put("/tasks/:taskId/labels", h.SetTaskLabels)
+}
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"lqkhoi-go-http-api/internal/handler"
+	"lqkhoi-go-http-api/internal/middlewares"
+	"lqkhoi-go-http-api/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupMetricsRoutes(prefixApp fiber.Router, h *handler.MetricsHandler, lm fiber.Handler, am fiber.Handler) {
+	log := prefixApp.Group("/")
+	log.Use(lm)
+
+	authenticated := log.Group("/")
+	authenticated.Use(am)
+
+	projectManagerOnly := authenticated.Group("/")
+	projectManagerOnly.Use(middlewares.RequireRoleIs(models.ProjectManager))
+	projectManagerOnly.Get("/sprints/:sprintId/burndown", h.GetSprintBurndown)
+	projectManagerOnly.Get("/projects/:projectId/velocity", h.GetProjectVelocity)
+}
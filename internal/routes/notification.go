@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"lqkhoi-go-http-api/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupNotificationRoutes(prefixApp fiber.Router, h *handler.NotificationHandler, lm fiber.Handler, am fiber.Handler) {
+	log := prefixApp.Group("/")
+	log.Use(lm)
+
+	authenticated := log.Group("/")
+	authenticated.Use(am)
+	authenticated.Get("/me/notifications", h.ListNotifications)
+	authenticated.Get("/me/notifications/unread-count", h.CountUnread)
+	authenticated.Post("/me/notifications/read-all", h.MarkAllRead)
+	authenticated.Post("/me/notifications/:notificationId/read", h.MarkRead)
+}
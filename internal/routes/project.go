@@ -8,18 +8,21 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-func SetupProjectRoutes(prefixApp fiber.Router, h *handler.ProjectHandler, lm fiber.Handler) {
+func SetupProjectRoutes(prefixApp fiber.Router, h *handler.ProjectHandler, lm fiber.Handler, am fiber.Handler) {
 	log := prefixApp.Group("/")
 	log.Use(lm)
 
 	authenticated := log.Group("/")
-	authenticated.Use(middlewares.AuthMiddleware)
+	authenticated.Use(am)
+	authenticated.Get("/projects/:projectId/members", h.ListMembers)
+	authenticated.Get("/projects/:projectId/activity", h.ListActivity)
 
 	projectManagerOnly := authenticated.Group("/projects")
 	projectManagerOnly.Use(middlewares.RequireRoleIs(models.ProjectManager))
 
 	projectManagerOnly.Post("/", h.CreateProjectHandler)
 	projectManagerOnly.Post("/:projectId/members", h.AddTeamMembers)
+	projectManagerOnly.Delete("/:projectId/members/:userId", h.RemoveMember)
 	projectManagerOnly.Get("/", h.ListProjectsHanlder)
 	projectManagerOnly.Get("/:projectId", h.GetProject)
 	projectManagerOnly.Put("/:projectId", h.UpdateProject)
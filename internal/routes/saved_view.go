@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"lqkhoi-go-http-api/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupSavedViewRoutes(prefixApp fiber.Router, h *handler.SavedViewHandler, lm fiber.Handler, am fiber.Handler) {
+	log := prefixApp.Group("/")
+	log.Use(lm)
+
+	authenticated := log.Group("/")
+	authenticated.Use(am)
+	authenticated.Get("/views", h.ListViews)
+	authenticated.Post("/views", h.CreateView)
+	authenticated.Get("/views/:viewId", h.GetView)
+	authenticated.Put("/views/:viewId", h.UpdateView)
+	authenticated.Delete("/views/:viewId", h.DeleteView)
+}
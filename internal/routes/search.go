@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"lqkhoi-go-http-api/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupSearchRoutes(prefixApp fiber.Router, h *handler.SearchHandler, lm fiber.Handler, am fiber.Handler) {
+	log := prefixApp.Group("/")
+	log.Use(lm)
+
+	authenticated := log.Group("/")
+	authenticated.Use(am)
+	authenticated.Get("/search", h.Search)
+}
@@ -8,12 +8,14 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-func SetupSprintRoutes(prefixApp fiber.Router, h *handler.SprintHandler, lm fiber.Handler) {
+func SetupSprintRoutes(prefixApp fiber.Router, h *handler.SprintHandler, lm fiber.Handler, am fiber.Handler) {
 	log := prefixApp.Group("/")
 	log.Use(lm)
 
 	authenticated := log.Group("/")
-	authenticated.Use(middlewares.AuthMiddleware)
+	authenticated.Use(am)
+
+	authenticated.Get("/sprints/:sprintId/board", h.GetSprintBoard)
 
 	projectManagerSprint := authenticated.Group("/sprints")
 	projectManagerSprint.Use(middlewares.RequireRoleIs(models.ProjectManager))
@@ -23,4 +25,7 @@ func SetupSprintRoutes(prefixApp fiber.Router, h *handler.SprintHandler, lm fibe
 	projectManagerSprint.Get("/:sprintId", h.GetSprint)
 	projectManagerSprint.Put("/:sprintId", h.UpdateSprint)
 	projectManagerSprint.Delete("/:sprintId", h.DeleteSprint)
+	projectManagerSprint.Post("/:sprintId/start", h.StartSprint)
+	projectManagerSprint.Post("/:sprintId/complete", h.CompleteSprint)
+	projectManagerSprint.Get("/:sprintId/report", h.GetSprintReport)
 }
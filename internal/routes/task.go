@@ -8,13 +8,17 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-func SetupTaskRoutes(prefixApp fiber.Router, h *handler.TaskHandler, lm fiber.Handler) {
+func SetupTaskRoutes(prefixApp fiber.Router, h *handler.TaskHandler, lm fiber.Handler, am fiber.Handler) {
 	log := prefixApp.Group("/")
 	log.Use(lm)
 
 	authenticated := log.Group("/")
-	authenticated.Use(middlewares.AuthMiddleware)
+	authenticated.Use(am)
+	authenticated.Get("/tasks", h.FindTasks)
 	authenticated.Get("/tasks/:taskId", h.GetTask)
+	authenticated.Get("/tasks/:taskId/history", h.FindTaskHistory)
+	authenticated.Put("/tasks/:taskId/status", h.ChangeTaskStatus)
+	authenticated.Patch("/tasks/:taskId/move", h.MoveTask)
 
 	OwnerOrProjectManager := authenticated.Group("/")
 	OwnerOrProjectManager.Get("/users/:userId/tasks", h.FindTasksByUserID)
@@ -23,9 +27,11 @@ func SetupTaskRoutes(prefixApp fiber.Router, h *handler.TaskHandler, lm fiber.Ha
 	ProjectManagerOnly.Use(middlewares.RequireRoleIs(models.ProjectManager))
 
 	ProjectManagerOnly.Get("/projects/:projectId/tasks", h.FindTasksByProjectID)
+	ProjectManagerOnly.Get("/projects/:projectId/backlog", h.FindBacklogByProjectID)
 	ProjectManagerOnly.Post("/tasks",h.CreateTask)
-	ProjectManagerOnly.Get("/tasks", h.FindTasks)
 	ProjectManagerOnly.Put("/tasks/:taskId", h.UpdateTask)
 	ProjectManagerOnly.Delete("/tasks/:taskId", h.DeleteTask)
 	ProjectManagerOnly.Post("/tasks/:taskId/user/:userId", h.AssignTaskToUser)
+	ProjectManagerOnly.Post("/tasks/:taskId/sprint/:sprintId", h.MoveTaskToSprint)
+	ProjectManagerOnly.Delete("/tasks/:taskId/sprint", h.MoveTaskToBacklog)
 }
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"lqkhoi-go-http-api/internal/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupTaskLinkRoutes(prefixApp fiber.Router, h *handler.TaskLinkHandler, lm fiber.Handler, am fiber.Handler) {
+	log := prefixApp.Group("/")
+	log.Use(lm)
+
+	authenticated := log.Group("/")
+	authenticated.Use(am)
+	authenticated.Get("/tasks/:taskId/links", h.ListTaskLinks)
+	authenticated.Post("/tasks/:taskId/links", h.CreateTaskLink)
+	authenticated.Delete("/tasks/:taskId/links/:linkId", h.DeleteTaskLink)
+}
@@ -8,27 +8,26 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-func sampleHanlder(c *fiber.Ctx) error {
-	return nil
-}
-
-func SetupUserRoutes(prefixApp fiber.Router, h *handler.UserHandler, lm fiber.Handler) {
+func SetupUserRoutes(prefixApp fiber.Router, h *handler.UserHandler, lm fiber.Handler, am fiber.Handler) {
 	log := prefixApp.Group("/")
 	log.Use(lm)
 	
 	log.Post("/users", h.CreateUserHandler)
 	log.Post("/login", h.Login)
+	log.Post("/token/refresh", h.RefreshToken)
 
 	authenticated := log.Group("/")
-	authenticated.Use(middlewares.AuthMiddleware)
+	authenticated.Use(am)
 
 	authenticated.Get("/me", h.GetMe)
+	authenticated.Post("/logout", h.Logout)
 
 	ownerOrAdmin := authenticated.Group("/users/:userId")
 	ownerOrAdmin.Use(middlewares.RequireOwnerOrAdmin())
 
 	ownerOrAdmin.Get("/", h.GetUser)
-	ownerOrAdmin.Put("/", sampleHanlder)
+	ownerOrAdmin.Put("/", h.UpdateUser)
+	ownerOrAdmin.Put("/password", h.ChangePassword)
 	ownerOrAdmin.Delete("/", h.DeleteUser)
 
 	adminOnly := authenticated.Group("/users")
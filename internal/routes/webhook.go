@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"lqkhoi-go-http-api/internal/handler"
+	"lqkhoi-go-http-api/internal/middlewares"
+	"lqkhoi-go-http-api/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupWebhookRoutes(prefixApp fiber.Router, h *handler.WebhookHandler, lm fiber.Handler, am fiber.Handler) {
+	log := prefixApp.Group("/")
+	log.Use(lm)
+
+	authenticated := log.Group("/")
+	authenticated.Use(am)
+
+	projectManagerOnly := authenticated.Group("/")
+	projectManagerOnly.Use(middlewares.RequireRoleIs(models.ProjectManager))
+	projectManagerOnly.Get("/projects/:projectId/webhooks", h.ListWebhooks)
+	projectManagerOnly.Post("/projects/:projectId/webhooks", h.CreateWebhook)
+	projectManagerOnly.Put("/projects/:projectId/webhooks/:webhookId", h.UpdateWebhook)
+	projectManagerOnly.Delete("/projects/:projectId/webhooks/:webhookId", h.DeleteWebhook)
+	projectManagerOnly.Get("/projects/:projectId/webhooks/:webhookId/deliveries", h.ListDeliveries)
+	projectManagerOnly.Post("/projects/:projectId/webhooks/:webhookId/deliveries/:deliveryId/redeliver", h.Redeliver)
+}
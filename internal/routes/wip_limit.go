@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"lqkhoi-go-http-api/internal/handler"
+	"lqkhoi-go-http-api/internal/middlewares"
+	"lqkhoi-go-http-api/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupWipLimitRoutes(prefixApp fiber.Router, h *handler.WipLimitHandler, lm fiber.Handler, am fiber.Handler) {
+	log := prefixApp.Group("/")
+	log.Use(lm)
+
+	authenticated := log.Group("/")
+	authenticated.Use(am)
+	authenticated.Get("/projects/:projectId/wip-limits", h.GetWipLimits)
+
+	projectManagerOnly := authenticated.Group("/")
+	projectManagerOnly.Use(middlewares.RequireRoleIs(models.ProjectManager))
+	projectManagerOnly.Put("/projects/:projectId/wip-limits", h.UpdateWipLimits)
+}
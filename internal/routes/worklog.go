@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"lqkhoi-go-http-api/internal/handler"
+	"lqkhoi-go-http-api/internal/middlewares"
+	"lqkhoi-go-http-api/internal/models"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupWorklogRoutes(prefixApp fiber.Router, h *handler.WorklogHandler, lm fiber.Handler, am fiber.Handler) {
+	log := prefixApp.Group("/")
+	log.Use(lm)
+
+	authenticated := log.Group("/")
+	authenticated.Use(am)
+	authenticated.Get("/tasks/:taskId/worklogs", h.ListWorklogs)
+	authenticated.Post("/tasks/:taskId/worklogs", h.CreateWorklog)
+	authenticated.Put("/tasks/:taskId/worklogs/:worklogId", h.UpdateWorklog)
+	authenticated.Delete("/tasks/:taskId/worklogs/:worklogId", h.DeleteWorklog)
+	authenticated.Get("/users/:userId/timesheet", middlewares.RequireOwnerOrAdmin(), h.GetTimesheet)
+
+	projectManagerOnly := authenticated.Group("/")
+	projectManagerOnly.Use(middlewares.RequireRoleIs(models.ProjectManager))
+	projectManagerOnly.Get("/projects/:projectId/worklogs/totals", h.GetProjectTotals)
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/events"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"gorm.io/gorm/schema"
+)
+
+// activitySchemas caches the parsed schemas used to read the previous values
+// of updated columns.
+var activitySchemas sync.Map
+
+//go:generate mockgen -destination=./mocks/mock_activity.go -package=mocks . ActivityService
+
+type ActivityService interface {
+	Record(ctx context.Context, activity *models.ActivityLog)
+	ListProjectActivity(ctx context.Context, projectID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error)
+	ListEntityHistory(ctx context.Context, entityType models.ActivityEntityType, entityID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error)
+}
+
+type activityService struct {
+	activityRepository repository.ActivityRepository
+	publisher          events.Publisher
+}
+
+func NewActivityService(activityRepository repository.ActivityRepository, publisher events.Publisher) ActivityService {
+	return &activityService{
+		activityRepository: activityRepository,
+		publisher:          publisher,
+	}
+}
+
+// Record appends an activity to the log and publishes the domain events it
+// describes. Recording is best effort: the change it describes is already
+// committed, so a failure is only logged.
+func (s *activityService) Record(ctx context.Context, activity *models.ActivityLog) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ActivityService",
+		"method", "Record",
+		"entity_type", activity.EntityType,
+		"entity_id", activity.EntityID,
+		"action", activity.Action,
+	)
+
+	if activity.Action == models.ActivityUpdate && len(activity.Changes) == 0 {
+		logger.Debug("Update changed no field, skipping activity")
+		return
+	}
+
+	if _, err := s.activityRepository.Create(ctx, activity); err != nil {
+		logger.Error("Failed to record activity", "error", err)
+		return
+	}
+	logger.Debug("Activity recorded", "change_count", len(activity.Changes))
+
+	if s.publisher != nil {
+		s.publisher.Publish(ctx, events.FromActivity(activity)...)
+	}
+}
+
+func (s *activityService) ListProjectActivity(ctx context.Context, projectID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error) {
+	return s.activityRepository.FindByProjectID(ctx, projectID, page)
+}
+
+func (s *activityService) ListEntityHistory(ctx context.Context, entityType models.ActivityEntityType, entityID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error) {
+	return s.activityRepository.FindByEntity(ctx, entityType, entityID, page)
+}
+
+func newActivity(actorID, projectID int, entityType models.ActivityEntityType, entityID int, action models.ActivityAction, changes []models.ActivityChange) *models.ActivityLog {
+	return &models.ActivityLog{
+		ActorID:    actorID,
+		ProjectID:  projectID,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Changes:    changes,
+	}
+}
+
+// snapshotChanges lists the non-zero columns of model among columns as
+// created values, or as removed values when removed is true.
+func snapshotChanges(model any, removed bool, columns ...string) []models.ActivityChange {
+	changes := make([]models.ActivityChange, 0, len(columns))
+	for _, column := range columns {
+		value, ok := modelColumnValue(model, column)
+		if !ok || value == nil || reflect.ValueOf(value).IsZero() {
+			continue
+		}
+		formatted := formatActivityValue(value)
+		if formatted == nil {
+			continue
+		}
+		change := models.ActivityChange{Field: column}
+		if removed {
+			change.OldValue = formatted
+		} else {
+			change.NewValue = formatted
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// updateChanges compares updateMap with the values held by before, the model
+// as it was loaded prior to the update, and returns the columns that change.
+func updateChanges(before any, updateMap map[string]any) []models.ActivityChange {
+	columns := utils.MapKeys(updateMap)
+	sort.Strings(columns)
+
+	changes := make([]models.ActivityChange, 0, len(columns))
+	for _, column := range columns {
+		var oldValue *string
+		if value, ok := modelColumnValue(before, column); ok {
+			oldValue = formatActivityValue(value)
+		}
+		newValue := formatActivityValue(updateMap[column])
+		if equalActivityValues(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, models.ActivityChange{
+			Field:    column,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+	return changes
+}
+
+// valueChange returns the change of a single field between two values.
+func valueChange(column string, oldValue, newValue any) []models.ActivityChange {
+	change := models.ActivityChange{
+		Field:    column,
+		OldValue: formatActivityValue(oldValue),
+		NewValue: formatActivityValue(newValue),
+	}
+	if equalActivityValues(change.OldValue, change.NewValue) {
+		return nil
+	}
+	return []models.ActivityChange{change}
+}
+
+func modelColumnValue(model any, column string) (any, bool) {
+	s, err := schema.Parse(model, &activitySchemas, schema.NamingStrategy{})
+	if err != nil {
+		return nil, false
+	}
+	f := s.LookUpField(column)
+	if f == nil {
+		return nil, false
+	}
+	value, _ := f.ValueOf(context.Background(), reflect.Indirect(reflect.ValueOf(model)))
+	return value, true
+}
+
+// formatActivityValue renders a column value as stored in the activity log;
+// nil and nil pointers have no value.
+func formatActivityValue(value any) *string {
+	if value == nil {
+		return nil
+	}
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	var formatted string
+	switch t := v.Interface().(type) {
+	case time.Time:
+		formatted = t.UTC().Format(time.RFC3339)
+	default:
+		formatted = fmt.Sprint(t)
+	}
+	return &formatted
+}
+
+func equalActivityValues(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
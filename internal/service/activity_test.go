@@ -0,0 +1,51 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"lqkhoi-go-http-api/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateChanges(t *testing.T) {
+	due := time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC)
+	assigneeID := 7
+	before := &models.Task{
+		Title:      "Implement login API",
+		Status:     models.ToDoTask,
+		Priority:   models.HighPriority,
+		AssigneeID: &assigneeID,
+	}
+
+	changes := updateChanges(before, map[string]any{
+		"title":          "Implement login API",
+		"status":         models.InProgressTask,
+		"due_date":       &due,
+		"parent_task_id": nil,
+	})
+
+	str := func(s string) *string { return &s }
+	assert.Equal(t, []models.ActivityChange{
+		{Field: "due_date", OldValue: nil, NewValue: str("2025-04-20T00:00:00Z")},
+		{Field: "status", OldValue: str("TO_DO"), NewValue: str("IN_PROGRESS")},
+	}, changes)
+}
+
+func TestSnapshotChanges(t *testing.T) {
+	sprintID := 3
+	task := &models.Task{Title: "Write docs", Status: models.ToDoTask, SprintID: &sprintID}
+
+	created := snapshotChanges(task, false, "title", "status", "sprint_id", "parent_task_id", "unknown")
+	assert.Len(t, created, 3)
+	for _, change := range created {
+		assert.Nil(t, change.OldValue)
+		assert.NotNil(t, change.NewValue)
+	}
+	assert.Equal(t, "3", *created[2].NewValue)
+
+	removed := snapshotChanges(task, true, "title")
+	assert.Equal(t, "Write docs", *removed[0].OldValue)
+	assert.Nil(t, removed[0].NewValue)
+}
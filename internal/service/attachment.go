@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/internal/storage"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// maxAttachmentFileNameLength is the size of the file_name column.
+const maxAttachmentFileNameLength = 255
+
+type AttachmentService interface {
+	UploadAttachment(ctx context.Context, userID, taskID int, attachment *models.Attachment, content io.Reader) (*models.Attachment, error)
+	ListAttachments(ctx context.Context, userID, taskID int) ([]*models.Attachment, error)
+	OpenAttachment(ctx context.Context, userID, taskID, attachmentID int) (*models.Attachment, io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, userID, taskID, attachmentID int) error
+}
+
+type attachmentService struct {
+	attachmentRepository repository.AttachmentRepository
+	storage              storage.Storage
+	taskService          TaskService
+}
+
+func NewAttachmentService(attachmentRepository repository.AttachmentRepository, storage storage.Storage, taskService TaskService) AttachmentService {
+	return &attachmentService{
+		attachmentRepository: attachmentRepository,
+		storage:              storage,
+		taskService:          taskService,
+	}
+}
+
+// UploadAttachment stores content and records attachment, whose FileName,
+// ContentType and Size are set by the caller, on the task. Project viewers
+// may not upload. Size and type limits are enforced before the upload
+// reaches the service.
+func (s *attachmentService) UploadAttachment(ctx context.Context, userID, taskID int, attachment *models.Attachment, content io.Reader) (*models.Attachment, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "AttachmentService",
+		"method", "UploadAttachment",
+		"task_id", taskID,
+		"requestor_id", userID,
+		"file_name", attachment.FileName,
+		"size", attachment.Size,
+	)
+
+	logger.Info("Starting attachment upload process")
+	if _, err := s.taskService.GetAndVerifyContributorForTask(ctx, logger, userID, taskID); err != nil {
+		return nil, fmt.Errorf("cannot attach file to task %d: %w", taskID, err)
+	}
+
+	attachment.TaskID = taskID
+	attachment.UploadedByID = userID
+	attachment.FileName = sanitizeFileName(attachment.FileName)
+	attachment.StorageKey = fmt.Sprintf("tasks/%d/%s", taskID, uuid.NewString())
+
+	if err := s.storage.Put(ctx, attachment.StorageKey, content); err != nil {
+		logger.Error("Failed to store attachment content", "error", err)
+		return nil, fmt.Errorf("cannot store file for task %d: %w", taskID, structs.ErrStorageFail)
+	}
+
+	created, err := s.attachmentRepository.Create(ctx, attachment)
+	if err != nil {
+		logger.Error("Failed to create attachment in repository", "error", err)
+		if delErr := s.storage.Delete(ctx, attachment.StorageKey); delErr != nil {
+			logger.Error("Failed to remove stored content of unsaved attachment", "storage_key", attachment.StorageKey, "error", delErr)
+		}
+		return nil, fmt.Errorf("repository create failed for attachment of task %d: %w", taskID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Attachment uploaded successfully", "attachment_id", created.ID)
+	return created, nil
+}
+
+func (s *attachmentService) ListAttachments(ctx context.Context, userID, taskID int) ([]*models.Attachment, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "AttachmentService",
+		"method", "ListAttachments",
+		"task_id", taskID,
+		"requestor_id", userID,
+	)
+
+	if _, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, false); err != nil {
+		return nil, fmt.Errorf("cannot list attachments of task %d: %w", taskID, err)
+	}
+
+	attachments, err := s.attachmentRepository.FindByTaskID(ctx, taskID)
+	if err != nil {
+		logger.Error("Failed to find attachments of task", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Attachments found", "count", len(attachments))
+	return attachments, nil
+}
+
+// OpenAttachment returns the attachment with its content, which the caller closes.
+func (s *attachmentService) OpenAttachment(ctx context.Context, userID, taskID, attachmentID int) (*models.Attachment, io.ReadCloser, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "AttachmentService",
+		"method", "OpenAttachment",
+		"task_id", taskID,
+		"attachment_id", attachmentID,
+		"requestor_id", userID,
+	)
+
+	attachment, err := s.findTaskAttachment(ctx, userID, taskID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, structs.ErrStorageObjectNotExist) {
+			logger.Error("Stored content of attachment is missing", "storage_key", attachment.StorageKey)
+			return nil, nil, fmt.Errorf("content of attachment %d: %w", attachmentID, err)
+		}
+		logger.Error("Failed to open attachment content", "error", err)
+		return nil, nil, fmt.Errorf("cannot open attachment %d: %w", attachmentID, structs.ErrStorageFail)
+	}
+
+	logger.Info("Attachment opened")
+	return attachment, content, nil
+}
+
+// DeleteAttachment removes an attachment. Only its uploader or a manager of
+// the project may delete it.
+func (s *attachmentService) DeleteAttachment(ctx context.Context, userID, taskID, attachmentID int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "AttachmentService",
+		"method", "DeleteAttachment",
+		"task_id", taskID,
+		"attachment_id", attachmentID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting attachment deletion process")
+	attachment, err := s.findTaskAttachment(ctx, userID, taskID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	if attachment.UploadedByID != userID {
+		logger.Debug("Requestor is not the uploader, checking manager privileges")
+		if _, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, true); err != nil {
+			if errors.Is(err, structs.ErrUserNotManageProject) {
+				return fmt.Errorf("user %d cannot delete attachment %d: %w", userID, attachmentID, structs.ErrUserNotAttachmentUploader)
+			}
+			return err
+		}
+	}
+
+	if err := s.attachmentRepository.Delete(ctx, attachmentID); err != nil {
+		if errors.Is(err, structs.ErrAttachmentNotExist) {
+			return fmt.Errorf("%w with id %d", err, attachmentID)
+		}
+		logger.Error("Failed to delete attachment in repository", "error", err)
+		return fmt.Errorf("repository delete failed for attachment %d: %w", attachmentID, structs.ErrDatabaseFail)
+	}
+
+	// The metadata is gone, so a leftover file is only logged.
+	if err := s.storage.Delete(ctx, attachment.StorageKey); err != nil {
+		logger.Error("Failed to remove stored content of deleted attachment", "storage_key", attachment.StorageKey, "error", err)
+	}
+
+	logger.Info("Successfully deleted attachment")
+	return nil
+}
+
+// findTaskAttachment verifies the user may read the task and returns the
+// attachment, which must belong to it.
+func (s *attachmentService) findTaskAttachment(ctx context.Context, userID, taskID, attachmentID int) (*models.Attachment, error) {
+	logger := utils.LoggerFromContext(ctx).With(
+		"component", "AttachmentService",
+		"task_id", taskID,
+		"attachment_id", attachmentID,
+	)
+
+	if _, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, false); err != nil {
+		return nil, fmt.Errorf("cannot access attachments of task %d: %w", taskID, err)
+	}
+
+	attachment, err := s.attachmentRepository.FindByID(ctx, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+	if attachment.TaskID != taskID {
+		logger.Warn("Attachment belongs to another task", "attachment_task_id", attachment.TaskID)
+		return nil, fmt.Errorf("%w with id %d on task %d", structs.ErrAttachmentNotExist, attachmentID, taskID)
+	}
+	return attachment, nil
+}
+
+// sanitizeFileName keeps the base name of a client supplied file name,
+// without control characters and within the size of the column.
+func sanitizeFileName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	if name == "." || name == "/" || name == "" {
+		name = "file"
+	}
+	if len(name) > maxAttachmentFileNameLength {
+		ext := filepath.Ext(name)
+		if len(ext) > 16 {
+			ext = ""
+		}
+		name = strings.ToValidUTF8(name[:maxAttachmentFileNameLength-len(ext)], "") + ext
+	}
+	return name
+}
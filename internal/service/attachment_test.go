@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeFileName(t *testing.T) {
+	assert.Equal(t, "spec.pdf", sanitizeFileName("spec.pdf"))
+	assert.Equal(t, "passwd", sanitizeFileName("../../etc/passwd"))
+	assert.Equal(t, "shot.png", sanitizeFileName(`C:\Users\me\shot.png`))
+	assert.Equal(t, "ab.txt", sanitizeFileName("a\"\nb.txt"))
+	assert.Equal(t, "file", sanitizeFileName(""))
+
+	long := sanitizeFileName(strings.Repeat("x", 300) + ".png")
+	assert.Len(t, long, maxAttachmentFileNameLength)
+	assert.True(t, strings.HasSuffix(long, ".png"))
+}
+
+func TestAttachmentService_UploadAttachment_ViewerDenied(t *testing.T) {
+	taskService := &taskService{
+		taskRepository: &stubTaskRepository{tasks: []*models.Task{{ID: 3, ProjectID: 1}}},
+		projectService: &stubProjectService{projectID: 1, viewerIDs: []int{8}},
+	}
+	s := NewAttachmentService(nil, nil, taskService)
+
+	_, err := s.UploadAttachment(context.Background(), 8, 3, &models.Attachment{FileName: "spec.pdf"}, strings.NewReader("%PDF"))
+
+	assert.ErrorIs(t, err, structs.ErrUserNotAuthorizedForTask)
+}
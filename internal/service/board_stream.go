@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"lqkhoi-go-http-api/internal/events"
+	"lqkhoi-go-http-api/internal/realtime"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+// boardEventTypes lists the events that change what a board shows.
+var boardEventTypes = []events.Type{
+	events.TaskCreated,
+	events.TaskUpdated,
+	events.TaskStatusChanged,
+	events.TaskAssigned,
+	events.TaskDeleted,
+}
+
+// BoardStreamService streams the task events of a project to the members
+// watching its board, whichever instance of the API they are connected to.
+type BoardStreamService interface {
+	Subscribe(ctx context.Context, userID, projectID int) (<-chan events.Event, func(), error)
+	VerifySubscriber(ctx context.Context, userID, projectID int) error
+	HandleEvent(ctx context.Context, event events.Event)
+}
+
+type boardStreamService struct {
+	broker         realtime.Broker
+	hub            *realtime.Hub
+	projectService ProjectService
+}
+
+func NewBoardStreamService(broker realtime.Broker, hub *realtime.Hub, projectService ProjectService) BoardStreamService {
+	return &boardStreamService{
+		broker:         broker,
+		hub:            hub,
+		projectService: projectService,
+	}
+}
+
+// Subscribe returns the task events of the project from now on and a
+// function that ends the subscription; any member of the project may
+// subscribe. The channel is closed when the subscription ends.
+func (s *boardStreamService) Subscribe(ctx context.Context, userID, projectID int) (<-chan events.Event, func(), error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "BoardStreamService",
+		"method", "Subscribe",
+		"project_id", projectID,
+		"requestor_id", userID,
+	)
+
+	logger.Debug("Fetching project by ID")
+	if _, err := s.projectService.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return nil, nil, fmt.Errorf("cannot stream board: %w with id %d", err, projectID)
+		}
+		return nil, nil, err
+	}
+
+	logger.Debug("Verifying requestor is a project member")
+	if err := s.VerifySubscriber(ctx, userID, projectID); err != nil {
+		return nil, nil, err
+	}
+
+	ch, unsubscribe := s.hub.Subscribe(projectID)
+	logger.Info("Board stream subscribed", "subscribers", s.hub.Subscribers(projectID))
+	return ch, unsubscribe, nil
+}
+
+// VerifySubscriber checks that the user is still a member of the project, so
+// a stream opened before the user was removed from it can be ended.
+func (s *boardStreamService) VerifySubscriber(ctx context.Context, userID, projectID int) error {
+	if _, err := s.projectService.GetProjectMember(ctx, userID, projectID); err != nil {
+		if errors.Is(err, structs.ErrUserNotPartProject) {
+			return fmt.Errorf("user %d cannot stream board of project %d: %w", userID, projectID, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// HandleEvent publishes the task events to every instance, including this
+// one, which hands them to the subscribers of the project.
+func (s *boardStreamService) HandleEvent(ctx context.Context, event events.Event) {
+	if !slices.Contains(boardEventTypes, event.Type) {
+		return
+	}
+
+	if err := s.broker.Publish(ctx, event); err != nil {
+		utils.LoggerFromContext(ctx).Error("Failed to publish board event",
+			"component", "BoardStreamService",
+			"method", "HandleEvent",
+			"event_id", event.ID,
+			"event_type", event.Type,
+			"error", err)
+	}
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/events"
+	"lqkhoi-go-http-api/internal/realtime"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// localBroker hands published events straight to the hub, like Redis would
+// on a single instance.
+type localBroker struct {
+	hub       *realtime.Hub
+	published []events.Event
+}
+
+func (b *localBroker) Publish(ctx context.Context, event events.Event) error {
+	b.published = append(b.published, event)
+	b.hub.Broadcast(event)
+	return nil
+}
+
+func (b *localBroker) Run(ctx context.Context) {}
+
+func TestBoardStreamService(t *testing.T) {
+	ctx := context.Background()
+	hub := realtime.NewHub(4)
+	broker := &localBroker{hub: hub}
+	s := NewBoardStreamService(broker, hub, &stubProjectService{projectID: 1, memberIDs: []int{7}})
+
+	t.Run("members receive task events", func(t *testing.T) {
+		stream, unsubscribe, err := s.Subscribe(ctx, 7, 1)
+		require.NoError(t, err)
+		defer unsubscribe()
+
+		s.HandleEvent(ctx, events.Event{ID: "evt-1", Type: events.TaskAssigned, ProjectID: 1})
+		s.HandleEvent(ctx, events.Event{ID: "evt-2", Type: events.SprintStarted, ProjectID: 1})
+
+		require.Len(t, stream, 1)
+		assert.Equal(t, "evt-1", (<-stream).ID)
+	})
+
+	t.Run("non members cannot subscribe", func(t *testing.T) {
+		_, _, err := s.Subscribe(ctx, 8, 1)
+		assert.ErrorIs(t, err, structs.ErrUserNotPartProject)
+	})
+
+	t.Run("removed members fail verification", func(t *testing.T) {
+		assert.NoError(t, s.VerifySubscriber(ctx, 7, 1))
+		assert.ErrorIs(t, s.VerifySubscriber(ctx, 8, 1), structs.ErrUserNotPartProject)
+	})
+
+	t.Run("unknown project cannot be subscribed", func(t *testing.T) {
+		_, _, err := s.Subscribe(ctx, 7, 2)
+		assert.ErrorIs(t, err, structs.ErrProjectNotExist)
+	})
+}
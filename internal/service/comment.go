@@ -0,0 +1,379 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+// maxCommentDepth bounds how deep reply threads may grow, a top-level comment
+// being at depth 1.
+const maxCommentDepth = 50
+
+// mentionPattern matches "@" followed by an email address, e.g. "@jane@example.com".
+var mentionPattern = regexp.MustCompile(`@([A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,})`)
+
+type CommentService interface {
+	CreateComment(ctx context.Context, userID, taskID int, comment *models.Comment) (*models.Comment, error)
+	ListComments(ctx context.Context, userID, taskID int, page *dto.PageRequest) ([]*models.Comment, *dto.PageInfo, error)
+	UpdateComment(ctx context.Context, userID, taskID, commentID int, body string) (*models.Comment, error)
+	DeleteComment(ctx context.Context, userID, taskID, commentID int) error
+	ListCommentEdits(ctx context.Context, userID, taskID, commentID int) ([]*models.CommentEdit, error)
+}
+
+type commentService struct {
+	commentRepository repository.CommentRepository
+	taskService       TaskService
+	userService       UserService
+}
+
+func NewCommentService(commentRepository repository.CommentRepository, taskService TaskService, userService UserService) CommentService {
+	return &commentService{
+		commentRepository: commentRepository,
+		taskService:       taskService,
+		userService:       userService,
+	}
+}
+
+func (s *commentService) CreateComment(ctx context.Context, userID, taskID int, comment *models.Comment) (*models.Comment, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentService",
+		"method", "CreateComment",
+		"task_id", taskID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting comment creation process")
+	if _, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, false); err != nil {
+		return nil, fmt.Errorf("cannot comment on task %d: %w", taskID, err)
+	}
+
+	if comment.ParentCommentID != nil {
+		parent, err := s.commentRepository.FindByID(ctx, *comment.ParentCommentID)
+		if err != nil {
+			if errors.Is(err, structs.ErrCommentNotExist) {
+				return nil, fmt.Errorf("%w with id %d", structs.ErrParentCommentNotExist, *comment.ParentCommentID)
+			}
+			return nil, err
+		}
+		if parent.TaskID != taskID {
+			logger.Warn("Parent comment belongs to another task", "parent_task_id", parent.TaskID)
+			return nil, fmt.Errorf("%w with id %d on task %d", structs.ErrParentCommentNotExist, parent.ID, taskID)
+		}
+
+		depth, err := s.commentDepth(ctx, parent)
+		if err != nil {
+			logger.Error("Failed to compute parent comment depth", "error", err)
+			return nil, err
+		}
+		if depth+1 > maxCommentDepth {
+			logger.Warn("Reply would exceed the maximum thread depth", "parent_depth", depth, "max_depth", maxCommentDepth)
+			return nil, fmt.Errorf("cannot reply to comment %d, replies nest at most %d levels: %w", parent.ID, maxCommentDepth, structs.ErrCommentThreadTooDeep)
+		}
+	}
+
+	mentions, err := s.resolveMentions(ctx, comment.Body)
+	if err != nil {
+		logger.Error("Failed to resolve mentions", "error", err)
+		return nil, err
+	}
+
+	comment.TaskID = taskID
+	comment.AuthorID = userID
+	comment.Mentions = mentions
+
+	created, err := s.commentRepository.Create(ctx, comment)
+	if err != nil {
+		logger.Error("Failed to create comment in repository", "error", err)
+		return nil, fmt.Errorf("repository create failed for comment on task %d: %w", taskID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Comment created successfully", "comment_id", created.ID, "mention_count", len(mentions))
+
+	full, err := s.commentRepository.FindByID(ctx, created.ID)
+	if err != nil {
+		return created, nil
+	}
+	return full, nil
+}
+
+// ListComments returns a page of top-level comments of the task, each one
+// carrying its whole reply thread.
+func (s *commentService) ListComments(ctx context.Context, userID, taskID int, page *dto.PageRequest) ([]*models.Comment, *dto.PageInfo, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentService",
+		"method", "ListComments",
+		"task_id", taskID,
+		"requestor_id", userID,
+	)
+
+	if _, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, false); err != nil {
+		return nil, nil, fmt.Errorf("cannot list comments of task %d: %w", taskID, err)
+	}
+
+	roots, pageInfo, err := s.commentRepository.FindRootsByTaskID(ctx, taskID, page)
+	if err != nil {
+		logger.Error("Failed to find comments of task", "error", err)
+		return nil, nil, err
+	}
+
+	levels, err := s.collectReplies(ctx, roots)
+	if err != nil {
+		logger.Error("Failed to load reply threads", "error", err)
+		return nil, nil, err
+	}
+	attachReplies(levels)
+
+	logger.Info("Comments found", "count", len(roots))
+	return roots, pageInfo, nil
+}
+
+func (s *commentService) UpdateComment(ctx context.Context, userID, taskID, commentID int, body string) (*models.Comment, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentService",
+		"method", "UpdateComment",
+		"task_id", taskID,
+		"comment_id", commentID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting comment update process")
+	comment, err := s.findTaskComment(ctx, userID, taskID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.AuthorID != userID {
+		logger.Warn("Authorization failed: User is not the author of the comment", "author_id", comment.AuthorID)
+		return nil, fmt.Errorf("user %d cannot edit comment %d: %w", userID, commentID, structs.ErrUserNotCommentAuthor)
+	}
+
+	mentions, err := s.resolveMentions(ctx, body)
+	if err != nil {
+		logger.Error("Failed to resolve mentions", "error", err)
+		return nil, err
+	}
+
+	comment.Body = body
+	if err := s.commentRepository.UpdateBody(ctx, comment, userID, mentions); err != nil {
+		logger.Error("Failed to update comment in repository", "error", err)
+		return nil, fmt.Errorf("repository update failed for comment %d: %w", commentID, err)
+	}
+
+	logger.Info("Comment updated successfully", "mention_count", len(mentions))
+
+	updated, err := s.commentRepository.FindByID(ctx, commentID)
+	if err != nil {
+		return comment, nil
+	}
+	return updated, nil
+}
+
+// DeleteComment deletes the comment together with all of its replies. Only
+// the author or a manager of the project may delete a comment.
+func (s *commentService) DeleteComment(ctx context.Context, userID, taskID, commentID int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentService",
+		"method", "DeleteComment",
+		"task_id", taskID,
+		"comment_id", commentID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting comment deletion process")
+	comment, err := s.findTaskComment(ctx, userID, taskID, commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.AuthorID != userID {
+		logger.Debug("Requestor is not the author, checking manager privileges")
+		if _, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, true); err != nil {
+			if errors.Is(err, structs.ErrUserNotManageProject) {
+				return fmt.Errorf("user %d cannot delete comment %d: %w", userID, commentID, structs.ErrUserNotCommentAuthor)
+			}
+			return err
+		}
+	}
+
+	levels, err := s.collectReplies(ctx, []*models.Comment{comment})
+	if err != nil {
+		logger.Error("Failed to load reply thread", "error", err)
+		return err
+	}
+
+	commentIDs := make([]int, 0)
+	for _, level := range levels {
+		for _, c := range level {
+			commentIDs = append(commentIDs, c.ID)
+		}
+	}
+
+	logger.Debug("Attempting comment deletion", "comment_ids", commentIDs)
+	if err := s.commentRepository.DeleteByIDs(ctx, commentIDs); err != nil {
+		logger.Error("Failed to delete comments in repository", "error", err)
+		return fmt.Errorf("repository delete failed for comment %d: %w", commentID, err)
+	}
+
+	logger.Info("Successfully deleted comment", "deleted_count", len(commentIDs))
+	return nil
+}
+
+func (s *commentService) ListCommentEdits(ctx context.Context, userID, taskID, commentID int) ([]*models.CommentEdit, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "CommentService",
+		"method", "ListCommentEdits",
+		"task_id", taskID,
+		"comment_id", commentID,
+		"requestor_id", userID,
+	)
+
+	if _, err := s.findTaskComment(ctx, userID, taskID, commentID); err != nil {
+		return nil, err
+	}
+
+	edits, err := s.commentRepository.FindEditsByCommentID(ctx, commentID)
+	if err != nil {
+		logger.Error("Failed to find comment edits", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Comment edits found", "count", len(edits))
+	return edits, nil
+}
+
+// findTaskComment checks that the user can read the task and returns the
+// comment, which must belong to that task.
+func (s *commentService) findTaskComment(ctx context.Context, userID, taskID, commentID int) (*models.Comment, error) {
+	logger := utils.LoggerFromContext(ctx).With(
+		"component", "CommentService",
+		"method", "findTaskComment",
+	)
+
+	if _, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, false); err != nil {
+		return nil, fmt.Errorf("cannot access comments of task %d: %w", taskID, err)
+	}
+
+	comment, err := s.commentRepository.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.TaskID != taskID {
+		logger.Warn("Comment belongs to another task", "comment_task_id", comment.TaskID)
+		return nil, fmt.Errorf("%w with id %d on task %d", structs.ErrCommentNotExist, commentID, taskID)
+	}
+	return comment, nil
+}
+
+// resolveMentions turns the @email mentions of body into mention records for
+// the matching users; emails that do not belong to a user are ignored.
+func (s *commentService) resolveMentions(ctx context.Context, body string) ([]models.CommentMention, error) {
+	emails := parseMentionEmails(body)
+	if len(emails) == 0 {
+		return nil, nil
+	}
+
+	users, err := s.userService.FindByEmails(ctx, emails)
+	if err != nil {
+		return nil, err
+	}
+
+	mentions := make([]models.CommentMention, len(users))
+	for i, user := range users {
+		mentions[i].UserID = user.ID
+	}
+	return mentions, nil
+}
+
+// parseMentionEmails returns the distinct, lower-cased emails mentioned in
+// body, in order of first appearance.
+func parseMentionEmails(body string) []string {
+	matches := mentionPattern.FindAllStringSubmatch(body, -1)
+	seen := make(map[string]struct{}, len(matches))
+	emails := make([]string, 0, len(matches))
+	for _, m := range matches {
+		email := strings.ToLower(strings.TrimRight(m[1], "."))
+		if _, ok := seen[email]; ok {
+			continue
+		}
+		seen[email] = struct{}{}
+		emails = append(emails, email)
+	}
+	return emails
+}
+
+// commentDepth returns the level of the comment in its thread, a top-level
+// comment having depth 1.
+func (s *commentService) commentDepth(ctx context.Context, comment *models.Comment) (int, error) {
+	depth := 1
+	current := comment
+	for current.ParentCommentID != nil {
+		if depth >= maxCommentDepth {
+			return 0, fmt.Errorf("comment %d: %w", comment.ID, structs.ErrCommentThreadTooDeep)
+		}
+		parent, err := s.commentRepository.FindByID(ctx, *current.ParentCommentID)
+		if err != nil {
+			return 0, err
+		}
+		current = parent
+		depth++
+	}
+	return depth, nil
+}
+
+// collectReplies loads the reply threads below roots level by level. The
+// first level only contains roots. Threads deeper than maxCommentDepth are
+// an error rather than being cut short.
+func (s *commentService) collectReplies(ctx context.Context, roots []*models.Comment) ([][]*models.Comment, error) {
+	levels := [][]*models.Comment{roots}
+
+	for {
+		current := levels[len(levels)-1]
+		if len(current) == 0 {
+			return levels, nil
+		}
+		parentIDs := make([]int, len(current))
+		for i, c := range current {
+			parentIDs[i] = c.ID
+		}
+
+		replies, err := s.commentRepository.FindByParentIDs(ctx, parentIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(replies) == 0 {
+			return levels, nil
+		}
+		if len(levels) >= maxCommentDepth {
+			return nil, fmt.Errorf("reply %d: %w", replies[0].ID, structs.ErrCommentThreadTooDeep)
+		}
+		levels = append(levels, replies)
+	}
+}
+
+// attachReplies links every level produced by collectReplies to its parents,
+// starting from the deepest level so each copy already carries its replies.
+func attachReplies(levels [][]*models.Comment) {
+	for i := len(levels) - 1; i > 0; i-- {
+		byParent := make(map[int][]models.Comment)
+		for _, reply := range levels[i] {
+			byParent[*reply.ParentCommentID] = append(byParent[*reply.ParentCommentID], *reply)
+		}
+		for _, parent := range levels[i-1] {
+			parent.Replies = byParent[parent.ID]
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubCommentRepository serves a fixed set of comments.
+type stubCommentRepository struct {
+	repository.CommentRepository
+	comments []*models.Comment
+}
+
+func (r *stubCommentRepository) FindByID(ctx context.Context, id int) (*models.Comment, error) {
+	for _, comment := range r.comments {
+		if comment.ID == id {
+			return comment, nil
+		}
+	}
+	return nil, structs.ErrCommentNotExist
+}
+
+func (r *stubCommentRepository) FindByParentIDs(ctx context.Context, parentIDs []int) ([]*models.Comment, error) {
+	var replies []*models.Comment
+	for _, comment := range r.comments {
+		if comment.ParentCommentID != nil && slices.Contains(parentIDs, *comment.ParentCommentID) {
+			replies = append(replies, comment)
+		}
+	}
+	return replies, nil
+}
+
+// commentThread returns a chain of depth comments on task 1, each replying to
+// the previous one.
+func commentThread(depth int) []*models.Comment {
+	thread := make([]*models.Comment, depth)
+	for i := range thread {
+		thread[i] = &models.Comment{ID: i + 1, TaskID: 1}
+		if i > 0 {
+			parentID := i
+			thread[i].ParentCommentID = &parentID
+		}
+	}
+	return thread
+}
+
+func TestCommentService_CommentDepth(t *testing.T) {
+	ctx := context.Background()
+	thread := commentThread(maxCommentDepth)
+	s := &commentService{commentRepository: &stubCommentRepository{comments: thread}}
+
+	depth, err := s.commentDepth(ctx, thread[0])
+	require.NoError(t, err)
+	assert.Equal(t, 1, depth)
+
+	depth, err = s.commentDepth(ctx, thread[maxCommentDepth-1])
+	require.NoError(t, err)
+	assert.Equal(t, maxCommentDepth, depth)
+}
+
+// stubCommentTaskService lets everyone comment on any task.
+type stubCommentTaskService struct {
+	TaskService
+}
+
+func (s *stubCommentTaskService) GetAndVerifyProjectManagerForTask(ctx context.Context, baseLogger *slog.Logger, userID, taskID int, isCommand bool) (*models.Task, error) {
+	return &models.Task{ID: taskID}, nil
+}
+
+func TestCommentService_CreateComment_ReplyTooDeep(t *testing.T) {
+	ctx := context.Background()
+	thread := commentThread(maxCommentDepth)
+	s := NewCommentService(&stubCommentRepository{comments: thread}, &stubCommentTaskService{}, nil)
+
+	parentID := thread[maxCommentDepth-1].ID
+	_, err := s.CreateComment(ctx, 7, 1, &models.Comment{Body: "one level too deep", ParentCommentID: &parentID})
+
+	assert.ErrorIs(t, err, structs.ErrCommentThreadTooDeep)
+}
+
+func TestCommentService_CollectReplies(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("thread at the maximum depth is loaded", func(t *testing.T) {
+		thread := commentThread(maxCommentDepth)
+		s := &commentService{commentRepository: &stubCommentRepository{comments: thread}}
+
+		levels, err := s.collectReplies(ctx, thread[:1])
+		require.NoError(t, err)
+		assert.Len(t, levels, maxCommentDepth)
+	})
+
+	t.Run("deeper thread is an error", func(t *testing.T) {
+		thread := commentThread(maxCommentDepth + 1)
+		s := &commentService{commentRepository: &stubCommentRepository{comments: thread}}
+
+		_, err := s.collectReplies(ctx, thread[:1])
+		assert.ErrorIs(t, err, structs.ErrCommentThreadTooDeep)
+	})
+}
+
+func TestParseMentionEmails(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{"no mention", "Looks good to me", []string{}},
+		{"plain email is not a mention", "Mail jane@example.com for access", []string{}},
+		{"single mention", "Thanks @jane@example.com!", []string{"jane@example.com"}},
+		{"trailing period", "Ping @john.doe@example.co.uk.", []string{"john.doe@example.co.uk"}},
+		{"duplicates case-insensitive", "@Jane@Example.com and @jane@example.com, also @bob@example.org", []string{"jane@example.com", "bob@example.org"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseMentionEmails(tt.body))
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"lqkhoi-go-http-api/internal/config"
+	"lqkhoi-go-http-api/internal/mailer"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+)
+
+//go:generate mockgen -destination=./mocks/mock_email.go -package=mocks . EmailService
+
+// EmailService prepares the notification emails of a change: to the new
+// assignee of a task, to the assignee of a task due soon and to the members
+// of a project whose sprint started. The services queue them in the outbox in
+// the same transaction as the change, so they survive restarts. Users who
+// turned the kind of email off, and users who caused the change, get none.
+type EmailService interface {
+	TaskAssignedEmails(ctx context.Context, actorID int, task *models.Task, assignee *models.User) ([]*models.OutboundEmail, error)
+	TaskDueSoonEmails(ctx context.Context, tasks []*models.Task) ([]*models.OutboundEmail, error)
+	SprintStartedEmails(ctx context.Context, actorID int, sprint *models.Sprint) ([]*models.OutboundEmail, error)
+	Notify()
+}
+
+type emailService struct {
+	userRepository          repository.UserRepository
+	taskRepository          repository.TaskRepository
+	projectMemberRepository repository.ProjectMemberRepository
+	dispatcher              *EmailDispatcher
+	cfg                     config.DateTimeConfig
+}
+
+func NewEmailService(userRepository repository.UserRepository, taskRepository repository.TaskRepository, projectMemberRepository repository.ProjectMemberRepository, dispatcher *EmailDispatcher, cfg config.DateTimeConfig) EmailService {
+	return &emailService{
+		userRepository:          userRepository,
+		taskRepository:          taskRepository,
+		projectMemberRepository: projectMemberRepository,
+		dispatcher:              dispatcher,
+		cfg:                     cfg,
+	}
+}
+
+// Notify wakes the dispatcher up once queued emails are committed.
+func (s *emailService) Notify() {
+	if s.dispatcher != nil {
+		s.dispatcher.Notify()
+	}
+}
+
+// TaskAssignedEmails prepares the email to assignee of task, which the actor
+// is assigning to them. The task is expected with its project.
+func (s *emailService) TaskAssignedEmails(ctx context.Context, actorID int, task *models.Task, assignee *models.User) ([]*models.OutboundEmail, error) {
+	if assignee.ID == actorID || !assignee.EmailOnAssignment {
+		return nil, nil
+	}
+	actor, err := s.userRepository.FindByID(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := mailer.TaskAssignedData{
+		RecipientName: displayName(assignee),
+		ActorName:     displayName(actor),
+		ProjectName:   projectName(task.Project),
+		TaskTitle:     task.Title,
+		Priority:      string(task.Priority),
+	}
+	if task.DueDate != nil {
+		data.DueDate = task.DueDate.Format(s.cfg.Format)
+	}
+	subject := fmt.Sprintf("[%s] You were assigned to %s", data.ProjectName, task.Title)
+	email, err := newOutboundEmail(assignee, mailer.TaskAssignedTemplate, subject, data)
+	if err != nil {
+		return nil, err
+	}
+	return []*models.OutboundEmail{email}, nil
+}
+
+// TaskDueSoonEmails prepares the due date reminders to the assignees of
+// tasks.
+func (s *emailService) TaskDueSoonEmails(ctx context.Context, tasks []*models.Task) ([]*models.OutboundEmail, error) {
+	var emails []*models.OutboundEmail
+	for _, dueTask := range tasks {
+		// The task is loaded again for its assignee and project.
+		task, err := s.taskRepository.FindByID(ctx, dueTask.ID)
+		if err != nil {
+			return nil, err
+		}
+		if task.Assignee == nil || task.DueDate == nil || !task.Assignee.EmailOnDueSoon {
+			continue
+		}
+
+		data := mailer.TaskDueSoonData{
+			RecipientName: displayName(task.Assignee),
+			ProjectName:   projectName(task.Project),
+			TaskTitle:     task.Title,
+			Status:        string(task.Status),
+			DueDate:       task.DueDate.Format(s.cfg.Format),
+		}
+		subject := fmt.Sprintf("[%s] %s is due on %s", data.ProjectName, task.Title, data.DueDate)
+		email, err := newOutboundEmail(task.Assignee, mailer.TaskDueSoonTemplate, subject, data)
+		if err != nil {
+			return nil, err
+		}
+		emails = append(emails, email)
+	}
+	return emails, nil
+}
+
+// SprintStartedEmails prepares the emails to the members of the project of
+// sprint, which the actor is starting. The sprint is expected with its
+// project and tasks.
+func (s *emailService) SprintStartedEmails(ctx context.Context, actorID int, sprint *models.Sprint) ([]*models.OutboundEmail, error) {
+	members, err := s.projectMemberRepository.FindByProjectID(ctx, sprint.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var emails []*models.OutboundEmail
+	subject := fmt.Sprintf("[%s] Sprint %s has started", projectName(sprint.Project), sprint.Name)
+	for _, member := range members {
+		if member.User == nil || member.UserID == actorID || !member.User.EmailOnSprintStart {
+			continue
+		}
+		email, err := newOutboundEmail(member.User, mailer.SprintStartedTemplate, subject, mailer.SprintStartedData{
+			RecipientName: displayName(member.User),
+			ProjectName:   projectName(sprint.Project),
+			SprintName:    sprint.Name,
+			Goal:          sprint.Goal,
+			StartDate:     sprint.StartDate.Format(s.cfg.Format),
+			EndDate:       sprint.EndDate.Format(s.cfg.Format),
+			TaskCount:     len(sprint.Tasks),
+		})
+		if err != nil {
+			return nil, err
+		}
+		emails = append(emails, email)
+	}
+	return emails, nil
+}
+
+// newOutboundEmail renders the template into a pending email to user.
+func newOutboundEmail(user *models.User, template mailer.Template, subject string, data any) (*models.OutboundEmail, error) {
+	body, err := mailer.Render(template, data)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &models.OutboundEmail{
+		UserID:        &user.ID,
+		To:            user.Email,
+		Subject:       storableText(subject, 255),
+		HTMLBody:      body,
+		Template:      string(template),
+		Status:        models.EmailPending,
+		NextAttemptAt: &now,
+	}, nil
+}
+
+func displayName(user *models.User) string {
+	if name := strings.TrimSpace(user.FirstName + " " + user.LastName); name != "" {
+		return name
+	}
+	return user.Email
+}
+
+func projectName(project *models.Project) string {
+	if project == nil {
+		return ""
+	}
+	return project.Name
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"lqkhoi-go-http-api/internal/config"
+	"lqkhoi-go-http-api/internal/mailer"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+// emailBatchSize bounds the due emails loaded at once.
+const emailBatchSize = 50
+
+// EmailDispatcher sends the pending emails of the outbox in the background,
+// retrying failed ones with exponential backoff.
+type EmailDispatcher struct {
+	outboundEmailRepository repository.OutboundEmailRepository
+	mailer                  mailer.Mailer
+	cfg                     config.MailConfig
+	wake                    chan struct{}
+}
+
+func NewEmailDispatcher(outboundEmailRepository repository.OutboundEmailRepository, m mailer.Mailer, cfg config.MailConfig) *EmailDispatcher {
+	return &EmailDispatcher{
+		outboundEmailRepository: outboundEmailRepository,
+		mailer:                  m,
+		cfg:                     cfg,
+		wake:                    make(chan struct{}, 1),
+	}
+}
+
+// Notify asks the dispatcher to look for due emails now rather than at the
+// next poll.
+func (d *EmailDispatcher) Notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run sends due emails until ctx is done.
+func (d *EmailDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval())
+	defer ticker.Stop()
+
+	for {
+		d.sendDue(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// sendDue sends the due emails batch by batch until none is left. Emails are
+// sent one at a time to go easy on the SMTP server.
+func (d *EmailDispatcher) sendDue(ctx context.Context) {
+	logger := utils.LoggerFromContext(ctx).With(
+		"component", "EmailDispatcher",
+		"method", "sendDue",
+	)
+
+	for ctx.Err() == nil {
+		due, err := d.outboundEmailRepository.FindDue(ctx, time.Now(), emailBatchSize)
+		if err != nil {
+			logger.Error("Failed to find due emails", "error", err)
+			return
+		}
+		if len(due) == 0 {
+			return
+		}
+
+		for _, email := range due {
+			// An attempt that never records its outcome is retried once the
+			// lease, longer than any attempt, runs out.
+			claimed, err := d.outboundEmailRepository.Claim(ctx, email, time.Now().Add(2*d.cfg.Timeout()+time.Minute))
+			if err != nil || !claimed {
+				continue
+			}
+			d.attempt(ctx, email)
+		}
+
+		if len(due) < emailBatchSize {
+			return
+		}
+	}
+}
+
+// attempt sends the email once and records the outcome: sent, another
+// attempt after the backoff, or failure once no attempt is left.
+func (d *EmailDispatcher) attempt(ctx context.Context, email *models.OutboundEmail) {
+	logger := utils.LoggerFromContext(ctx).With(
+		"component", "EmailDispatcher",
+		"method", "attempt",
+		"email_id", email.ID,
+		"template", email.Template,
+	)
+
+	attempts := email.Attempts + 1
+	attemptedAt := time.Now()
+	updateMap := map[string]any{
+		"attempts":        attempts,
+		"last_attempt_at": attemptedAt,
+		"error":           "",
+	}
+
+	sendErr := d.mailer.Send(ctx, mailer.Message{
+		To:       email.To,
+		Subject:  email.Subject,
+		HTMLBody: email.HTMLBody,
+	})
+
+	switch {
+	case sendErr == nil:
+		updateMap["status"] = models.EmailSent
+		updateMap["sent_at"] = time.Now()
+		updateMap["next_attempt_at"] = nil
+		logger.Info("Email sent", "attempts", attempts)
+	case attempts >= d.cfg.MaxAttempts:
+		updateMap["status"] = models.EmailFailed
+		updateMap["next_attempt_at"] = nil
+		updateMap["error"] = storableText(sendErr.Error(), 500)
+		logger.Warn("Email failed, no attempt left", "attempts", attempts, "error", sendErr)
+	default:
+		nextAttemptAt := time.Now().Add(d.cfg.Backoff(attempts))
+		updateMap["next_attempt_at"] = nextAttemptAt
+		updateMap["error"] = storableText(sendErr.Error(), 500)
+		logger.Warn("Email failed, retrying later", "attempts", attempts, "next_attempt_at", nextAttemptAt, "error", sendErr)
+	}
+
+	if err := d.outboundEmailRepository.Update(ctx, email.ID, updateMap); err != nil {
+		logger.Error("Failed to record email attempt", "error", err)
+	}
+}
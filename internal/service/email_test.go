@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"lqkhoi-go-http-api/internal/config"
+	"lqkhoi-go-http-api/internal/mailer"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubOutboundEmailRepository struct {
+	updates map[int]map[string]any
+}
+
+func (r *stubOutboundEmailRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*models.OutboundEmail, error) {
+	return nil, nil
+}
+
+func (r *stubOutboundEmailRepository) Claim(ctx context.Context, email *models.OutboundEmail, leaseUntil time.Time) (bool, error) {
+	return true, nil
+}
+
+func (r *stubOutboundEmailRepository) Update(ctx context.Context, id int, updateMap map[string]any) error {
+	if r.updates == nil {
+		r.updates = map[int]map[string]any{}
+	}
+	r.updates[id] = updateMap
+	return nil
+}
+
+// stubUserRepository serves the users the email service looks up.
+type stubUserRepository struct {
+	repository.UserRepository
+	users []*models.User
+}
+
+func (r *stubUserRepository) FindByID(ctx context.Context, id int) (*models.User, error) {
+	for _, user := range r.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return nil, structs.ErrUserNotExist
+}
+
+type stubProjectMemberRepository struct {
+	repository.ProjectMemberRepository
+	members []*models.ProjectMember
+}
+
+func (r *stubProjectMemberRepository) FindByProjectID(ctx context.Context, projectID int) ([]*models.ProjectMember, error) {
+	return r.members, nil
+}
+
+// fakeMailer records the messages it sends, failing with err when set.
+type fakeMailer struct {
+	sent []mailer.Message
+	err  error
+}
+
+func (m *fakeMailer) Send(ctx context.Context, msg mailer.Message) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func TestEmailService_TaskAssignedEmails(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.DateTimeConfig{Format: "2006-01-02"}
+	actor := &models.User{ID: 2, Email: "lead@example.com", FirstName: "Lea", LastName: "Dupont"}
+	dueDate := time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC)
+	task := &models.Task{ID: 3, ProjectID: 1, Title: "Design login page", Project: &models.Project{ID: 1, Name: "Website"}, DueDate: &dueDate}
+	s := NewEmailService(&stubUserRepository{users: []*models.User{actor}}, nil, nil, nil, cfg)
+
+	cases := []struct {
+		name     string
+		actorID  int
+		assignee *models.User
+		emailed  bool
+	}{
+		{name: "assignee is emailed", actorID: 2, assignee: &models.User{ID: 7, Email: "dev@example.com", FirstName: "Dev", EmailOnAssignment: true}, emailed: true},
+		{name: "opted out assignee is not emailed", actorID: 2, assignee: &models.User{ID: 7, Email: "dev@example.com"}},
+		{name: "self assignment is not emailed", actorID: 7, assignee: &models.User{ID: 7, Email: "dev@example.com", EmailOnAssignment: true}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			emails, err := s.TaskAssignedEmails(ctx, c.actorID, task, c.assignee)
+
+			require.NoError(t, err)
+			if !c.emailed {
+				assert.Empty(t, emails)
+				return
+			}
+			require.Len(t, emails, 1)
+			email := emails[0]
+			assert.Equal(t, "dev@example.com", email.To)
+			assert.Equal(t, 7, *email.UserID)
+			assert.Equal(t, "[Website] You were assigned to Design login page", email.Subject)
+			assert.Equal(t, string(mailer.TaskAssignedTemplate), email.Template)
+			assert.Equal(t, models.EmailPending, email.Status)
+			assert.Contains(t, email.HTMLBody, "Lea Dupont")
+			assert.Contains(t, email.HTMLBody, "2025-05-02")
+		})
+	}
+}
+
+func TestEmailService_TaskDueSoonEmails(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.DateTimeConfig{Format: "2006-01-02"}
+	dueDate := time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC)
+	project := &models.Project{ID: 1, Name: "Website"}
+	subscribed := &models.User{ID: 7, Email: "dev@example.com", EmailOnDueSoon: true}
+	optedOut := &models.User{ID: 8, Email: "quiet@example.com"}
+	tasks := &stubTaskRepository{tasks: []*models.Task{
+		{ID: 3, ProjectID: 1, Title: "Design login page", AssigneeID: &subscribed.ID, Assignee: subscribed, Project: project, DueDate: &dueDate},
+		{ID: 4, ProjectID: 1, Title: "Write copy", AssigneeID: &optedOut.ID, Assignee: optedOut, Project: project, DueDate: &dueDate},
+	}}
+	s := NewEmailService(nil, tasks, nil, nil, cfg)
+
+	emails, err := s.TaskDueSoonEmails(ctx, []*models.Task{{ID: 3}, {ID: 4}})
+
+	require.NoError(t, err)
+	require.Len(t, emails, 1)
+	assert.Equal(t, "[Website] Design login page is due on 2025-05-02", emails[0].Subject)
+}
+
+func TestEmailService_SprintStartedEmails(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.DateTimeConfig{Format: "2006-01-02"}
+	startDate := time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC)
+	actor := &models.User{ID: 2, Email: "lead@example.com", EmailOnSprintStart: true}
+	optedOut := &models.User{ID: 8, Email: "quiet@example.com"}
+	subscribed := &models.User{ID: 9, Email: "qa@example.com", EmailOnSprintStart: true}
+	sprint := &models.Sprint{ID: 4, ProjectID: 1, Name: "Sprint 1", Project: &models.Project{ID: 1, Name: "Website"},
+		StartDate: startDate, EndDate: startDate.AddDate(0, 0, 14), Tasks: []models.Task{{ID: 3}}}
+	members := &stubProjectMemberRepository{members: []*models.ProjectMember{
+		{UserID: 2, User: actor}, {UserID: 8, User: optedOut}, {UserID: 9, User: subscribed},
+	}}
+	s := NewEmailService(nil, nil, members, nil, cfg)
+
+	emails, err := s.SprintStartedEmails(ctx, actor.ID, sprint)
+
+	require.NoError(t, err)
+	require.Len(t, emails, 1, "members but the actor are emailed")
+	assert.Equal(t, "qa@example.com", emails[0].To)
+	assert.Equal(t, "[Website] Sprint Sprint 1 has started", emails[0].Subject)
+}
+
+func TestEmailDispatcher_Attempt(t *testing.T) {
+	cfg := config.MailConfig{TimeoutSeconds: 5, MaxAttempts: 3, InitialBackoffSeconds: 30, PollIntervalSeconds: 15}
+	newEmail := func(attempts int) *models.OutboundEmail {
+		return &models.OutboundEmail{ID: 5, To: "dev@example.com", Subject: "Hello", HTMLBody: "<p>Hi</p>", Attempts: attempts}
+	}
+
+	t.Run("sent email is recorded", func(t *testing.T) {
+		repo := &stubOutboundEmailRepository{}
+		m := &fakeMailer{}
+		d := NewEmailDispatcher(repo, m, cfg)
+
+		d.attempt(context.Background(), newEmail(0))
+
+		require.Len(t, m.sent, 1)
+		assert.Equal(t, mailer.Message{To: "dev@example.com", Subject: "Hello", HTMLBody: "<p>Hi</p>"}, m.sent[0])
+		assert.Equal(t, models.EmailSent, repo.updates[5]["status"])
+		assert.Equal(t, 1, repo.updates[5]["attempts"])
+	})
+
+	t.Run("failure is retried with backoff", func(t *testing.T) {
+		repo := &stubOutboundEmailRepository{}
+		d := NewEmailDispatcher(repo, &fakeMailer{err: errors.New("connection refused")}, cfg)
+
+		d.attempt(context.Background(), newEmail(1))
+
+		update := repo.updates[5]
+		assert.NotContains(t, update, "status")
+		nextAttemptAt, ok := update["next_attempt_at"].(time.Time)
+		require.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(60*time.Second), nextAttemptAt, 5*time.Second)
+		assert.Equal(t, "connection refused", update["error"])
+	})
+
+	t.Run("failure without attempt left is final", func(t *testing.T) {
+		repo := &stubOutboundEmailRepository{}
+		d := NewEmailDispatcher(repo, &fakeMailer{err: errors.New("mailbox unavailable")}, cfg)
+
+		d.attempt(context.Background(), newEmail(2))
+
+		assert.Equal(t, models.EmailFailed, repo.updates[5]["status"])
+		assert.Nil(t, repo.updates[5]["next_attempt_at"])
+	})
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+type LabelService interface {
+	ListLabels(ctx context.Context, userID, projectID int) ([]*models.Label, error)
+	CreateLabel(ctx context.Context, userID, projectID int, label *models.Label) (*models.Label, error)
+	UpdateLabel(ctx context.Context, userID, projectID, labelID int, data *dto.UpdateLabelRequest) (*models.Label, error)
+	DeleteLabel(ctx context.Context, userID, projectID, labelID int) error
+	SetTaskLabels(ctx context.Context, userID, taskID int, labelIDs []int) (*models.Task, error)
+}
+
+type labelService struct {
+	labelRepository repository.LabelRepository
+	taskService     TaskService
+	projectService  ProjectService
+	activityService ActivityService
+}
+
+func NewLabelService(labelRepository repository.LabelRepository, taskService TaskService, projectService ProjectService, activityService ActivityService) LabelService {
+	return &labelService{
+		labelRepository: labelRepository,
+		taskService:     taskService,
+		projectService:  projectService,
+		activityService: activityService,
+	}
+}
+
+func (s *labelService) ListLabels(ctx context.Context, userID, projectID int) ([]*models.Label, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelService",
+		"method", "ListLabels",
+		"project_id", projectID,
+		"requestor_id", userID,
+	)
+
+	if _, err := s.projectService.GetProjectMember(ctx, userID, projectID); err != nil {
+		return nil, fmt.Errorf("cannot list labels of project %d: %w", projectID, err)
+	}
+
+	labels, err := s.labelRepository.FindByProjectID(ctx, projectID)
+	if err != nil {
+		logger.Error("Failed to find labels of project", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Labels found", "count", len(labels))
+	return labels, nil
+}
+
+func (s *labelService) CreateLabel(ctx context.Context, userID, projectID int, label *models.Label) (*models.Label, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelService",
+		"method", "CreateLabel",
+		"project_id", projectID,
+		"requestor_id", userID,
+		"name", label.Name,
+	)
+
+	logger.Info("Starting label creation process")
+	if _, err := s.projectService.GetAndVerifyProjectManager(ctx, userID, projectID); err != nil {
+		return nil, fmt.Errorf("cannot create label in project %d: %w", projectID, err)
+	}
+
+	if err := s.validateNameAvailable(ctx, projectID, 0, label.Name); err != nil {
+		logger.Warn("Label name is already taken", "error", err)
+		return nil, err
+	}
+
+	label.ProjectID = projectID
+	label.Color = strings.ToUpper(label.Color)
+	created, err := s.labelRepository.Create(ctx, label)
+	if err != nil {
+		logger.Error("Failed to create label in repository", "error", err)
+		return nil, fmt.Errorf("repository create failed for label of project %d: %w", projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Label created successfully", "label_id", created.ID)
+	return created, nil
+}
+
+func (s *labelService) UpdateLabel(ctx context.Context, userID, projectID, labelID int, data *dto.UpdateLabelRequest) (*models.Label, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelService",
+		"method", "UpdateLabel",
+		"project_id", projectID,
+		"label_id", labelID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting label update process")
+	label, err := s.getProjectLabel(ctx, userID, projectID, labelID)
+	if err != nil {
+		return nil, err
+	}
+
+	updateMap := make(map[string]any)
+	if data.Name != nil && *data.Name != label.Name {
+		if err := s.validateNameAvailable(ctx, projectID, labelID, *data.Name); err != nil {
+			logger.Warn("Label name is already taken", "error", err)
+			return nil, err
+		}
+		updateMap["name"] = *data.Name
+	}
+	if data.Color != nil {
+		updateMap["color"] = strings.ToUpper(*data.Color)
+	}
+
+	if len(updateMap) == 0 {
+		logger.Info("No fields to update")
+		return label, nil
+	}
+
+	if err := s.labelRepository.Update(ctx, labelID, updateMap); err != nil {
+		logger.Error("Failed to update label in repository", "error", err)
+		return nil, fmt.Errorf("repository update failed for label %d: %w", labelID, structs.ErrDatabaseFail)
+	}
+
+	updated, err := s.labelRepository.FindByID(ctx, labelID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Label updated successfully")
+	return updated, nil
+}
+
+// DeleteLabel removes the label from the project and from every task carrying it.
+func (s *labelService) DeleteLabel(ctx context.Context, userID, projectID, labelID int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelService",
+		"method", "DeleteLabel",
+		"project_id", projectID,
+		"label_id", labelID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting label deletion process")
+	if _, err := s.getProjectLabel(ctx, userID, projectID, labelID); err != nil {
+		return err
+	}
+
+	if err := s.labelRepository.Delete(ctx, labelID); err != nil {
+		if errors.Is(err, structs.ErrLabelNotExist) {
+			return fmt.Errorf("%w with id %d", err, labelID)
+		}
+		logger.Error("Failed to delete label in repository", "error", err)
+		return fmt.Errorf("repository delete failed for label %d: %w", labelID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully deleted label")
+	return nil
+}
+
+// SetTaskLabels replaces the labels of the task with labelIDs, which must all
+// belong to the project of the task.
+func (s *labelService) SetTaskLabels(ctx context.Context, userID, taskID int, labelIDs []int) (*models.Task, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "LabelService",
+		"method", "SetTaskLabels",
+		"task_id", taskID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting task labels replacement process", "label_ids", labelIDs)
+	task, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, true)
+	if err != nil {
+		return nil, fmt.Errorf("cannot label task %d: %w", taskID, err)
+	}
+
+	seen := make(map[int]struct{}, len(labelIDs))
+	unique := make([]int, 0, len(labelIDs))
+	for _, id := range labelIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	labelIDs = unique
+
+	labels, err := s.labelRepository.FindByIDs(ctx, labelIDs)
+	if err != nil {
+		logger.Error("Failed to find labels", "error", err)
+		return nil, err
+	}
+	if len(labels) != len(labelIDs) {
+		logger.Warn("Some labels do not exist", "found", len(labels), "requested", len(labelIDs))
+		return nil, fmt.Errorf("cannot label task %d: %w", taskID, structs.ErrLabelNotExist)
+	}
+	for _, label := range labels {
+		if label.ProjectID != task.ProjectID {
+			logger.Warn("Label belongs to another project", "label_id", label.ID, "label_project_id", label.ProjectID)
+			return nil, fmt.Errorf("label %d cannot be set on task %d: %w", label.ID, taskID, structs.ErrLabelNotInProject)
+		}
+	}
+
+	if err := s.labelRepository.ReplaceTaskLabels(ctx, taskID, labelIDs); err != nil {
+		logger.Error("Failed to replace labels of task in repository", "error", err)
+		return nil, fmt.Errorf("repository update failed for labels of task %d: %w", taskID, structs.ErrDatabaseFail)
+	}
+
+	oldNames := make([]*models.Label, 0, len(task.TaskLabels))
+	for _, taskLabel := range task.TaskLabels {
+		if taskLabel.Label != nil {
+			oldNames = append(oldNames, taskLabel.Label)
+		}
+	}
+	s.activityService.Record(ctx, newActivity(userID, task.ProjectID, models.ActivityEntityTask, taskID, models.ActivityUpdate,
+		valueChange("labels", labelNames(oldNames), labelNames(labels))))
+
+	logger.Info("Task labels replaced successfully", "count", len(labelIDs))
+	return s.taskService.FindByID(ctx, userID, taskID)
+}
+
+// getProjectLabel verifies that the user manages the project and returns the
+// label, which must belong to it.
+func (s *labelService) getProjectLabel(ctx context.Context, userID, projectID, labelID int) (*models.Label, error) {
+	if _, err := s.projectService.GetAndVerifyProjectManager(ctx, userID, projectID); err != nil {
+		return nil, fmt.Errorf("cannot manage labels of project %d: %w", projectID, err)
+	}
+
+	label, err := s.labelRepository.FindByID(ctx, labelID)
+	if err != nil {
+		return nil, err
+	}
+	if label.ProjectID != projectID {
+		return nil, fmt.Errorf("%w with id %d in project %d", structs.ErrLabelNotExist, labelID, projectID)
+	}
+	return label, nil
+}
+
+// validateNameAvailable checks that no label of the project other than
+// labelID already uses name.
+func (s *labelService) validateNameAvailable(ctx context.Context, projectID, labelID int, name string) error {
+	existing, err := s.labelRepository.FindByProjectAndName(ctx, projectID, name)
+	if err != nil {
+		if errors.Is(err, structs.ErrLabelNotExist) {
+			return nil
+		}
+		return err
+	}
+	if existing.ID != labelID {
+		return fmt.Errorf("label %q in project %d: %w", name, projectID, structs.ErrLabelNameTaken)
+	}
+	return nil
+}
+
+// labelNames returns the sorted, comma separated names of labels.
+func labelNames(labels []*models.Label) string {
+	names := make([]string, len(labels))
+	for i, label := range labels {
+		names[i] = label.Name
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubLabelRepository struct {
+	labels []*models.Label
+}
+
+func (r *stubLabelRepository) Create(ctx context.Context, label *models.Label) (*models.Label, error) {
+	r.labels = append(r.labels, label)
+	return label, nil
+}
+
+func (r *stubLabelRepository) FindByID(ctx context.Context, id int) (*models.Label, error) {
+	for _, label := range r.labels {
+		if label.ID == id {
+			return label, nil
+		}
+	}
+	return nil, structs.ErrLabelNotExist
+}
+
+func (r *stubLabelRepository) FindByIDs(ctx context.Context, ids []int) ([]*models.Label, error) {
+	return r.labels, nil
+}
+
+func (r *stubLabelRepository) FindByProjectID(ctx context.Context, projectID int) ([]*models.Label, error) {
+	return r.labels, nil
+}
+
+func (r *stubLabelRepository) FindByProjectAndName(ctx context.Context, projectID int, name string) (*models.Label, error) {
+	for _, label := range r.labels {
+		if label.ProjectID == projectID && label.Name == name {
+			return label, nil
+		}
+	}
+	return nil, structs.ErrLabelNotExist
+}
+
+func (r *stubLabelRepository) Update(ctx context.Context, id int, updateMap map[string]any) error {
+	return nil
+}
+
+func (r *stubLabelRepository) Delete(ctx context.Context, id int) error {
+	return nil
+}
+
+func (r *stubLabelRepository) ReplaceTaskLabels(ctx context.Context, taskID int, labelIDs []int) error {
+	return nil
+}
+
+func TestLabelService_ValidateNameAvailable(t *testing.T) {
+	ctx := context.Background()
+	repo := &stubLabelRepository{labels: []*models.Label{
+		{ID: 1, ProjectID: 1, Name: "frontend"},
+		{ID: 2, ProjectID: 2, Name: "backend"},
+	}}
+	s := &labelService{labelRepository: repo}
+
+	t.Run("name used in the project", func(t *testing.T) {
+		assert.ErrorIs(t, s.validateNameAvailable(ctx, 1, 0, "frontend"), structs.ErrLabelNameTaken)
+	})
+
+	t.Run("name kept by the same label", func(t *testing.T) {
+		assert.NoError(t, s.validateNameAvailable(ctx, 1, 1, "frontend"))
+	})
+
+	t.Run("name used in another project", func(t *testing.T) {
+		assert.NoError(t, s.validateNameAvailable(ctx, 1, 0, "backend"))
+	})
+}
+
+func TestLabelNames(t *testing.T) {
+	labels := []*models.Label{{Name: "ui"}, {Name: "bug"}}
+	assert.Equal(t, "bug, ui", labelNames(labels))
+	assert.Equal(t, "", labelNames(nil))
+}
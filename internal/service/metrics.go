@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+type MetricsService interface {
+	GetSprintBurndown(ctx context.Context, userID, sprintID int) (*dto.BurndownResponse, error)
+	GetProjectVelocity(ctx context.Context, userID, projectID int) (*dto.VelocityResponse, error)
+}
+
+type metricsService struct {
+	sprintRepository   repository.SprintRepository
+	taskRepository     repository.TaskRepository
+	activityRepository repository.ActivityRepository
+	sprintService      SprintService
+	projectService     ProjectService
+}
+
+func NewMetricsService(sprintRepository repository.SprintRepository,
+	taskRepository repository.TaskRepository,
+	activityRepository repository.ActivityRepository,
+	sprintService SprintService,
+	projectService ProjectService) MetricsService {
+	return &metricsService{
+		sprintRepository:   sprintRepository,
+		taskRepository:     taskRepository,
+		activityRepository: activityRepository,
+		sprintService:      sprintService,
+		projectService:     projectService,
+	}
+}
+
+// statusEvent is a status change of a task read from the activity log.
+type statusEvent struct {
+	at       time.Time
+	oldValue *string
+	newValue *string
+}
+
+// GetSprintBurndown returns the unfinished tasks and story points of the sprint at
+// the end of every sprint day. The status of a task on a given day is
+// replayed from its status changes in the activity log.
+func (s *metricsService) GetSprintBurndown(ctx context.Context, userID, sprintID int) (*dto.BurndownResponse, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "MetricsService",
+		"method", "GetSprintBurndown",
+		"sprint_id", sprintID,
+		"requestor_id", userID,
+	)
+
+	sprint, err := s.sprintService.GetAndVerifyProjectManagerForSprint(ctx, logger, userID, sprintID)
+	if err != nil {
+		if errors.Is(err, structs.ErrUserNotManageProject) {
+			return nil, fmt.Errorf("authorization failure for user id %d: %w", userID, err)
+		} else {
+			return nil, fmt.Errorf("cannot fetch sprint: %w with sprint id: %d", err, sprintID)
+		}
+	}
+
+	tasks := sprint.Tasks
+	if sprint.Status == models.SprintClosed {
+		tasks, err = s.closedSprintTasks(ctx, sprintID)
+		if err != nil {
+			logger.Error("Failed to load tasks of closed sprint", "error", err)
+			return nil, err
+		}
+	}
+
+	taskIDs := make([]int, len(tasks))
+	for i, task := range tasks {
+		taskIDs[i] = task.ID
+	}
+	activities, err := s.activityRepository.FindFieldChanges(ctx, models.ActivityEntityTask, taskIDs, "status")
+	if err != nil {
+		logger.Error("Failed to load task status history", "error", err)
+		return nil, err
+	}
+
+	cutoff := time.Now()
+	if sprint.CompletedAt != nil {
+		cutoff = *sprint.CompletedAt
+	}
+
+	logger.Info("Computing burndown", "task_count", len(tasks), "status_change_count", len(activities))
+	return buildBurndown(sprint, tasks, activities, cutoff), nil
+}
+
+// GetProjectVelocity returns the tasks committed and completed in every
+// closed sprint of the project, as recorded in the sprint reports.
+func (s *metricsService) GetProjectVelocity(ctx context.Context, userID, projectID int) (*dto.VelocityResponse, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "MetricsService",
+		"method", "GetProjectVelocity",
+		"project_id", projectID,
+		"requestor_id", userID,
+	)
+
+	if _, err := s.projectService.GetAndVerifyProjectManager(ctx, userID, projectID); err != nil {
+		if errors.Is(err, structs.ErrUserNotManageProject) {
+			return nil, fmt.Errorf("authorization failure for user id %d: %w", userID, err)
+		}
+		return nil, fmt.Errorf("cannot fetch project: %w with project id: %d", err, projectID)
+	}
+
+	sprints, err := s.sprintRepository.FindClosedByProjectID(ctx, projectID)
+	if err != nil {
+		logger.Error("Failed to load closed sprints", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Computing velocity", "closed_sprint_count", len(sprints))
+	return buildVelocity(projectID, sprints), nil
+}
+
+// closedSprintTasks returns the tasks listed in the report of a closed
+// sprint. Tasks deleted since keep the status they had when the sprint ended.
+func (s *metricsService) closedSprintTasks(ctx context.Context, sprintID int) ([]models.Task, error) {
+	report, err := s.sprintRepository.FindReportBySprintID(ctx, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskIDs := make([]int, len(report.Tasks))
+	for i, reportTask := range report.Tasks {
+		taskIDs[i] = reportTask.TaskID
+	}
+	found, err := s.taskRepository.FindByIDs(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*models.Task, len(found))
+	for _, task := range found {
+		byID[task.ID] = task
+	}
+
+	tasks := make([]models.Task, len(report.Tasks))
+	for i, reportTask := range report.Tasks {
+		if task, ok := byID[reportTask.TaskID]; ok {
+			tasks[i] = *task
+			continue
+		}
+		tasks[i] = models.Task{ID: reportTask.TaskID, Title: reportTask.Title, Status: reportTask.Status, StoryPoints: reportTask.StoryPoints}
+	}
+	return tasks, nil
+}
+
+// buildBurndown computes one point per day from the start to the end date of
+// the sprint. Days after cutoff, the completion time of the sprint or now,
+// have no remaining value yet.
+func buildBurndown(sprint *models.Sprint, tasks []models.Task, activities []*models.ActivityLog, cutoff time.Time) *dto.BurndownResponse {
+	history := make(map[int][]statusEvent)
+	for _, activity := range activities {
+		for _, change := range activity.Changes {
+			if change.Field != "status" {
+				continue
+			}
+			history[activity.EntityID] = append(history[activity.EntityID], statusEvent{
+				at:       activity.CreatedAt,
+				oldValue: change.OldValue,
+				newValue: change.NewValue,
+			})
+		}
+	}
+
+	start := truncateToDay(sprint.StartDate)
+	end := truncateToDay(sprint.EndDate)
+	days := int(end.Sub(start).Hours()/24) + 1
+	if days < 1 {
+		days = 1
+	}
+
+	total, totalPoints := len(tasks), 0
+	for _, task := range tasks {
+		totalPoints += storyPoints(&task)
+	}
+
+	points := make([]dto.BurndownPoint, days)
+	for i := range points {
+		day := start.AddDate(0, 0, i)
+		points[i].Date = day
+		if days > 1 {
+			pace := float64(days-1-i) / float64(days-1)
+			points[i].IdealTasks = float64(total) * pace
+			points[i].IdealPoints = float64(totalPoints) * pace
+		}
+
+		if day.After(cutoff) {
+			continue
+		}
+		at := day.AddDate(0, 0, 1)
+		if at.After(cutoff) {
+			at = cutoff
+		}
+
+		remaining, remainingPoints := 0, 0
+		for _, task := range tasks {
+			if !task.CreatedAt.IsZero() && task.CreatedAt.After(at) {
+				continue
+			}
+			if statusAt(task.Status, history[task.ID], at) != models.DoneTask {
+				remaining++
+				remainingPoints += storyPoints(&task)
+			}
+		}
+		points[i].RemainingTasks = &remaining
+		points[i].RemainingPoints = &remainingPoints
+	}
+
+	return &dto.BurndownResponse{
+		SprintID:    sprint.ID,
+		Status:      sprint.Status,
+		StartDate:   sprint.StartDate,
+		EndDate:     sprint.EndDate,
+		TotalTasks:  total,
+		TotalPoints: totalPoints,
+		Points:      points,
+	}
+}
+
+// statusAt returns the status a task had at the given time, given its
+// current status and its status changes ordered oldest first.
+func statusAt(current models.TaskStatus, events []statusEvent, at time.Time) models.TaskStatus {
+	if len(events) == 0 {
+		return current
+	}
+
+	var status *string
+	for _, event := range events {
+		if event.at.After(at) {
+			break
+		}
+		status = event.newValue
+	}
+	if status == nil {
+		status = events[0].oldValue
+		if status == nil {
+			status = events[0].newValue
+		}
+	}
+	if status == nil {
+		return current
+	}
+	return models.TaskStatus(*status)
+}
+
+func buildVelocity(projectID int, sprints []*models.Sprint) *dto.VelocityResponse {
+	response := &dto.VelocityResponse{
+		ProjectID: projectID,
+		Sprints:   make([]dto.VelocitySprint, 0, len(sprints)),
+	}
+
+	completed, completedPoints := 0, 0
+	for _, sprint := range sprints {
+		if sprint.Report == nil {
+			continue
+		}
+		velocity := dto.VelocitySprint{
+			SprintID:       sprint.ID,
+			Name:           sprint.Name,
+			StartDate:      sprint.StartDate,
+			CompletedAt:    sprint.CompletedAt,
+			CommittedTasks: len(sprint.Report.Tasks),
+			CompletedTasks: sprint.Report.CompletedCount,
+		}
+		for _, task := range sprint.Report.Tasks {
+			if task.StoryPoints == nil {
+				continue
+			}
+			velocity.CommittedPoints += *task.StoryPoints
+			if task.Outcome == models.SprintTaskCompleted {
+				velocity.CompletedPoints += *task.StoryPoints
+			}
+		}
+		response.Sprints = append(response.Sprints, velocity)
+		completed += velocity.CompletedTasks
+		completedPoints += velocity.CompletedPoints
+	}
+
+	if len(response.Sprints) > 0 {
+		response.AverageCompletedTasks = float64(completed) / float64(len(response.Sprints))
+		response.AverageCompletedPoints = float64(completedPoints) / float64(len(response.Sprints))
+	}
+	return response
+}
+
+func storyPoints(task *models.Task) int {
+	if task.StoryPoints == nil {
+		return 0
+	}
+	return *task.StoryPoints
+}
+
+func truncateToDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"lqkhoi-go-http-api/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildBurndown(t *testing.T) {
+	day := func(d, h int) time.Time { return time.Date(2025, 4, d, h, 0, 0, 0, time.UTC) }
+	str := func(s string) *string { return &s }
+	statusChange := func(taskID int, at time.Time, from, to string) *models.ActivityLog {
+		return &models.ActivityLog{
+			CreatedAt:  at,
+			EntityType: models.ActivityEntityTask,
+			EntityID:   taskID,
+			Changes:    []models.ActivityChange{{Field: "status", OldValue: str(from), NewValue: str(to)}},
+		}
+	}
+
+	five := 5
+	sprint := &models.Sprint{ID: 1, Status: models.SprintActive, StartDate: day(14, 0), EndDate: day(17, 0)}
+	tasks := []models.Task{
+		{ID: 1, Status: models.DoneTask, CreatedAt: day(10, 0)},
+		{ID: 2, Status: models.DoneTask, CreatedAt: day(10, 0)},
+		{ID: 3, Status: models.InProgressTask, CreatedAt: day(15, 9), StoryPoints: &five},
+	}
+	activities := []*models.ActivityLog{
+		statusChange(1, day(14, 10), "TO_DO", "DONE"),
+		statusChange(2, day(15, 10), "IN_PROGRESS", "DONE"),
+	}
+
+	burndown := buildBurndown(sprint, tasks, activities, day(16, 12))
+
+	assert.Equal(t, 3, burndown.TotalTasks)
+	assert.Equal(t, 5, burndown.TotalPoints)
+	if assert.Len(t, burndown.Points, 4) {
+		remaining := make([]*int, len(burndown.Points))
+		remainingPoints := make([]*int, len(burndown.Points))
+		ideal := make([]float64, len(burndown.Points))
+		for i, point := range burndown.Points {
+			remaining[i] = point.RemainingTasks
+			remainingPoints[i] = point.RemainingPoints
+			ideal[i] = point.IdealTasks
+		}
+		zero, one := 0, 1
+		assert.Equal(t, []*int{&one, &one, &one, nil}, remaining)
+		assert.Equal(t, []*int{&zero, &five, &five, nil}, remainingPoints)
+		assert.Equal(t, []float64{3, 2, 1, 0}, ideal)
+	}
+}
+
+func TestStatusAt(t *testing.T) {
+	str := func(s string) *string { return &s }
+	at := time.Date(2025, 4, 15, 0, 0, 0, 0, time.UTC)
+	events := []statusEvent{
+		{at: at, oldValue: str("TO_DO"), newValue: str("IN_PROGRESS")},
+		{at: at.Add(time.Hour), oldValue: str("IN_PROGRESS"), newValue: str("DONE")},
+	}
+
+	assert.Equal(t, models.ToDoTask, statusAt(models.DoneTask, events, at.Add(-time.Minute)))
+	assert.Equal(t, models.InProgressTask, statusAt(models.DoneTask, events, at.Add(time.Minute)))
+	assert.Equal(t, models.DoneTask, statusAt(models.DoneTask, events, at.Add(2*time.Hour)))
+	assert.Equal(t, models.ReviewTask, statusAt(models.ReviewTask, nil, at))
+}
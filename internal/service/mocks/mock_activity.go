@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lqkhoi-go-http-api/internal/service (interfaces: ActivityService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_activity.go -package=mocks . ActivityService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	dto "lqkhoi-go-http-api/internal/dto"
+	models "lqkhoi-go-http-api/internal/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockActivityService is a mock of ActivityService interface.
+type MockActivityService struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityServiceMockRecorder is the mock recorder for MockActivityService.
+type MockActivityServiceMockRecorder struct {
+	mock *MockActivityService
+}
+
+// NewMockActivityService creates a new mock instance.
+func NewMockActivityService(ctrl *gomock.Controller) *MockActivityService {
+	mock := &MockActivityService{ctrl: ctrl}
+	mock.recorder = &MockActivityServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityService) EXPECT() *MockActivityServiceMockRecorder {
+	return m.recorder
+}
+
+// ListEntityHistory mocks base method.
+func (m *MockActivityService) ListEntityHistory(ctx context.Context, entityType models.ActivityEntityType, entityID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntityHistory", ctx, entityType, entityID, page)
+	ret0, _ := ret[0].([]*models.ActivityLog)
+	ret1, _ := ret[1].(*dto.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListEntityHistory indicates an expected call of ListEntityHistory.
+func (mr *MockActivityServiceMockRecorder) ListEntityHistory(ctx, entityType, entityID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntityHistory", reflect.TypeOf((*MockActivityService)(nil).ListEntityHistory), ctx, entityType, entityID, page)
+}
+
+// ListProjectActivity mocks base method.
+func (m *MockActivityService) ListProjectActivity(ctx context.Context, projectID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectActivity", ctx, projectID, page)
+	ret0, _ := ret[0].([]*models.ActivityLog)
+	ret1, _ := ret[1].(*dto.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListProjectActivity indicates an expected call of ListProjectActivity.
+func (mr *MockActivityServiceMockRecorder) ListProjectActivity(ctx, projectID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectActivity", reflect.TypeOf((*MockActivityService)(nil).ListProjectActivity), ctx, projectID, page)
+}
+
+// Record mocks base method.
+func (m *MockActivityService) Record(ctx context.Context, activity *models.ActivityLog) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Record", ctx, activity)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockActivityServiceMockRecorder) Record(ctx, activity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockActivityService)(nil).Record), ctx, activity)
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lqkhoi-go-http-api/internal/service (interfaces: EmailService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_email.go -package=mocks . EmailService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "lqkhoi-go-http-api/internal/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEmailService is a mock of EmailService interface.
+type MockEmailService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailServiceMockRecorder is the mock recorder for MockEmailService.
+type MockEmailServiceMockRecorder struct {
+	mock *MockEmailService
+}
+
+// NewMockEmailService creates a new mock instance.
+func NewMockEmailService(ctrl *gomock.Controller) *MockEmailService {
+	mock := &MockEmailService{ctrl: ctrl}
+	mock.recorder = &MockEmailServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailService) EXPECT() *MockEmailServiceMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockEmailService) Notify() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Notify")
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockEmailServiceMockRecorder) Notify() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockEmailService)(nil).Notify))
+}
+
+// SprintStartedEmails mocks base method.
+func (m *MockEmailService) SprintStartedEmails(ctx context.Context, actorID int, sprint *models.Sprint) ([]*models.OutboundEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SprintStartedEmails", ctx, actorID, sprint)
+	ret0, _ := ret[0].([]*models.OutboundEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SprintStartedEmails indicates an expected call of SprintStartedEmails.
+func (mr *MockEmailServiceMockRecorder) SprintStartedEmails(ctx, actorID, sprint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SprintStartedEmails", reflect.TypeOf((*MockEmailService)(nil).SprintStartedEmails), ctx, actorID, sprint)
+}
+
+// TaskAssignedEmails mocks base method.
+func (m *MockEmailService) TaskAssignedEmails(ctx context.Context, actorID int, task *models.Task, assignee *models.User) ([]*models.OutboundEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskAssignedEmails", ctx, actorID, task, assignee)
+	ret0, _ := ret[0].([]*models.OutboundEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskAssignedEmails indicates an expected call of TaskAssignedEmails.
+func (mr *MockEmailServiceMockRecorder) TaskAssignedEmails(ctx, actorID, task, assignee any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskAssignedEmails", reflect.TypeOf((*MockEmailService)(nil).TaskAssignedEmails), ctx, actorID, task, assignee)
+}
+
+// TaskDueSoonEmails mocks base method.
+func (m *MockEmailService) TaskDueSoonEmails(ctx context.Context, tasks []*models.Task) ([]*models.OutboundEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDueSoonEmails", ctx, tasks)
+	ret0, _ := ret[0].([]*models.OutboundEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDueSoonEmails indicates an expected call of TaskDueSoonEmails.
+func (mr *MockEmailServiceMockRecorder) TaskDueSoonEmails(ctx, tasks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDueSoonEmails", reflect.TypeOf((*MockEmailService)(nil).TaskDueSoonEmails), ctx, tasks)
+}
@@ -0,0 +1,208 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lqkhoi-go-http-api/internal/service (interfaces: ProjectService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_project.go -package=mocks . ProjectService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	dto "lqkhoi-go-http-api/internal/dto"
+	models "lqkhoi-go-http-api/internal/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectService is a mock of ProjectService interface.
+type MockProjectService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectServiceMockRecorder is the mock recorder for MockProjectService.
+type MockProjectServiceMockRecorder struct {
+	mock *MockProjectService
+}
+
+// NewMockProjectService creates a new mock instance.
+func NewMockProjectService(ctrl *gomock.Controller) *MockProjectService {
+	mock := &MockProjectService{ctrl: ctrl}
+	mock.recorder = &MockProjectServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectService) EXPECT() *MockProjectServiceMockRecorder {
+	return m.recorder
+}
+
+// AddTeamMembers mocks base method.
+func (m *MockProjectService) AddTeamMembers(ctx context.Context, userID, projectID int, userIDsToAdd []int, role models.ProjectMemberRole) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTeamMembers", ctx, userID, projectID, userIDsToAdd, role)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTeamMembers indicates an expected call of AddTeamMembers.
+func (mr *MockProjectServiceMockRecorder) AddTeamMembers(ctx, userID, projectID, userIDsToAdd, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTeamMembers", reflect.TypeOf((*MockProjectService)(nil).AddTeamMembers), ctx, userID, projectID, userIDsToAdd, role)
+}
+
+// CreateProject mocks base method.
+func (m *MockProjectService) CreateProject(ctx context.Context, project *models.Project) (*models.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", ctx, project)
+	ret0, _ := ret[0].(*models.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockProjectServiceMockRecorder) CreateProject(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectService)(nil).CreateProject), ctx, project)
+}
+
+// DeleteProject mocks base method.
+func (m *MockProjectService) DeleteProject(ctx context.Context, userID, projectID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", ctx, userID, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockProjectServiceMockRecorder) DeleteProject(ctx, userID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectService)(nil).DeleteProject), ctx, userID, projectID)
+}
+
+// FindByID mocks base method.
+func (m *MockProjectService) FindByID(ctx context.Context, id int) (*models.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*models.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockProjectServiceMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockProjectService)(nil).FindByID), ctx, id)
+}
+
+// GetAndVerifyProjectManager mocks base method.
+func (m *MockProjectService) GetAndVerifyProjectManager(ctx context.Context, userID, projectID int) (*models.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAndVerifyProjectManager", ctx, userID, projectID)
+	ret0, _ := ret[0].(*models.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAndVerifyProjectManager indicates an expected call of GetAndVerifyProjectManager.
+func (mr *MockProjectServiceMockRecorder) GetAndVerifyProjectManager(ctx, userID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAndVerifyProjectManager", reflect.TypeOf((*MockProjectService)(nil).GetAndVerifyProjectManager), ctx, userID, projectID)
+}
+
+// GetProjectMember mocks base method.
+func (m *MockProjectService) GetProjectMember(ctx context.Context, userID, projectID int) (*models.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectMember", ctx, userID, projectID)
+	ret0, _ := ret[0].(*models.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectMember indicates an expected call of GetProjectMember.
+func (mr *MockProjectServiceMockRecorder) GetProjectMember(ctx, userID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectMember", reflect.TypeOf((*MockProjectService)(nil).GetProjectMember), ctx, userID, projectID)
+}
+
+// ListActivity mocks base method.
+func (m *MockProjectService) ListActivity(ctx context.Context, userID, projectID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivity", ctx, userID, projectID, page)
+	ret0, _ := ret[0].([]*models.ActivityLog)
+	ret1, _ := ret[1].(*dto.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListActivity indicates an expected call of ListActivity.
+func (mr *MockProjectServiceMockRecorder) ListActivity(ctx, userID, projectID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivity", reflect.TypeOf((*MockProjectService)(nil).ListActivity), ctx, userID, projectID, page)
+}
+
+// ListMembers mocks base method.
+func (m *MockProjectService) ListMembers(ctx context.Context, userID, projectID int) ([]*models.ProjectMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, userID, projectID)
+	ret0, _ := ret[0].([]*models.ProjectMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockProjectServiceMockRecorder) ListMembers(ctx, userID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockProjectService)(nil).ListMembers), ctx, userID, projectID)
+}
+
+// ListProjects mocks base method.
+func (m *MockProjectService) ListProjects(ctx context.Context, filter dto.ProjectFilter, page *dto.PageRequest) ([]*models.Project, *dto.PageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", ctx, filter, page)
+	ret0, _ := ret[0].([]*models.Project)
+	ret1, _ := ret[1].(*dto.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockProjectServiceMockRecorder) ListProjects(ctx, filter, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockProjectService)(nil).ListProjects), ctx, filter, page)
+}
+
+// RemoveMember mocks base method.
+func (m *MockProjectService) RemoveMember(ctx context.Context, userID, projectID, memberID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, userID, projectID, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockProjectServiceMockRecorder) RemoveMember(ctx, userID, projectID, memberID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockProjectService)(nil).RemoveMember), ctx, userID, projectID, memberID)
+}
+
+// UpdateProject mocks base method.
+func (m *MockProjectService) UpdateProject(ctx context.Context, userID, projectId int, data *dto.UpdateProjectRequest) (*models.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", ctx, userID, projectId, data)
+	ret0, _ := ret[0].(*models.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProject indicates an expected call of UpdateProject.
+func (mr *MockProjectServiceMockRecorder) UpdateProject(ctx, userID, projectId, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockProjectService)(nil).UpdateProject), ctx, userID, projectId, data)
+}
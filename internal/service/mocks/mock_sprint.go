@@ -0,0 +1,194 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lqkhoi-go-http-api/internal/service (interfaces: SprintService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_sprint.go -package=mocks . SprintService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	slog "log/slog"
+	dto "lqkhoi-go-http-api/internal/dto"
+	models "lqkhoi-go-http-api/internal/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSprintService is a mock of SprintService interface.
+type MockSprintService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSprintServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSprintServiceMockRecorder is the mock recorder for MockSprintService.
+type MockSprintServiceMockRecorder struct {
+	mock *MockSprintService
+}
+
+// NewMockSprintService creates a new mock instance.
+func NewMockSprintService(ctrl *gomock.Controller) *MockSprintService {
+	mock := &MockSprintService{ctrl: ctrl}
+	mock.recorder = &MockSprintServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSprintService) EXPECT() *MockSprintServiceMockRecorder {
+	return m.recorder
+}
+
+// CompleteSprint mocks base method.
+func (m *MockSprintService) CompleteSprint(ctx context.Context, userID, sprintID int, carryOver models.CarryOverTarget, nextSprintID *int, overrideWipLimit bool) (*models.SprintReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteSprint", ctx, userID, sprintID, carryOver, nextSprintID, overrideWipLimit)
+	ret0, _ := ret[0].(*models.SprintReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteSprint indicates an expected call of CompleteSprint.
+func (mr *MockSprintServiceMockRecorder) CompleteSprint(ctx, userID, sprintID, carryOver, nextSprintID, overrideWipLimit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteSprint", reflect.TypeOf((*MockSprintService)(nil).CompleteSprint), ctx, userID, sprintID, carryOver, nextSprintID, overrideWipLimit)
+}
+
+// CreateSprint mocks base method.
+func (m *MockSprintService) CreateSprint(ctx context.Context, userID, projectID int, sprint *models.Sprint) (*models.Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSprint", ctx, userID, projectID, sprint)
+	ret0, _ := ret[0].(*models.Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSprint indicates an expected call of CreateSprint.
+func (mr *MockSprintServiceMockRecorder) CreateSprint(ctx, userID, projectID, sprint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSprint", reflect.TypeOf((*MockSprintService)(nil).CreateSprint), ctx, userID, projectID, sprint)
+}
+
+// DeleteSprint mocks base method.
+func (m *MockSprintService) DeleteSprint(ctx context.Context, userID, sprintID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSprint", ctx, userID, sprintID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSprint indicates an expected call of DeleteSprint.
+func (mr *MockSprintServiceMockRecorder) DeleteSprint(ctx, userID, sprintID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSprint", reflect.TypeOf((*MockSprintService)(nil).DeleteSprint), ctx, userID, sprintID)
+}
+
+// FindByID mocks base method.
+func (m *MockSprintService) FindByID(ctx context.Context, userID, sprintID int) (*models.Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, userID, sprintID)
+	ret0, _ := ret[0].(*models.Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockSprintServiceMockRecorder) FindByID(ctx, userID, sprintID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockSprintService)(nil).FindByID), ctx, userID, sprintID)
+}
+
+// FindSprints mocks base method.
+func (m *MockSprintService) FindSprints(ctx context.Context, filter *dto.SprintFilter, page *dto.PageRequest) ([]*models.Sprint, *dto.PageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSprints", ctx, filter, page)
+	ret0, _ := ret[0].([]*models.Sprint)
+	ret1, _ := ret[1].(*dto.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindSprints indicates an expected call of FindSprints.
+func (mr *MockSprintServiceMockRecorder) FindSprints(ctx, filter, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSprints", reflect.TypeOf((*MockSprintService)(nil).FindSprints), ctx, filter, page)
+}
+
+// GetAndVerifyProjectManagerForSprint mocks base method.
+func (m *MockSprintService) GetAndVerifyProjectManagerForSprint(ctx context.Context, baseLogger *slog.Logger, userID, sprintID int) (*models.Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAndVerifyProjectManagerForSprint", ctx, baseLogger, userID, sprintID)
+	ret0, _ := ret[0].(*models.Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAndVerifyProjectManagerForSprint indicates an expected call of GetAndVerifyProjectManagerForSprint.
+func (mr *MockSprintServiceMockRecorder) GetAndVerifyProjectManagerForSprint(ctx, baseLogger, userID, sprintID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAndVerifyProjectManagerForSprint", reflect.TypeOf((*MockSprintService)(nil).GetAndVerifyProjectManagerForSprint), ctx, baseLogger, userID, sprintID)
+}
+
+// GetSprintBoard mocks base method.
+func (m *MockSprintService) GetSprintBoard(ctx context.Context, userID, sprintID int) ([]*models.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSprintBoard", ctx, userID, sprintID)
+	ret0, _ := ret[0].([]*models.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSprintBoard indicates an expected call of GetSprintBoard.
+func (mr *MockSprintServiceMockRecorder) GetSprintBoard(ctx, userID, sprintID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSprintBoard", reflect.TypeOf((*MockSprintService)(nil).GetSprintBoard), ctx, userID, sprintID)
+}
+
+// GetSprintReport mocks base method.
+func (m *MockSprintService) GetSprintReport(ctx context.Context, userID, sprintID int) (*models.SprintReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSprintReport", ctx, userID, sprintID)
+	ret0, _ := ret[0].(*models.SprintReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSprintReport indicates an expected call of GetSprintReport.
+func (mr *MockSprintServiceMockRecorder) GetSprintReport(ctx, userID, sprintID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSprintReport", reflect.TypeOf((*MockSprintService)(nil).GetSprintReport), ctx, userID, sprintID)
+}
+
+// StartSprint mocks base method.
+func (m *MockSprintService) StartSprint(ctx context.Context, userID, sprintID int) (*models.Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSprint", ctx, userID, sprintID)
+	ret0, _ := ret[0].(*models.Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSprint indicates an expected call of StartSprint.
+func (mr *MockSprintServiceMockRecorder) StartSprint(ctx, userID, sprintID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSprint", reflect.TypeOf((*MockSprintService)(nil).StartSprint), ctx, userID, sprintID)
+}
+
+// UpdateSprint mocks base method.
+func (m *MockSprintService) UpdateSprint(ctx context.Context, userID, sprintID int, data *dto.UpdateSprintRequest) (*models.Sprint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSprint", ctx, userID, sprintID, data)
+	ret0, _ := ret[0].(*models.Sprint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSprint indicates an expected call of UpdateSprint.
+func (mr *MockSprintServiceMockRecorder) UpdateSprint(ctx, userID, sprintID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSprint", reflect.TypeOf((*MockSprintService)(nil).UpdateSprint), ctx, userID, sprintID, data)
+}
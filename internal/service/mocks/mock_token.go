@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lqkhoi-go-http-api/internal/service (interfaces: TokenService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_token.go -package=mocks . TokenService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	dto "lqkhoi-go-http-api/internal/dto"
+	models "lqkhoi-go-http-api/internal/models"
+	structs "lqkhoi-go-http-api/pkg/structs"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTokenService is a mock of TokenService interface.
+type MockTokenService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTokenServiceMockRecorder is the mock recorder for MockTokenService.
+type MockTokenServiceMockRecorder struct {
+	mock *MockTokenService
+}
+
+// NewMockTokenService creates a new mock instance.
+func NewMockTokenService(ctrl *gomock.Controller) *MockTokenService {
+	mock := &MockTokenService{ctrl: ctrl}
+	mock.recorder = &MockTokenServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenService) EXPECT() *MockTokenServiceMockRecorder {
+	return m.recorder
+}
+
+// ConsumeRefreshToken mocks base method.
+func (m *MockTokenService) ConsumeRefreshToken(ctx context.Context, refreshToken string) (*structs.Claims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeRefreshToken", ctx, refreshToken)
+	ret0, _ := ret[0].(*structs.Claims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeRefreshToken indicates an expected call of ConsumeRefreshToken.
+func (mr *MockTokenServiceMockRecorder) ConsumeRefreshToken(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeRefreshToken", reflect.TypeOf((*MockTokenService)(nil).ConsumeRefreshToken), ctx, refreshToken)
+}
+
+// IsTokenRevoked mocks base method.
+func (m *MockTokenService) IsTokenRevoked(ctx context.Context, claims *structs.Claims) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTokenRevoked", ctx, claims)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsTokenRevoked indicates an expected call of IsTokenRevoked.
+func (mr *MockTokenServiceMockRecorder) IsTokenRevoked(ctx, claims any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTokenRevoked", reflect.TypeOf((*MockTokenService)(nil).IsTokenRevoked), ctx, claims)
+}
+
+// IssueTokenPair mocks base method.
+func (m *MockTokenService) IssueTokenPair(ctx context.Context, user *models.User, sessionID string) (*dto.TokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueTokenPair", ctx, user, sessionID)
+	ret0, _ := ret[0].(*dto.TokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueTokenPair indicates an expected call of IssueTokenPair.
+func (mr *MockTokenServiceMockRecorder) IssueTokenPair(ctx, user, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueTokenPair", reflect.TypeOf((*MockTokenService)(nil).IssueTokenPair), ctx, user, sessionID)
+}
+
+// ReleaseRefreshToken mocks base method.
+func (m *MockTokenService) ReleaseRefreshToken(ctx context.Context, claims *structs.Claims) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseRefreshToken", ctx, claims)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseRefreshToken indicates an expected call of ReleaseRefreshToken.
+func (mr *MockTokenServiceMockRecorder) ReleaseRefreshToken(ctx, claims any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseRefreshToken", reflect.TypeOf((*MockTokenService)(nil).ReleaseRefreshToken), ctx, claims)
+}
+
+// RevokeSession mocks base method.
+func (m *MockTokenService) RevokeSession(ctx context.Context, claims *structs.Claims) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, claims)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockTokenServiceMockRecorder) RevokeSession(ctx, claims any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockTokenService)(nil).RevokeSession), ctx, claims)
+}
+
+// RevokeUserTokens mocks base method.
+func (m *MockTokenService) RevokeUserTokens(ctx context.Context, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserTokens", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserTokens indicates an expected call of RevokeUserTokens.
+func (mr *MockTokenServiceMockRecorder) RevokeUserTokens(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserTokens", reflect.TypeOf((*MockTokenService)(nil).RevokeUserTokens), ctx, userID)
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	dto "lqkhoi-go-http-api/internal/dto"
 	models "lqkhoi-go-http-api/internal/models"
+	structs "lqkhoi-go-http-api/pkg/structs"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -56,6 +57,20 @@ func (mr *MockUserServiceMockRecorder) AssignUsersToProject(ctx, projectID, user
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignUsersToProject", reflect.TypeOf((*MockUserService)(nil).AssignUsersToProject), ctx, projectID, userIDs)
 }
 
+// ChangePassword mocks base method.
+func (m *MockUserService) ChangePassword(ctx context.Context, userID int, data *dto.ChangePasswordRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, userID, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockUserServiceMockRecorder) ChangePassword(ctx, userID, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUserService)(nil).ChangePassword), ctx, userID, data)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserService) CreateUser(ctx context.Context, user *models.User) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +100,21 @@ func (mr *MockUserServiceMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserService)(nil).DeleteUser), ctx, id)
 }
 
+// FindByEmails mocks base method.
+func (m *MockUserService) FindByEmails(ctx context.Context, emails []string) ([]*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmails", ctx, emails)
+	ret0, _ := ret[0].([]*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmails indicates an expected call of FindByEmails.
+func (mr *MockUserServiceMockRecorder) FindByEmails(ctx, emails any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmails", reflect.TypeOf((*MockUserService)(nil).FindByEmails), ctx, emails)
+}
+
 // FindByID mocks base method.
 func (m *MockUserService) FindByID(ctx context.Context, id int) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -101,40 +131,41 @@ func (mr *MockUserServiceMockRecorder) FindByID(ctx, id any) *gomock.Call {
 }
 
 // FindValidTeamMembersForAssignment mocks base method.
-func (m *MockUserService) FindValidTeamMembersForAssignment(ctx context.Context, userIDs []int) ([]int, error) {
+func (m *MockUserService) FindValidTeamMembersForAssignment(ctx context.Context, userIDs []int, role models.ProjectMemberRole) ([]int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindValidTeamMembersForAssignment", ctx, userIDs)
+	ret := m.ctrl.Call(m, "FindValidTeamMembersForAssignment", ctx, userIDs, role)
 	ret0, _ := ret[0].([]int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindValidTeamMembersForAssignment indicates an expected call of FindValidTeamMembersForAssignment.
-func (mr *MockUserServiceMockRecorder) FindValidTeamMembersForAssignment(ctx, userIDs any) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) FindValidTeamMembersForAssignment(ctx, userIDs, role any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindValidTeamMembersForAssignment", reflect.TypeOf((*MockUserService)(nil).FindValidTeamMembersForAssignment), ctx, userIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindValidTeamMembersForAssignment", reflect.TypeOf((*MockUserService)(nil).FindValidTeamMembersForAssignment), ctx, userIDs, role)
 }
 
 // GetAllUsers mocks base method.
-func (m *MockUserService) GetAllUsers(ctx context.Context) ([]*models.User, error) {
+func (m *MockUserService) GetAllUsers(ctx context.Context, page *dto.PageRequest) ([]*models.User, *dto.PageInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllUsers", ctx)
+	ret := m.ctrl.Call(m, "GetAllUsers", ctx, page)
 	ret0, _ := ret[0].([]*models.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(*dto.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetAllUsers indicates an expected call of GetAllUsers.
-func (mr *MockUserServiceMockRecorder) GetAllUsers(ctx any) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) GetAllUsers(ctx, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockUserService)(nil).GetAllUsers), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockUserService)(nil).GetAllUsers), ctx, page)
 }
 
 // Login mocks base method.
-func (m *MockUserService) Login(ctx context.Context, rq dto.LoginRequest) (string, error) {
+func (m *MockUserService) Login(ctx context.Context, rq dto.LoginRequest) (*dto.TokenResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Login", ctx, rq)
-	ret0, _ := ret[0].(string)
+	ret0, _ := ret[0].(*dto.TokenResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -145,6 +176,35 @@ func (mr *MockUserServiceMockRecorder) Login(ctx, rq any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUserService)(nil).Login), ctx, rq)
 }
 
+// Logout mocks base method.
+func (m *MockUserService) Logout(ctx context.Context, claims *structs.Claims) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, claims)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockUserServiceMockRecorder) Logout(ctx, claims any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockUserService)(nil).Logout), ctx, claims)
+}
+
+// RefreshToken mocks base method.
+func (m *MockUserService) RefreshToken(ctx context.Context, refreshToken string) (*dto.TokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshToken", ctx, refreshToken)
+	ret0, _ := ret[0].(*dto.TokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockUserServiceMockRecorder) RefreshToken(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockUserService)(nil).RefreshToken), ctx, refreshToken)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserService) UpdateUser(ctx context.Context, userID int, data *dto.UpdateUserRequest) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: lqkhoi-go-http-api/internal/service (interfaces: WipLimitService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks/mock_wip_limit.go -package=mocks . WipLimitService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "lqkhoi-go-http-api/internal/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWipLimitService is a mock of WipLimitService interface.
+type MockWipLimitService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWipLimitServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWipLimitServiceMockRecorder is the mock recorder for MockWipLimitService.
+type MockWipLimitServiceMockRecorder struct {
+	mock *MockWipLimitService
+}
+
+// NewMockWipLimitService creates a new mock instance.
+func NewMockWipLimitService(ctrl *gomock.Controller) *MockWipLimitService {
+	mock := &MockWipLimitService{ctrl: ctrl}
+	mock.recorder = &MockWipLimitServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWipLimitService) EXPECT() *MockWipLimitServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckLimit mocks base method.
+func (m *MockWipLimitService) CheckLimit(ctx context.Context, task *models.Task, status models.TaskStatus, assigneeID *int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckLimit", ctx, task, status, assigneeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckLimit indicates an expected call of CheckLimit.
+func (mr *MockWipLimitServiceMockRecorder) CheckLimit(ctx, task, status, assigneeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLimit", reflect.TypeOf((*MockWipLimitService)(nil).CheckLimit), ctx, task, status, assigneeID)
+}
+
+// CheckTasksEntering mocks base method.
+func (m *MockWipLimitService) CheckTasksEntering(ctx context.Context, projectID int, sprintID *int, tasks []*models.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckTasksEntering", ctx, projectID, sprintID, tasks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckTasksEntering indicates an expected call of CheckTasksEntering.
+func (mr *MockWipLimitServiceMockRecorder) CheckTasksEntering(ctx, projectID, sprintID, tasks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckTasksEntering", reflect.TypeOf((*MockWipLimitService)(nil).CheckTasksEntering), ctx, projectID, sprintID, tasks)
+}
+
+// GetWipLimits mocks base method.
+func (m *MockWipLimitService) GetWipLimits(ctx context.Context, userID, projectID int) ([]*models.WipLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWipLimits", ctx, userID, projectID)
+	ret0, _ := ret[0].([]*models.WipLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWipLimits indicates an expected call of GetWipLimits.
+func (mr *MockWipLimitServiceMockRecorder) GetWipLimits(ctx, userID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWipLimits", reflect.TypeOf((*MockWipLimitService)(nil).GetWipLimits), ctx, userID, projectID)
+}
+
+// UpdateWipLimits mocks base method.
+func (m *MockWipLimitService) UpdateWipLimits(ctx context.Context, userID, projectID int, limits []*models.WipLimit) ([]*models.WipLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWipLimits", ctx, userID, projectID, limits)
+	ret0, _ := ret[0].([]*models.WipLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWipLimits indicates an expected call of UpdateWipLimits.
+func (mr *MockWipLimitServiceMockRecorder) UpdateWipLimits(ctx, userID, projectID, limits any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWipLimits", reflect.TypeOf((*MockWipLimitService)(nil).UpdateWipLimits), ctx, userID, projectID, limits)
+}
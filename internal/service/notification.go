@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"lqkhoi-go-http-api/internal/config"
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/events"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+type NotificationService interface {
+	ListNotifications(ctx context.Context, userID int, unreadOnly bool, page *dto.PageRequest) ([]*models.Notification, *dto.PageInfo, error)
+	CountUnread(ctx context.Context, userID int) (int64, error)
+	MarkRead(ctx context.Context, userID, notificationID int) (*models.Notification, error)
+	MarkAllRead(ctx context.Context, userID int) (int64, error)
+	HandleEvent(ctx context.Context, event events.Event)
+	NotifyDueSoon(ctx context.Context, now time.Time, window time.Duration) error
+}
+
+type notificationService struct {
+	notificationRepository repository.NotificationRepository
+	taskRepository         repository.TaskRepository
+	projectRepository      repository.ProjectRepository
+	emailService           EmailService
+	publisher              events.Publisher
+	cfg                    config.DateTimeConfig
+}
+
+func NewNotificationService(notificationRepository repository.NotificationRepository, taskRepository repository.TaskRepository, projectRepository repository.ProjectRepository, emailService EmailService, publisher events.Publisher, cfg config.DateTimeConfig) NotificationService {
+	return &notificationService{
+		notificationRepository: notificationRepository,
+		taskRepository:         taskRepository,
+		projectRepository:      projectRepository,
+		emailService:           emailService,
+		publisher:              publisher,
+		cfg:                    cfg,
+	}
+}
+
+func (s *notificationService) ListNotifications(ctx context.Context, userID int, unreadOnly bool, page *dto.PageRequest) ([]*models.Notification, *dto.PageInfo, error) {
+	return s.notificationRepository.FindByUserID(ctx, userID, unreadOnly, page)
+}
+
+func (s *notificationService) CountUnread(ctx context.Context, userID int) (int64, error) {
+	return s.notificationRepository.CountUnread(ctx, userID)
+}
+
+// MarkRead marks a notification of the user as read. Notifications of other
+// users are reported as not existing.
+func (s *notificationService) MarkRead(ctx context.Context, userID, notificationID int) (*models.Notification, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "NotificationService",
+		"method", "MarkRead",
+		"notification_id", notificationID,
+		"requestor_id", userID,
+	)
+
+	notification, err := s.notificationRepository.FindByID(ctx, notificationID)
+	if err != nil {
+		return nil, err
+	}
+	if notification.UserID != userID {
+		logger.Warn("Notification belongs to another user", "user_id", notification.UserID)
+		return nil, fmt.Errorf("%w with id %d", structs.ErrNotificationNotExist, notificationID)
+	}
+	if notification.IsRead() {
+		return notification, nil
+	}
+
+	readAt := time.Now()
+	if err := s.notificationRepository.MarkRead(ctx, notificationID, readAt); err != nil {
+		return nil, err
+	}
+
+	logger.Info("Notification marked read")
+	notification.ReadAt = &readAt
+	return notification, nil
+}
+
+func (s *notificationService) MarkAllRead(ctx context.Context, userID int) (int64, error) {
+	return s.notificationRepository.MarkAllRead(ctx, userID, time.Now())
+}
+
+// HandleEvent notifies the users concerned by an event: the new assignee of
+// a task, the assignee of a task whose status changed, and the member added
+// to or removed from a project. Users are not notified of their own actions.
+func (s *notificationService) HandleEvent(ctx context.Context, event events.Event) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "NotificationService",
+		"method", "HandleEvent",
+		"event_id", event.ID,
+		"event_type", event.Type,
+	)
+
+	var notification *models.Notification
+	var err error
+	switch event.Type {
+	case events.TaskAssigned:
+		notification, err = s.taskAssignedNotification(ctx, event)
+	case events.TaskStatusChanged:
+		notification, err = s.taskStatusChangedNotification(ctx, event)
+	case events.ProjectMemberAdded, events.ProjectMemberRemoved:
+		notification, err = s.projectMemberNotification(ctx, event)
+	default:
+		return
+	}
+	if err != nil {
+		logger.Error("Failed to prepare notification", "error", err)
+		return
+	}
+	if notification == nil || notification.UserID == event.ActorID {
+		return
+	}
+
+	actorID := event.ActorID
+	notification.ProjectID = event.ProjectID
+	notification.ActorID = &actorID
+	if err := s.notificationRepository.CreateMany(ctx, []*models.Notification{notification}, nil); err != nil {
+		logger.Error("Failed to create notification", "error", err)
+		return
+	}
+	logger.Debug("Notification created", "user_id", notification.UserID)
+}
+
+func (s *notificationService) taskAssignedNotification(ctx context.Context, event events.Event) (*models.Notification, error) {
+	change, ok := event.Change("assignee_id")
+	if !ok || change.NewValue == nil {
+		return nil, nil
+	}
+	assigneeID, err := strconv.Atoi(*change.NewValue)
+	if err != nil {
+		return nil, fmt.Errorf("invalid assignee %q: %w", *change.NewValue, err)
+	}
+
+	task, err := s.taskRepository.FindByID(ctx, event.EntityID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Notification{
+		UserID:  assigneeID,
+		Type:    models.NotificationTaskAssigned,
+		TaskID:  &task.ID,
+		Message: fmt.Sprintf("You were assigned to %q", task.Title),
+	}, nil
+}
+
+func (s *notificationService) taskStatusChangedNotification(ctx context.Context, event events.Event) (*models.Notification, error) {
+	change, ok := event.Change("status")
+	if !ok || change.NewValue == nil {
+		return nil, nil
+	}
+
+	task, err := s.taskRepository.FindByID(ctx, event.EntityID)
+	if err != nil {
+		return nil, err
+	}
+	if task.AssigneeID == nil {
+		return nil, nil
+	}
+
+	message := fmt.Sprintf("%q moved to %s", task.Title, *change.NewValue)
+	if change.OldValue != nil {
+		message = fmt.Sprintf("%q moved from %s to %s", task.Title, *change.OldValue, *change.NewValue)
+	}
+	return &models.Notification{
+		UserID:  *task.AssigneeID,
+		Type:    models.NotificationTaskStatusChanged,
+		TaskID:  &task.ID,
+		Message: message,
+	}, nil
+}
+
+func (s *notificationService) projectMemberNotification(ctx context.Context, event events.Event) (*models.Notification, error) {
+	project, err := s.projectRepository.FindByID(ctx, event.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	notification := &models.Notification{
+		UserID:  event.EntityID,
+		Type:    models.NotificationProjectMemberAdded,
+		Message: fmt.Sprintf("You were added to project %q", project.Name),
+	}
+	if event.Type == events.ProjectMemberRemoved {
+		notification.Type = models.NotificationProjectMemberRemoved
+		notification.Message = fmt.Sprintf("You were removed from project %q", project.Name)
+	}
+	return notification, nil
+}
+
+// NotifyDueSoon reminds the assignees of the open tasks due from today until
+// window from now, in the app and by email, and publishes a due soon event
+// for each reminder. An
+// assignee is reminded once per due date: a reminder made less than window
+// before the due date is not repeated.
+func (s *notificationService) NotifyDueSoon(ctx context.Context, now time.Time, window time.Duration) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "NotificationService",
+		"method", "NotifyDueSoon",
+	)
+
+	// Due dates are dates, stored as midnight UTC.
+	year, month, day := now.UTC().Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	tasks, err := s.taskRepository.FindAssignedDueBetween(ctx, today, now.Add(window))
+	if err != nil {
+		return err
+	}
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	taskIDs := make([]int, len(tasks))
+	since := now
+	for i, task := range tasks {
+		taskIDs[i] = task.ID
+		if remindFrom := task.DueDate.Add(-window); remindFrom.Before(since) {
+			since = remindFrom
+		}
+	}
+	reminded, err := s.notificationRepository.FindTaskNotificationsSince(ctx, models.NotificationTaskDueSoon, taskIDs, since)
+	if err != nil {
+		return err
+	}
+
+	var notifications []*models.Notification
+	var dueSoon []events.Event
+	var remindedTasks []*models.Task
+	for _, task := range tasks {
+		if hasDueReminder(reminded, task, window) {
+			continue
+		}
+		remindedTasks = append(remindedTasks, task)
+		dueDate := task.DueDate.Format(s.cfg.Format)
+		notifications = append(notifications, &models.Notification{
+			UserID:    *task.AssigneeID,
+			Type:      models.NotificationTaskDueSoon,
+			ProjectID: task.ProjectID,
+			TaskID:    &task.ID,
+			Message:   fmt.Sprintf("%q is due on %s", task.Title, dueDate),
+		})
+		dueSoon = append(dueSoon, events.Event{
+			ID:         uuid.NewString(),
+			Type:       events.TaskDueSoon,
+			OccurredAt: now,
+			ProjectID:  task.ProjectID,
+			EntityType: models.ActivityEntityTask,
+			EntityID:   task.ID,
+			Changes:    []events.Change{{Field: "due_date", NewValue: &dueDate}},
+		})
+	}
+	emails, err := s.emailService.TaskDueSoonEmails(ctx, remindedTasks)
+	if err != nil {
+		logger.Error("Failed to prepare due date emails, reminding without them", "error", err)
+	}
+	if err := s.notificationRepository.CreateMany(ctx, notifications, emails); err != nil {
+		return err
+	}
+	if len(emails) > 0 {
+		s.emailService.Notify()
+	}
+	if s.publisher != nil {
+		s.publisher.Publish(ctx, dueSoon...)
+	}
+
+	logger.Info("Due date reminders created", "count", len(notifications))
+	return nil
+}
+
+// hasDueReminder reports whether the assignee of task was already reminded
+// of its current due date.
+func hasDueReminder(reminded []*models.Notification, task *models.Task, window time.Duration) bool {
+	remindFrom := task.DueDate.Add(-window)
+	for _, notification := range reminded {
+		if notification.TaskID != nil && *notification.TaskID == task.ID &&
+			notification.UserID == *task.AssigneeID &&
+			!notification.CreatedAt.Before(remindFrom) {
+			return true
+		}
+	}
+	return false
+}
+
+// DueSoonReminder periodically reminds assignees of their tasks due soon.
+type DueSoonReminder struct {
+	notificationService NotificationService
+	cfg                 config.NotificationConfig
+}
+
+func NewDueSoonReminder(notificationService NotificationService, cfg config.NotificationConfig) *DueSoonReminder {
+	return &DueSoonReminder{
+		notificationService: notificationService,
+		cfg:                 cfg,
+	}
+}
+
+// Run sends the reminders every scan interval until ctx is done.
+func (r *DueSoonReminder) Run(ctx context.Context) {
+	logger := utils.LoggerFromContext(ctx).With(
+		"component", "DueSoonReminder",
+		"method", "Run",
+	)
+
+	ticker := time.NewTicker(r.cfg.ScanInterval())
+	defer ticker.Stop()
+
+	for {
+		if err := r.notificationService.NotifyDueSoon(ctx, time.Now(), r.cfg.DueSoonWindow()); err != nil {
+			logger.Error("Failed to send due date reminders", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"lqkhoi-go-http-api/internal/config"
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/events"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubNotificationRepository struct {
+	notifications []*models.Notification
+	emails        []*models.OutboundEmail
+}
+
+func (r *stubNotificationRepository) CreateMany(ctx context.Context, notifications []*models.Notification, emails []*models.OutboundEmail) error {
+	for _, notification := range notifications {
+		notification.ID = len(r.notifications) + 1
+		notification.CreatedAt = time.Now()
+		r.notifications = append(r.notifications, notification)
+	}
+	r.emails = append(r.emails, emails...)
+	return nil
+}
+
+func (r *stubNotificationRepository) FindByID(ctx context.Context, id int) (*models.Notification, error) {
+	for _, notification := range r.notifications {
+		if notification.ID == id {
+			return notification, nil
+		}
+	}
+	return nil, structs.ErrNotificationNotExist
+}
+
+func (r *stubNotificationRepository) FindByUserID(ctx context.Context, userID int, unreadOnly bool, page *dto.PageRequest) ([]*models.Notification, *dto.PageInfo, error) {
+	return nil, nil, nil
+}
+
+func (r *stubNotificationRepository) FindTaskNotificationsSince(ctx context.Context, notificationType models.NotificationType, taskIDs []int, since time.Time) ([]*models.Notification, error) {
+	var found []*models.Notification
+	for _, notification := range r.notifications {
+		if notification.Type == notificationType && !notification.CreatedAt.Before(since) {
+			found = append(found, notification)
+		}
+	}
+	return found, nil
+}
+
+func (r *stubNotificationRepository) CountUnread(ctx context.Context, userID int) (int64, error) {
+	return 0, nil
+}
+
+func (r *stubNotificationRepository) MarkRead(ctx context.Context, id int, readAt time.Time) error {
+	return nil
+}
+
+func (r *stubNotificationRepository) MarkAllRead(ctx context.Context, userID int, readAt time.Time) (int64, error) {
+	return 0, nil
+}
+
+func TestNotificationService_HandleEvent(t *testing.T) {
+	ctx := context.Background()
+	assigneeID := 7
+	tasks := &stubTaskRepository{tasks: []*models.Task{{ID: 3, ProjectID: 1, Title: "Design login page", AssigneeID: &assigneeID}}}
+	newValue := func(value string) *string { return &value }
+
+	t.Run("assignee is notified", func(t *testing.T) {
+		repo := &stubNotificationRepository{}
+		s := &notificationService{notificationRepository: repo, taskRepository: tasks}
+
+		s.HandleEvent(ctx, events.Event{Type: events.TaskAssigned, ProjectID: 1, ActorID: 2, EntityID: 3,
+			Changes: []events.Change{{Field: "assignee_id", NewValue: newValue("7")}}})
+
+		require.Len(t, repo.notifications, 1)
+		notification := repo.notifications[0]
+		assert.Equal(t, 7, notification.UserID)
+		assert.Equal(t, models.NotificationTaskAssigned, notification.Type)
+		assert.Equal(t, 1, notification.ProjectID)
+		assert.Equal(t, 2, *notification.ActorID)
+		assert.Equal(t, `You were assigned to "Design login page"`, notification.Message)
+	})
+
+	t.Run("self assignment is not notified", func(t *testing.T) {
+		repo := &stubNotificationRepository{}
+		s := &notificationService{notificationRepository: repo, taskRepository: tasks}
+
+		s.HandleEvent(ctx, events.Event{Type: events.TaskAssigned, ProjectID: 1, ActorID: 7, EntityID: 3,
+			Changes: []events.Change{{Field: "assignee_id", NewValue: newValue("7")}}})
+
+		assert.Empty(t, repo.notifications)
+	})
+
+	t.Run("assignee is notified of status changes", func(t *testing.T) {
+		repo := &stubNotificationRepository{}
+		s := &notificationService{notificationRepository: repo, taskRepository: tasks}
+
+		s.HandleEvent(ctx, events.Event{Type: events.TaskStatusChanged, ProjectID: 1, ActorID: 2, EntityID: 3,
+			Changes: []events.Change{{Field: "status", OldValue: newValue("TO_DO"), NewValue: newValue("REVIEW")}}})
+
+		require.Len(t, repo.notifications, 1)
+		assert.Equal(t, 7, repo.notifications[0].UserID)
+		assert.Equal(t, `"Design login page" moved from TO_DO to REVIEW`, repo.notifications[0].Message)
+	})
+}
+
+func TestNotificationService_NotifyDueSoon(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 5, 1, 15, 0, 0, 0, time.UTC)
+	window := 24 * time.Hour
+	assignee := &models.User{ID: 7, Email: "dev@example.com", EmailOnDueSoon: true}
+	today := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	tomorrow := today.AddDate(0, 0, 1)
+	nextWeek := today.AddDate(0, 0, 7)
+	tasks := &stubTaskRepository{tasks: []*models.Task{
+		{ID: 1, ProjectID: 1, Title: "Due today", AssigneeID: &assignee.ID, Assignee: assignee, DueDate: &today},
+		{ID: 2, ProjectID: 1, Title: "Due tomorrow", AssigneeID: &assignee.ID, Assignee: assignee, DueDate: &tomorrow},
+		{ID: 3, ProjectID: 1, Title: "Due next week", AssigneeID: &assignee.ID, Assignee: assignee, DueDate: &nextWeek},
+	}}
+	cfg := config.DateTimeConfig{Format: "2006-01-02"}
+	repo := &stubNotificationRepository{}
+	s := &notificationService{notificationRepository: repo, taskRepository: tasks, emailService: NewEmailService(nil, tasks, nil, nil, cfg), cfg: cfg}
+
+	require.NoError(t, s.NotifyDueSoon(ctx, now, window))
+	require.Len(t, repo.notifications, 2)
+	assert.Equal(t, models.NotificationTaskDueSoon, repo.notifications[0].Type)
+	assert.Equal(t, `"Due today" is due on 2025-05-01`, repo.notifications[0].Message)
+	assert.Nil(t, repo.notifications[0].ActorID)
+	require.Len(t, repo.emails, 2, "emails are queued with the reminders")
+	assert.Equal(t, "dev@example.com", repo.emails[0].To)
+
+	t.Run("reminders are not repeated", func(t *testing.T) {
+		require.NoError(t, s.NotifyDueSoon(ctx, now.Add(time.Hour), window))
+		assert.Len(t, repo.notifications, 2)
+		assert.Len(t, repo.emails, 2)
+	})
+
+	t.Run("reminders made before the window do not count", func(t *testing.T) {
+		for _, notification := range repo.notifications {
+			notification.CreatedAt = now.Add(-48 * time.Hour)
+		}
+		require.NoError(t, s.NotifyDueSoon(ctx, now.Add(time.Hour), window))
+		assert.Len(t, repo.notifications, 4)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"lqkhoi-go-http-api/internal/dto"
 	"lqkhoi-go-http-api/internal/models"
@@ -13,25 +14,35 @@ import (
 	"lqkhoi-go-http-api/pkg/utils"
 )
 
+//go:generate mockgen -destination=./mocks/mock_project.go -package=mocks . ProjectService
+
 type ProjectService interface {
 	CreateProject(ctx context.Context, project *models.Project) (*models.Project, error)
-	ListProjects(ctx context.Context, filter dto.ProjectFilter) ([]*models.Project, error)
+	ListProjects(ctx context.Context, filter dto.ProjectFilter, page *dto.PageRequest) ([]*models.Project, *dto.PageInfo, error)
 	FindByID(ctx context.Context, id int) (*models.Project, error)
-	AddTeamMembers(ctx context.Context, userID, projectID int, userIDsToAdd []int) (int, error)
+	AddTeamMembers(ctx context.Context, userID, projectID int, userIDsToAdd []int, role models.ProjectMemberRole) (int, error)
+	ListMembers(ctx context.Context, userID, projectID int) ([]*models.ProjectMember, error)
+	RemoveMember(ctx context.Context, userID, projectID, memberID int) error
 	UpdateProject(ctx context.Context, userID, projectId int, data *dto.UpdateProjectRequest) (*models.Project, error)
 	DeleteProject(ctx context.Context, userID, projectID int) error
 	GetAndVerifyProjectManager(ctx context.Context, userID, projectID int) (*models.Project, error)
+	GetProjectMember(ctx context.Context, userID, projectID int) (*models.ProjectMember, error)
+	ListActivity(ctx context.Context, userID, projectID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error)
 }
 
 type projectService struct {
-	projectRepository repository.ProjectRepository
-	userService       UserService
+	projectRepository       repository.ProjectRepository
+	projectMemberRepository repository.ProjectMemberRepository
+	userService             UserService
+	activityService         ActivityService
 }
 
-func NewProjectService(projectRepository repository.ProjectRepository, userService UserService) ProjectService {
+func NewProjectService(projectRepository repository.ProjectRepository, projectMemberRepository repository.ProjectMemberRepository, userService UserService, activityService ActivityService) ProjectService {
 	return &projectService{
-		projectRepository: projectRepository,
-		userService: userService,
+		projectRepository:       projectRepository,
+		projectMemberRepository: projectMemberRepository,
+		userService:             userService,
+		activityService:         activityService,
 	}
 }
 
@@ -56,9 +67,16 @@ func (s *projectService) GetAndVerifyProjectManager(ctx context.Context, userID,
 	}
 
 	logger.Debug(structs.MsgVerifyingProjectManager)
-	if project.ManagerID != userID {
-		logger.Warn(structs.MsgAuthorizationFailure,
-			"manager_id", project.ManagerID)
+	member, err := s.GetProjectMember(ctx, userID, projectID)
+	if err != nil {
+		if errors.Is(err, structs.ErrUserNotPartProject) {
+			logger.Warn(structs.MsgAuthorizationFailure, "reason", "user is not a project member")
+			return nil, structs.ErrUserNotManageProject
+		}
+		return nil, err
+	}
+	if member.Role != models.ProjectRoleManager {
+		logger.Warn(structs.MsgAuthorizationFailure, "member_role", member.Role)
 		return nil, structs.ErrUserNotManageProject
 	}
 
@@ -66,16 +84,49 @@ func (s *projectService) GetAndVerifyProjectManager(ctx context.Context, userID,
 	return project, nil
 }
 
+func (s *projectService) GetProjectMember(ctx context.Context, userID, projectID int) (*models.ProjectMember, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ProjectService",
+		"method", "GetProjectMember",
+		"project_id", projectID,
+		"user_id", userID,
+	)
+
+	member, err := s.projectMemberRepository.FindByProjectAndUser(ctx, projectID, userID)
+	if err != nil {
+		if errors.Is(err, structs.ErrUserNotPartProject) {
+			logger.Debug("User is not a member of the project")
+			return nil, structs.ErrUserNotPartProject
+		}
+		logger.Error("Failed to fetch project membership", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	logger.Debug("Project membership found", "role", member.Role)
+	return member, nil
+}
+
 func (s *projectService) CreateProject(ctx context.Context, project *models.Project) (*models.Project, error) {
-	return s.projectRepository.Create(ctx, project)
+	project.Members = []models.ProjectMember{
+		{UserID: project.ManagerID, Role: models.ProjectRoleManager},
+	}
+	project, err := s.projectRepository.Create(ctx, project)
+	if err != nil {
+		return project, err
+	}
+
+	s.activityService.Record(ctx, newActivity(project.ManagerID, project.ID, models.ActivityEntityProject, project.ID, models.ActivityCreate,
+		snapshotChanges(project, false, "name", "status", "start_date", "end_date", "manager_id")))
+	return project, nil
 }
 
-func (s *projectService) ListProjects(ctx context.Context, filter dto.ProjectFilter) ([]*models.Project, error) {
-	projects, err := s.projectRepository.Find(ctx, filter)
+func (s *projectService) ListProjects(ctx context.Context, filter dto.ProjectFilter, page *dto.PageRequest) ([]*models.Project, *dto.PageInfo, error) {
+	projects, pageInfo, err := s.projectRepository.Find(ctx, filter, page)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list projects: %w", err)
+		return nil, nil, fmt.Errorf("failed to list projects: %w", err)
 	}
-	return projects, nil
+	return projects, pageInfo, nil
 }
 
 func (s *projectService) FindByID(ctx context.Context, id int) (*models.Project, error) {
@@ -91,7 +142,7 @@ func (s *projectService) FindByID(ctx context.Context, id int) (*models.Project,
 	return project, nil
 }
 
-func (s *projectService) AddTeamMembers(ctx context.Context, userID, projectID int, userIDsToAdd []int) (int, error) {
+func (s *projectService) AddTeamMembers(ctx context.Context, userID, projectID int, userIDsToAdd []int, role models.ProjectMemberRole) (int, error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "ProjectService",
@@ -99,65 +150,166 @@ func (s *projectService) AddTeamMembers(ctx context.Context, userID, projectID i
 		"project_id", projectID,
 		"requestor_id", userID,
 		"users_to_add", userIDsToAdd,
+		"role", role,
 	)
 
 	logger.Debug("Starting team member addition process")
 
-	project, err := s.projectRepository.FindByID(ctx, projectID)
+	_, err := s.GetAndVerifyProjectManager(ctx, userID, projectID)
 	if err != nil {
 		if errors.Is(err, structs.ErrProjectNotExist) {
-			logger.Error("Project not found", "error", err)
-			return 0, err
+			return 0, fmt.Errorf("cannot add members: %w with id %d", err, projectID)
 		}
-		logger.Error("Failed to retrieve project", "error", err)
+		if errors.Is(err, structs.ErrUserNotManageProject) {
+			return 0, fmt.Errorf("user %d cannot add members to project %d: %w", userID, projectID, err)
+		}
+		logger.Error("Failed initial project retrieval or authorization", "error", err)
 		return 0, err
 	}
 
-	logger.Debug("Project found", "project_manager_id", project.ManagerID)
+	logger.Debug("Validating users for assignment")
+	validUserIDs, validationErr := s.userService.FindValidTeamMembersForAssignment(ctx, userIDsToAdd, role)
+	if validationErr != nil && validUserIDs == nil {
+		logger.Error("Failed to validate users", "error", validationErr)
+		return 0, structs.ErrDatabaseFail
+	}
 
-	if managerID := project.ManagerID; managerID != userID {
-		logger.Error("Authorization failure",
-			"error", structs.ErrUserNotManageProject,
-			"requestor_id", userID,
-			"manager_id", managerID,
-		)
-		return 0, structs.ErrUserNotManageProject
+	logger.Debug("Filtering out users who are already members")
+	existing, err := s.projectMemberRepository.FindByProjectAndUsers(ctx, projectID, validUserIDs)
+	if err != nil {
+		logger.Error("Failed to fetch existing project members", "error", err)
+		return 0, structs.ErrDatabaseFail
 	}
 
-	logger.Debug("Validating users for assignment")
-	validUserIDs, validationErr := s.userService.FindValidTeamMembersForAssignment(ctx, userIDsToAdd)
+	existingIDs := make(map[int]struct{}, len(existing))
+	for _, m := range existing {
+		existingIDs[m.UserID] = struct{}{}
+	}
 
+	invalidUserMessages := make([]string, 0, len(existing)+1)
 	if validationErr != nil {
-		logger.Debug("User validation produced errors",
-			"valid_users_count", len(validUserIDs),
-		)
+		invalidUserMessages = append(invalidUserMessages, validationErr.Error())
+	}
 
-		if len(validUserIDs) == 0 {
-			return 0, fmt.Errorf("%w: underlying reason: %v", structs.ErrNoValidUserStatus, validationErr)
+	members := make([]*models.ProjectMember, 0, len(validUserIDs))
+	for _, id := range validUserIDs {
+		if _, ok := existingIDs[id]; ok {
+			logger.Warn("User is already a member of the project", "user_id", id)
+			invalidUserMessages = append(invalidUserMessages, fmt.Sprintf("user %d is already a member of project %d", id, projectID))
+			continue
 		}
+		members = append(members, &models.ProjectMember{
+			ProjectID: projectID,
+			UserID:    id,
+			Role:      role,
+		})
+	}
 
-		logger.Error("Some users failed validation",
-			"valid_users_count", len(validUserIDs),
-			"requested_users_count", len(userIDsToAdd),
-		)
-	} else {
-		logger.Debug("All users validated successfully", "valid_users_count", len(validUserIDs))
+	var partialErr error
+	if len(invalidUserMessages) > 0 {
+		partialErr = errors.New(strings.Join(invalidUserMessages, "; "))
 	}
 
-	logger.Debug("Assigning users to project", "user_count", len(validUserIDs))
+	if len(members) == 0 {
+		logger.Warn("No valid users to add")
+		return 0, fmt.Errorf("%w: underlying reason: %v", structs.ErrNoValidUserStatus, partialErr)
+	}
 
-	err = s.userService.AssignUsersToProject(ctx, projectID, validUserIDs)
-	if err != nil {
-		logger.Error("Failed to assign users to project", "error", err)
+	logger.Debug("Adding users to project", "user_count", len(members))
+	if err := s.projectMemberRepository.CreateMany(ctx, members); err != nil {
+		logger.Error("Failed to add users to project", "error", err)
 		return 0, structs.ErrDatabaseFail
 	}
 
 	logger.Info("Successfully added users to project",
 		"project_id", projectID,
-		"user_count", len(validUserIDs),
+		"user_count", len(members),
 	)
 
-	return len(validUserIDs), validationErr
+	for _, member := range members {
+		s.activityService.Record(ctx, newActivity(userID, projectID, models.ActivityEntityProjectMember, member.UserID, models.ActivityCreate,
+			snapshotChanges(member, false, "user_id", "role")))
+	}
+
+	return len(members), partialErr
+}
+
+func (s *projectService) ListMembers(ctx context.Context, userID, projectID int) ([]*models.ProjectMember, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ProjectService",
+		"method", "ListMembers",
+		"project_id", projectID,
+		"requestor_id", userID,
+	)
+
+	logger.Debug("Fetching project by ID")
+	if _, err := s.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return nil, fmt.Errorf("cannot list members: %w with id %d", err, projectID)
+		}
+		return nil, err
+	}
+
+	logger.Debug("Verifying requestor is a project member")
+	if _, err := s.GetProjectMember(ctx, userID, projectID); err != nil {
+		if errors.Is(err, structs.ErrUserNotPartProject) {
+			return nil, fmt.Errorf("user %d cannot list members of project %d: %w", userID, projectID, err)
+		}
+		return nil, err
+	}
+
+	members, err := s.projectMemberRepository.FindByProjectID(ctx, projectID)
+	if err != nil {
+		logger.Error("Failed to list project members", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully listed project members", "count", len(members))
+	return members, nil
+}
+
+func (s *projectService) RemoveMember(ctx context.Context, userID, projectID, memberID int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ProjectService",
+		"method", "RemoveMember",
+		"project_id", projectID,
+		"requestor_id", userID,
+		"member_id", memberID,
+	)
+
+	logger.Debug("Starting member removal process")
+	project, err := s.GetAndVerifyProjectManager(ctx, userID, projectID)
+	if err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return fmt.Errorf("cannot remove member: %w with id %d", err, projectID)
+		}
+		if errors.Is(err, structs.ErrUserNotManageProject) {
+			return fmt.Errorf("user %d cannot remove members of project %d: %w", userID, projectID, err)
+		}
+		logger.Error("Failed initial project retrieval or authorization", "error", err)
+		return err
+	}
+
+	if project.ManagerID == memberID {
+		logger.Warn("Attempt to remove the project manager", "manager_id", project.ManagerID)
+		return fmt.Errorf("cannot remove user %d: %w", memberID, structs.ErrProjectOwnerRemoval)
+	}
+
+	if err := s.projectMemberRepository.DeleteByProjectAndUser(ctx, projectID, memberID); err != nil {
+		if errors.Is(err, structs.ErrUserNotPartProject) {
+			return fmt.Errorf("cannot remove user %d: %w", memberID, err)
+		}
+		logger.Error("Failed to remove project member in repository", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	logger.Info("Successfully removed project member")
+
+	s.activityService.Record(ctx, newActivity(userID, projectID, models.ActivityEntityProjectMember, memberID, models.ActivityDelete,
+		valueChange("user_id", memberID, nil)))
+	return nil
 }
 
 func (s *projectService) UpdateProject(ctx context.Context, userID, projectID int, data *dto.UpdateProjectRequest) (*models.Project, error) {
@@ -226,6 +378,9 @@ func (s *projectService) UpdateProject(ctx context.Context, userID, projectID in
 
 	logger.Info("Succesfully updated")
 
+	s.activityService.Record(ctx, newActivity(userID, projectID, models.ActivityEntityProject, projectID, models.ActivityUpdate,
+		updateChanges(project, updateMap)))
+
 	updatedProject, _ := s.projectRepository.FindByID(ctx, projectID)
 	return updatedProject, nil
 }
@@ -241,7 +396,7 @@ func (s *projectService) DeleteProject(ctx context.Context, userID, projectID in
 
 	logger.Debug("Starting project deletion process")
 
-	_, err := s.GetAndVerifyProjectManager(ctx, userID, projectID)
+	project, err := s.GetAndVerifyProjectManager(ctx, userID, projectID)
 	if err != nil {
 		if errors.Is(err, structs.ErrProjectNotExist) {
 			return fmt.Errorf("cannot delete project: %w with id %d", err, projectID)
@@ -260,5 +415,45 @@ func (s *projectService) DeleteProject(ctx context.Context, userID, projectID in
 	}
 
 	logger.Info("Successfully deleted project")
+
+	s.activityService.Record(ctx, newActivity(userID, projectID, models.ActivityEntityProject, projectID, models.ActivityDelete,
+		snapshotChanges(project, true, "name", "status")))
 	return nil
 }
+
+// ListActivity returns a page of the activity log of the project; any member
+// of the project may read it.
+func (s *projectService) ListActivity(ctx context.Context, userID, projectID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "ProjectService",
+		"method", "ListActivity",
+		"project_id", projectID,
+		"requestor_id", userID,
+	)
+
+	logger.Debug("Fetching project by ID")
+	if _, err := s.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return nil, nil, fmt.Errorf("cannot list activity: %w with id %d", err, projectID)
+		}
+		return nil, nil, err
+	}
+
+	logger.Debug("Verifying requestor is a project member")
+	if _, err := s.GetProjectMember(ctx, userID, projectID); err != nil {
+		if errors.Is(err, structs.ErrUserNotPartProject) {
+			return nil, nil, fmt.Errorf("user %d cannot list activity of project %d: %w", userID, projectID, err)
+		}
+		return nil, nil, err
+	}
+
+	activities, pageInfo, err := s.activityService.ListProjectActivity(ctx, projectID, page)
+	if err != nil {
+		logger.Error("Failed to list project activity", "error", err)
+		return nil, nil, err
+	}
+
+	logger.Info("Successfully listed project activity", "count", len(activities))
+	return activities, pageInfo, nil
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/models"
+	repomocks "lqkhoi-go-http-api/internal/repository/mocks"
+	servicemocks "lqkhoi-go-http-api/internal/service/mocks"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type projectServiceMocks struct {
+	projectRepo       *repomocks.MockProjectRepository
+	projectMemberRepo *repomocks.MockProjectMemberRepository
+	userService       *servicemocks.MockUserService
+	activityService   *servicemocks.MockActivityService
+}
+
+func setupProjectServiceTest(t *testing.T) (context.Context, *projectServiceMocks, ProjectService) {
+	ctrl := gomock.NewController(t)
+	mocks := &projectServiceMocks{
+		projectRepo:       repomocks.NewMockProjectRepository(ctrl),
+		projectMemberRepo: repomocks.NewMockProjectMemberRepository(ctrl),
+		userService:       servicemocks.NewMockUserService(ctrl),
+		activityService:   servicemocks.NewMockActivityService(ctrl),
+	}
+	projectService := NewProjectService(mocks.projectRepo, mocks.projectMemberRepo, mocks.userService, mocks.activityService)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	ctx := utils.ContextWithLogger(context.Background(), logger)
+
+	return ctx, mocks, projectService
+}
+
+// expectRequestor expects project to be loaded and the membership of
+// userID to be looked up, returning role, or no membership when role is
+// empty.
+func expectRequestor(ctx context.Context, mocks *projectServiceMocks, project *models.Project, userID int, role models.ProjectMemberRole) {
+	mocks.projectRepo.EXPECT().FindByID(ctx, project.ID).Return(project, nil).Times(1)
+	if role == "" {
+		mocks.projectMemberRepo.EXPECT().FindByProjectAndUser(ctx, project.ID, userID).Return(nil, structs.ErrUserNotPartProject).Times(1)
+		return
+	}
+	mocks.projectMemberRepo.EXPECT().FindByProjectAndUser(ctx, project.ID, userID).
+		Return(&models.ProjectMember{ProjectID: project.ID, UserID: userID, Role: role}, nil).Times(1)
+}
+
+// requestorRoles are the outcomes of the manager-only operations for each
+// role of the requestor; an empty role is a user outside the project.
+var requestorRoles = []struct {
+	name string
+	role models.ProjectMemberRole
+	err  error
+}{
+	{name: "Manager", role: models.ProjectRoleManager},
+	{name: "Member", role: models.ProjectRoleMember, err: structs.ErrUserNotManageProject},
+	{name: "Viewer", role: models.ProjectRoleViewer, err: structs.ErrUserNotManageProject},
+	{name: "Non-Member", err: structs.ErrUserNotManageProject},
+}
+
+func TestProjectService_GetAndVerifyProjectManager(t *testing.T) {
+	const userID = 2
+	project := &models.Project{ID: 1, ManagerID: 1}
+
+	for _, tc := range requestorRoles {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, mocks, projectService := setupProjectServiceTest(t)
+
+			expectRequestor(ctx, mocks, project, userID, tc.role)
+
+			got, err := projectService.GetAndVerifyProjectManager(ctx, userID, project.ID)
+
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, project, got)
+		})
+	}
+
+	t.Run("Project Not Found", func(t *testing.T) {
+		ctx, mocks, projectService := setupProjectServiceTest(t)
+
+		mocks.projectRepo.EXPECT().FindByID(ctx, 9).Return(nil, structs.ErrProjectNotExist).Times(1)
+
+		got, err := projectService.GetAndVerifyProjectManager(ctx, userID, 9)
+
+		require.ErrorIs(t, err, structs.ErrProjectNotExist)
+		assert.Nil(t, got)
+	})
+}
+
+func TestProjectService_AddTeamMembers(t *testing.T) {
+	const userID = 2
+	project := &models.Project{ID: 1, ManagerID: 1}
+
+	for _, tc := range requestorRoles {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, mocks, projectService := setupProjectServiceTest(t)
+
+			expectRequestor(ctx, mocks, project, userID, tc.role)
+			if tc.err == nil {
+				mocks.userService.EXPECT().FindValidTeamMembersForAssignment(ctx, []int{5, 6}, models.ProjectRoleMember).
+					Return([]int{5, 6}, nil).Times(1)
+				mocks.projectMemberRepo.EXPECT().FindByProjectAndUsers(ctx, project.ID, []int{5, 6}).
+					Return([]*models.ProjectMember{{ProjectID: project.ID, UserID: 6, Role: models.ProjectRoleViewer}}, nil).Times(1)
+				mocks.projectMemberRepo.EXPECT().CreateMany(ctx, []*models.ProjectMember{{ProjectID: project.ID, UserID: 5, Role: models.ProjectRoleMember}}).
+					Return(nil).Times(1)
+				mocks.activityService.EXPECT().Record(ctx, gomock.Any()).Times(1)
+			}
+
+			added, err := projectService.AddTeamMembers(ctx, userID, project.ID, []int{5, 6}, models.ProjectRoleMember)
+
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				assert.Zero(t, added)
+				return
+			}
+			assert.Equal(t, 1, added)
+			assert.ErrorContains(t, err, "user 6 is already a member of project 1")
+		})
+	}
+}
+
+func TestProjectService_RemoveMember(t *testing.T) {
+	const ownerID, userID = 1, 2
+
+	for _, tc := range requestorRoles {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, mocks, projectService := setupProjectServiceTest(t)
+			project := &models.Project{ID: 1, ManagerID: ownerID}
+
+			expectRequestor(ctx, mocks, project, userID, tc.role)
+			if tc.err == nil {
+				mocks.projectMemberRepo.EXPECT().DeleteByProjectAndUser(ctx, project.ID, 5).Return(nil).Times(1)
+				mocks.activityService.EXPECT().Record(ctx, gomock.Any()).Times(1)
+			}
+
+			err := projectService.RemoveMember(ctx, userID, project.ID, 5)
+
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	cases := []struct {
+		name      string
+		requestor int
+		memberID  int
+		deleteErr error
+		err       error
+	}{
+		{name: "Manager Removes Another Manager", requestor: ownerID, memberID: userID},
+		{name: "Manager Removes Itself", requestor: userID, memberID: userID},
+		{name: "Manager Removes Last Manager", requestor: userID, memberID: ownerID, err: structs.ErrProjectOwnerRemoval},
+		{name: "Last Manager Removes Itself", requestor: ownerID, memberID: ownerID, err: structs.ErrProjectOwnerRemoval},
+		{name: "Removed User Not a Member", requestor: ownerID, memberID: 9, deleteErr: structs.ErrUserNotPartProject, err: structs.ErrUserNotPartProject},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, mocks, projectService := setupProjectServiceTest(t)
+			project := &models.Project{ID: 1, ManagerID: ownerID}
+
+			expectRequestor(ctx, mocks, project, tc.requestor, models.ProjectRoleManager)
+			if tc.memberID != ownerID {
+				mocks.projectMemberRepo.EXPECT().DeleteByProjectAndUser(ctx, project.ID, tc.memberID).Return(tc.deleteErr).Times(1)
+			}
+			if tc.err == nil {
+				mocks.activityService.EXPECT().Record(ctx, gomock.Any()).Times(1)
+			}
+
+			err := projectService.RemoveMember(ctx, tc.requestor, project.ID, tc.memberID)
+
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"lqkhoi-go-http-api/internal/config"
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/filter"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+type SavedViewService interface {
+	ListViews(ctx context.Context, userID int) ([]*models.SavedView, error)
+	GetView(ctx context.Context, userID, viewID int) (*models.SavedView, error)
+	CreateView(ctx context.Context, userID int, view *models.SavedView) (*models.SavedView, error)
+	UpdateView(ctx context.Context, userID, viewID int, data *dto.UpdateSavedViewRequest) (*models.SavedView, error)
+	DeleteView(ctx context.Context, userID, viewID int) error
+	ResolveViewFilter(ctx context.Context, userID, viewID int) (filter.Expr, error)
+}
+
+type savedViewService struct {
+	savedViewRepository repository.SavedViewRepository
+	projectService      ProjectService
+	cfg                 config.DateTimeConfig
+}
+
+func NewSavedViewService(savedViewRepository repository.SavedViewRepository, projectService ProjectService, cfg config.DateTimeConfig) SavedViewService {
+	return &savedViewService{
+		savedViewRepository: savedViewRepository,
+		projectService:      projectService,
+		cfg:                 cfg,
+	}
+}
+
+// ListViews returns the views of the user together with the views shared to
+// the user's projects.
+func (s *savedViewService) ListViews(ctx context.Context, userID int) ([]*models.SavedView, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SavedViewService",
+		"method", "ListViews",
+		"requestor_id", userID,
+	)
+
+	views, err := s.savedViewRepository.FindVisibleToUser(ctx, userID)
+	if err != nil {
+		logger.Error("Failed to find saved views", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Saved views found", "count", len(views))
+	return views, nil
+}
+
+// GetView returns a view the user owns or that is shared to one of the
+// user's projects. Personal views of other users are reported as missing.
+func (s *savedViewService) GetView(ctx context.Context, userID, viewID int) (*models.SavedView, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SavedViewService",
+		"method", "GetView",
+		"view_id", viewID,
+		"requestor_id", userID,
+	)
+
+	view, err := s.savedViewRepository.FindByID(ctx, viewID)
+	if err != nil {
+		return nil, err
+	}
+	if view.OwnerID == userID {
+		return view, nil
+	}
+	if !view.IsShared() {
+		logger.Warn("Saved view is personal to another user", "owner_id", view.OwnerID)
+		return nil, fmt.Errorf("%w with id %d", structs.ErrSavedViewNotExist, viewID)
+	}
+	if _, err := s.projectService.GetProjectMember(ctx, userID, *view.ProjectID); err != nil {
+		return nil, fmt.Errorf("cannot use saved view %d: %w", viewID, err)
+	}
+	return view, nil
+}
+
+func (s *savedViewService) CreateView(ctx context.Context, userID int, view *models.SavedView) (*models.SavedView, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SavedViewService",
+		"method", "CreateView",
+		"requestor_id", userID,
+		"name", view.Name,
+	)
+
+	logger.Info("Starting saved view creation process")
+	if _, err := filter.Parse(view.Filter, s.cfg.Format); err != nil {
+		logger.Warn("Filter of saved view is invalid", "error", err)
+		return nil, err
+	}
+	if view.IsShared() {
+		if _, err := s.projectService.GetProjectMember(ctx, userID, *view.ProjectID); err != nil {
+			return nil, fmt.Errorf("cannot share saved view to project %d: %w", *view.ProjectID, err)
+		}
+	}
+
+	view.OwnerID = userID
+	created, err := s.savedViewRepository.Create(ctx, view)
+	if err != nil {
+		logger.Error("Failed to create saved view in repository", "error", err)
+		return nil, fmt.Errorf("repository create failed for saved view of user %d: %w", userID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Saved view created successfully", "view_id", created.ID)
+	return created, nil
+}
+
+// UpdateView changes a view of the user; only the owner can change a view,
+// shared or not.
+func (s *savedViewService) UpdateView(ctx context.Context, userID, viewID int, data *dto.UpdateSavedViewRequest) (*models.SavedView, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SavedViewService",
+		"method", "UpdateView",
+		"view_id", viewID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting saved view update process")
+	view, err := s.getOwnedView(ctx, userID, viewID)
+	if err != nil {
+		return nil, err
+	}
+
+	updateMap := make(map[string]any)
+	if data.Name != nil {
+		updateMap["name"] = *data.Name
+	}
+	if data.Filter != nil {
+		if _, err := filter.Parse(*data.Filter, s.cfg.Format); err != nil {
+			logger.Warn("Filter of saved view is invalid", "error", err)
+			return nil, err
+		}
+		updateMap["filter"] = *data.Filter
+	}
+	if data.ProjectID != nil {
+		if *data.ProjectID == 0 {
+			updateMap["project_id"] = nil
+		} else {
+			if _, err := s.projectService.GetProjectMember(ctx, userID, *data.ProjectID); err != nil {
+				return nil, fmt.Errorf("cannot share saved view to project %d: %w", *data.ProjectID, err)
+			}
+			updateMap["project_id"] = *data.ProjectID
+		}
+	}
+
+	if len(updateMap) == 0 {
+		logger.Info("No fields to update")
+		return view, nil
+	}
+
+	if err := s.savedViewRepository.Update(ctx, viewID, updateMap); err != nil {
+		logger.Error("Failed to update saved view in repository", "error", err)
+		return nil, fmt.Errorf("repository update failed for saved view %d: %w", viewID, structs.ErrDatabaseFail)
+	}
+
+	updated, err := s.savedViewRepository.FindByID(ctx, viewID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Saved view updated successfully")
+	return updated, nil
+}
+
+func (s *savedViewService) DeleteView(ctx context.Context, userID, viewID int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SavedViewService",
+		"method", "DeleteView",
+		"view_id", viewID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting saved view deletion process")
+	if _, err := s.getOwnedView(ctx, userID, viewID); err != nil {
+		return err
+	}
+
+	if err := s.savedViewRepository.Delete(ctx, viewID); err != nil {
+		logger.Error("Failed to delete saved view in repository", "error", err)
+		return fmt.Errorf("repository delete failed for saved view %d: %w", viewID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully deleted saved view")
+	return nil
+}
+
+// ResolveViewFilter returns the filter expression of a view the user can
+// use. The expression of a shared view is limited to the tasks of its
+// project.
+func (s *savedViewService) ResolveViewFilter(ctx context.Context, userID, viewID int) (filter.Expr, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SavedViewService",
+		"method", "ResolveViewFilter",
+		"view_id", viewID,
+		"requestor_id", userID,
+	)
+
+	view, err := s.GetView(ctx, userID, viewID)
+	if err != nil {
+		return nil, err
+	}
+
+	expr, err := filter.Parse(view.Filter, s.cfg.Format)
+	if err != nil {
+		logger.Warn("Stored filter of saved view no longer parses", "filter", view.Filter, "error", err)
+		return nil, fmt.Errorf("saved view %d: %w", viewID, err)
+	}
+	if !view.IsShared() {
+		return expr, nil
+	}
+
+	inProject := filter.Comparison{
+		Field:  filter.FieldProject,
+		Op:     filter.OpEq,
+		Values: []filter.Value{{Int: *view.ProjectID}},
+	}
+	return filter.And{Exprs: []filter.Expr{inProject, expr}}, nil
+}
+
+// getOwnedView returns a view the user can see, failing with
+// ErrUserNotViewOwner when someone else owns it.
+func (s *savedViewService) getOwnedView(ctx context.Context, userID, viewID int) (*models.SavedView, error) {
+	view, err := s.GetView(ctx, userID, viewID)
+	if err != nil {
+		return nil, err
+	}
+	if view.OwnerID != userID {
+		return nil, fmt.Errorf("saved view %d of user %d: %w", viewID, view.OwnerID, structs.ErrUserNotViewOwner)
+	}
+	return view, nil
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/config"
+	"lqkhoi-go-http-api/internal/filter"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubSavedViewRepository struct {
+	views []*models.SavedView
+}
+
+func (r *stubSavedViewRepository) Create(ctx context.Context, view *models.SavedView) (*models.SavedView, error) {
+	r.views = append(r.views, view)
+	return view, nil
+}
+
+func (r *stubSavedViewRepository) FindByID(ctx context.Context, id int) (*models.SavedView, error) {
+	for _, view := range r.views {
+		if view.ID == id {
+			return view, nil
+		}
+	}
+	return nil, structs.ErrSavedViewNotExist
+}
+
+func (r *stubSavedViewRepository) FindVisibleToUser(ctx context.Context, userID int) ([]*models.SavedView, error) {
+	return r.views, nil
+}
+
+func (r *stubSavedViewRepository) Update(ctx context.Context, id int, updateMap map[string]any) error {
+	return nil
+}
+
+func (r *stubSavedViewRepository) Delete(ctx context.Context, id int) error {
+	return nil
+}
+
+func TestSavedViewService_ResolveViewFilter(t *testing.T) {
+	ctx := context.Background()
+	projectID := 3
+	repo := &stubSavedViewRepository{views: []*models.SavedView{
+		{ID: 1, OwnerID: 5, Name: "Mine", Filter: "assignee = me and status != DONE"},
+		{ID: 2, OwnerID: 5, Name: "Team", Filter: "priority = CRITICAL", ProjectID: &projectID},
+		{ID: 3, OwnerID: 6, Name: "Private", Filter: "status = TO_DO"},
+	}}
+	s := &savedViewService{savedViewRepository: repo, cfg: config.DateTimeConfig{Format: "2006-01-02"}}
+
+	t.Run("personal view", func(t *testing.T) {
+		expr, err := s.ResolveViewFilter(ctx, 5, 1)
+		assert.NoError(t, err)
+		assert.IsType(t, filter.And{}, expr)
+		assert.Len(t, expr.(filter.And).Exprs, 2)
+	})
+
+	t.Run("shared view is limited to its project", func(t *testing.T) {
+		expr, err := s.ResolveViewFilter(ctx, 5, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, filter.And{Exprs: []filter.Expr{
+			filter.Comparison{Field: filter.FieldProject, Op: filter.OpEq, Values: []filter.Value{{Int: projectID}}},
+			filter.Comparison{Field: filter.FieldPriority, Op: filter.OpEq, Values: []filter.Value{{Text: "CRITICAL"}}},
+		}}, expr)
+	})
+
+	t.Run("personal view of another user", func(t *testing.T) {
+		_, err := s.ResolveViewFilter(ctx, 5, 3)
+		assert.ErrorIs(t, err, structs.ErrSavedViewNotExist)
+	})
+}
+
+func TestSavedViewService_CreateView_InvalidFilter(t *testing.T) {
+	repo := &stubSavedViewRepository{}
+	s := &savedViewService{savedViewRepository: repo, cfg: config.DateTimeConfig{Format: "2006-01-02"}}
+
+	_, err := s.CreateView(context.Background(), 5, &models.SavedView{Name: "Broken", Filter: "priority = URGENT"})
+	assert.ErrorIs(t, err, structs.ErrInvalidTaskFilter)
+	assert.Empty(t, repo.views)
+}
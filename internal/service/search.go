@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, userID int, query string, scope dto.SearchScope, limit int) ([]*models.TaskSearchResult, []*models.ProjectSearchResult, error)
+}
+
+type searchService struct {
+	searchRepository repository.SearchRepository
+}
+
+func NewSearchService(searchRepository repository.SearchRepository) SearchService {
+	return &searchService{
+		searchRepository: searchRepository,
+	}
+}
+
+// Search looks for query in the titles, names and descriptions of the tasks
+// and projects the user can see, up to limit results of each kind.
+func (s *searchService) Search(ctx context.Context, userID int, query string, scope dto.SearchScope, limit int) ([]*models.TaskSearchResult, []*models.ProjectSearchResult, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SearchService",
+		"method", "Search",
+		"requestor_id", userID,
+		"scope", scope,
+	)
+
+	query = strings.TrimSpace(query)
+	if query == "" || utf8.RuneCountInString(query) > dto.MaxSearchQueryLength {
+		logger.Warn("Search query is empty or too long", "length", len(query))
+		return nil, nil, fmt.Errorf("query must have 1 to %d characters: %w", dto.MaxSearchQueryLength, structs.ErrInvalidSearchQuery)
+	}
+	if limit < 1 || limit > dto.MaxSearchLimit {
+		return nil, nil, fmt.Errorf("limit must be between 1 and %d: %w", dto.MaxSearchLimit, structs.ErrInvalidSearchQuery)
+	}
+
+	var tasks []*models.TaskSearchResult
+	var projects []*models.ProjectSearchResult
+	var err error
+	if scope == dto.SearchAll || scope == dto.SearchTasks {
+		if tasks, err = s.searchRepository.SearchTasks(ctx, userID, query, limit); err != nil {
+			return nil, nil, err
+		}
+	}
+	if scope == dto.SearchAll || scope == dto.SearchProjects {
+		if projects, err = s.searchRepository.SearchProjects(ctx, userID, query, limit); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	logger.Info("Search completed", "task_count", len(tasks), "project_count", len(projects))
+	return tasks, projects, nil
+}
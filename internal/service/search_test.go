@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubSearchRepository struct {
+	taskQueries    []string
+	projectQueries []string
+}
+
+func (r *stubSearchRepository) SearchTasks(ctx context.Context, userID int, query string, limit int) ([]*models.TaskSearchResult, error) {
+	r.taskQueries = append(r.taskQueries, query)
+	return []*models.TaskSearchResult{{ID: 1, Title: query}}, nil
+}
+
+func (r *stubSearchRepository) SearchProjects(ctx context.Context, userID int, query string, limit int) ([]*models.ProjectSearchResult, error) {
+	r.projectQueries = append(r.projectQueries, query)
+	return []*models.ProjectSearchResult{{ID: 1, Name: query}}, nil
+}
+
+func TestSearchService_Search(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invalid queries", func(t *testing.T) {
+		s := NewSearchService(&stubSearchRepository{})
+		_, _, err := s.Search(ctx, 1, "   ", dto.SearchAll, 10)
+		assert.ErrorIs(t, err, structs.ErrInvalidSearchQuery)
+		_, _, err = s.Search(ctx, 1, strings.Repeat("a", dto.MaxSearchQueryLength+1), dto.SearchAll, 10)
+		assert.ErrorIs(t, err, structs.ErrInvalidSearchQuery)
+		_, _, err = s.Search(ctx, 1, "login", dto.SearchAll, dto.MaxSearchLimit+1)
+		assert.ErrorIs(t, err, structs.ErrInvalidSearchQuery)
+	})
+
+	t.Run("scope selects the searched items", func(t *testing.T) {
+		repo := &stubSearchRepository{}
+		s := NewSearchService(repo)
+		tasks, projects, err := s.Search(ctx, 1, " login ", dto.SearchTasks, 10)
+		require.NoError(t, err)
+		assert.Len(t, tasks, 1)
+		assert.Empty(t, projects)
+		assert.Equal(t, []string{"login"}, repo.taskQueries)
+
+		tasks, projects, err = s.Search(ctx, 1, "login", dto.SearchAll, 10)
+		require.NoError(t, err)
+		assert.Len(t, tasks, 1)
+		assert.Len(t, projects, 1)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"lqkhoi-go-http-api/internal/config"
 	"lqkhoi-go-http-api/internal/dto"
@@ -14,27 +15,45 @@ import (
 	"lqkhoi-go-http-api/pkg/utils"
 )
 
+//go:generate mockgen -destination=./mocks/mock_sprint.go -package=mocks . SprintService
+
 type SprintService interface {
 	CreateSprint(ctx context.Context, userID, projectID int, sprint *models.Sprint) (*models.Sprint, error)
 	FindByID(ctx context.Context, userID, sprintID int) (*models.Sprint, error)
-	FindSprints(ctx context.Context, filter *dto.SprintFilter) ([]*models.Sprint, error)
+	FindSprints(ctx context.Context, filter *dto.SprintFilter, page *dto.PageRequest) ([]*models.Sprint, *dto.PageInfo, error)
 	GetAndVerifyProjectManagerForSprint(ctx context.Context, baseLogger *slog.Logger, userID, sprintID int) (*models.Sprint, error)
 	UpdateSprint(ctx context.Context, userID, sprintID int, data *dto.UpdateSprintRequest) (*models.Sprint, error)
 	DeleteSprint(ctx context.Context, userID, sprintID int) error
+	StartSprint(ctx context.Context, userID, sprintID int) (*models.Sprint, error)
+	CompleteSprint(ctx context.Context, userID, sprintID int, carryOver models.CarryOverTarget, nextSprintID *int, overrideWipLimit bool) (*models.SprintReport, error)
+	GetSprintReport(ctx context.Context, userID, sprintID int) (*models.SprintReport, error)
+	GetSprintBoard(ctx context.Context, userID, sprintID int) ([]*models.Task, error)
 }
 
 type sprintService struct {
 	sprintRepository repository.SprintRepository
+	taskRepository   repository.TaskRepository
 	projectService   ProjectService
+	activityService  ActivityService
+	wipLimitService  WipLimitService
+	emailService     EmailService
 	cfg              config.DateTimeConfig
 }
 
 func NewSprintService(sprintRepository repository.SprintRepository,
+	taskRepository repository.TaskRepository,
 	projectService ProjectService,
+	activityService ActivityService,
+	wipLimitService WipLimitService,
+	emailService EmailService,
 	cfg config.DateTimeConfig) SprintService {
 	return &sprintService{
 		sprintRepository: sprintRepository,
+		taskRepository:   taskRepository,
 		projectService:   projectService,
+		activityService:  activityService,
+		wipLimitService:  wipLimitService,
+		emailService:     emailService,
 		cfg:              cfg,
 	}
 }
@@ -60,9 +79,14 @@ func (s *sprintService) GetAndVerifyProjectManagerForSprint(ctx context.Context,
 
 	logger.Debug("Starting authorize user")
 
-	if sprint.Project.ManagerID != userID {
+	member, err := s.projectService.GetProjectMember(ctx, userID, sprint.ProjectID)
+	if err != nil && !errors.Is(err, structs.ErrUserNotPartProject) {
+		logger.Error("Failed to fetch project membership", "error", err)
+		return nil, err
+	}
+	if member == nil || member.Role != models.ProjectRoleManager {
 		logger.Error("MsgAuthorizationFailure",
-			"manager_id", sprint.Project.ManagerID)
+			"project_id", sprint.ProjectID)
 		return nil, structs.ErrUserNotManageProject
 	}
 
@@ -115,22 +139,25 @@ func (s *sprintService) CreateSprint(ctx context.Context, userID, projectID int,
 		return nil, structs.ErrDatabaseFail
 	}
 
+	s.activityService.Record(ctx, newActivity(userID, sprint.ProjectID, models.ActivityEntitySprint, sprint.ID, models.ActivityCreate,
+		snapshotChanges(sprint, false, "name", "goal", "start_date", "end_date")))
+
 	return sprint, nil
 }
 
-func (s *sprintService) FindSprints(ctx context.Context, filter *dto.SprintFilter) ([]*models.Sprint, error) {
+func (s *sprintService) FindSprints(ctx context.Context, filter *dto.SprintFilter, page *dto.PageRequest) ([]*models.Sprint, *dto.PageInfo, error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "SprintService",
 		"method", "FindSprints",
 	)
 
-	sprints, err := s.sprintRepository.Find(ctx, filter)
+	sprints, pageInfo, err := s.sprintRepository.Find(ctx, filter, page)
 	if err != nil {
 		logger.Error("Failed to list sprints", "error", err)
-		return nil, fmt.Errorf("failed to list sprints: %w", err)
+		return nil, nil, fmt.Errorf("failed to list sprints: %w", err)
 	}
-	return sprints, nil
+	return sprints, pageInfo, nil
 }
 
 func (s *sprintService) FindByID(ctx context.Context, userID, sprintID int) (*models.Sprint, error) {
@@ -226,6 +253,9 @@ func (s *sprintService) UpdateSprint(ctx context.Context, userID, sprintID int,
 
 	logger.Info("Succesfully updated sprint")
 
+	s.activityService.Record(ctx, newActivity(userID, sprint.ProjectID, models.ActivityEntitySprint, sprintID, models.ActivityUpdate,
+		updateChanges(sprint, updateMap)))
+
 	updatedSprint, _ := s.sprintRepository.FindByID(ctx, sprintID)
 	return updatedSprint, nil
 }
@@ -240,7 +270,7 @@ func (s *sprintService) DeleteSprint(ctx context.Context, userID, sprintID int)
 	)
 
 	logger.Debug("Starting sprint deletion process")
-	_, err := s.GetAndVerifyProjectManagerForSprint(ctx, logger, userID, sprintID)
+	sprint, err := s.GetAndVerifyProjectManagerForSprint(ctx, logger, userID, sprintID)
 	if err != nil {
 		if errors.Is(err, structs.ErrUserNotManageProject) {
 			return fmt.Errorf("authorization failure for user id %d: %w", userID, err)
@@ -257,5 +287,295 @@ func (s *sprintService) DeleteSprint(ctx context.Context, userID, sprintID int)
 	}
 
 	logger.Info("Successfully deleted sprint")
+
+	s.activityService.Record(ctx, newActivity(userID, sprint.ProjectID, models.ActivityEntitySprint, sprintID, models.ActivityDelete,
+		snapshotChanges(sprint, true, "name")))
 	return nil
 }
+
+// StartSprint makes a planned sprint the active sprint of its project. A
+// project has at most one active sprint at a time.
+func (s *sprintService) StartSprint(ctx context.Context, userID, sprintID int) (*models.Sprint, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintService",
+		"method", "StartSprint",
+		"sprint_id", sprintID,
+		"requestor_id", userID,
+	)
+
+	logger.Debug("Starting sprint start process")
+	sprint, err := s.GetAndVerifyProjectManagerForSprint(ctx, logger, userID, sprintID)
+	if err != nil {
+		if errors.Is(err, structs.ErrUserNotManageProject) {
+			return nil, fmt.Errorf("authorization failure for user id %d: %w", userID, err)
+		} else {
+			return nil, fmt.Errorf("cannot fetch sprint: %w with sprint id: %d", err, sprintID)
+		}
+	}
+
+	switch sprint.Status {
+	case models.SprintPlanned:
+	case models.SprintClosed:
+		logger.Warn("Sprint is already closed")
+		return nil, fmt.Errorf("cannot start sprint %d: %w", sprintID, structs.ErrSprintClosed)
+	default:
+		logger.Warn("Sprint is not planned", "status", sprint.Status)
+		return nil, fmt.Errorf("cannot start sprint %d: %w", sprintID, structs.ErrSprintNotPlanned)
+	}
+
+	active, err := s.sprintRepository.FindActiveByProjectID(ctx, sprint.ProjectID)
+	if err != nil && !errors.Is(err, structs.ErrSprintNotExist) {
+		logger.Error("Failed to find active sprint of project", "error", err)
+		return nil, err
+	}
+	if active != nil {
+		logger.Warn("Project already has an active sprint", "active_sprint_id", active.ID)
+		return nil, fmt.Errorf("cannot start sprint %d while sprint %d is active: %w", sprintID, active.ID, structs.ErrActiveSprintExists)
+	}
+
+	emails, err := s.emailService.SprintStartedEmails(ctx, userID, sprint)
+	if err != nil {
+		logger.Error("Failed to prepare sprint start emails, starting without them", "error", err)
+	}
+
+	if err := s.sprintRepository.Start(ctx, sprintID, time.Now(), emails); err != nil {
+		logger.Error("Failed to start sprint in repository", "error", err)
+		return nil, fmt.Errorf("repository failed to start sprint %d: %w", sprintID, err)
+	}
+
+	logger.Info("Successfully started sprint")
+	if len(emails) > 0 {
+		s.emailService.Notify()
+	}
+
+	s.activityService.Record(ctx, newActivity(userID, sprint.ProjectID, models.ActivityEntitySprint, sprintID, models.ActivityUpdate,
+		valueChange("status", sprint.Status, models.SprintActive)))
+
+	startedSprint, err := s.sprintRepository.FindByID(ctx, sprintID)
+	if err != nil {
+		sprint.Status = models.SprintActive
+		return sprint, nil
+	}
+	return startedSprint, nil
+}
+
+// CompleteSprint closes the active sprint. Tasks whose top-level task is not
+// done are carried over, with their subtasks, to the next sprint or to the
+// backlog, as long as the board columns there can take them or the project
+// manager overrides the WIP limits; the outcome of every task is kept in the
+// sprint report.
+func (s *sprintService) CompleteSprint(ctx context.Context, userID, sprintID int, carryOver models.CarryOverTarget, nextSprintID *int, overrideWipLimit bool) (*models.SprintReport, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintService",
+		"method", "CompleteSprint",
+		"sprint_id", sprintID,
+		"requestor_id", userID,
+		"carry_over", carryOver,
+	)
+
+	logger.Debug("Starting sprint completion process")
+	sprint, err := s.GetAndVerifyProjectManagerForSprint(ctx, logger, userID, sprintID)
+	if err != nil {
+		if errors.Is(err, structs.ErrUserNotManageProject) {
+			return nil, fmt.Errorf("authorization failure for user id %d: %w", userID, err)
+		} else {
+			return nil, fmt.Errorf("cannot fetch sprint: %w with sprint id: %d", err, sprintID)
+		}
+	}
+
+	if sprint.Status != models.SprintActive {
+		logger.Warn("Sprint is not active", "status", sprint.Status)
+		return nil, fmt.Errorf("cannot complete sprint %d: %w", sprintID, structs.ErrSprintNotActive)
+	}
+
+	report := &models.SprintReport{
+		ProjectID:     sprint.ProjectID,
+		CompletedByID: userID,
+		CarryOver:     carryOver,
+	}
+	if carryOver == models.CarryOverNextSprint {
+		next, err := s.findNextSprint(ctx, logger, sprint, nextSprintID)
+		if err != nil {
+			return nil, err
+		}
+		report.NextSprintID = &next.ID
+	}
+
+	carried := carriedOverTasks(sprint.Tasks)
+	carriedTaskIDs := make([]int, 0, len(carried))
+	carriedTasks := make([]*models.Task, 0, len(carried))
+	report.Tasks = make([]models.SprintReportTask, len(sprint.Tasks))
+	for i, task := range sprint.Tasks {
+		outcome := models.SprintTaskCompleted
+		if carried[task.ID] {
+			outcome = models.SprintTaskCarriedOver
+			carriedTaskIDs = append(carriedTaskIDs, task.ID)
+			carriedTasks = append(carriedTasks, &sprint.Tasks[i])
+			report.CarriedOverCount++
+		} else {
+			report.CompletedCount++
+		}
+		report.Tasks[i] = models.SprintReportTask{
+			TaskID:      task.ID,
+			Title:       task.Title,
+			Status:      task.Status,
+			Outcome:     outcome,
+			StoryPoints: task.StoryPoints,
+		}
+	}
+
+	if overrideWipLimit {
+		// Only project managers get this far.
+		logger.Info("WIP limits overridden by project manager for carried over tasks", "carried_over_count", len(carriedTasks))
+	} else if err := s.wipLimitService.CheckTasksEntering(ctx, sprint.ProjectID, report.NextSprintID, carriedTasks); err != nil {
+		return nil, fmt.Errorf("cannot carry over tasks of sprint %d: %w", sprintID, err)
+	}
+
+	logger.Debug("Attempting sprint completion", "carried_task_ids", carriedTaskIDs, "next_sprint_id", report.NextSprintID)
+	if err := s.sprintRepository.Complete(ctx, sprintID, report, carriedTaskIDs); err != nil {
+		logger.Error("Failed to complete sprint in repository", "error", err)
+		return nil, fmt.Errorf("repository failed to complete sprint %d: %w", sprintID, err)
+	}
+
+	logger.Info("Successfully completed sprint",
+		"completed_count", report.CompletedCount,
+		"carried_over_count", report.CarriedOverCount)
+
+	s.activityService.Record(ctx, newActivity(userID, sprint.ProjectID, models.ActivityEntitySprint, sprintID, models.ActivityUpdate,
+		valueChange("status", sprint.Status, models.SprintClosed)))
+	for _, task := range sprint.Tasks {
+		if carried[task.ID] {
+			s.activityService.Record(ctx, newActivity(userID, task.ProjectID, models.ActivityEntityTask, task.ID, models.ActivityUpdate,
+				valueChange("sprint_id", task.SprintID, report.NextSprintID)))
+		}
+	}
+
+	fullReport, err := s.sprintRepository.FindReportBySprintID(ctx, sprintID)
+	if err != nil {
+		return report, nil
+	}
+	return fullReport, nil
+}
+
+func (s *sprintService) GetSprintReport(ctx context.Context, userID, sprintID int) (*models.SprintReport, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintService",
+		"method", "GetSprintReport",
+		"sprint_id", sprintID,
+		"requestor_id", userID,
+	)
+
+	if _, err := s.GetAndVerifyProjectManagerForSprint(ctx, logger, userID, sprintID); err != nil {
+		if errors.Is(err, structs.ErrUserNotManageProject) {
+			return nil, fmt.Errorf("authorization failure for user id %d: %w", userID, err)
+		} else {
+			return nil, fmt.Errorf("cannot fetch sprint: %w with sprint id: %d", err, sprintID)
+		}
+	}
+
+	report, err := s.sprintRepository.FindReportBySprintID(ctx, sprintID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch report of sprint %d: %w", sprintID, err)
+	}
+	return report, nil
+}
+
+// GetSprintBoard returns the tasks of the sprint in rank order, for any
+// member of its project.
+func (s *sprintService) GetSprintBoard(ctx context.Context, userID, sprintID int) ([]*models.Task, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "SprintService",
+		"method", "GetSprintBoard",
+		"sprint_id", sprintID,
+		"requestor_id", userID,
+	)
+
+	sprint, err := s.sprintRepository.FindByID(ctx, sprintID)
+	if err != nil {
+		if errors.Is(err, structs.ErrSprintNotExist) {
+			return nil, fmt.Errorf("cannot fetch board: %w with sprint id: %d", err, sprintID)
+		}
+		logger.Error("Can not fetch sprint", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	if _, err := s.projectService.GetProjectMember(ctx, userID, sprint.ProjectID); err != nil {
+		if errors.Is(err, structs.ErrUserNotPartProject) {
+			logger.Warn("Only project members can see the board", "project_id", sprint.ProjectID)
+			return nil, fmt.Errorf("user %d cannot see board of sprint %d: %w", userID, sprintID, err)
+		}
+		return nil, err
+	}
+
+	tasks, err := s.taskRepository.FindBySprintIDInRankOrder(ctx, sprintID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch tasks of sprint %d: %w", sprintID, err)
+	}
+
+	logger.Debug("Sprint board retrieved", "task_count", len(tasks))
+	return tasks, nil
+}
+
+// findNextSprint returns the sprint unfinished tasks of sprint are carried
+// over to: the requested one, or else the next planned sprint of the project.
+func (s *sprintService) findNextSprint(ctx context.Context, logger *slog.Logger, sprint *models.Sprint, nextSprintID *int) (*models.Sprint, error) {
+	if nextSprintID == nil {
+		next, err := s.sprintRepository.FindNextPlanned(ctx, sprint.ProjectID, sprint.StartDate)
+		if err != nil {
+			if errors.Is(err, structs.ErrSprintNotExist) {
+				logger.Warn("Project has no planned sprint to carry tasks over to")
+				return nil, fmt.Errorf("cannot complete sprint %d: %w", sprint.ID, structs.ErrNoNextSprint)
+			}
+			return nil, err
+		}
+		return next, nil
+	}
+
+	if *nextSprintID == sprint.ID {
+		return nil, fmt.Errorf("cannot carry tasks of sprint %d over to itself: %w", sprint.ID, structs.ErrNoNextSprint)
+	}
+	next, err := s.sprintRepository.FindByID(ctx, *nextSprintID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot carry tasks over: %w with sprint id %d", err, *nextSprintID)
+	}
+	if next.ProjectID != sprint.ProjectID {
+		logger.Warn("Next sprint belongs to another project", "next_sprint_id", next.ID, "next_sprint_project_id", next.ProjectID)
+		return nil, fmt.Errorf("cannot carry tasks over to sprint %d: %w", next.ID, structs.ErrSprintNotInProject)
+	}
+	if next.Status == models.SprintClosed {
+		logger.Warn("Next sprint is already closed", "next_sprint_id", next.ID)
+		return nil, fmt.Errorf("cannot carry tasks over to sprint %d: %w", next.ID, structs.ErrSprintClosed)
+	}
+	return next, nil
+}
+
+// carriedOverTasks returns the IDs of the tasks that are carried over when
+// the sprint holding tasks is completed: every task whose top-level task is
+// not done. Subtasks always share the sprint of their parent, so a subtree
+// is carried over as a whole.
+func carriedOverTasks(tasks []models.Task) map[int]bool {
+	byID := make(map[int]*models.Task, len(tasks))
+	for i := range tasks {
+		byID[tasks[i].ID] = &tasks[i]
+	}
+
+	carried := make(map[int]bool)
+	for i := range tasks {
+		root := &tasks[i]
+		for depth := 0; root.ParentTaskID != nil && depth < len(tasks); depth++ {
+			parent, ok := byID[*root.ParentTaskID]
+			if !ok {
+				break
+			}
+			root = parent
+		}
+		if root.Status != models.DoneTask {
+			carried[tasks[i].ID] = true
+		}
+	}
+	return carried
+}
@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/config"
+	"lqkhoi-go-http-api/internal/models"
+	repomocks "lqkhoi-go-http-api/internal/repository/mocks"
+	servicemocks "lqkhoi-go-http-api/internal/service/mocks"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCarriedOverTasks(t *testing.T) {
+	parent := func(id int) *int { return &id }
+	tasks := []models.Task{
+		{ID: 1, Status: models.DoneTask},
+		{ID: 2, Status: models.InProgressTask},
+		{ID: 3, Status: models.DoneTask, ParentTaskID: parent(2)},
+		{ID: 4, Status: models.ToDoTask, ParentTaskID: parent(3)},
+		{ID: 5, Status: models.ToDoTask, ParentTaskID: parent(1)},
+		{ID: 6, Status: models.ReviewTask, ParentTaskID: parent(99)},
+	}
+
+	assert.Equal(t, map[int]bool{2: true, 3: true, 4: true, 6: true}, carriedOverTasks(tasks))
+}
+
+type sprintServiceMocks struct {
+	sprintRepo      *repomocks.MockSprintRepository
+	projectService  *servicemocks.MockProjectService
+	activityService *servicemocks.MockActivityService
+	wipLimitService *servicemocks.MockWipLimitService
+	emailService    *servicemocks.MockEmailService
+}
+
+func setupSprintServiceTest(t *testing.T) (context.Context, *sprintServiceMocks, *sprintService) {
+	ctrl := gomock.NewController(t)
+	mocks := &sprintServiceMocks{
+		sprintRepo:      repomocks.NewMockSprintRepository(ctrl),
+		projectService:  servicemocks.NewMockProjectService(ctrl),
+		activityService: servicemocks.NewMockActivityService(ctrl),
+		wipLimitService: servicemocks.NewMockWipLimitService(ctrl),
+		emailService:    servicemocks.NewMockEmailService(ctrl),
+	}
+	sprintService := NewSprintService(mocks.sprintRepo, nil, mocks.projectService, mocks.activityService, mocks.wipLimitService, mocks.emailService, config.DateTimeConfig{}).(*sprintService)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	ctx := utils.ContextWithLogger(context.Background(), logger)
+
+	return ctx, mocks, sprintService
+}
+
+// expectSprintManager expects sprint to be loaded for its project manager
+// userID.
+func expectSprintManager(ctx context.Context, mocks *sprintServiceMocks, userID int, sprint *models.Sprint) {
+	mocks.sprintRepo.EXPECT().FindByID(ctx, sprint.ID).Return(sprint, nil).Times(1)
+	mocks.projectService.EXPECT().GetProjectMember(ctx, userID, sprint.ProjectID).
+		Return(&models.ProjectMember{UserID: userID, ProjectID: sprint.ProjectID, Role: models.ProjectRoleManager}, nil).Times(1)
+}
+
+func TestSprintService_StartSprint(t *testing.T) {
+	const userID = 1
+
+	cases := []struct {
+		name     string
+		status   models.SprintStatus
+		active   *models.Sprint
+		startErr error
+		err      error
+	}{
+		{name: "Success", status: models.SprintPlanned},
+		{name: "Failure - Project Has Active Sprint", status: models.SprintPlanned, active: &models.Sprint{ID: 3, ProjectID: 1, Status: models.SprintActive}, err: structs.ErrActiveSprintExists},
+		{name: "Failure - Sprint Started Concurrently", status: models.SprintPlanned, startErr: structs.ErrActiveSprintExists, err: structs.ErrActiveSprintExists},
+		{name: "Failure - Sprint Already Active", status: models.SprintActive, err: structs.ErrSprintNotPlanned},
+		{name: "Failure - Sprint Closed", status: models.SprintClosed, err: structs.ErrSprintClosed},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, mocks, sprintService := setupSprintServiceTest(t)
+			sprint := &models.Sprint{ID: 2, ProjectID: 1, Status: tc.status}
+
+			expectSprintManager(ctx, mocks, userID, sprint)
+			if tc.status == models.SprintPlanned {
+				if tc.active != nil {
+					mocks.sprintRepo.EXPECT().FindActiveByProjectID(ctx, sprint.ProjectID).Return(tc.active, nil).Times(1)
+				} else {
+					mocks.sprintRepo.EXPECT().FindActiveByProjectID(ctx, sprint.ProjectID).Return(nil, structs.ErrSprintNotExist).Times(1)
+					mocks.emailService.EXPECT().SprintStartedEmails(ctx, userID, sprint).Return(nil, nil).Times(1)
+					mocks.sprintRepo.EXPECT().Start(ctx, sprint.ID, gomock.Any(), gomock.Nil()).Return(tc.startErr).Times(1)
+				}
+			}
+			if tc.err == nil {
+				mocks.activityService.EXPECT().Record(ctx, gomock.Any()).Times(1)
+				mocks.sprintRepo.EXPECT().FindByID(ctx, sprint.ID).
+					Return(&models.Sprint{ID: sprint.ID, ProjectID: sprint.ProjectID, Status: models.SprintActive}, nil).Times(1)
+			}
+
+			started, err := sprintService.StartSprint(ctx, userID, sprint.ID)
+
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				assert.Nil(t, started)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, models.SprintActive, started.Status)
+		})
+	}
+}
+
+func TestSprintService_CompleteSprint(t *testing.T) {
+	const userID, projectID = 1, 1
+	id := func(id int) *int { return &id }
+	tasks := []models.Task{
+		{ID: 10, ProjectID: projectID, Status: models.DoneTask},
+		{ID: 11, ProjectID: projectID, Status: models.InProgressTask},
+		{ID: 12, ProjectID: projectID, Status: models.DoneTask, ParentTaskID: id(11)},
+		{ID: 13, ProjectID: projectID, Status: models.ToDoTask, ParentTaskID: id(10)},
+		{ID: 14, ProjectID: projectID, Status: models.ToDoTask},
+	}
+
+	cases := []struct {
+		name         string
+		carryOver    models.CarryOverTarget
+		nextSprintID *int
+		next         *models.Sprint
+	}{
+		{name: "Success - Next Planned Sprint", carryOver: models.CarryOverNextSprint, next: &models.Sprint{ID: 3, ProjectID: projectID, Status: models.SprintPlanned}},
+		{name: "Success - Requested Sprint", carryOver: models.CarryOverNextSprint, nextSprintID: id(4), next: &models.Sprint{ID: 4, ProjectID: projectID, Status: models.SprintPlanned}},
+		{name: "Success - Backlog", carryOver: models.CarryOverBacklog},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, mocks, sprintService := setupSprintServiceTest(t)
+			sprint := &models.Sprint{ID: 2, ProjectID: projectID, Status: models.SprintActive, Tasks: append([]models.Task(nil), tasks...)}
+			var nextSprintID *int
+			if tc.next != nil {
+				nextSprintID = &tc.next.ID
+			}
+
+			expectSprintManager(ctx, mocks, userID, sprint)
+			if tc.nextSprintID != nil {
+				mocks.sprintRepo.EXPECT().FindByID(ctx, *tc.nextSprintID).Return(tc.next, nil).Times(1)
+			} else if tc.next != nil {
+				mocks.sprintRepo.EXPECT().FindNextPlanned(ctx, projectID, sprint.StartDate).Return(tc.next, nil).Times(1)
+			}
+			mocks.wipLimitService.EXPECT().CheckTasksEntering(ctx, projectID, nextSprintID, []*models.Task{&sprint.Tasks[1], &sprint.Tasks[2], &sprint.Tasks[4]}).
+				Return(nil).Times(1)
+			mocks.sprintRepo.EXPECT().Complete(ctx, sprint.ID, gomock.Any(), []int{11, 12, 14}).Return(nil).Times(1)
+			mocks.activityService.EXPECT().Record(ctx, gomock.Any()).Times(4)
+			mocks.sprintRepo.EXPECT().FindReportBySprintID(ctx, sprint.ID).Return(nil, structs.ErrSprintReportNotExist).Times(1)
+
+			report, err := sprintService.CompleteSprint(ctx, userID, sprint.ID, tc.carryOver, tc.nextSprintID, false)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.carryOver, report.CarryOver)
+			assert.Equal(t, nextSprintID, report.NextSprintID)
+			assert.Equal(t, userID, report.CompletedByID)
+			assert.Equal(t, 2, report.CompletedCount)
+			assert.Equal(t, 3, report.CarriedOverCount)
+			outcomes := make(map[int]models.SprintTaskOutcome, len(report.Tasks))
+			for _, task := range report.Tasks {
+				outcomes[task.TaskID] = task.Outcome
+			}
+			assert.Equal(t, map[int]models.SprintTaskOutcome{
+				10: models.SprintTaskCompleted,
+				11: models.SprintTaskCarriedOver,
+				12: models.SprintTaskCarriedOver,
+				13: models.SprintTaskCompleted,
+				14: models.SprintTaskCarriedOver,
+			}, outcomes)
+		})
+	}
+
+	t.Run("Failure - Sprint Not Active", func(t *testing.T) {
+		ctx, mocks, sprintService := setupSprintServiceTest(t)
+		sprint := &models.Sprint{ID: 2, ProjectID: projectID, Status: models.SprintPlanned}
+
+		expectSprintManager(ctx, mocks, userID, sprint)
+
+		report, err := sprintService.CompleteSprint(ctx, userID, sprint.ID, models.CarryOverBacklog, nil, false)
+
+		require.ErrorIs(t, err, structs.ErrSprintNotActive)
+		assert.Nil(t, report)
+	})
+}
+
+func TestSprintService_FindNextSprint(t *testing.T) {
+	id := func(id int) *int { return &id }
+	errDB := errors.New("connection refused")
+	sprint := &models.Sprint{ID: 2, ProjectID: 1, Status: models.SprintActive}
+
+	cases := []struct {
+		name         string
+		nextSprintID *int
+		found        *models.Sprint
+		findErr      error
+		err          error
+	}{
+		{name: "Success - Next Planned Sprint", found: &models.Sprint{ID: 3, ProjectID: 1, Status: models.SprintPlanned}},
+		{name: "Success - Requested Sprint", nextSprintID: id(4), found: &models.Sprint{ID: 4, ProjectID: 1, Status: models.SprintPlanned}},
+		{name: "Failure - No Planned Sprint", findErr: structs.ErrSprintNotExist, err: structs.ErrNoNextSprint},
+		{name: "Failure - Planned Sprint Lookup Failed", findErr: errDB, err: errDB},
+		{name: "Failure - Requested Sprint Is Completed Sprint", nextSprintID: id(2), err: structs.ErrNoNextSprint},
+		{name: "Failure - Requested Sprint Not Found", nextSprintID: id(4), findErr: structs.ErrSprintNotExist, err: structs.ErrSprintNotExist},
+		{name: "Failure - Requested Sprint In Other Project", nextSprintID: id(4), found: &models.Sprint{ID: 4, ProjectID: 5, Status: models.SprintPlanned}, err: structs.ErrSprintNotInProject},
+		{name: "Failure - Requested Sprint Closed", nextSprintID: id(4), found: &models.Sprint{ID: 4, ProjectID: 1, Status: models.SprintClosed}, err: structs.ErrSprintClosed},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, mocks, sprintService := setupSprintServiceTest(t)
+
+			if tc.nextSprintID == nil {
+				mocks.sprintRepo.EXPECT().FindNextPlanned(ctx, sprint.ProjectID, sprint.StartDate).Return(tc.found, tc.findErr).Times(1)
+			} else if *tc.nextSprintID != sprint.ID {
+				mocks.sprintRepo.EXPECT().FindByID(ctx, *tc.nextSprintID).Return(tc.found, tc.findErr).Times(1)
+			}
+
+			next, err := sprintService.findNextSprint(ctx, utils.LoggerFromContext(ctx), sprint, tc.nextSprintID)
+
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				assert.Nil(t, next)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.found, next)
+		})
+	}
+}
+
+func TestSprintService_CompleteSprint_WipLimit(t *testing.T) {
+	const userID, projectID = 1, 1
+	nextSprint := &models.Sprint{ID: 2, ProjectID: projectID, Status: models.SprintPlanned}
+
+	cases := []struct {
+		name     string
+		override bool
+		wipErr   error
+		err      error
+	}{
+		{name: "Success - Within WIP Limit"},
+		{name: "Success - WIP Limit Overridden", override: true},
+		{name: "Failure - WIP Limit Reached", wipErr: structs.ErrWipLimitReached, err: structs.ErrWipLimitReached},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, mocks, sprintService := setupSprintServiceTest(t)
+			sprint := &models.Sprint{ID: 1, ProjectID: projectID, Status: models.SprintActive, Tasks: []models.Task{
+				{ID: 10, ProjectID: projectID, Status: models.DoneTask},
+				{ID: 11, ProjectID: projectID, Status: models.InProgressTask},
+			}}
+
+			expectSprintManager(ctx, mocks, userID, sprint)
+			mocks.sprintRepo.EXPECT().FindNextPlanned(ctx, projectID, sprint.StartDate).Return(nextSprint, nil).Times(1)
+			if !tc.override {
+				mocks.wipLimitService.EXPECT().CheckTasksEntering(ctx, projectID, &nextSprint.ID, []*models.Task{&sprint.Tasks[1]}).
+					Return(tc.wipErr).Times(1)
+			}
+			if tc.err == nil {
+				mocks.sprintRepo.EXPECT().Complete(ctx, sprint.ID, gomock.Any(), []int{11}).Return(nil).Times(1)
+				mocks.activityService.EXPECT().Record(ctx, gomock.Any()).Times(2)
+				mocks.sprintRepo.EXPECT().FindReportBySprintID(ctx, sprint.ID).Return(nil, structs.ErrSprintReportNotExist).Times(1)
+			}
+
+			report, err := sprintService.CompleteSprint(ctx, userID, sprint.ID, models.CarryOverNextSprint, nil, tc.override)
+
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				assert.Nil(t, report)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 1, report.CarriedOverCount)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+)
+
+// stubTaskRepository serves a fixed set of tasks.
+type stubTaskRepository struct {
+	repository.TaskRepository
+	tasks []*models.Task
+}
+
+func (r *stubTaskRepository) FindByID(ctx context.Context, id int) (*models.Task, error) {
+	for _, task := range r.tasks {
+		if task.ID == id {
+			return task, nil
+		}
+	}
+	return nil, structs.ErrTaskNotExist
+}
+
+func (r *stubTaskRepository) FindAssignedDueBetween(ctx context.Context, from, to time.Time) ([]*models.Task, error) {
+	var due []*models.Task
+	for _, task := range r.tasks {
+		if task.AssigneeID != nil && task.DueDate != nil && !task.DueDate.Before(from) && task.DueDate.Before(to) {
+			due = append(due, task)
+		}
+	}
+	return due, nil
+}
+
+func (r *stubTaskRepository) CountInBoardColumn(ctx context.Context, projectID int, sprintID *int, status models.TaskStatus, assigneeID *int, excludeID int) (int64, error) {
+	var count int64
+	for _, task := range r.tasks {
+		if task.ID == excludeID || task.ProjectID != projectID || task.Status != status || !sameSprint(task.SprintID, sprintID) {
+			continue
+		}
+		if assigneeID != nil && (task.AssigneeID == nil || *task.AssigneeID != *assigneeID) {
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// stubProjectService knows one project and its managers, members and viewers.
+type stubProjectService struct {
+	ProjectService
+	projectID  int
+	managerIDs []int
+	memberIDs  []int
+	viewerIDs  []int
+}
+
+func (s *stubProjectService) FindByID(ctx context.Context, id int) (*models.Project, error) {
+	if id != s.projectID {
+		return nil, structs.ErrProjectNotExist
+	}
+	return &models.Project{ID: id}, nil
+}
+
+func (s *stubProjectService) GetProjectMember(ctx context.Context, userID, projectID int) (*models.ProjectMember, error) {
+	if slices.Contains(s.managerIDs, userID) {
+		return &models.ProjectMember{ProjectID: projectID, UserID: userID, Role: models.ProjectRoleManager}, nil
+	}
+	if slices.Contains(s.memberIDs, userID) {
+		return &models.ProjectMember{ProjectID: projectID, UserID: userID, Role: models.ProjectRoleMember}, nil
+	}
+	if slices.Contains(s.viewerIDs, userID) {
+		return &models.ProjectMember{ProjectID: projectID, UserID: userID, Role: models.ProjectRoleViewer}, nil
+	}
+	return nil, structs.ErrUserNotPartProject
+}
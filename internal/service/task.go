@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
+	"strings"
 
 	"lqkhoi-go-http-api/internal/dto"
 	"lqkhoi-go-http-api/internal/models"
@@ -14,29 +16,47 @@ import (
 )
 
 type TaskService interface {
-	CreateTask(ctx context.Context, userID, sprintID int, task *models.Task) (*models.Task, error)
-	AssignTaskToUser(ctx context.Context, userID, reqID, taskID int) error
+	GetAndVerifyProjectManagerForTask(ctx context.Context, baseLogger *slog.Logger, userID, taskID int, isCommand bool) (*models.Task, error)
+	GetAndVerifyContributorForTask(ctx context.Context, baseLogger *slog.Logger, userID, taskID int) (*models.Task, error)
+	CreateTask(ctx context.Context, userID int, task *models.Task, overrideWipLimit bool) (*models.Task, error)
+	AssignTaskToUser(ctx context.Context, userID, reqID, taskID int, overrideWipLimit bool) error
 	FindByID(ctx context.Context, userID, taskID int) (*models.Task, error)
 	UpdateTask(ctx context.Context, userID, taskID int, data *dto.UpdateTaskRequest) (*models.Task, error)
-	FindTasksByUserID(ctx context.Context, userID int) ([]*models.Task, error)
-	FindTasksByProjectID(ctx context.Context, userID, projectID int) ([]*models.Task, error)
-	FindTasks(ctx context.Context, filter *dto.TaskFilter) ([]*models.Task, error)
+	ChangeTaskStatus(ctx context.Context, userID, taskID int, status models.TaskStatus, overrideWipLimit bool) (*models.Task, error)
+	MoveTask(ctx context.Context, userID, taskID int, data *dto.MoveTaskRequest) (*models.Task, error)
+	FindTasksByUserID(ctx context.Context, userID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error)
+	FindTasksByProjectID(ctx context.Context, userID, projectID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error)
+	FindBacklogByProjectID(ctx context.Context, userID, projectID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error)
+	MoveTaskToSprint(ctx context.Context, userID, taskID, sprintID int, overrideWipLimit bool) (*models.Task, error)
+	MoveTaskToBacklog(ctx context.Context, userID, taskID int, overrideWipLimit bool) (*models.Task, error)
+	FindTasks(ctx context.Context, filter *dto.TaskFilter, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error)
 	DeleteTask(ctx context.Context, userID, taskID int) error
+	FindTaskHistory(ctx context.Context, userID, taskID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error)
 }
 
 type taskService struct {
-	taskRepository repository.TaskRepository
-	projectService ProjectService
+	taskRepository     repository.TaskRepository
+	taskLinkRepository repository.TaskLinkRepository
+	projectService     ProjectService
 	sprintService  SprintService
-	userService    UserService
+	userService     UserService
+	activityService ActivityService
+	workflowService WorkflowService
+	wipLimitService WipLimitService
+	emailService    EmailService
 }
 
-func NewTaskService(taskRepository repository.TaskRepository, projectService ProjectService, sprintService SprintService, userService UserService) TaskService {
+func NewTaskService(taskRepository repository.TaskRepository, taskLinkRepository repository.TaskLinkRepository, projectService ProjectService, sprintService SprintService, userService UserService, activityService ActivityService, workflowService WorkflowService, wipLimitService WipLimitService, emailService EmailService) TaskService {
 	return &taskService{
-		taskRepository: taskRepository,
-		projectService: projectService,
-		sprintService:  sprintService,
-		userService:    userService,
+		taskRepository:     taskRepository,
+		taskLinkRepository: taskLinkRepository,
+		projectService:  projectService,
+		sprintService:   sprintService,
+		userService:     userService,
+		activityService: activityService,
+		workflowService: workflowService,
+		wipLimitService: wipLimitService,
+		emailService:    emailService,
 	}
 }
 
@@ -58,30 +78,35 @@ func (s *taskService) GetAndVerifyProjectManagerForTask(ctx context.Context, bas
 		}
 	}
 
-	logger.Debug("Task retrieval success", "task_id", task.ID, "project_id", task.Project.ID, "manager_id", task.Project.ManagerID, "assignee_id", task.AssigneeID)
+	logger.Debug("Task retrieval success", "task_id", task.ID, "project_id", task.ProjectID, "assignee_id", task.AssigneeID)
 
 	logger.Debug("Starting authorize user")
-	isManager := task.Project.ManagerID == userID
+	member, err := s.projectService.GetProjectMember(ctx, userID, task.ProjectID)
+	if err != nil && !errors.Is(err, structs.ErrUserNotPartProject) {
+		logger.Error("Failed to fetch project membership", "error", err)
+		return nil, err
+	}
+	isManager := member != nil && member.Role == models.ProjectRoleManager
+	isMember := member != nil
 	isAssignee := task.AssigneeID != nil && *task.AssigneeID == userID
 
 	if isCommand {
 		logger.Debug("Command operation: Checking for manager privileges")
 		if !isManager {
 			logger.Warn("Authorization failed: User is not project manager for command operation",
-				"required_manager_id", task.Project.ManagerID)
+				"is_member", isMember)
 			return nil, structs.ErrUserNotManageProject
 		}
 		logger.Debug("Authorization success: User is project manager")
 	} else {
-		logger.Debug("Query operation: Checking for manager or assignee privileges")
-		if !isManager && !isAssignee {
-			logger.Warn("Authorization failed: User is neither project manager nor task assignee for query operation",
-				"required_manager_id", task.Project.ManagerID,
+		logger.Debug("Query operation: Checking for membership or assignee privileges")
+		if !isMember && !isAssignee {
+			logger.Warn("Authorization failed: User is neither project member nor task assignee for query operation",
 				"task_assignee_id", task.AssigneeID)
 			return nil, structs.ErrUserNotAuthorizedForTask
 		}
-		if isManager {
-			logger.Debug("Authorization success: User is project manager")
+		if isMember {
+			logger.Debug("Authorization success: User is project member", "role", member.Role)
 		} else {
 			logger.Debug("Authorization success: User is task assignee")
 		}
@@ -91,31 +116,150 @@ func (s *taskService) GetAndVerifyProjectManagerForTask(ctx context.Context, bas
 	return task, nil
 }
 
-func (s *taskService) CreateTask(ctx context.Context, userID, sprintID int, task *models.Task) (*models.Task, error) {
+// GetAndVerifyContributorForTask returns the task when the user may add work
+// to it: its assignee or a project member whose role can be assigned tasks.
+// Project viewers may only read.
+func (s *taskService) GetAndVerifyContributorForTask(ctx context.Context, baseLogger *slog.Logger, userID, taskID int) (*models.Task, error) {
+	logger := baseLogger.With(
+		"method", "GetAndVerifyContributorForTask",
+	)
+
+	task, err := s.taskRepository.FindByID(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, structs.ErrTaskNotExist) {
+			return nil, structs.ErrTaskNotExist
+		}
+		logger.Error("Database error during task fetch", "original_error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+
+	if task.AssigneeID != nil && *task.AssigneeID == userID {
+		logger.Debug("Authorization success: User is task assignee")
+		return task, nil
+	}
+
+	member, err := s.projectService.GetProjectMember(ctx, userID, task.ProjectID)
+	if err != nil && !errors.Is(err, structs.ErrUserNotPartProject) {
+		logger.Error("Failed to fetch project membership", "error", err)
+		return nil, err
+	}
+	if member == nil || !member.Role.CanBeAssigned() {
+		logger.Warn("Authorization failed: User is neither a contributing project member nor task assignee",
+			"task_assignee_id", task.AssigneeID)
+		return nil, structs.ErrUserNotAuthorizedForTask
+	}
+
+	logger.Debug("Authorization success: User is project member", "role", member.Role)
+	return task, nil
+}
+
+func (s *taskService) CreateTask(ctx context.Context, userID int, task *models.Task, overrideWipLimit bool) (*models.Task, error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "TaskService",
 		"method", "CreateTask",
-		"sprint_id", sprintID,
+		"project_id", task.ProjectID,
 		"requestor_id", userID,
 	)
 
+	var parent *models.Task
+	if task.ParentTaskID != nil {
+		logger.Debug("Validating parent task", "parent_task_id", *task.ParentTaskID)
+		var err error
+		parent, err = s.findParentTask(ctx, *task.ParentTaskID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create task: %w", err)
+		}
+
+		if task.ProjectID != 0 && task.ProjectID != parent.ProjectID {
+			logger.Warn("Parent task belongs to another project", "parent_project_id", parent.ProjectID)
+			return nil, fmt.Errorf("cannot create task under parent %d: %w", parent.ID, structs.ErrSubtaskProjectMismatch)
+		}
+		if task.SprintID != nil && !sameSprint(task.SprintID, parent.SprintID) {
+			logger.Warn("Parent task belongs to another sprint or to the backlog", "parent_task_id", parent.ID)
+			return nil, fmt.Errorf("cannot create task under parent %d: %w", parent.ID, structs.ErrSubtaskSprintMismatch)
+		}
+
+		logger.Debug("Subtask inherits project and sprint of its parent")
+		task.ProjectID = parent.ProjectID
+		task.SprintID = parent.SprintID
+	}
+
 	logger.Debug("Start verify project manager")
 
-	sprint, err := s.sprintService.GetAndVerifyProjectManagerForSprint(ctx, logger, userID, sprintID)
-	if err != nil {
-		if errors.Is(err, structs.ErrSprintNotExist) {
-			return nil, fmt.Errorf("cannot create task: %w with sprint id %d", err, sprintID)
+	var sprint *models.Sprint
+	var project *models.Project
+	if task.SprintID != nil {
+		sprintID := *task.SprintID
+		var err error
+		sprint, err = s.sprintService.GetAndVerifyProjectManagerForSprint(ctx, logger, userID, sprintID)
+		if err != nil {
+			if errors.Is(err, structs.ErrSprintNotExist) {
+				return nil, fmt.Errorf("cannot create task: %w with sprint id %d", err, sprintID)
+			}
+			if errors.Is(err, structs.ErrUserNotManageProject) {
+				return nil, fmt.Errorf("user %d cannot create task in sprint %d: %w", userID, sprintID, err)
+			}
+			logger.Error("Failed initial sprint retrieval or authorization", "error", err)
+			return nil, err
 		}
-		if errors.Is(err, structs.ErrUserNotManageProject) {
-			return nil, fmt.Errorf("user %d cannot create task in sprint %d: %w", userID, sprintID, err)
+
+		if task.ProjectID != 0 && task.ProjectID != sprint.ProjectID {
+			logger.Warn("Sprint belongs to another project", "sprint_id", sprintID, "sprint_project_id", sprint.ProjectID)
+			return nil, fmt.Errorf("cannot create task in sprint %d: %w", sprintID, structs.ErrSprintNotInProject)
 		}
-		logger.Error("Failed initial project retrieval or authorization", "error", err)
+		if sprint.Status == models.SprintClosed {
+			logger.Warn("Sprint is already closed", "sprint_id", sprintID)
+			return nil, fmt.Errorf("cannot create task in sprint %d: %w", sprintID, structs.ErrSprintClosed)
+		}
+		task.ProjectID = sprint.ProjectID
+	} else {
+		logger.Debug("No sprint given, task is created in the project backlog")
+		var err error
+		project, err = s.projectService.GetAndVerifyProjectManager(ctx, userID, task.ProjectID)
+		if err != nil {
+			if errors.Is(err, structs.ErrProjectNotExist) {
+				return nil, fmt.Errorf("cannot create task: %w with project id %d", err, task.ProjectID)
+			}
+			if errors.Is(err, structs.ErrUserNotManageProject) {
+				return nil, fmt.Errorf("user %d cannot create task in project %d: %w", userID, task.ProjectID, err)
+			}
+			logger.Error("Failed initial project retrieval or authorization", "error", err)
+			return nil, err
+		}
+	}
+
+	if parent != nil {
+		if parent.Status == models.DoneTask {
+			logger.Warn("Parent task is already done", "parent_task_id", parent.ID)
+			return nil, fmt.Errorf("cannot create task under parent %d: %w", parent.ID, structs.ErrParentTaskDone)
+		}
+
+		depth, err := s.taskDepth(ctx, parent)
+		if err != nil {
+			logger.Error("Failed to compute parent task depth", "error", err)
+			return nil, err
+		}
+		if depth+1 > models.MaxTaskDepth {
+			logger.Warn("Subtask would exceed the maximum depth", "parent_depth", depth, "max_depth", models.MaxTaskDepth)
+			return nil, fmt.Errorf("cannot create task under parent %d: %w", parent.ID, structs.ErrTaskHierarchyTooDeep)
+		}
+	}
+
+	status := task.Status
+	if status == "" {
+		status = models.ToDoTask
+	}
+	if err := s.validateWipLimit(ctx, logger, userID, task, status, task.AssigneeID, overrideWipLimit); err != nil {
 		return nil, err
 	}
 
-	task.ProjectID = sprint.ProjectID
-	task.SprintID = sprintID
+	rank, err := s.rankAtColumnEnd(ctx, task.ProjectID, task.SprintID, status)
+	if err != nil {
+		logger.Error("Failed to rank task at the end of its board column", "error", err)
+		return nil, err
+	}
+	task.Rank = rank
 
 	task, err = s.taskRepository.Create(ctx, task)
 	if err != nil {
@@ -123,11 +267,16 @@ func (s *taskService) CreateTask(ctx context.Context, userID, sprintID int, task
 		return nil, structs.ErrDatabaseFail
 	}
 
+	s.activityService.Record(ctx, newActivity(userID, task.ProjectID, models.ActivityEntityTask, task.ID, models.ActivityCreate,
+		snapshotChanges(task, false, "title", "status", "priority", "sprint_id", "parent_task_id", "due_date",
+			"story_points", "original_estimate_minutes", "remaining_estimate_minutes")))
+
 	task.Sprint = sprint
+	task.Project = project
 	return task, nil
 }
 
-func (s *taskService) AssignTaskToUser(ctx context.Context, userID, reqID, taskID int) error {
+func (s *taskService) AssignTaskToUser(ctx context.Context, userID, reqID, taskID int, overrideWipLimit bool) error {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "TaskService",
@@ -146,7 +295,7 @@ func (s *taskService) AssignTaskToUser(ctx context.Context, userID, reqID, taskI
 			return fmt.Errorf("cannot fetch task: %w with task id: %d", err, taskID)
 		}
 	}
-	logger.Debug("Task retrieval success", "task_id", task.ID, "project_id", task.Project.ID, "manager_id", task.Project.ManagerID, "assignee_id", task.AssigneeID)
+	logger.Debug("Task retrieval success", "task_id", task.ID, "project_id", task.ProjectID, "assignee_id", task.AssigneeID)
 	logger.Info("Verify user id to assign task")
 	user, err := s.userService.FindByID(ctx, userID)
 	if err != nil {
@@ -158,17 +307,42 @@ func (s *taskService) AssignTaskToUser(ctx context.Context, userID, reqID, taskI
 		return fmt.Errorf("cannot assign task: %w with user id %d", err, userID)
 	}
 
-	if user.CurrentProjectID == nil || *user.CurrentProjectID != task.ProjectID {
-		logger.Warn("User is not part of the project", "user_id", userID, "project_id", task.ProjectID)
-		return structs.ErrUserNotPartProject
+	member, err := s.projectService.GetProjectMember(ctx, user.ID, task.ProjectID)
+	if err != nil {
+		if errors.Is(err, structs.ErrUserNotPartProject) {
+			logger.Warn("User is not part of the project", "user_id", userID, "project_id", task.ProjectID)
+			return fmt.Errorf("cannot assign task %d to user %d: %w", task.ID, userID, err)
+		}
+		return err
+	}
+	if !member.Role.CanBeAssigned() {
+		logger.Warn("User cannot be assigned tasks in the project", "user_id", userID, "role", member.Role)
+		return fmt.Errorf("cannot assign task %d to user %d: %w", task.ID, userID, structs.ErrMemberCannotBeAssigned)
 	}
 
-	if err := s.taskRepository.AssignTaskToUser(ctx, userID, task.ID); err != nil {
+	if task.AssigneeID == nil || *task.AssigneeID != userID {
+		if err := s.validateWipLimit(ctx, logger, reqID, task, task.Status, &userID, overrideWipLimit); err != nil {
+			return fmt.Errorf("cannot assign task %d to user %d: %w", task.ID, userID, err)
+		}
+	}
+
+	emails, err := s.emailService.TaskAssignedEmails(ctx, reqID, task, user)
+	if err != nil {
+		logger.Error("Failed to prepare assignment email, assigning without it", "error", err)
+	}
+
+	if err := s.taskRepository.AssignTaskToUser(ctx, userID, task.ID, emails); err != nil {
 		logger.Error("Failed to assign task to user in repository", "error", err)
 		return fmt.Errorf("repository failed to assign task to user %d: %w", userID, structs.ErrDatabaseFail)
 	}
 
 	logger.Info("Successfully assigned task to user")
+	if len(emails) > 0 {
+		s.emailService.Notify()
+	}
+
+	s.activityService.Record(ctx, newActivity(reqID, task.ProjectID, models.ActivityEntityTask, task.ID, models.ActivityAssign,
+		valueChange("assignee_id", task.AssigneeID, userID)))
 	return nil
 }
 
@@ -190,6 +364,135 @@ func (s *taskService) UpdateTask(ctx context.Context, userID, taskID int, data *
 			return nil, fmt.Errorf("cannot fetch task: %w with task id: %d", err, taskID)
 		}
 	}
+
+	return s.applyTaskUpdate(ctx, logger, userID, task, data)
+}
+
+// ChangeTaskStatus moves a task to another status. Unlike UpdateTask it is
+// open to every project member, the project workflow deciding which roles
+// may make the transition.
+func (s *taskService) ChangeTaskStatus(ctx context.Context, userID, taskID int, status models.TaskStatus, overrideWipLimit bool) (*models.Task, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskService",
+		"method", "ChangeTaskStatus",
+		"task_id", taskID,
+		"requestor_id", userID,
+		"status", status,
+	)
+
+	logger.Debug("Starting task status change process")
+	task, err := s.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, false)
+	if err != nil {
+		if errors.Is(err, structs.ErrUserNotAuthorizedForTask) {
+			return nil, fmt.Errorf("authorization failure for user id %d: %w", userID, err)
+		}
+		return nil, fmt.Errorf("cannot fetch task: %w with task id: %d", err, taskID)
+	}
+
+	return s.applyTaskUpdate(ctx, logger, userID, task, &dto.UpdateTaskRequest{Status: &status, OverrideWipLimit: overrideWipLimit})
+}
+
+// MoveTask moves a task on the board of its sprint, or of the backlog: to the
+// column of another status and to a position within that column, in one
+// write. Like ChangeTaskStatus it is open to every project member.
+func (s *taskService) MoveTask(ctx context.Context, userID, taskID int, data *dto.MoveTaskRequest) (*models.Task, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskService",
+		"method", "MoveTask",
+		"task_id", taskID,
+		"requestor_id", userID,
+		"status", data.Status,
+	)
+
+	logger.Debug("Starting task move process")
+	task, err := s.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, false)
+	if err != nil {
+		if errors.Is(err, structs.ErrUserNotAuthorizedForTask) {
+			return nil, fmt.Errorf("authorization failure for user id %d: %w", userID, err)
+		}
+		return nil, fmt.Errorf("cannot fetch task: %w with task id: %d", err, taskID)
+	}
+
+	if data.Status != task.Status {
+		if err := s.validateHierarchyUpdate(ctx, logger, task, &dto.UpdateTaskRequest{Status: &data.Status}); err != nil {
+			return nil, err
+		}
+		if err := s.validateStatusTransition(ctx, logger, userID, task, data.Status); err != nil {
+			return nil, err
+		}
+		if err := s.validateNotBlocked(ctx, logger, task, data.Status); err != nil {
+			return nil, err
+		}
+		if err := s.validateWipLimit(ctx, logger, userID, task, data.Status, task.AssigneeID, data.OverrideWipLimit); err != nil {
+			return nil, fmt.Errorf("cannot move task %d: %w", task.ID, err)
+		}
+	}
+
+	tasks, err := s.taskRepository.FindBoardColumn(ctx, task.ProjectID, task.SprintID, data.Status)
+	if err != nil {
+		return nil, err
+	}
+	column := make([]*models.Task, 0, len(tasks))
+	for _, t := range tasks {
+		if t.ID != task.ID {
+			column = append(column, t)
+		}
+	}
+
+	index, err := boardIndex(column, data.AfterTaskID, data.BeforeTaskID)
+	if err != nil {
+		logger.Warn("Invalid position on the board", "after_task_id", data.AfterTaskID, "before_task_id", data.BeforeTaskID)
+		return nil, fmt.Errorf("cannot move task %d: %w", task.ID, err)
+	}
+	rank, reranked := boardRank(column, index)
+	if len(reranked) > 0 {
+		logger.Info("Ranking the board column again", "reranked", len(reranked))
+	}
+
+	if err := s.taskRepository.MoveOnBoard(ctx, task.ID, data.Status, rank, reranked); err != nil {
+		if errors.Is(err, structs.ErrTaskNotExist) {
+			return nil, fmt.Errorf("cannot move task: %w with task id: %d", err, task.ID)
+		}
+		return nil, fmt.Errorf("repository failed to move task %d: %w", task.ID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully moved task", "rank", rank)
+
+	s.activityService.Record(ctx, newActivity(userID, task.ProjectID, models.ActivityEntityTask, task.ID, models.ActivityUpdate,
+		updateChanges(task, map[string]any{"status": data.Status, "rank": rank})))
+
+	movedTask, err := s.taskRepository.FindByID(ctx, task.ID)
+	if err != nil {
+		task.Status = data.Status
+		task.Rank = rank
+		return task, nil
+	}
+	return movedTask, nil
+}
+
+// applyTaskUpdate validates and writes the changes of data to an already
+// authorized task.
+func (s *taskService) applyTaskUpdate(ctx context.Context, logger *slog.Logger, userID int, task *models.Task, data *dto.UpdateTaskRequest) (*models.Task, error) {
+	taskID := task.ID
+	if err := s.validateHierarchyUpdate(ctx, logger, task, data); err != nil {
+		return nil, err
+	}
+	if data.Status != nil {
+		if err := s.validateStatusTransition(ctx, logger, userID, task, *data.Status); err != nil {
+			return nil, err
+		}
+		if err := s.validateNotBlocked(ctx, logger, task, *data.Status); err != nil {
+			return nil, err
+		}
+		if *data.Status != task.Status {
+			if err := s.validateWipLimit(ctx, logger, userID, task, *data.Status, task.AssigneeID, data.OverrideWipLimit); err != nil {
+				return nil, fmt.Errorf("cannot update task %d: %w", task.ID, err)
+			}
+		}
+	}
+
 	updateMap := make(map[string]any)
 	if data.Title != nil {
 		updateMap["title"] = *data.Title
@@ -199,6 +502,21 @@ func (s *taskService) UpdateTask(ctx context.Context, userID, taskID int, data *
 	}
 	if data.Status != nil {
 		updateMap["status"] = *data.Status
+		if *data.Status != task.Status {
+			rank, err := s.rankAtColumnEnd(ctx, task.ProjectID, task.SprintID, *data.Status)
+			if err != nil {
+				logger.Error("Failed to rank task at the end of its new board column", "error", err)
+				return nil, err
+			}
+			updateMap["rank"] = rank
+		}
+	}
+	if data.ParentTaskID != nil {
+		if *data.ParentTaskID == 0 {
+			updateMap["parent_task_id"] = nil
+		} else {
+			updateMap["parent_task_id"] = *data.ParentTaskID
+		}
 	}
 	if data.Priority != nil {
 		updateMap["priority"] = *data.Priority
@@ -206,6 +524,15 @@ func (s *taskService) UpdateTask(ctx context.Context, userID, taskID int, data *
 	if data.DueDate != nil {
 		updateMap["due_date"] = data.DueDate
 	}
+	if data.StoryPoints != nil {
+		updateMap["story_points"] = *data.StoryPoints
+	}
+	if data.OriginalEstimateMinutes != nil {
+		updateMap["original_estimate_minutes"] = *data.OriginalEstimateMinutes
+	}
+	if data.RemainingEstimateMinutes != nil {
+		updateMap["remaining_estimate_minutes"] = *data.RemainingEstimateMinutes
+	}
 
 	if len(updateMap) == 0 {
 		logger.Info("No fields to update, returning current sprint")
@@ -221,6 +548,9 @@ func (s *taskService) UpdateTask(ctx context.Context, userID, taskID int, data *
 
 	logger.Info("Successfully updated task")
 
+	s.activityService.Record(ctx, newActivity(userID, task.ProjectID, models.ActivityEntityTask, taskID, models.ActivityUpdate,
+		updateChanges(task, updateMap)))
+
 	updatedTask, err := s.taskRepository.FindByID(ctx, taskID)
 	if err != nil {
 		return task, nil
@@ -248,10 +578,26 @@ func (s *taskService) FindByID(ctx context.Context, userID, taskID int) (*models
 		}
 	}
 
+	logger.Debug("Loading subtask tree")
+	levels, err := s.collectSubtree(ctx, task)
+	if err != nil {
+		logger.Error("Failed to load subtask tree", "error", err)
+		return nil, err
+	}
+	attachSubtasks(levels)
+
+	logger.Debug("Loading task links")
+	links, err := s.taskLinkRepository.FindByTaskID(ctx, task.ID)
+	if err != nil {
+		logger.Error("Failed to load task links", "error", err)
+		return nil, err
+	}
+	task.Links = links
+
 	return task, nil
 }
 
-func (s *taskService) FindTasksByProjectID(ctx context.Context, userID, projectID int) ([]*models.Task, error) {
+func (s *taskService) FindTasksByProjectID(ctx context.Context, userID, projectID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "TaskService",
@@ -264,23 +610,123 @@ func (s *taskService) FindTasksByProjectID(ctx context.Context, userID, projectI
 	_, err := s.projectService.GetAndVerifyProjectManager(ctx, userID, projectID)
 	if err != nil {
 		if errors.Is(err, structs.ErrProjectNotExist) {
-			return nil, fmt.Errorf("cannot find project: %w with id %d", err, projectID)
+			return nil, nil, fmt.Errorf("cannot find project: %w with id %d", err, projectID)
 		}
 		if errors.Is(err, structs.ErrUserNotManageProject) {
-			return nil, fmt.Errorf("user %d cannot query project %d: %w", userID, projectID, err)
+			return nil, nil, fmt.Errorf("user %d cannot query project %d: %w", userID, projectID, err)
 		}
 		logger.Error("Failed initial project retrieval or authorization", "error", err)
-		return nil, err
+		return nil, nil, err
 	}
 
-	tasks, err := s.taskRepository.FindTasksByProjectID(ctx, projectID)
+	tasks, pageInfo, err := s.taskRepository.FindTasksByProjectID(ctx, projectID, page)
 	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, pageInfo, nil
+}
+
+func (s *taskService) FindBacklogByProjectID(ctx context.Context, userID, projectID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskService",
+		"method", "FindBacklogByProjectID",
+		"project_id", projectID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting backlog retreival process")
+	_, err := s.projectService.GetAndVerifyProjectManager(ctx, userID, projectID)
+	if err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return nil, nil, fmt.Errorf("cannot find project: %w with id %d", err, projectID)
+		}
+		if errors.Is(err, structs.ErrUserNotManageProject) {
+			return nil, nil, fmt.Errorf("user %d cannot query project %d: %w", userID, projectID, err)
+		}
+		logger.Error("Failed initial project retrieval or authorization", "error", err)
+		return nil, nil, err
+	}
+
+	tasks, pageInfo, err := s.taskRepository.FindBacklogByProjectID(ctx, projectID, page)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, pageInfo, nil
+}
+
+func (s *taskService) MoveTaskToSprint(ctx context.Context, userID, taskID, sprintID int, overrideWipLimit bool) (*models.Task, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskService",
+		"method", "MoveTaskToSprint",
+		"task_id", taskID,
+		"sprint_id", sprintID,
+		"requestor_id", userID,
+	)
+
+	logger.Debug("Starting move task to sprint process")
+	task, err := s.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, true)
+	if err != nil {
+		if errors.Is(err, structs.ErrUserNotManageProject) || errors.Is(err, structs.ErrUserNotAuthorizedForTask) {
+			return nil, fmt.Errorf("authorization failure for user id %d: %w", userID, err)
+		} else {
+			return nil, fmt.Errorf("cannot fetch task: %w with task id: %d", err, taskID)
+		}
+	}
+
+	sprint, err := s.sprintService.GetAndVerifyProjectManagerForSprint(ctx, logger, userID, sprintID)
+	if err != nil {
+		if errors.Is(err, structs.ErrSprintNotExist) {
+			return nil, fmt.Errorf("cannot move task: %w with sprint id %d", err, sprintID)
+		}
+		if errors.Is(err, structs.ErrUserNotManageProject) {
+			return nil, fmt.Errorf("user %d cannot move task into sprint %d: %w", userID, sprintID, err)
+		}
+		logger.Error("Failed initial sprint retrieval or authorization", "error", err)
 		return nil, err
 	}
-	return tasks, nil
+
+	if sprint.ProjectID != task.ProjectID {
+		logger.Warn("Sprint belongs to another project", "task_project_id", task.ProjectID, "sprint_project_id", sprint.ProjectID)
+		return nil, fmt.Errorf("cannot move task %d into sprint %d: %w", task.ID, sprintID, structs.ErrSprintNotInProject)
+	}
+	if sprint.Status == models.SprintClosed {
+		logger.Warn("Sprint is already closed", "sprint_id", sprintID)
+		return nil, fmt.Errorf("cannot move task %d into sprint %d: %w", task.ID, sprintID, structs.ErrSprintClosed)
+	}
+
+	return s.moveSubtreeToSprint(ctx, logger, userID, task, &sprintID, overrideWipLimit)
 }
 
-func (s *taskService) FindTasksByUserID(ctx context.Context, userID int) ([]*models.Task, error) {
+func (s *taskService) MoveTaskToBacklog(ctx context.Context, userID, taskID int, overrideWipLimit bool) (*models.Task, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskService",
+		"method", "MoveTaskToBacklog",
+		"task_id", taskID,
+		"requestor_id", userID,
+	)
+
+	logger.Debug("Starting move task to backlog process")
+	task, err := s.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, true)
+	if err != nil {
+		if errors.Is(err, structs.ErrUserNotManageProject) || errors.Is(err, structs.ErrUserNotAuthorizedForTask) {
+			return nil, fmt.Errorf("authorization failure for user id %d: %w", userID, err)
+		} else {
+			return nil, fmt.Errorf("cannot fetch task: %w with task id: %d", err, taskID)
+		}
+	}
+
+	if task.SprintID == nil {
+		logger.Info("Task is already in the backlog, nothing to move")
+		return task, nil
+	}
+
+	return s.moveSubtreeToSprint(ctx, logger, userID, task, nil, overrideWipLimit)
+}
+
+func (s *taskService) FindTasksByUserID(ctx context.Context, userID int, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "TaskService",
@@ -289,26 +735,26 @@ func (s *taskService) FindTasksByUserID(ctx context.Context, userID int) ([]*mod
 	)
 
 	logger.Info("Starting task retreival process")
-	tasks, err := s.taskRepository.FindTaskByUserID(ctx, userID)
+	tasks, pageInfo, err := s.taskRepository.FindTaskByUserID(ctx, userID, page)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return tasks, nil
+	return tasks, pageInfo, nil
 }
 
-func (s *taskService) FindTasks(ctx context.Context, filter *dto.TaskFilter) ([]*models.Task, error) {
+func (s *taskService) FindTasks(ctx context.Context, filter *dto.TaskFilter, page *dto.PageRequest) ([]*models.Task, *dto.PageInfo, error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "TaskService",
 		"method", "FindTasks",
 	)
 
-	tasks, err := s.taskRepository.Find(ctx, filter)
+	tasks, pageInfo, err := s.taskRepository.Find(ctx, filter, page)
 	if err != nil {
 		logger.Error("Failed to find tasks by filter", "error", err)
-		return nil, fmt.Errorf("database error finding tasks with filter: %w", err)
+		return nil, nil, fmt.Errorf("database error finding tasks with filter: %w", err)
 	}
-	return tasks, nil
+	return tasks, pageInfo, nil
 }
 
 func (s *taskService) DeleteTask(ctx context.Context, userID, taskID int) error {
@@ -321,7 +767,7 @@ func (s *taskService) DeleteTask(ctx context.Context, userID, taskID int) error
 	)
 
 	logger.Info("Starting task deletion process")
-	_, err := s.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, true)
+	task, err := s.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, true)
 	if err != nil {
 		if errors.Is(err, structs.ErrUserNotManageProject) || errors.Is(err, structs.ErrUserNotAuthorizedForTask) {
 			return fmt.Errorf("authorization failure for user id %d: %w", userID, err)
@@ -330,13 +776,461 @@ func (s *taskService) DeleteTask(ctx context.Context, userID, taskID int) error
 		}
 	}
 
-	logger.Info("Authorization successful, attempting task deletion")
+	logger.Info("Authorization successful, collecting subtasks to delete")
+
+	levels, err := s.collectSubtree(ctx, task)
+	if err != nil {
+		logger.Error("Failed to load subtask tree", "error", err)
+		return err
+	}
+
+	taskIDs := make([]int, 0)
+	for _, level := range levels {
+		for _, t := range level {
+			taskIDs = append(taskIDs, t.ID)
+		}
+	}
 
-	if err := s.taskRepository.Delete(ctx, taskID); err != nil {
+	logger.Debug("Attempting task deletion", "task_ids", taskIDs)
+	if err := s.taskRepository.DeleteByIDs(ctx, taskIDs); err != nil {
 		logger.Error("Failed to delete task in repository", "error", err)
 		return fmt.Errorf("repository delete failed for task %d: %w", taskID, structs.ErrDatabaseFail)
 	}
+	if err := s.taskLinkRepository.DeleteByTaskIDs(ctx, taskIDs); err != nil {
+		logger.Error("Failed to delete links of deleted tasks", "error", err)
+		return fmt.Errorf("repository delete failed for links of task %d: %w", taskID, structs.ErrDatabaseFail)
+	}
 
 	logger.Info("Successfully deleted task")
+
+	for _, level := range levels {
+		for _, t := range level {
+			s.activityService.Record(ctx, newActivity(userID, t.ProjectID, models.ActivityEntityTask, t.ID, models.ActivityDelete,
+				snapshotChanges(t, true, "title", "status")))
+		}
+	}
+	return nil
+}
+
+// FindTaskHistory returns a page of the activity log of the task; the same
+// users that can read the task can read its history.
+func (s *taskService) FindTaskHistory(ctx context.Context, userID, taskID int, page *dto.PageRequest) ([]*models.ActivityLog, *dto.PageInfo, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskService",
+		"method", "FindTaskHistory",
+		"task_id", taskID,
+		"requestor_id", userID,
+	)
+
+	if _, err := s.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, false); err != nil {
+		return nil, nil, fmt.Errorf("cannot read history of task %d: %w", taskID, err)
+	}
+
+	activities, pageInfo, err := s.activityService.ListEntityHistory(ctx, models.ActivityEntityTask, taskID, page)
+	if err != nil {
+		logger.Error("Failed to find task history", "error", err)
+		return nil, nil, err
+	}
+
+	logger.Info("Task history found", "count", len(activities))
+	return activities, pageInfo, nil
+}
+
+// moveSubtreeToSprint moves task and all of its subtasks into the given
+// sprint, or into the project backlog when sprintID is nil, as long as the
+// board columns there can take them. Subtasks cannot be moved on their own
+// since they always share the sprint of their parent.
+func (s *taskService) moveSubtreeToSprint(ctx context.Context, baseLogger *slog.Logger, userID int, task *models.Task, sprintID *int, overrideWipLimit bool) (*models.Task, error) {
+	logger := baseLogger.With(
+		"method", "moveSubtreeToSprint",
+	)
+
+	if task.ParentTaskID != nil {
+		logger.Warn("Subtask cannot be moved without its parent", "parent_task_id", *task.ParentTaskID)
+		return nil, fmt.Errorf("cannot move subtask %d, move parent task %d instead: %w", task.ID, *task.ParentTaskID, structs.ErrSubtaskSprintMismatch)
+	}
+
+	levels, err := s.collectSubtree(ctx, task)
+	if err != nil {
+		logger.Error("Failed to load subtask tree", "error", err)
+		return nil, err
+	}
+
+	taskIDs := make([]int, 0)
+	subtree := make([]*models.Task, 0)
+	for _, level := range levels {
+		for _, t := range level {
+			taskIDs = append(taskIDs, t.ID)
+			subtree = append(subtree, t)
+		}
+	}
+
+	if overrideWipLimit {
+		if err := s.verifyWipLimitOverride(ctx, logger, userID, task.ProjectID); err != nil {
+			return nil, err
+		}
+	} else if err := s.wipLimitService.CheckTasksEntering(ctx, task.ProjectID, sprintID, subtree); err != nil {
+		return nil, fmt.Errorf("cannot move task %d: %w", task.ID, err)
+	}
+
+	logger.Debug("Attempting to move tasks", "task_ids", taskIDs)
+	if err := s.taskRepository.UpdateSprintByIDs(ctx, taskIDs, sprintID); err != nil {
+		logger.Error("Failed to move tasks in repository", "error", err)
+		return nil, fmt.Errorf("repository failed to move task %d: %w", task.ID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully moved task with its subtasks", "moved_count", len(taskIDs))
+
+	for _, level := range levels {
+		for _, t := range level {
+			s.activityService.Record(ctx, newActivity(userID, t.ProjectID, models.ActivityEntityTask, t.ID, models.ActivityUpdate,
+				valueChange("sprint_id", t.SprintID, sprintID)))
+		}
+	}
+
+	movedTask, err := s.taskRepository.FindByID(ctx, task.ID)
+	if err != nil {
+		task.SprintID = sprintID
+		task.Sprint = nil
+		return task, nil
+	}
+	return movedTask, nil
+}
+
+// sameSprint reports whether two optional sprint IDs point to the same
+// sprint, two backlog tasks being in the same "sprint".
+func sameSprint(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func (s *taskService) findParentTask(ctx context.Context, parentID int) (*models.Task, error) {
+	parent, err := s.taskRepository.FindByID(ctx, parentID)
+	if err != nil {
+		if errors.Is(err, structs.ErrTaskNotExist) {
+			return nil, fmt.Errorf("%w with id %d", structs.ErrParentTaskNotExist, parentID)
+		}
+		return nil, structs.ErrDatabaseFail
+	}
+	return parent, nil
+}
+
+// taskDepth returns the level of the task in its hierarchy, a top-level task
+// having depth 1.
+func (s *taskService) taskDepth(ctx context.Context, task *models.Task) (int, error) {
+	depth := 1
+	current := task
+	for current.ParentTaskID != nil {
+		if depth > models.MaxTaskDepth {
+			return 0, fmt.Errorf("task %d: %w", task.ID, structs.ErrTaskHierarchyCycle)
+		}
+		parent, err := s.findParentTask(ctx, *current.ParentTaskID)
+		if err != nil {
+			return 0, err
+		}
+		current = parent
+		depth++
+	}
+	return depth, nil
+}
+
+// collectSubtree loads the task hierarchy below root level by level. The
+// first level only contains root.
+func (s *taskService) collectSubtree(ctx context.Context, root *models.Task) ([][]*models.Task, error) {
+	levels := [][]*models.Task{{root}}
+	seen := map[int]struct{}{root.ID: {}}
+
+	for len(levels) <= models.MaxTaskDepth {
+		current := levels[len(levels)-1]
+		parentIDs := make([]int, len(current))
+		for i, t := range current {
+			parentIDs[i] = t.ID
+		}
+
+		children, err := s.taskRepository.FindByParentIDs(ctx, parentIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(children) == 0 {
+			return levels, nil
+		}
+
+		for _, child := range children {
+			if _, ok := seen[child.ID]; ok {
+				return nil, fmt.Errorf("task %d: %w", child.ID, structs.ErrTaskHierarchyCycle)
+			}
+			seen[child.ID] = struct{}{}
+		}
+		levels = append(levels, children)
+	}
+
+	return nil, fmt.Errorf("task %d: %w", root.ID, structs.ErrTaskHierarchyTooDeep)
+}
+
+// attachSubtasks links every level produced by collectSubtree to its parents,
+// starting from the deepest level so each copy already carries its children.
+func attachSubtasks(levels [][]*models.Task) {
+	for i := len(levels) - 1; i > 0; i-- {
+		byParent := make(map[int][]models.Task)
+		for _, child := range levels[i] {
+			byParent[*child.ParentTaskID] = append(byParent[*child.ParentTaskID], *child)
+		}
+		for _, parent := range levels[i-1] {
+			parent.Subtasks = byParent[parent.ID]
+		}
+	}
+}
+
+// validateStatusTransition checks the move of task to status against the
+// workflow of its project for the project role of the user.
+func (s *taskService) validateStatusTransition(ctx context.Context, baseLogger *slog.Logger, userID int, task *models.Task, status models.TaskStatus) error {
+	logger := baseLogger.With(
+		"method", "validateStatusTransition",
+	)
+
+	if status == task.Status {
+		return nil
+	}
+
+	member, err := s.projectService.GetProjectMember(ctx, userID, task.ProjectID)
+	if err != nil {
+		if errors.Is(err, structs.ErrUserNotPartProject) {
+			logger.Warn("Only project members can change the status of a task")
+			return fmt.Errorf("user %d cannot change status of task %d: %w", userID, task.ID, structs.ErrUserNotAuthorizedForTask)
+		}
+		return err
+	}
+
+	if err := s.workflowService.ValidateTransition(ctx, task.ProjectID, member.Role, task.Status, status); err != nil {
+		return fmt.Errorf("cannot update task %d: %w", task.ID, err)
+	}
+	return nil
+}
+
+// validateNotBlocked refuses to start work on a task while a task blocking it
+// is not done.
+func (s *taskService) validateNotBlocked(ctx context.Context, baseLogger *slog.Logger, task *models.Task, status models.TaskStatus) error {
+	logger := baseLogger.With(
+		"method", "validateNotBlocked",
+	)
+
+	if status != models.InProgressTask || task.Status == models.InProgressTask {
+		return nil
+	}
+
+	blockers, err := s.taskLinkRepository.FindOpenBlockers(ctx, task.ID)
+	if err != nil {
+		return err
+	}
+	if len(blockers) == 0 {
+		return nil
+	}
+
+	blockerIDs := make([]string, len(blockers))
+	for i, blocker := range blockers {
+		blockerIDs[i] = strconv.Itoa(blocker.ID)
+	}
+	logger.Warn("Task still has open blockers", "blocker_ids", blockerIDs)
+	return fmt.Errorf("cannot start task %d, blocked by %s: %w", task.ID, strings.Join(blockerIDs, ", "), structs.ErrTaskHasOpenBlockers)
+}
+
+// validateWipLimit checks the WIP limit of the status against the task moving
+// into its column with assigneeID, unless the requestor overrides the limit,
+// which only project managers may do.
+func (s *taskService) validateWipLimit(ctx context.Context, baseLogger *slog.Logger, userID int, task *models.Task, status models.TaskStatus, assigneeID *int, override bool) error {
+	logger := baseLogger.With(
+		"method", "validateWipLimit",
+	)
+
+	if !override {
+		return s.wipLimitService.CheckLimit(ctx, task, status, assigneeID)
+	}
+
+	if err := s.verifyWipLimitOverride(ctx, logger, userID, task.ProjectID); err != nil {
+		return err
+	}
+	logger.Info("WIP limit overridden by project manager", "status", status)
 	return nil
 }
+
+// verifyWipLimitOverride checks that the user manages the project and so may
+// override its WIP limits.
+func (s *taskService) verifyWipLimitOverride(ctx context.Context, logger *slog.Logger, userID, projectID int) error {
+	member, err := s.projectService.GetProjectMember(ctx, userID, projectID)
+	if err != nil && !errors.Is(err, structs.ErrUserNotPartProject) {
+		return err
+	}
+	if member == nil || member.Role != models.ProjectRoleManager {
+		logger.Warn("Only project managers can override WIP limits")
+		return fmt.Errorf("user %d cannot override WIP limits of project %d: %w", userID, projectID, structs.ErrWipLimitOverrideDenied)
+	}
+	return nil
+}
+
+func (s *taskService) validateHierarchyUpdate(ctx context.Context, baseLogger *slog.Logger, task *models.Task, data *dto.UpdateTaskRequest) error {
+	logger := baseLogger.With(
+		"method", "validateHierarchyUpdate",
+	)
+
+	parentID := task.ParentTaskID
+	if data.ParentTaskID != nil {
+		parentID = nil
+		if *data.ParentTaskID != 0 {
+			parentID = data.ParentTaskID
+		}
+	}
+
+	status := task.Status
+	if data.Status != nil {
+		status = *data.Status
+	}
+
+	var parent *models.Task
+	if parentID != nil {
+		var err error
+		parent, err = s.findParentTask(ctx, *parentID)
+		if err != nil {
+			return fmt.Errorf("cannot update task %d: %w", task.ID, err)
+		}
+	}
+
+	if data.ParentTaskID != nil && parent != nil {
+		logger.Debug("Validating new parent task", "parent_task_id", parent.ID)
+		if parent.ProjectID != task.ProjectID {
+			logger.Warn("Parent task belongs to another project", "parent_project_id", parent.ProjectID)
+			return fmt.Errorf("cannot move task %d under parent %d: %w", task.ID, parent.ID, structs.ErrSubtaskProjectMismatch)
+		}
+		if !sameSprint(parent.SprintID, task.SprintID) {
+			logger.Warn("Parent task belongs to another sprint or to the backlog", "parent_task_id", parent.ID)
+			return fmt.Errorf("cannot move task %d under parent %d: %w", task.ID, parent.ID, structs.ErrSubtaskSprintMismatch)
+		}
+
+		levels, err := s.collectSubtree(ctx, task)
+		if err != nil {
+			return err
+		}
+		for _, level := range levels {
+			for _, t := range level {
+				if t.ID == parent.ID {
+					logger.Warn("New parent is the task itself or one of its subtasks", "parent_task_id", parent.ID)
+					return fmt.Errorf("cannot move task %d under parent %d: %w", task.ID, parent.ID, structs.ErrTaskHierarchyCycle)
+				}
+			}
+		}
+
+		depth, err := s.taskDepth(ctx, parent)
+		if err != nil {
+			return err
+		}
+		if depth+len(levels) > models.MaxTaskDepth {
+			logger.Warn("Moved subtree would exceed the maximum depth", "parent_depth", depth, "subtree_height", len(levels))
+			return fmt.Errorf("cannot move task %d under parent %d: %w", task.ID, parent.ID, structs.ErrTaskHierarchyTooDeep)
+		}
+	}
+
+	if parent != nil && parent.Status == models.DoneTask && status != models.DoneTask {
+		logger.Warn("Task cannot be open below a done parent", "parent_task_id", parent.ID)
+		return fmt.Errorf("cannot update task %d: %w", task.ID, structs.ErrParentTaskDone)
+	}
+
+	if status == models.DoneTask && task.Status != models.DoneTask {
+		openCount, err := s.taskRepository.CountOpenSubtasks(ctx, task.ID)
+		if err != nil {
+			return err
+		}
+		if openCount > 0 {
+			logger.Warn("Task still has open subtasks", "open_subtasks", openCount)
+			return fmt.Errorf("cannot complete task %d with %d open subtasks: %w", task.ID, openCount, structs.ErrTaskHasOpenSubtasks)
+		}
+	}
+
+	return nil
+}
+
+// rankAtColumnEnd returns the rank placing a task last in the board column of
+// the status, in the sprint or in the backlog of the project when sprintID is
+// nil.
+func (s *taskService) rankAtColumnEnd(ctx context.Context, projectID int, sprintID *int, status models.TaskStatus) (string, error) {
+	column, err := s.taskRepository.FindBoardColumn(ctx, projectID, sprintID, status)
+	if err != nil {
+		return "", err
+	}
+	var last string
+	if len(column) > 0 {
+		last = column[len(column)-1].Rank
+	}
+	rank, err := utils.RankBetween(last, "")
+	if err != nil {
+		return "", fmt.Errorf("cannot rank after task %d: %w", column[len(column)-1].ID, err)
+	}
+	return rank, nil
+}
+
+// boardIndex returns the position in column, the tasks of a board column in
+// rank order, of a task placed right after afterID and right before beforeID.
+// Without either the task goes last.
+func boardIndex(column []*models.Task, afterID, beforeID *int) (int, error) {
+	indexOf := func(id int) int {
+		for i, task := range column {
+			if task.ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+
+	index := len(column)
+	if afterID != nil {
+		after := indexOf(*afterID)
+		if after < 0 {
+			return 0, fmt.Errorf("task %d: %w", *afterID, structs.ErrTaskNotInBoardColumn)
+		}
+		index = after + 1
+	}
+	if beforeID != nil {
+		before := indexOf(*beforeID)
+		if before < 0 {
+			return 0, fmt.Errorf("task %d: %w", *beforeID, structs.ErrTaskNotInBoardColumn)
+		}
+		if afterID != nil && before != index {
+			return 0, fmt.Errorf("task %d does not follow task %d: %w", *beforeID, *afterID, structs.ErrInvalidBoardPosition)
+		}
+		index = before
+	}
+	return index, nil
+}
+
+// boardRank returns the rank placing a task at index in column, the tasks of
+// a board column in rank order. Ranks normally leave room between any two
+// tasks, but tasks coming from other columns may share a rank, and ranks get
+// longer as tasks keep being placed at the same spot: the column is then
+// ranked again and the new ranks of its tasks are returned as well.
+func boardRank(column []*models.Task, index int) (string, map[int]string) {
+	var prev, next string
+	if index > 0 {
+		prev = column[index-1].Rank
+	}
+	if index < len(column) {
+		next = column[index].Rank
+	}
+	rank, err := utils.RankBetween(prev, next)
+	if err == nil && (next != "" || index == len(column)) && len(rank) <= utils.MaxRankLength {
+		return rank, nil
+	}
+
+	ranks := utils.SpreadRanks(len(column) + 1)
+	reranked := make(map[int]string, len(column))
+	for i, task := range column {
+		spread := ranks[i]
+		if i >= index {
+			spread = ranks[i+1]
+		}
+		if spread != task.Rank {
+			reranked[task.ID] = spread
+		}
+	}
+	return ranks[index], reranked
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+// maxBlockingChainLength bounds how far blocking links are followed when a
+// new blocking link is checked for cycles.
+const maxBlockingChainLength = 1000
+
+type TaskLinkService interface {
+	CreateTaskLink(ctx context.Context, userID, taskID, targetTaskID int, linkType models.TaskLinkType) (*models.TaskLink, error)
+	ListTaskLinks(ctx context.Context, userID, taskID int) ([]*models.TaskLink, error)
+	DeleteTaskLink(ctx context.Context, userID, taskID, linkID int) error
+}
+
+type taskLinkService struct {
+	taskLinkRepository repository.TaskLinkRepository
+	taskService        TaskService
+}
+
+func NewTaskLinkService(taskLinkRepository repository.TaskLinkRepository, taskService TaskService) TaskLinkService {
+	return &taskLinkService{
+		taskLinkRepository: taskLinkRepository,
+		taskService:        taskService,
+	}
+}
+
+// CreateTaskLink links the task to targetTaskID, the task being the source of
+// the link. Both tasks must belong to the same project, which the user must
+// manage.
+func (s *taskLinkService) CreateTaskLink(ctx context.Context, userID, taskID, targetTaskID int, linkType models.TaskLinkType) (*models.TaskLink, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskLinkService",
+		"method", "CreateTaskLink",
+		"task_id", taskID,
+		"target_task_id", targetTaskID,
+		"type", linkType,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting task link creation process")
+	if taskID == targetTaskID {
+		logger.Warn("Task cannot be linked to itself")
+		return nil, fmt.Errorf("cannot link task %d: %w", taskID, structs.ErrTaskLinkSelf)
+	}
+
+	task, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, true)
+	if err != nil {
+		return nil, fmt.Errorf("cannot link task %d: %w", taskID, err)
+	}
+	target, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, targetTaskID, false)
+	if err != nil {
+		return nil, fmt.Errorf("cannot link task %d to task %d: %w", taskID, targetTaskID, err)
+	}
+	if task.ProjectID != target.ProjectID {
+		logger.Warn("Target task belongs to another project", "target_project_id", target.ProjectID)
+		return nil, fmt.Errorf("cannot link task %d to task %d: %w", taskID, targetTaskID, structs.ErrTaskLinkProjectMismatch)
+	}
+
+	exists, err := s.taskLinkRepository.ExistsBetween(ctx, taskID, targetTaskID, linkType)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		logger.Warn("Tasks are already linked with this type")
+		return nil, fmt.Errorf("cannot link task %d to task %d: %w", taskID, targetTaskID, structs.ErrTaskLinkExists)
+	}
+
+	if linkType == models.BlocksLink {
+		if err := s.validateNoBlockingCycle(ctx, taskID, targetTaskID); err != nil {
+			logger.Warn("Blocking link would create a cycle", "error", err)
+			return nil, err
+		}
+	}
+
+	created, err := s.taskLinkRepository.Create(ctx, &models.TaskLink{
+		SourceTaskID: taskID,
+		TargetTaskID: targetTaskID,
+		Type:         linkType,
+		CreatedByID:  userID,
+	})
+	if err != nil {
+		logger.Error("Failed to create task link in repository", "error", err)
+		return nil, fmt.Errorf("repository create failed for link of task %d: %w", taskID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Task link created successfully", "link_id", created.ID)
+
+	full, err := s.taskLinkRepository.FindByID(ctx, created.ID)
+	if err != nil {
+		return created, nil
+	}
+	return full, nil
+}
+
+func (s *taskLinkService) ListTaskLinks(ctx context.Context, userID, taskID int) ([]*models.TaskLink, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskLinkService",
+		"method", "ListTaskLinks",
+		"task_id", taskID,
+		"requestor_id", userID,
+	)
+
+	if _, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, false); err != nil {
+		return nil, fmt.Errorf("cannot list links of task %d: %w", taskID, err)
+	}
+
+	links, err := s.taskLinkRepository.FindByTaskID(ctx, taskID)
+	if err != nil {
+		logger.Error("Failed to find links of task", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Task links found", "count", len(links))
+	return links, nil
+}
+
+// DeleteTaskLink removes a link the task is the source or the target of.
+func (s *taskLinkService) DeleteTaskLink(ctx context.Context, userID, taskID, linkID int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TaskLinkService",
+		"method", "DeleteTaskLink",
+		"task_id", taskID,
+		"link_id", linkID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting task link deletion process")
+	if _, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, true); err != nil {
+		return fmt.Errorf("cannot unlink task %d: %w", taskID, err)
+	}
+
+	link, err := s.taskLinkRepository.FindByID(ctx, linkID)
+	if err != nil {
+		return err
+	}
+	if link.SourceTaskID != taskID && link.TargetTaskID != taskID {
+		logger.Warn("Link belongs to other tasks", "source_task_id", link.SourceTaskID, "target_task_id", link.TargetTaskID)
+		return fmt.Errorf("%w with id %d on task %d", structs.ErrTaskLinkNotExist, linkID, taskID)
+	}
+
+	if err := s.taskLinkRepository.Delete(ctx, linkID); err != nil {
+		logger.Error("Failed to delete task link in repository", "error", err)
+		return fmt.Errorf("repository delete failed for link %d: %w", linkID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully deleted task link")
+	return nil
+}
+
+// validateNoBlockingCycle checks that sourceID blocking targetID does not
+// close a cycle, i.e. that targetID does not already block sourceID directly
+// or through other tasks.
+func (s *taskLinkService) validateNoBlockingCycle(ctx context.Context, sourceID, targetID int) error {
+	seen := map[int]struct{}{targetID: {}}
+	frontier := []int{targetID}
+
+	for len(frontier) > 0 {
+		if len(seen) > maxBlockingChainLength {
+			return fmt.Errorf("task %d: %w", targetID, structs.ErrTaskLinkCycle)
+		}
+
+		blocked, err := s.taskLinkRepository.FindBlockedTaskIDs(ctx, frontier)
+		if err != nil {
+			return err
+		}
+
+		frontier = frontier[:0]
+		for _, id := range blocked {
+			if id == sourceID {
+				return fmt.Errorf("task %d already blocks task %d: %w", targetID, sourceID, structs.ErrTaskLinkCycle)
+			}
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			frontier = append(frontier, id)
+		}
+	}
+	return nil
+}
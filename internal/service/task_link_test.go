@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubTaskLinkRepository struct {
+	links []*models.TaskLink
+}
+
+func (r *stubTaskLinkRepository) Create(ctx context.Context, link *models.TaskLink) (*models.TaskLink, error) {
+	r.links = append(r.links, link)
+	return link, nil
+}
+
+func (r *stubTaskLinkRepository) FindByID(ctx context.Context, id int) (*models.TaskLink, error) {
+	for _, link := range r.links {
+		if link.ID == id {
+			return link, nil
+		}
+	}
+	return nil, structs.ErrTaskLinkNotExist
+}
+
+func (r *stubTaskLinkRepository) FindByTaskID(ctx context.Context, taskID int) ([]*models.TaskLink, error) {
+	return r.links, nil
+}
+
+func (r *stubTaskLinkRepository) ExistsBetween(ctx context.Context, taskA, taskB int, linkType models.TaskLinkType) (bool, error) {
+	return false, nil
+}
+
+func (r *stubTaskLinkRepository) FindBlockedTaskIDs(ctx context.Context, sourceIDs []int) ([]int, error) {
+	var targetIDs []int
+	for _, link := range r.links {
+		if link.Type != models.BlocksLink {
+			continue
+		}
+		for _, id := range sourceIDs {
+			if link.SourceTaskID == id {
+				targetIDs = append(targetIDs, link.TargetTaskID)
+			}
+		}
+	}
+	return targetIDs, nil
+}
+
+func (r *stubTaskLinkRepository) FindOpenBlockers(ctx context.Context, taskID int) ([]*models.Task, error) {
+	return nil, nil
+}
+
+func (r *stubTaskLinkRepository) Delete(ctx context.Context, id int) error {
+	return nil
+}
+
+func (r *stubTaskLinkRepository) DeleteByTaskIDs(ctx context.Context, taskIDs []int) error {
+	return nil
+}
+
+func TestTaskLinkService_ValidateNoBlockingCycle(t *testing.T) {
+	ctx := context.Background()
+	repo := &stubTaskLinkRepository{links: []*models.TaskLink{
+		{SourceTaskID: 1, TargetTaskID: 2, Type: models.BlocksLink},
+		{SourceTaskID: 2, TargetTaskID: 3, Type: models.BlocksLink},
+		{SourceTaskID: 3, TargetTaskID: 4, Type: models.RelatesToLink},
+		{SourceTaskID: 5, TargetTaskID: 2, Type: models.BlocksLink},
+	}}
+	s := &taskLinkService{taskLinkRepository: repo}
+
+	t.Run("direct cycle", func(t *testing.T) {
+		assert.ErrorIs(t, s.validateNoBlockingCycle(ctx, 2, 1), structs.ErrTaskLinkCycle)
+	})
+
+	t.Run("transitive cycle", func(t *testing.T) {
+		assert.ErrorIs(t, s.validateNoBlockingCycle(ctx, 3, 1), structs.ErrTaskLinkCycle)
+	})
+
+	t.Run("non blocking links are ignored", func(t *testing.T) {
+		assert.NoError(t, s.validateNoBlockingCycle(ctx, 4, 3))
+	})
+
+	t.Run("shared blocked task is not a cycle", func(t *testing.T) {
+		assert.NoError(t, s.validateNoBlockingCycle(ctx, 1, 5))
+		assert.NoError(t, s.validateNoBlockingCycle(ctx, 1, 3))
+	})
+}
@@ -0,0 +1,473 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	repomocks "lqkhoi-go-http-api/internal/repository/mocks"
+	servicemocks "lqkhoi-go-http-api/internal/service/mocks"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBoardIndex(t *testing.T) {
+	id := func(id int) *int { return &id }
+	column := []*models.Task{{ID: 1}, {ID: 2}, {ID: 3}}
+
+	cases := []struct {
+		name     string
+		afterID  *int
+		beforeID *int
+		index    int
+		err      error
+	}{
+		{name: "no neighbour goes last", index: 3},
+		{name: "after a task", afterID: id(1), index: 1},
+		{name: "before a task", beforeID: id(1), index: 0},
+		{name: "between two tasks", afterID: id(2), beforeID: id(3), index: 2},
+		{name: "neighbour from another column", afterID: id(9), err: structs.ErrTaskNotInBoardColumn},
+		{name: "neighbours apart", afterID: id(1), beforeID: id(3), err: structs.ErrInvalidBoardPosition},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			index, err := boardIndex(column, c.afterID, c.beforeID)
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.index, index)
+		})
+	}
+}
+
+func TestBoardRank(t *testing.T) {
+	t.Run("rank fits between neighbours", func(t *testing.T) {
+		column := []*models.Task{{ID: 1, Rank: "a"}, {ID: 2, Rank: "b"}}
+
+		rank, reranked := boardRank(column, 1)
+
+		assert.True(t, "a" < rank && rank < "b", "rank %q", rank)
+		assert.Empty(t, reranked)
+	})
+
+	t.Run("column sharing ranks is ranked again", func(t *testing.T) {
+		column := []*models.Task{{ID: 1, Rank: "i"}, {ID: 2, Rank: "i"}, {ID: 3, Rank: "i"}}
+
+		rank, reranked := boardRank(column, 2)
+
+		require.Len(t, reranked, 3)
+		assert.True(t, reranked[1] < reranked[2] && reranked[2] < rank && rank < reranked[3])
+	})
+
+	t.Run("column is ranked again before ranks get too long", func(t *testing.T) {
+		column := []*models.Task{{ID: 1, Rank: "i"}}
+		for id := 2; id < 2000; id++ {
+			rank, reranked := boardRank(column, 0)
+			for _, task := range column {
+				if newRank, ok := reranked[task.ID]; ok {
+					task.Rank = newRank
+				}
+			}
+			require.LessOrEqual(t, len(rank), utils.MaxRankLength)
+			column = append([]*models.Task{{ID: id, Rank: rank}}, column...)
+		}
+
+		for i := 1; i < len(column); i++ {
+			require.Less(t, column[i-1].Rank, column[i].Rank)
+		}
+	})
+}
+
+func TestTaskService_GetAndVerifyContributorForTask(t *testing.T) {
+	ctx := context.Background()
+	assigneeID := 9
+	s := &taskService{
+		taskRepository: &stubTaskRepository{tasks: []*models.Task{{ID: 3, ProjectID: 1, AssigneeID: &assigneeID}}},
+		projectService: &stubProjectService{projectID: 1, memberIDs: []int{7}, viewerIDs: []int{8}},
+	}
+
+	cases := []struct {
+		name   string
+		userID int
+		err    error
+	}{
+		{name: "member", userID: 7},
+		{name: "assignee outside the project", userID: 9},
+		{name: "viewer", userID: 8, err: structs.ErrUserNotAuthorizedForTask},
+		{name: "outsider", userID: 10, err: structs.ErrUserNotAuthorizedForTask},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			task, err := s.GetAndVerifyContributorForTask(ctx, slog.Default(), c.userID, 3)
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 3, task.ID)
+		})
+	}
+
+	_, err := s.GetAndVerifyContributorForTask(ctx, slog.Default(), 7, 4)
+	assert.ErrorIs(t, err, structs.ErrTaskNotExist)
+}
+
+type taskServiceMocks struct {
+	taskRepo        *repomocks.MockTaskRepository
+	projectService  *servicemocks.MockProjectService
+	sprintService   *servicemocks.MockSprintService
+	activityService *servicemocks.MockActivityService
+	wipLimitService *servicemocks.MockWipLimitService
+}
+
+func setupTaskServiceTest(t *testing.T) (context.Context, *gomock.Controller, *taskServiceMocks, *taskService) {
+	ctrl := gomock.NewController(t)
+	mocks := &taskServiceMocks{
+		taskRepo:        repomocks.NewMockTaskRepository(ctrl),
+		projectService:  servicemocks.NewMockProjectService(ctrl),
+		sprintService:   servicemocks.NewMockSprintService(ctrl),
+		activityService: servicemocks.NewMockActivityService(ctrl),
+		wipLimitService: servicemocks.NewMockWipLimitService(ctrl),
+	}
+	taskService := NewTaskService(mocks.taskRepo, nil, mocks.projectService, mocks.sprintService, nil, mocks.activityService, nil, mocks.wipLimitService, nil).(*taskService)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	ctx := utils.ContextWithLogger(context.Background(), logger)
+
+	return ctx, ctrl, mocks, taskService
+}
+
+// taskChain returns depth tasks of project 1, each one a subtask of the
+// previous one, with IDs starting at 1.
+func taskChain(depth int) []*models.Task {
+	tasks := make([]*models.Task, depth)
+	for i := range tasks {
+		tasks[i] = &models.Task{ID: i + 1, ProjectID: 1, Status: models.ToDoTask}
+		if i > 0 {
+			parentID := i
+			tasks[i].ParentTaskID = &parentID
+		}
+	}
+	return tasks
+}
+
+// expectTasks lets the repository mock serve the given tasks by ID.
+func expectTasks(mockTaskRepo *repomocks.MockTaskRepository, tasks ...*models.Task) {
+	mockTaskRepo.EXPECT().
+		FindByID(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id int) (*models.Task, error) {
+			for _, task := range tasks {
+				if task.ID == id {
+					return task, nil
+				}
+			}
+			return nil, structs.ErrTaskNotExist
+		}).AnyTimes()
+}
+
+// expectSubtree lets the repository mock serve the subtasks of the given
+// chain, each task having the next one as its only subtask.
+func expectSubtree(mockTaskRepo *repomocks.MockTaskRepository, chain []*models.Task) {
+	for i, task := range chain {
+		var children []*models.Task
+		if i+1 < len(chain) {
+			children = []*models.Task{chain[i+1]}
+		}
+		mockTaskRepo.EXPECT().FindByParentIDs(gomock.Any(), []int{task.ID}).Return(children, nil).Times(1)
+	}
+}
+
+func TestTaskService_CreateTask_Hierarchy(t *testing.T) {
+	userID := 7
+	id := func(id int) *int { return &id }
+
+	cases := []struct {
+		name            string
+		tasks           []*models.Task
+		parentID        int
+		verifiesManager bool
+		err             error
+	}{
+		{name: "Failure - parent at maximum depth", tasks: taskChain(models.MaxTaskDepth), parentID: models.MaxTaskDepth, verifiesManager: true, err: structs.ErrTaskHierarchyTooDeep},
+		{name: "Failure - parent in a cycle", tasks: []*models.Task{
+			{ID: 1, ProjectID: 1, Status: models.ToDoTask, ParentTaskID: id(2)},
+			{ID: 2, ProjectID: 1, Status: models.ToDoTask, ParentTaskID: id(1)},
+		}, parentID: 1, verifiesManager: true, err: structs.ErrTaskHierarchyCycle},
+		{name: "Failure - parent already done", tasks: []*models.Task{{ID: 1, ProjectID: 1, Status: models.DoneTask}}, parentID: 1, verifiesManager: true, err: structs.ErrParentTaskDone},
+		{name: "Failure - parent does not exist", parentID: 99, err: structs.ErrParentTaskNotExist},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx, ctrl, mocks, service := setupTaskServiceTest(t)
+			defer ctrl.Finish()
+
+			expectTasks(mocks.taskRepo, c.tasks...)
+			if c.verifiesManager {
+				mocks.projectService.EXPECT().
+					GetAndVerifyProjectManager(ctx, userID, 1).
+					Return(&models.Project{ID: 1}, nil).Times(1)
+			}
+
+			createdTask, err := service.CreateTask(ctx, userID, &models.Task{Title: "Subtask", ProjectID: 1, ParentTaskID: id(c.parentID)}, false)
+
+			assert.ErrorIs(t, err, c.err)
+			assert.Nil(t, createdTask)
+		})
+	}
+}
+
+func TestTaskService_ValidateHierarchyUpdate(t *testing.T) {
+	status := func(s models.TaskStatus) *models.TaskStatus { return &s }
+	id := func(id int) *int { return &id }
+	count := func(n int64) *int64 { return &n }
+
+	cases := []struct {
+		name         string
+		task         *models.Task
+		tasks        []*models.Task
+		subtree      []*models.Task
+		openSubtasks *int64
+		data         *dto.UpdateTaskRequest
+		err          error
+	}{
+		{
+			name:         "Failure - task with open subtasks cannot be done",
+			task:         &models.Task{ID: 1, ProjectID: 1, Status: models.InProgressTask},
+			openSubtasks: count(2),
+			data:         &dto.UpdateTaskRequest{Status: status(models.DoneTask)},
+			err:          structs.ErrTaskHasOpenSubtasks,
+		},
+		{
+			name:         "Success - task without open subtasks is done",
+			task:         &models.Task{ID: 1, ProjectID: 1, Status: models.InProgressTask},
+			openSubtasks: count(0),
+			data:         &dto.UpdateTaskRequest{Status: status(models.DoneTask)},
+		},
+		{
+			name:  "Failure - subtask reopened below a done parent",
+			task:  &models.Task{ID: 2, ProjectID: 1, Status: models.DoneTask, ParentTaskID: id(1)},
+			tasks: []*models.Task{{ID: 1, ProjectID: 1, Status: models.DoneTask}},
+			data:  &dto.UpdateTaskRequest{Status: status(models.ToDoTask)},
+			err:   structs.ErrParentTaskDone,
+		},
+		{
+			name:    "Failure - task moved below its own subtask",
+			task:    taskChain(1)[0],
+			tasks:   taskChain(3),
+			subtree: taskChain(3),
+			data:    &dto.UpdateTaskRequest{ParentTaskID: id(3)},
+			err:     structs.ErrTaskHierarchyCycle,
+		},
+		{
+			name:  "Failure - moved subtree exceeds maximum depth",
+			task:  &models.Task{ID: 10, ProjectID: 1, Status: models.ToDoTask},
+			tasks: taskChain(models.MaxTaskDepth - 1),
+			subtree: []*models.Task{
+				{ID: 10, ProjectID: 1, Status: models.ToDoTask},
+				{ID: 11, ProjectID: 1, Status: models.ToDoTask, ParentTaskID: id(10)},
+			},
+			data: &dto.UpdateTaskRequest{ParentTaskID: id(models.MaxTaskDepth - 1)},
+			err:  structs.ErrTaskHierarchyTooDeep,
+		},
+		{
+			name:  "Success - moved subtree reaches maximum depth",
+			task:  &models.Task{ID: 10, ProjectID: 1, Status: models.ToDoTask},
+			tasks: taskChain(models.MaxTaskDepth - 2),
+			subtree: []*models.Task{
+				{ID: 10, ProjectID: 1, Status: models.ToDoTask},
+				{ID: 11, ProjectID: 1, Status: models.ToDoTask, ParentTaskID: id(10)},
+			},
+			data: &dto.UpdateTaskRequest{ParentTaskID: id(models.MaxTaskDepth - 2)},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx, ctrl, mocks, service := setupTaskServiceTest(t)
+			defer ctrl.Finish()
+
+			expectTasks(mocks.taskRepo, c.tasks...)
+			if c.subtree != nil {
+				expectSubtree(mocks.taskRepo, c.subtree)
+			}
+			if c.openSubtasks != nil {
+				mocks.taskRepo.EXPECT().CountOpenSubtasks(ctx, c.task.ID).Return(*c.openSubtasks, nil).Times(1)
+			}
+
+			err := service.validateHierarchyUpdate(ctx, slog.Default(), c.task, c.data)
+
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTaskService_FindBacklogByProjectID(t *testing.T) {
+	userID, projectID := 7, 1
+	page := &dto.PageRequest{Page: 1, Limit: 10}
+
+	cases := []struct {
+		name    string
+		authErr error
+	}{
+		{name: "Success"},
+		{name: "Failure - user does not manage project", authErr: structs.ErrUserNotManageProject},
+		{name: "Failure - project does not exist", authErr: structs.ErrProjectNotExist},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx, ctrl, mocks, service := setupTaskServiceTest(t)
+			defer ctrl.Finish()
+
+			backlog := []*models.Task{{ID: 1, ProjectID: projectID}, {ID: 2, ProjectID: projectID}}
+			pageInfo := &dto.PageInfo{Total: 2, Limit: 10, Page: 1}
+			if c.authErr != nil {
+				mocks.projectService.EXPECT().
+					GetAndVerifyProjectManager(ctx, userID, projectID).
+					Return(nil, c.authErr).Times(1)
+			} else {
+				mocks.projectService.EXPECT().
+					GetAndVerifyProjectManager(ctx, userID, projectID).
+					Return(&models.Project{ID: projectID}, nil).Times(1)
+				mocks.taskRepo.EXPECT().
+					FindBacklogByProjectID(ctx, projectID, page).
+					Return(backlog, pageInfo, nil).Times(1)
+			}
+
+			tasks, info, err := service.FindBacklogByProjectID(ctx, userID, projectID, page)
+
+			if c.authErr != nil {
+				assert.ErrorIs(t, err, c.authErr)
+				assert.Nil(t, tasks)
+				assert.Nil(t, info)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, backlog, tasks)
+			assert.Equal(t, pageInfo, info)
+		})
+	}
+}
+
+// expectProjectRole lets the project service mock report the role of the user
+// in project 1.
+func expectProjectRole(mockProjectService *servicemocks.MockProjectService, userID int, role models.ProjectMemberRole) {
+	mockProjectService.EXPECT().
+		GetProjectMember(gomock.Any(), userID, 1).
+		Return(&models.ProjectMember{UserID: userID, ProjectID: 1, Role: role}, nil).AnyTimes()
+}
+
+func TestTaskService_MoveTaskToSprint(t *testing.T) {
+	userID, sprintID := 7, 3
+
+	cases := []struct {
+		name      string
+		depth     int
+		taskID    int
+		role      models.ProjectMemberRole
+		sprint    *models.Sprint
+		sprintErr error
+		err       error
+	}{
+		{name: "Success - subtree moves with its parent", depth: 3, taskID: 1, role: models.ProjectRoleManager, sprint: &models.Sprint{ID: sprintID, ProjectID: 1, Status: models.SprintActive}},
+		{name: "Failure - user does not manage project", depth: 1, taskID: 1, role: models.ProjectRoleMember, err: structs.ErrUserNotManageProject},
+		{name: "Failure - sprint does not exist", depth: 1, taskID: 1, role: models.ProjectRoleManager, sprintErr: structs.ErrSprintNotExist, err: structs.ErrSprintNotExist},
+		{name: "Failure - sprint of another project", depth: 1, taskID: 1, role: models.ProjectRoleManager, sprint: &models.Sprint{ID: sprintID, ProjectID: 2, Status: models.SprintActive}, err: structs.ErrSprintNotInProject},
+		{name: "Failure - sprint already closed", depth: 1, taskID: 1, role: models.ProjectRoleManager, sprint: &models.Sprint{ID: sprintID, ProjectID: 1, Status: models.SprintClosed}, err: structs.ErrSprintClosed},
+		{name: "Failure - subtask moved without its parent", depth: 2, taskID: 2, role: models.ProjectRoleManager, sprint: &models.Sprint{ID: sprintID, ProjectID: 1, Status: models.SprintActive}, err: structs.ErrSubtaskSprintMismatch},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx, ctrl, mocks, service := setupTaskServiceTest(t)
+			defer ctrl.Finish()
+
+			chain := taskChain(c.depth)
+			expectTasks(mocks.taskRepo, chain...)
+			expectProjectRole(mocks.projectService, userID, c.role)
+			if c.role == models.ProjectRoleManager {
+				mocks.sprintService.EXPECT().
+					GetAndVerifyProjectManagerForSprint(ctx, gomock.Any(), userID, sprintID).
+					Return(c.sprint, c.sprintErr).Times(1)
+			}
+			if c.err == nil {
+				expectSubtree(mocks.taskRepo, chain)
+				mocks.wipLimitService.EXPECT().CheckTasksEntering(ctx, 1, &sprintID, chain).Return(nil).Times(1)
+				mocks.taskRepo.EXPECT().UpdateSprintByIDs(ctx, []int{1, 2, 3}, &sprintID).Return(nil).Times(1)
+				mocks.activityService.EXPECT().Record(ctx, gomock.Any()).Times(len(chain))
+			}
+
+			movedTask, err := service.MoveTaskToSprint(ctx, userID, c.taskID, sprintID, false)
+
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+				assert.Nil(t, movedTask)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.taskID, movedTask.ID)
+		})
+	}
+}
+
+func TestTaskService_MoveTaskToBacklog(t *testing.T) {
+	userID, sprintID := 7, 3
+
+	cases := []struct {
+		name     string
+		depth    int
+		taskID   int
+		inSprint bool
+		wipErr   error
+		err      error
+	}{
+		{name: "Success - subtree moves with its parent", depth: 3, taskID: 1, inSprint: true},
+		{name: "Success - task already in backlog", depth: 1, taskID: 1},
+		{name: "Failure - backlog column at its WIP limit", depth: 2, taskID: 1, inSprint: true, wipErr: structs.ErrWipLimitReached, err: structs.ErrWipLimitReached},
+		{name: "Failure - subtask moved without its parent", depth: 2, taskID: 2, inSprint: true, err: structs.ErrSubtaskSprintMismatch},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx, ctrl, mocks, service := setupTaskServiceTest(t)
+			defer ctrl.Finish()
+
+			chain := taskChain(c.depth)
+			if c.inSprint {
+				for _, task := range chain {
+					task.SprintID = &sprintID
+				}
+			}
+			expectTasks(mocks.taskRepo, chain...)
+			expectProjectRole(mocks.projectService, userID, models.ProjectRoleManager)
+			moves := c.inSprint && c.taskID == 1
+			if moves {
+				expectSubtree(mocks.taskRepo, chain)
+				mocks.wipLimitService.EXPECT().CheckTasksEntering(ctx, 1, nil, chain).Return(c.wipErr).Times(1)
+			}
+			if moves && c.err == nil {
+				mocks.taskRepo.EXPECT().UpdateSprintByIDs(ctx, []int{1, 2, 3}, nil).Return(nil).Times(1)
+				mocks.activityService.EXPECT().Record(ctx, gomock.Any()).Times(len(chain))
+			}
+
+			movedTask, err := service.MoveTaskToBacklog(ctx, userID, c.taskID, false)
+
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+				assert.Nil(t, movedTask)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.taskID, movedTask.ID)
+		})
+	}
+}
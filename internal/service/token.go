@@ -0,0 +1,317 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"lqkhoi-go-http-api/internal/cache"
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+//go:generate mockgen -destination=./mocks/mock_token.go -package=mocks . TokenService
+
+type TokenService interface {
+	IssueTokenPair(ctx context.Context, user *models.User, sessionID string) (*dto.TokenResponse, error)
+	ConsumeRefreshToken(ctx context.Context, refreshToken string) (*structs.Claims, error)
+	ReleaseRefreshToken(ctx context.Context, claims *structs.Claims) error
+	RevokeSession(ctx context.Context, claims *structs.Claims) error
+	RevokeUserTokens(ctx context.Context, userID int) error
+	IsTokenRevoked(ctx context.Context, claims *structs.Claims) (bool, error)
+}
+
+type tokenService struct {
+	cacheRepository cache.CacheRepository
+}
+
+func NewTokenService(cacheRepository cache.CacheRepository) TokenService {
+	return &tokenService{
+		cacheRepository: cacheRepository,
+	}
+}
+
+// tokenVersionKey holds the current token version of the user. It does not
+// expire, since tokens issued under an earlier version must stay revoked.
+func tokenVersionKey(userID int) string {
+	return fmt.Sprintf("auth:token_version:%d", userID)
+}
+
+func revokedTokenKey(tokenID string) string {
+	return fmt.Sprintf("auth:revoked_token:%s", tokenID)
+}
+
+// sessionKey holds the ID of the only refresh token of the session that may
+// still be exchanged.
+func sessionKey(sessionID string) string {
+	return fmt.Sprintf("auth:session:%s", sessionID)
+}
+
+// sessionRotating marks a session whose refresh token was consumed and whose
+// next token has not been issued yet, so the consumed token cannot be
+// exchanged a second time in between.
+const sessionRotating = "rotating"
+
+// IssueTokenPair signs a new access and refresh token for the user. An empty
+// sessionID starts a new session; otherwise the pair continues the given one,
+// whose refresh token must have been consumed by ConsumeRefreshToken.
+func (s *tokenService) IssueTokenPair(ctx context.Context, user *models.User, sessionID string) (*dto.TokenResponse, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TokenService",
+		"method", "IssueTokenPair",
+		"user_id", user.ID,
+	)
+
+	isNewSession := sessionID == ""
+	if isNewSession {
+		id, err := utils.NewTokenID()
+		if err != nil {
+			logger.Error("Failed to generate session ID", "error", err)
+			return nil, structs.ErrTokenCanNotBeSigned
+		}
+		sessionID = id
+	}
+
+	tokenVersion, err := s.tokenVersion(ctx, user.ID)
+	if err != nil {
+		logger.Error("Failed to read token version", "error", err)
+		return nil, fmt.Errorf("failed to read token version of user %d: %w", user.ID, err)
+	}
+
+	accessToken, _, err := utils.GenerateAccessToken(user.ID, user.Email, user.Role, sessionID, tokenVersion)
+	if err != nil {
+		logger.Error("Can not sign access token", "error", err)
+		return nil, structs.ErrTokenCanNotBeSigned
+	}
+
+	refreshToken, refreshClaims, err := utils.GenerateRefreshToken(user.ID, user.Email, user.Role, sessionID, tokenVersion)
+	if err != nil {
+		logger.Error("Can not sign refresh token", "error", err)
+		return nil, structs.ErrTokenCanNotBeSigned
+	}
+
+	exp := int(utils.RefreshTokenTTL.Minutes())
+	if isNewSession {
+		if err := s.cacheRepository.Set(ctx, sessionKey(sessionID), refreshClaims.ID, exp); err != nil {
+			logger.Error("Failed to store session", "session_id", sessionID, "error", err)
+			return nil, fmt.Errorf("failed to store session %s: %w", sessionID, err)
+		}
+	} else {
+		rotated, err := s.cacheRepository.CompareAndSwap(ctx, sessionKey(sessionID), sessionRotating, refreshClaims.ID, exp)
+		if err != nil {
+			logger.Error("Failed to rotate session", "session_id", sessionID, "error", err)
+			return nil, fmt.Errorf("failed to rotate session %s: %w", sessionID, err)
+		}
+		if !rotated {
+			logger.Warn("Session ended while its refresh token was being rotated", "session_id", sessionID)
+			return nil, structs.ErrRefreshTokenInvalid
+		}
+	}
+
+	logger.Info("Issued token pair", "session_id", sessionID)
+	return &dto.TokenResponse{
+		Token:        accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(utils.AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+// ConsumeRefreshToken validates a refresh token and atomically marks it as
+// exchanged, then returns its claims so a new pair can be issued for the same
+// session. Presenting a refresh token that was already exchanged means it
+// leaked: the session and every other token of the user are revoked.
+func (s *tokenService) ConsumeRefreshToken(ctx context.Context, refreshToken string) (*structs.Claims, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TokenService",
+		"method", "ConsumeRefreshToken",
+	)
+
+	claims, err := utils.ParseToken(refreshToken)
+	if err != nil {
+		logger.Warn("Refresh token failed verification", "error", err)
+		return nil, structs.ErrRefreshTokenInvalid
+	}
+	if claims.TokenType != structs.TokenTypeRefresh || claims.SessionID == "" || claims.ID == "" {
+		logger.Warn("Token is not a refresh token", "user_id", claims.UserID, "type", claims.TokenType)
+		return nil, structs.ErrRefreshTokenInvalid
+	}
+	logger = logger.With("user_id", claims.UserID, "session_id", claims.SessionID)
+
+	revoked, err := s.IsTokenRevoked(ctx, claims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check refresh token revocation: %w", err)
+	}
+	if revoked {
+		logger.Warn("Refresh token was revoked")
+		return nil, structs.ErrRefreshTokenInvalid
+	}
+
+	consumed, err := s.cacheRepository.CompareAndSwap(ctx, sessionKey(claims.SessionID), claims.ID, sessionRotating, int(utils.RefreshTokenTTL.Minutes()))
+	if err != nil {
+		logger.Error("Failed to consume refresh token", "error", err)
+		return nil, fmt.Errorf("failed to consume refresh token of session %s: %w", claims.SessionID, err)
+	}
+
+	if !consumed {
+		_, err := s.cacheRepository.Get(ctx, sessionKey(claims.SessionID))
+		if errors.Is(err, structs.ErrRedisKeyNotExist) {
+			logger.Warn("Session does not exist or was logged out")
+			return nil, structs.ErrRefreshTokenInvalid
+		}
+		if err != nil {
+			logger.Error("Failed to read session", "error", err)
+			return nil, fmt.Errorf("failed to read session %s: %w", claims.SessionID, err)
+		}
+
+		logger.Warn("Refresh token reuse detected, revoking session and user tokens", "token_id", claims.ID)
+		if err := s.cacheRepository.Del(ctx, sessionKey(claims.SessionID)); err != nil && !errors.Is(err, structs.ErrRedisKeyNotExist) {
+			logger.Error("Failed to delete session after reuse", "error", err)
+		}
+		if err := s.RevokeUserTokens(ctx, claims.UserID); err != nil {
+			logger.Error("Failed to revoke user tokens after reuse", "error", err)
+		}
+		return nil, structs.ErrRefreshTokenReused
+	}
+
+	logger.Debug("Refresh token accepted", "token_id", claims.ID)
+	return claims, nil
+}
+
+// ReleaseRefreshToken hands a refresh token consumed by ConsumeRefreshToken
+// back to its session when no new pair could be issued for it, so the client
+// can retry the exchange instead of being treated as reusing the token.
+func (s *tokenService) ReleaseRefreshToken(ctx context.Context, claims *structs.Claims) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TokenService",
+		"method", "ReleaseRefreshToken",
+		"user_id", claims.UserID,
+		"session_id", claims.SessionID,
+	)
+
+	released, err := s.cacheRepository.CompareAndSwap(ctx, sessionKey(claims.SessionID), sessionRotating, claims.ID, int(utils.RefreshTokenTTL.Minutes()))
+	if err != nil {
+		logger.Error("Failed to release refresh token", "error", err)
+		return fmt.Errorf("failed to release refresh token of session %s: %w", claims.SessionID, err)
+	}
+	if !released {
+		logger.Warn("Session is no longer rotating, refresh token not released")
+		return nil
+	}
+
+	logger.Info("Refresh token released", "token_id", claims.ID)
+	return nil
+}
+
+// RevokeSession blacklists the given access token until it expires and ends
+// its session so the refresh token can no longer be exchanged.
+func (s *tokenService) RevokeSession(ctx context.Context, claims *structs.Claims) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TokenService",
+		"method", "RevokeSession",
+		"user_id", claims.UserID,
+		"session_id", claims.SessionID,
+	)
+
+	if claims.ID != "" && claims.ExpiresAt != nil {
+		remaining := time.Until(claims.ExpiresAt.Time)
+		exp := int(remaining.Minutes()) + 1
+		if err := s.cacheRepository.Set(ctx, revokedTokenKey(claims.ID), 1, exp); err != nil {
+			logger.Error("Failed to blacklist access token", "token_id", claims.ID, "error", err)
+			return fmt.Errorf("failed to revoke token %s: %w", claims.ID, err)
+		}
+	}
+
+	if claims.SessionID != "" {
+		err := s.cacheRepository.Del(ctx, sessionKey(claims.SessionID))
+		if err != nil && !errors.Is(err, structs.ErrRedisKeyNotExist) {
+			logger.Error("Failed to delete session", "error", err)
+			return fmt.Errorf("failed to delete session %s: %w", claims.SessionID, err)
+		}
+	}
+
+	logger.Info("Session revoked")
+	return nil
+}
+
+// RevokeUserTokens invalidates every token issued to the user up to now by
+// bumping the token version of the user; tokens carrying an older version
+// are revoked. Tokens issued from now on carry the new version.
+func (s *tokenService) RevokeUserTokens(ctx context.Context, userID int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TokenService",
+		"method", "RevokeUserTokens",
+		"user_id", userID,
+	)
+
+	version, err := s.cacheRepository.Increment(ctx, tokenVersionKey(userID))
+	if err != nil {
+		logger.Error("Failed to bump token version", "error", err)
+		return fmt.Errorf("failed to revoke tokens of user %d: %w", userID, err)
+	}
+
+	logger.Info("Revoked all outstanding tokens of user", "token_version", version)
+	return nil
+}
+
+// IsTokenRevoked reports whether the token was logged out or was issued
+// before the last revocation of its user, i.e. carries an older token
+// version.
+func (s *tokenService) IsTokenRevoked(ctx context.Context, claims *structs.Claims) (bool, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "TokenService",
+		"method", "IsTokenRevoked",
+		"user_id", claims.UserID,
+	)
+
+	if claims.ID != "" {
+		_, err := s.cacheRepository.Get(ctx, revokedTokenKey(claims.ID))
+		if err == nil {
+			logger.Debug("Token is blacklisted", "token_id", claims.ID)
+			return true, nil
+		}
+		if !errors.Is(err, structs.ErrRedisKeyNotExist) {
+			logger.Error("Failed to read token blacklist", "error", err)
+			return false, err
+		}
+	}
+
+	version, err := s.tokenVersion(ctx, claims.UserID)
+	if err != nil {
+		logger.Error("Failed to read token version", "error", err)
+		return false, err
+	}
+
+	revoked := claims.TokenVersion < version
+	logger.Debug("Checked token against token version", "token_version", claims.TokenVersion, "current_version", version, "revoked", revoked)
+	return revoked, nil
+}
+
+// tokenVersion returns the current token version of the user, 0 until their
+// tokens are revoked for the first time.
+func (s *tokenService) tokenVersion(ctx context.Context, userID int) (int64, error) {
+	value, err := s.cacheRepository.Get(ctx, tokenVersionKey(userID))
+	if err != nil {
+		if errors.Is(err, structs.ErrRedisKeyNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		utils.LoggerFromContext(ctx).Error("Stored token version is malformed", "user_id", userID, "value", value, "error", err)
+		return 0, structs.ErrInternalServer
+	}
+	return version, nil
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/cache"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubCacheRepository keeps keys in memory and swaps them under a lock like
+// the Redis script does.
+type stubCacheRepository struct {
+	cache.CacheRepository
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func newStubCacheRepository() *stubCacheRepository {
+	return &stubCacheRepository{values: map[string]string{}}
+}
+
+func (r *stubCacheRepository) Get(ctx context.Context, key string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	value, ok := r.values[key]
+	if !ok {
+		return "", structs.ErrRedisKeyNotExist
+	}
+	return value, nil
+}
+
+func (r *stubCacheRepository) Set(ctx context.Context, key string, value any, exp int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.values[key] = fmt.Sprint(value)
+	return nil
+}
+
+func (r *stubCacheRepository) Del(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.values[key]; !ok {
+		return structs.ErrRedisKeyNotExist
+	}
+	delete(r.values, key)
+	return nil
+}
+
+func (r *stubCacheRepository) Increment(ctx context.Context, key string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	value, _ := strconv.ParseInt(r.values[key], 10, 64)
+	value++
+	r.values[key] = strconv.FormatInt(value, 10)
+	return value, nil
+}
+
+func (r *stubCacheRepository) CompareAndSwap(ctx context.Context, key string, old, value any, exp int) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if current, ok := r.values[key]; !ok || current != fmt.Sprint(old) {
+		return false, nil
+	}
+	r.values[key] = fmt.Sprint(value)
+	return true, nil
+}
+
+func TestTokenService_ConsumeRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	user := &models.User{ID: 5, Email: "rotate@example.com", Role: models.TeamMember}
+
+	issueSession := func(t *testing.T, s TokenService) string {
+		pair, err := s.IssueTokenPair(ctx, user, "")
+		require.NoError(t, err)
+		return pair.RefreshToken
+	}
+
+	t.Run("token is exchanged once", func(t *testing.T) {
+		s := NewTokenService(newStubCacheRepository())
+		refreshToken := issueSession(t, s)
+
+		claims, err := s.ConsumeRefreshToken(ctx, refreshToken)
+		require.NoError(t, err)
+		rotated, err := s.IssueTokenPair(ctx, user, claims.SessionID)
+		require.NoError(t, err)
+
+		_, err = s.ConsumeRefreshToken(ctx, refreshToken)
+		assert.ErrorIs(t, err, structs.ErrRefreshTokenReused)
+		_, err = s.ConsumeRefreshToken(ctx, rotated.RefreshToken)
+		assert.ErrorIs(t, err, structs.ErrRefreshTokenInvalid, "reuse ends the session")
+	})
+
+	t.Run("same token refreshed twice before rotation", func(t *testing.T) {
+		s := NewTokenService(newStubCacheRepository())
+		refreshToken := issueSession(t, s)
+
+		claims, err := s.ConsumeRefreshToken(ctx, refreshToken)
+		require.NoError(t, err)
+		_, err = s.ConsumeRefreshToken(ctx, refreshToken)
+		assert.ErrorIs(t, err, structs.ErrRefreshTokenReused)
+
+		_, err = s.IssueTokenPair(ctx, user, claims.SessionID)
+		assert.ErrorIs(t, err, structs.ErrRefreshTokenInvalid, "revoked session is not rotated")
+	})
+
+	t.Run("released token can be exchanged again", func(t *testing.T) {
+		s := NewTokenService(newStubCacheRepository())
+		refreshToken := issueSession(t, s)
+
+		claims, err := s.ConsumeRefreshToken(ctx, refreshToken)
+		require.NoError(t, err)
+		require.NoError(t, s.ReleaseRefreshToken(ctx, claims))
+
+		claims, err = s.ConsumeRefreshToken(ctx, refreshToken)
+		require.NoError(t, err, "retry after a failed exchange is not a reuse")
+		_, err = s.IssueTokenPair(ctx, user, claims.SessionID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("concurrent refreshes let one through", func(t *testing.T) {
+		s := NewTokenService(newStubCacheRepository())
+		refreshToken := issueSession(t, s)
+
+		const attempts = 8
+		errs := make([]error, attempts)
+		var wg sync.WaitGroup
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = s.ConsumeRefreshToken(ctx, refreshToken)
+			}(i)
+		}
+		wg.Wait()
+
+		accepted := 0
+		for _, err := range errs {
+			if err == nil {
+				accepted++
+				continue
+			}
+			assert.True(t, errors.Is(err, structs.ErrRefreshTokenReused) || errors.Is(err, structs.ErrRefreshTokenInvalid), "error %v", err)
+		}
+		assert.Equal(t, 1, accepted)
+	})
+}
+
+func TestTokenService_RevokeUserTokens(t *testing.T) {
+	ctx := context.Background()
+	user := &models.User{ID: 5, Email: "revoke@example.com", Role: models.TeamMember}
+	s := NewTokenService(newStubCacheRepository())
+
+	before, err := s.IssueTokenPair(ctx, user, "")
+	require.NoError(t, err)
+	require.NoError(t, s.RevokeUserTokens(ctx, user.ID))
+	after, err := s.IssueTokenPair(ctx, user, "")
+	require.NoError(t, err)
+
+	// The pairs are usually issued within the same second, which the issue
+	// times of the tokens could not tell apart.
+	for _, c := range []struct {
+		token   string
+		revoked bool
+	}{
+		{before.Token, true},
+		{before.RefreshToken, true},
+		{after.Token, false},
+		{after.RefreshToken, false},
+	} {
+		claims, err := utils.ParseToken(c.token)
+		require.NoError(t, err)
+		revoked, err := s.IsTokenRevoked(ctx, claims)
+		require.NoError(t, err)
+		assert.Equal(t, c.revoked, revoked)
+	}
+}
@@ -22,22 +22,28 @@ import (
 type UserService interface {
 	CreateUser(ctx context.Context, user *models.User) (*models.User, error)
 	FindByID(ctx context.Context, id int) (*models.User, error)
-	FindValidTeamMembersForAssignment(ctx context.Context, userIDs []int) ([]int, error)
+	FindByEmails(ctx context.Context, emails []string) ([]*models.User, error)
+	FindValidTeamMembersForAssignment(ctx context.Context, userIDs []int, role models.ProjectMemberRole) ([]int, error)
 	AssignUsersToProject(ctx context.Context, projectID int, userIDs []int) error
-	Login(ctx context.Context, rq dto.LoginRequest) (string, error)
-	GetAllUsers(ctx context.Context) ([]*models.User, error)
+	Login(ctx context.Context, rq dto.LoginRequest) (*dto.TokenResponse, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*dto.TokenResponse, error)
+	Logout(ctx context.Context, claims *structs.Claims) error
+	GetAllUsers(ctx context.Context, page *dto.PageRequest) ([]*models.User, *dto.PageInfo, error)
 	UpdateUser(ctx context.Context, userID int,
 		data *dto.UpdateUserRequest) (*models.User, error)
+	ChangePassword(ctx context.Context, userID int, data *dto.ChangePasswordRequest) error
 	DeleteUser(ctx context.Context, id int) error
 }
 
 type userService struct {
 	userRepository repository.UserRepository
+	tokenService   TokenService
 }
 
-func NewUserService(userRepository repository.UserRepository) UserService {
+func NewUserService(userRepository repository.UserRepository, tokenService TokenService) UserService {
 	return &userService{
 		userRepository: userRepository,
+		tokenService:   tokenService,
 	}
 }
 
@@ -75,12 +81,34 @@ func (s *userService) FindByID(ctx context.Context, id int) (*models.User, error
 	return user, nil
 }
 
-func (s *userService) FindValidTeamMembersForAssignment(ctx context.Context, userIDs []int) ([]int, error) {
+// FindByEmails returns the users owning the given emails; unknown emails are
+// skipped.
+func (s *userService) FindByEmails(ctx context.Context, emails []string) ([]*models.User, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "UserService",
+		"method", "FindByEmails",
+	)
+
+	users, err := s.userRepository.FindByEmails(ctx, emails)
+	if err != nil {
+		logger.Error("Failed to load users by emails", "error", err)
+		return nil, structs.ErrDatabaseFail
+	}
+	logger.Debug("Found users by emails", "requested", len(emails), "found", len(users))
+	return users, nil
+}
+
+// FindValidTeamMembersForAssignment returns the users that may join a project
+// with the given role. Project managers can only join as MANAGER, every other
+// role is reserved for team members.
+func (s *userService) FindValidTeamMembersForAssignment(ctx context.Context, userIDs []int, role models.ProjectMemberRole) ([]int, error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "UserService",
 		"method", "FindValidTeamMembersForAssignment",
 		"userIDs", userIDs,
+		"role", role,
 	)
 	logger.Debug("Finding and validating team members for assignment")
 
@@ -102,7 +130,12 @@ func (s *userService) FindValidTeamMembersForAssignment(ctx context.Context, use
 		foundIDs[u.ID] = struct{}{}
 	}
 
-	invalidUserMessages := make([]string, 0, 2*len(userIDs))
+	requiredRole := models.TeamMember
+	if role == models.ProjectRoleManager {
+		requiredRole = models.ProjectManager
+	}
+
+	invalidUserMessages := make([]string, 0, len(userIDs))
 	validUserIDs := make([]int, 0, len(users))
 
 	for _, reqID := range userIDs {
@@ -117,16 +150,9 @@ func (s *userService) FindValidTeamMembersForAssignment(ctx context.Context, use
 		userLogger := logger.With("user_id", user.ID)
 		isValid := true
 
-		if user.Role != models.TeamMember {
-			msg := fmt.Sprintf("user %d has incorrect role '%s' (required: '%s')", user.ID, user.Role, models.TeamMember)
-			userLogger.Warn("Invalid role for assignment", "current_role", user.Role, "required_role", models.TeamMember)
-			invalidUserMessages = append(invalidUserMessages, msg)
-			isValid = false
-		}
-
-		if user.CurrentProjectID != nil {
-			msg := fmt.Sprintf("user %d is already assigned to project %d", user.ID, *user.CurrentProjectID)
-			userLogger.Warn("User already assigned to a project", "project_id", *user.CurrentProjectID)
+		if user.Role != requiredRole {
+			msg := fmt.Sprintf("user %d has incorrect role '%s' (required: '%s')", user.ID, user.Role, requiredRole)
+			userLogger.Warn("Invalid role for assignment", "current_role", user.Role, "required_role", requiredRole)
 			invalidUserMessages = append(invalidUserMessages, msg)
 			isValid = false
 		}
@@ -172,7 +198,7 @@ func (s *userService) AssignUsersToProject(ctx context.Context, projectID int, u
 	return nil
 }
 
-func (s *userService) Login(ctx context.Context, rq dto.LoginRequest) (string, error) {
+func (s *userService) Login(ctx context.Context, rq dto.LoginRequest) (*dto.TokenResponse, error) {
 	baseLogger := utils.LoggerFromContext(ctx)
 	logger := baseLogger.With(
 		"component", "UserService",
@@ -185,45 +211,107 @@ func (s *userService) Login(ctx context.Context, rq dto.LoginRequest) (string, e
 	if err != nil {
 		if errors.Is(err, structs.ErrEmailNotExist) {
 			logger.Error("Email does not exist", "email", rq.Email)
-			return "", fmt.Errorf("fail to find email: %w", err)
+			return nil, fmt.Errorf("fail to find email: %w", err)
 		}
 		logger.Error("Internal database error looking up email", "email", rq.Email, "error", err.Error())
-		return "", structs.ErrDatabaseFail
+		return nil, structs.ErrDatabaseFail
 	}
 
 	if user == nil {
 		logger.Warn("No user found", "email", rq.Email)
-		return "", structs.ErrEmailNotExist
+		return nil, structs.ErrEmailNotExist
 	}
 
 	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(rq.Password))
 	if err == nil {
 		logger.Info("User provide corrected password", "email", rq.Email)
-		token, err := utils.GenerateToken(user.ID, rq.Email, user.Role)
+		token, err := s.tokenService.IssueTokenPair(ctx, user, "")
 		if err != nil {
-			logger.Error("Can not sign token for user", "email", rq.Email)
-			return "", structs.ErrTokenCanNotBeSigned
+			logger.Error("Can not issue tokens for user", "email", rq.Email, "error", err)
+			return nil, fmt.Errorf("fail to issue tokens: %w", err)
 		}
 		return token, nil
 	} else if err == bcrypt.ErrMismatchedHashAndPassword {
 		logger.Error("Incorrect Password Login Attempt for email", "email", rq.Email)
 		logger.Debug("Incorrect Password Login Attempt for email", "email", rq.Email, "provided_password", rq.Password)
-		return "", structs.ErrPasswordIncorrect
+		return nil, structs.ErrPasswordIncorrect
 	} else {
 		logger.Error("Error comparing password for email", "email", rq.Email, "error", err.Error())
-		return "", structs.ErrInternalServer
+		return nil, structs.ErrInternalServer
 	}
 
 }
 
-func (s *userService) GetAllUsers(ctx context.Context) ([]*models.User, error) {
-	users, err := s.userRepository.List(ctx)
+func (s *userService) RefreshToken(ctx context.Context, refreshToken string) (*dto.TokenResponse, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "UserService",
+		"method", "RefreshToken",
+	)
+
+	claims, err := s.tokenService.ConsumeRefreshToken(ctx, refreshToken)
 	if err != nil {
-		slog.Error("Internal database fail", "error", err)
+		logger.Warn("Refresh token rejected", "error", err)
+		return nil, fmt.Errorf("fail to refresh token: %w", err)
+	}
+
+	// The consumed token goes back to its session on failure, otherwise the
+	// retry of the client would be taken for a reuse and end all its sessions.
+	releaseToken := func() {
+		if err := s.tokenService.ReleaseRefreshToken(ctx, claims); err != nil {
+			logger.Error("Failed to release refresh token", "user_id", claims.UserID, "error", err)
+		}
+	}
+
+	// Reload the user so a deleted account or a changed role is reflected in
+	// the new access token.
+	user, err := s.userRepository.FindByID(ctx, claims.UserID)
+	if err != nil {
+		releaseToken()
+		if errors.Is(err, structs.ErrUserNotExist) {
+			logger.Warn("User of refresh token no longer exists", "user_id", claims.UserID)
+			return nil, structs.ErrRefreshTokenInvalid
+		}
+		logger.Error("Internal database error looking up user", "user_id", claims.UserID, "error", err)
 		return nil, structs.ErrDatabaseFail
 	}
+
+	token, err := s.tokenService.IssueTokenPair(ctx, user, claims.SessionID)
+	if err != nil {
+		releaseToken()
+		logger.Error("Can not issue tokens for user", "user_id", user.ID, "error", err)
+		return nil, fmt.Errorf("fail to issue tokens: %w", err)
+	}
+
+	logger.Info("Token pair refreshed", "user_id", user.ID)
+	return token, nil
+}
+
+func (s *userService) Logout(ctx context.Context, claims *structs.Claims) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "UserService",
+		"method", "Logout",
+		"user_id", claims.UserID,
+	)
+
+	if err := s.tokenService.RevokeSession(ctx, claims); err != nil {
+		logger.Error("Failed to revoke session", "error", err)
+		return fmt.Errorf("fail to logout: %w", structs.ErrInternalServer)
+	}
+
+	logger.Info("User logged out")
+	return nil
+}
+
+func (s *userService) GetAllUsers(ctx context.Context, page *dto.PageRequest) ([]*models.User, *dto.PageInfo, error) {
+	users, pageInfo, err := s.userRepository.List(ctx, page)
+	if err != nil {
+		slog.Error("Internal database fail", "error", err)
+		return nil, nil, structs.ErrDatabaseFail
+	}
 	slog.Info("Found a list of user", "users", users)
-	return users, nil
+	return users, pageInfo, nil
 }
 
 func (s *userService) UpdateUser(ctx context.Context, userID int,
@@ -243,6 +331,15 @@ func (s *userService) UpdateUser(ctx context.Context, userID int,
 	if data.LastName != nil {
 		updateMap["last_name"] = *data.LastName
 	}
+	if data.EmailOnAssignment != nil {
+		updateMap["email_on_assignment"] = *data.EmailOnAssignment
+	}
+	if data.EmailOnDueSoon != nil {
+		updateMap["email_on_due_soon"] = *data.EmailOnDueSoon
+	}
+	if data.EmailOnSprintStart != nil {
+		updateMap["email_on_sprint_start"] = *data.EmailOnSprintStart
+	}
 	if len(updateMap) == 0 {
 		logger.Info("No fields to update, returning current user")
 		return s.userRepository.FindByID(ctx, userID)
@@ -264,6 +361,64 @@ func (s *userService) UpdateUser(ctx context.Context, userID int,
 	return updatedProject, nil
 }
 
+func (s *userService) ChangePassword(ctx context.Context, userID int, data *dto.ChangePasswordRequest) error {
+	logger := utils.LoggerFromContext(ctx).With(
+		"component", "UserService",
+		"method", "ChangePassword",
+		"user_id", userID,
+	)
+
+	logger.Debug("Starting password change")
+	user, err := s.userRepository.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, structs.ErrUserNotExist) {
+			logger.Warn("User does not exist")
+			return err
+		}
+		logger.Error("Failed to load user", "error", err)
+		return structs.ErrDatabaseFail
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(data.CurrentPassword))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			logger.Warn("Current password does not match")
+			return structs.ErrPasswordIncorrect
+		}
+		logger.Error("Error comparing current password", "error", err)
+		return structs.ErrInternalServer
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(data.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		logger.Error("Failed to hash new password", "error", err)
+		if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+			return structs.ErrPasswordTooLong
+		}
+		return structs.ErrInternalServer
+	}
+
+	updateMap := map[string]any{
+		"password": string(hashedPassword),
+	}
+	if err := s.userRepository.Update(ctx, userID, updateMap); err != nil {
+		logger.Error("Failed to update password in repository", "error", err)
+		if errors.Is(err, structs.ErrUserNotExist) {
+			return fmt.Errorf("repository failed to update password: %w", err)
+		}
+		return structs.ErrDatabaseFail
+	}
+
+	logger.Debug("Password updated, revoking outstanding tokens")
+	if err := s.tokenService.RevokeUserTokens(ctx, userID); err != nil {
+		logger.Error("Password changed but tokens could not be revoked", "error", err)
+		return fmt.Errorf("password changed but tokens were not revoked: %w", structs.ErrInternalServer)
+	}
+
+	logger.Info("Successfully changed password")
+	return nil
+}
+
 func (s *userService) DeleteUser(ctx context.Context, id int) error {
 	logger := utils.LoggerFromContext(ctx).With(
 		"component", "UserService",
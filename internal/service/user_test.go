@@ -12,6 +12,7 @@ import (
 	"lqkhoi-go-http-api/internal/dto"
 	"lqkhoi-go-http-api/internal/models"
 	repomocks "lqkhoi-go-http-api/internal/repository/mocks"
+	servicemocks "lqkhoi-go-http-api/internal/service/mocks"
 	"lqkhoi-go-http-api/pkg/structs"
 	"lqkhoi-go-http-api/pkg/utils"
 
@@ -22,14 +23,20 @@ import (
 )
 
 func setupUserServiceTest(t *testing.T) (context.Context, *gomock.Controller, *repomocks.MockUserRepository, UserService) {
+	ctx, ctrl, mockUserRepo, _, userService := setupUserServiceWithTokenTest(t)
+	return ctx, ctrl, mockUserRepo, userService
+}
+
+func setupUserServiceWithTokenTest(t *testing.T) (context.Context, *gomock.Controller, *repomocks.MockUserRepository, *servicemocks.MockTokenService, UserService) {
 	ctrl := gomock.NewController(t)
 	mockUserRepo := repomocks.NewMockUserRepository(ctrl)
-	userService := NewUserService(mockUserRepo)
+	mockTokenService := servicemocks.NewMockTokenService(ctrl)
+	userService := NewUserService(mockUserRepo, mockTokenService)
 
 	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
 	ctx := utils.ContextWithLogger(context.Background(), logger)
 
-	return ctx, ctrl, mockUserRepo, userService
+	return ctx, ctrl, mockUserRepo, mockTokenService, userService
 }
 
 func TestUserService_CreateUser(t *testing.T) {
@@ -171,14 +178,14 @@ func TestUserService_FindValidTeamMembersForAssignment(t *testing.T) {
 			Return([]*models.User{validUser1, validUser2}, nil).
 			Times(1)
 
-		resultIDs, err := service.FindValidTeamMembersForAssignment(ctx, validIDs)
+		resultIDs, err := service.FindValidTeamMembersForAssignment(ctx, validIDs, models.ProjectRoleMember)
 
 		require.NoError(t, err)
 		assert.ElementsMatch(t, validIDs, resultIDs)
 	})
 
 	t.Run("Success - Empty Input", func(t *testing.T) {
-		resultIDs, err := service.FindValidTeamMembersForAssignment(ctx, []int{})
+		resultIDs, err := service.FindValidTeamMembersForAssignment(ctx, []int{}, models.ProjectRoleMember)
 		require.NoError(t, err)
 		assert.Empty(t, resultIDs)
 	})
@@ -190,7 +197,7 @@ func TestUserService_FindValidTeamMembersForAssignment(t *testing.T) {
 			Return(nil, dbErr).
 			Times(1)
 
-		resultIDs, err := service.FindValidTeamMembersForAssignment(ctx, userIDs)
+		resultIDs, err := service.FindValidTeamMembersForAssignment(ctx, userIDs, models.ProjectRoleMember)
 
 		require.Error(t, err)
 		assert.Nil(t, resultIDs)
@@ -204,17 +211,31 @@ func TestUserService_FindValidTeamMembersForAssignment(t *testing.T) {
 			Return(repoResultUsers, nil).
 			Times(1)
 
-		resultIDs, err := service.FindValidTeamMembersForAssignment(ctx, userIDs)
+		resultIDs, err := service.FindValidTeamMembersForAssignment(ctx, userIDs, models.ProjectRoleMember)
 
 		require.Error(t, err)
 		assert.ErrorContains(t, err, "validation failed for some users")
 		assert.ErrorContains(t, err, "user 5 not found")
 		assert.ErrorContains(t, err, fmt.Sprintf("user %d has incorrect role '%s'", user2.ID, user2.Role))
-		assert.ErrorContains(t, err, fmt.Sprintf("user %d is already assigned to project %d", user3.ID, *user3.CurrentProjectID))
+		assert.NotContains(t, err.Error(), fmt.Sprintf("user %d", user3.ID), "membership in another project must not disqualify a user")
 
-		expectedValidIDs := []int{1, 4}
+		expectedValidIDs := []int{1, 3, 4}
 		assert.ElementsMatch(t, expectedValidIDs, resultIDs)
 	})
+
+	t.Run("Partial Success - Manager Role Requires Project Manager", func(t *testing.T) {
+		managerIDs := []int{1, 2}
+		mockUserRepo.EXPECT().
+			FindByIDs(ctx, managerIDs).
+			Return([]*models.User{user1, user2}, nil).
+			Times(1)
+
+		resultIDs, err := service.FindValidTeamMembersForAssignment(ctx, managerIDs, models.ProjectRoleManager)
+
+		require.Error(t, err)
+		assert.ErrorContains(t, err, fmt.Sprintf("user %d has incorrect role '%s'", user1.ID, user1.Role))
+		assert.ElementsMatch(t, []int{2}, resultIDs)
+	})
 }
 
 func TestUserService_AssignUsersToProject(t *testing.T) {
@@ -254,7 +275,7 @@ func TestUserService_AssignUsersToProject(t *testing.T) {
 }
 
 func TestUserService_Login(t *testing.T) {
-	ctx, ctrl, mockUserRepo, service := setupUserServiceTest(t)
+	ctx, ctrl, mockUserRepo, mockTokenService, service := setupUserServiceWithTokenTest(t)
 	defer ctrl.Finish()
 
 	email := "login@example.com"
@@ -281,6 +302,10 @@ func TestUserService_Login(t *testing.T) {
 			FindByEmail(ctx, email).
 			Return(dbUser, nil).
 			Times(1)
+		mockTokenService.EXPECT().
+			IssueTokenPair(ctx, dbUser, "").
+			Return(&dto.TokenResponse{Token: "access", RefreshToken: "refresh"}, nil).
+			Times(1)
 
 		token, err := service.Login(ctx, loginReq)
 
@@ -353,6 +378,132 @@ func TestUserService_Login(t *testing.T) {
 		assert.NotErrorIs(t, err, structs.ErrPasswordIncorrect)
 	})
 
+	t.Run("Failure - Token Issue Error", func(t *testing.T) {
+		mockUserRepo.EXPECT().
+			FindByEmail(ctx, email).
+			Return(dbUser, nil).
+			Times(1)
+		mockTokenService.EXPECT().
+			IssueTokenPair(ctx, dbUser, "").
+			Return(nil, structs.ErrTokenCanNotBeSigned).
+			Times(1)
+
+		token, err := service.Login(ctx, loginReq)
+
+		require.Error(t, err)
+		assert.Nil(t, token)
+		assert.ErrorIs(t, err, structs.ErrTokenCanNotBeSigned)
+	})
+}
+
+func TestUserService_RefreshToken(t *testing.T) {
+	ctx, ctrl, mockUserRepo, mockTokenService, service := setupUserServiceWithTokenTest(t)
+	defer ctrl.Finish()
+
+	refreshToken := "refresh_token"
+	claims := &structs.Claims{UserID: 31, SessionID: "session-1"}
+	dbUser := &models.User{ID: 31, Email: "refresh@example.com", Role: models.TeamMember}
+
+	t.Run("Success", func(t *testing.T) {
+		expected := &dto.TokenResponse{Token: "access", RefreshToken: "rotated"}
+		mockTokenService.EXPECT().
+			ConsumeRefreshToken(ctx, refreshToken).
+			Return(claims, nil).
+			Times(1)
+		mockUserRepo.EXPECT().
+			FindByID(ctx, claims.UserID).
+			Return(dbUser, nil).
+			Times(1)
+		mockTokenService.EXPECT().
+			IssueTokenPair(ctx, dbUser, claims.SessionID).
+			Return(expected, nil).
+			Times(1)
+
+		token, err := service.RefreshToken(ctx, refreshToken)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, token)
+	})
+
+	t.Run("Failure - Token Reused", func(t *testing.T) {
+		mockTokenService.EXPECT().
+			ConsumeRefreshToken(ctx, refreshToken).
+			Return(nil, structs.ErrRefreshTokenReused).
+			Times(1)
+
+		token, err := service.RefreshToken(ctx, refreshToken)
+
+		require.Error(t, err)
+		assert.Nil(t, token)
+		assert.ErrorIs(t, err, structs.ErrRefreshTokenReused)
+	})
+
+	t.Run("Failure - User Deleted", func(t *testing.T) {
+		mockTokenService.EXPECT().
+			ConsumeRefreshToken(ctx, refreshToken).
+			Return(claims, nil).
+			Times(1)
+		mockUserRepo.EXPECT().
+			FindByID(ctx, claims.UserID).
+			Return(nil, structs.ErrUserNotExist).
+			Times(1)
+		mockTokenService.EXPECT().
+			ReleaseRefreshToken(ctx, claims).
+			Return(nil).
+			Times(1)
+
+		token, err := service.RefreshToken(ctx, refreshToken)
+
+		require.Error(t, err)
+		assert.Nil(t, token)
+		assert.ErrorIs(t, err, structs.ErrRefreshTokenInvalid)
+	})
+
+	t.Run("Failure - Database Error Releases Token", func(t *testing.T) {
+		mockTokenService.EXPECT().
+			ConsumeRefreshToken(ctx, refreshToken).
+			Return(claims, nil).
+			Times(1)
+		mockUserRepo.EXPECT().
+			FindByID(ctx, claims.UserID).
+			Return(nil, errors.New("connection refused")).
+			Times(1)
+		mockTokenService.EXPECT().
+			ReleaseRefreshToken(ctx, claims).
+			Return(nil).
+			Times(1)
+
+		token, err := service.RefreshToken(ctx, refreshToken)
+
+		require.Error(t, err)
+		assert.Nil(t, token)
+		assert.ErrorIs(t, err, structs.ErrDatabaseFail)
+	})
+
+	t.Run("Failure - Issue Error Releases Token", func(t *testing.T) {
+		mockTokenService.EXPECT().
+			ConsumeRefreshToken(ctx, refreshToken).
+			Return(claims, nil).
+			Times(1)
+		mockUserRepo.EXPECT().
+			FindByID(ctx, claims.UserID).
+			Return(dbUser, nil).
+			Times(1)
+		mockTokenService.EXPECT().
+			IssueTokenPair(ctx, dbUser, claims.SessionID).
+			Return(nil, structs.ErrTokenCanNotBeSigned).
+			Times(1)
+		mockTokenService.EXPECT().
+			ReleaseRefreshToken(ctx, claims).
+			Return(nil).
+			Times(1)
+
+		token, err := service.RefreshToken(ctx, refreshToken)
+
+		require.Error(t, err)
+		assert.Nil(t, token)
+		assert.ErrorIs(t, err, structs.ErrTokenCanNotBeSigned)
+	})
 }
 
 func TestUserService_GetAllUsers(t *testing.T) {
@@ -364,26 +515,30 @@ func TestUserService_GetAllUsers(t *testing.T) {
 		{ID: 2, Email: "user2@example.com"},
 	}
 
+	page := &dto.PageRequest{Limit: 2, Page: 1, SortField: "id"}
+
 	t.Run("Success", func(t *testing.T) {
+		expectedInfo := &dto.PageInfo{Total: 5, Limit: 2, Page: 1, NextCursor: "next"}
 		mockUserRepo.EXPECT().
-			List(ctx).
-			Return(expectedUsers, nil).
+			List(ctx, page).
+			Return(expectedUsers, expectedInfo, nil).
 			Times(1)
 
-		users, err := service.GetAllUsers(ctx)
+		users, pageInfo, err := service.GetAllUsers(ctx, page)
 
 		require.NoError(t, err)
 		assert.Equal(t, expectedUsers, users)
+		assert.Equal(t, expectedInfo, pageInfo)
 	})
 
 	t.Run("Failure - Repository Error", func(t *testing.T) {
 		dbErr := errors.New("list failed")
 		mockUserRepo.EXPECT().
-			List(ctx).
-			Return(nil, dbErr).
+			List(ctx, page).
+			Return(nil, nil, dbErr).
 			Times(1)
 
-		users, err := service.GetAllUsers(ctx)
+		users, _, err := service.GetAllUsers(ctx, page)
 
 		require.Error(t, err)
 		assert.Nil(t, users)
@@ -499,6 +654,88 @@ func TestUserService_UpdateUser(t *testing.T) {
 	})
 }
 
+func TestUserService_ChangePassword(t *testing.T) {
+	ctx, ctrl, mockUserRepo, mockTokenService, service := setupUserServiceWithTokenTest(t)
+	defer ctrl.Finish()
+
+	userID := 7
+	currentPassword := "currentPassword123"
+	newPassword := "brandNewPassword456"
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(currentPassword), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	storedUser := &models.User{ID: userID, Email: "pw@example.com", Password: string(hashedPassword)}
+	req := &dto.ChangePasswordRequest{CurrentPassword: currentPassword, NewPassword: newPassword}
+
+	t.Run("Success", func(t *testing.T) {
+		mockUserRepo.EXPECT().
+			FindByID(ctx, userID).
+			Return(storedUser, nil).
+			Times(1)
+		mockUserRepo.EXPECT().
+			Update(ctx, userID, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id int, updateMap map[string]any) error {
+				newHash, ok := updateMap["password"].(string)
+				require.True(t, ok, "update map should contain the new password hash")
+				assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(newHash), []byte(newPassword)))
+				return nil
+			}).
+			Times(1)
+		mockTokenService.EXPECT().
+			RevokeUserTokens(ctx, userID).
+			Return(nil).
+			Times(1)
+
+		err := service.ChangePassword(ctx, userID, req)
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Current Password Incorrect", func(t *testing.T) {
+		mockUserRepo.EXPECT().
+			FindByID(ctx, userID).
+			Return(storedUser, nil).
+			Times(1)
+
+		wrongReq := &dto.ChangePasswordRequest{CurrentPassword: "notTheRightOne", NewPassword: newPassword}
+		err := service.ChangePassword(ctx, userID, wrongReq)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, structs.ErrPasswordIncorrect)
+	})
+
+	t.Run("Failure - User Not Found", func(t *testing.T) {
+		mockUserRepo.EXPECT().
+			FindByID(ctx, userID).
+			Return(nil, structs.ErrUserNotExist).
+			Times(1)
+
+		err := service.ChangePassword(ctx, userID, req)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, structs.ErrUserNotExist)
+	})
+
+	t.Run("Failure - Token Revocation Error", func(t *testing.T) {
+		mockUserRepo.EXPECT().
+			FindByID(ctx, userID).
+			Return(storedUser, nil).
+			Times(1)
+		mockUserRepo.EXPECT().
+			Update(ctx, userID, gomock.Any()).
+			Return(nil).
+			Times(1)
+		mockTokenService.EXPECT().
+			RevokeUserTokens(ctx, userID).
+			Return(structs.ErrRedisConnection).
+			Times(1)
+
+		err := service.ChangePassword(ctx, userID, req)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, structs.ErrInternalServer)
+	})
+}
+
 func TestUserService_DeleteUser(t *testing.T) {
 	ctx, ctrl, mockUserRepo, service := setupUserServiceTest(t)
 	defer ctrl.Finish()
@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/events"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+type WebhookService interface {
+	ListWebhooks(ctx context.Context, userID, projectID int) ([]*models.Webhook, error)
+	CreateWebhook(ctx context.Context, userID, projectID int, webhook *models.Webhook) (*models.Webhook, error)
+	UpdateWebhook(ctx context.Context, userID, projectID, webhookID int, data *dto.UpdateWebhookRequest) (*models.Webhook, error)
+	DeleteWebhook(ctx context.Context, userID, projectID, webhookID int) error
+	ListDeliveries(ctx context.Context, userID, projectID, webhookID int, page *dto.PageRequest) ([]*models.WebhookDelivery, *dto.PageInfo, error)
+	Redeliver(ctx context.Context, userID, projectID, webhookID, deliveryID int) (*models.WebhookDelivery, error)
+	HandleEvent(ctx context.Context, event events.Event)
+}
+
+type webhookService struct {
+	webhookRepository repository.WebhookRepository
+	projectService    ProjectService
+	dispatcher        *WebhookDispatcher
+}
+
+func NewWebhookService(webhookRepository repository.WebhookRepository, projectService ProjectService, dispatcher *WebhookDispatcher) WebhookService {
+	return &webhookService{
+		webhookRepository: webhookRepository,
+		projectService:    projectService,
+		dispatcher:        dispatcher,
+	}
+}
+
+func (s *webhookService) ListWebhooks(ctx context.Context, userID, projectID int) ([]*models.Webhook, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookService",
+		"method", "ListWebhooks",
+		"project_id", projectID,
+		"requestor_id", userID,
+	)
+
+	if _, err := s.projectService.GetAndVerifyProjectManager(ctx, userID, projectID); err != nil {
+		return nil, fmt.Errorf("cannot list webhooks of project %d: %w", projectID, err)
+	}
+
+	webhooks, err := s.webhookRepository.FindByProjectID(ctx, projectID)
+	if err != nil {
+		logger.Error("Failed to find webhooks of project", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Webhooks found", "count", len(webhooks))
+	return webhooks, nil
+}
+
+// CreateWebhook subscribes a URL to events of the project, which the user must
+// manage. A secret is generated unless one is given; the returned webhook is
+// the only place it is shown.
+func (s *webhookService) CreateWebhook(ctx context.Context, userID, projectID int, webhook *models.Webhook) (*models.Webhook, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookService",
+		"method", "CreateWebhook",
+		"project_id", projectID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting webhook creation process")
+	if _, err := s.projectService.GetAndVerifyProjectManager(ctx, userID, projectID); err != nil {
+		return nil, fmt.Errorf("cannot create webhook in project %d: %w", projectID, err)
+	}
+
+	if err := validateWebhookURL(ctx, webhook.URL); err != nil {
+		logger.Warn("Webhook URL is not allowed", "error", err)
+		return nil, fmt.Errorf("cannot create webhook in project %d: %w", projectID, err)
+	}
+
+	if webhook.Secret == "" {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			logger.Error("Failed to generate webhook secret", "error", err)
+			return nil, fmt.Errorf("cannot generate secret for webhook of project %d: %w", projectID, err)
+		}
+		webhook.Secret = secret
+	}
+	webhook.ProjectID = projectID
+	webhook.CreatedByID = userID
+	webhook.Events = uniqueEventTypes(webhook.Events)
+
+	created, err := s.webhookRepository.Create(ctx, webhook)
+	if err != nil {
+		logger.Error("Failed to create webhook in repository", "error", err)
+		return nil, fmt.Errorf("repository create failed for webhook of project %d: %w", projectID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Webhook created successfully", "webhook_id", created.ID)
+	return created, nil
+}
+
+func (s *webhookService) UpdateWebhook(ctx context.Context, userID, projectID, webhookID int, data *dto.UpdateWebhookRequest) (*models.Webhook, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookService",
+		"method", "UpdateWebhook",
+		"project_id", projectID,
+		"webhook_id", webhookID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting webhook update process")
+	webhook, err := s.getProjectWebhook(ctx, userID, projectID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	updateMap := make(map[string]any)
+	if data.URL != nil {
+		if err := validateWebhookURL(ctx, *data.URL); err != nil {
+			logger.Warn("Webhook URL is not allowed", "error", err)
+			return nil, fmt.Errorf("cannot update webhook %d: %w", webhookID, err)
+		}
+		updateMap["url"] = *data.URL
+	}
+	if len(data.Events) > 0 {
+		encoded, err := json.Marshal(uniqueEventTypes(data.Events))
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode events of webhook %d: %w", webhookID, err)
+		}
+		updateMap["events"] = string(encoded)
+	}
+	if data.Active != nil {
+		updateMap["active"] = *data.Active
+	}
+
+	if len(updateMap) == 0 {
+		logger.Info("No fields to update")
+		return webhook, nil
+	}
+
+	if err := s.webhookRepository.Update(ctx, webhookID, updateMap); err != nil {
+		logger.Error("Failed to update webhook in repository", "error", err)
+		return nil, fmt.Errorf("repository update failed for webhook %d: %w", webhookID, structs.ErrDatabaseFail)
+	}
+
+	updated, err := s.webhookRepository.FindByID(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Webhook updated successfully")
+	return updated, nil
+}
+
+func (s *webhookService) DeleteWebhook(ctx context.Context, userID, projectID, webhookID int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookService",
+		"method", "DeleteWebhook",
+		"project_id", projectID,
+		"webhook_id", webhookID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting webhook deletion process")
+	if _, err := s.getProjectWebhook(ctx, userID, projectID, webhookID); err != nil {
+		return err
+	}
+
+	if err := s.webhookRepository.Delete(ctx, webhookID); err != nil {
+		logger.Error("Failed to delete webhook in repository", "error", err)
+		return err
+	}
+
+	logger.Info("Successfully deleted webhook")
+	return nil
+}
+
+func (s *webhookService) ListDeliveries(ctx context.Context, userID, projectID, webhookID int, page *dto.PageRequest) ([]*models.WebhookDelivery, *dto.PageInfo, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookService",
+		"method", "ListDeliveries",
+		"project_id", projectID,
+		"webhook_id", webhookID,
+		"requestor_id", userID,
+	)
+
+	if _, err := s.getProjectWebhook(ctx, userID, projectID, webhookID); err != nil {
+		return nil, nil, err
+	}
+
+	deliveries, pageInfo, err := s.webhookRepository.FindDeliveriesByWebhookID(ctx, webhookID, page)
+	if err != nil {
+		logger.Error("Failed to find deliveries of webhook", "error", err)
+		return nil, nil, err
+	}
+
+	logger.Info("Webhook deliveries found", "count", len(deliveries))
+	return deliveries, pageInfo, nil
+}
+
+// Redeliver queues the payload of a past delivery again as a new delivery,
+// whatever the outcome of the original.
+func (s *webhookService) Redeliver(ctx context.Context, userID, projectID, webhookID, deliveryID int) (*models.WebhookDelivery, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookService",
+		"method", "Redeliver",
+		"project_id", projectID,
+		"webhook_id", webhookID,
+		"delivery_id", deliveryID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting webhook redelivery process")
+	if _, err := s.getProjectWebhook(ctx, userID, projectID, webhookID); err != nil {
+		return nil, err
+	}
+
+	original, err := s.webhookRepository.FindDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if original.WebhookID != webhookID {
+		logger.Warn("Delivery belongs to another webhook", "delivery_webhook_id", original.WebhookID)
+		return nil, fmt.Errorf("%w with id %d on webhook %d", structs.ErrWebhookDeliveryNotExist, deliveryID, webhookID)
+	}
+
+	now := time.Now()
+	redelivery := &models.WebhookDelivery{
+		WebhookID:      webhookID,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		Payload:        original.Payload,
+		RedeliveryOfID: &original.ID,
+		Status:         models.DeliveryPending,
+		NextAttemptAt:  &now,
+	}
+	if err := s.webhookRepository.CreateDeliveries(ctx, []*models.WebhookDelivery{redelivery}); err != nil {
+		logger.Error("Failed to queue redelivery", "error", err)
+		return nil, fmt.Errorf("repository create failed for redelivery of %d: %w", deliveryID, err)
+	}
+	s.notifyDispatcher()
+
+	logger.Info("Webhook redelivery queued", "redelivery_id", redelivery.ID)
+	return redelivery, nil
+}
+
+// HandleEvent queues a delivery of event for every active webhook of its
+// project subscribed to its type. It runs on the event bus, away from the
+// request that caused the event.
+func (s *webhookService) HandleEvent(ctx context.Context, event events.Event) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WebhookService",
+		"method", "HandleEvent",
+		"event_id", event.ID,
+		"event_type", event.Type,
+		"project_id", event.ProjectID,
+	)
+
+	webhooks, err := s.webhookRepository.FindActiveByProjectID(ctx, event.ProjectID)
+	if err != nil {
+		logger.Error("Failed to find webhooks for event", "error", err)
+		return
+	}
+
+	var deliveries []*models.WebhookDelivery
+	var payload []byte
+	now := time.Now()
+	for _, webhook := range webhooks {
+		if !webhook.Subscribes(string(event.Type)) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(event); err != nil {
+				logger.Error("Failed to encode event payload", "error", err)
+				return
+			}
+		}
+		deliveries = append(deliveries, &models.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			EventID:       event.ID,
+			EventType:     string(event.Type),
+			Payload:       string(payload),
+			Status:        models.DeliveryPending,
+			NextAttemptAt: &now,
+		})
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+
+	if err := s.webhookRepository.CreateDeliveries(ctx, deliveries); err != nil {
+		logger.Error("Failed to queue webhook deliveries", "error", err)
+		return
+	}
+	s.notifyDispatcher()
+	logger.Debug("Webhook deliveries queued", "count", len(deliveries))
+}
+
+// getProjectWebhook returns a webhook of the project, which the user must
+// manage.
+func (s *webhookService) getProjectWebhook(ctx context.Context, userID, projectID, webhookID int) (*models.Webhook, error) {
+	if _, err := s.projectService.GetAndVerifyProjectManager(ctx, userID, projectID); err != nil {
+		return nil, fmt.Errorf("cannot manage webhooks of project %d: %w", projectID, err)
+	}
+
+	webhook, err := s.webhookRepository.FindByID(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if webhook.ProjectID != projectID {
+		return nil, fmt.Errorf("%w with id %d in project %d", structs.ErrWebhookNotExist, webhookID, projectID)
+	}
+	return webhook, nil
+}
+
+func (s *webhookService) notifyDispatcher() {
+	if s.dispatcher != nil {
+		s.dispatcher.Notify()
+	}
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+func uniqueEventTypes(eventTypes []string) []string {
+	seen := make(map[string]struct{}, len(eventTypes))
+	unique := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if _, ok := seen[eventType]; ok {
+			continue
+		}
+		seen[eventType] = struct{}{}
+		unique = append(unique, eventType)
+	}
+	return unique
+}
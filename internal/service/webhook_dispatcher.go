@@ -0,0 +1,220 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"lqkhoi-go-http-api/internal/config"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+const (
+	// webhookBatchSize bounds the due deliveries loaded at once.
+	webhookBatchSize = 50
+	// webhookConcurrency bounds the deliveries attempted in parallel.
+	webhookConcurrency = 8
+	// maxWebhookResponseBody bounds the part of a response kept in the log.
+	maxWebhookResponseBody = 1024
+
+	// WebhookSignatureHeader carries "sha256=" and the hex HMAC-SHA256 of the
+	// body, keyed with the secret of the webhook.
+	WebhookSignatureHeader = "X-Webhook-Signature-256"
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+)
+
+// WebhookDispatcher attempts pending webhook deliveries in the background,
+// retrying failed ones with exponential backoff.
+type WebhookDispatcher struct {
+	webhookRepository repository.WebhookRepository
+	client            *http.Client
+	cfg               config.WebhookConfig
+	wake              chan struct{}
+}
+
+func NewWebhookDispatcher(webhookRepository repository.WebhookRepository, cfg config.WebhookConfig) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		webhookRepository: webhookRepository,
+		client:            newWebhookClient(cfg.Timeout()),
+		cfg:               cfg,
+		wake:              make(chan struct{}, 1),
+	}
+}
+
+// Notify asks the dispatcher to look for due deliveries now rather than at
+// the next poll.
+func (d *WebhookDispatcher) Notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run attempts due deliveries until ctx is done.
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval())
+	defer ticker.Stop()
+
+	for {
+		d.deliverDue(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// deliverDue attempts the due deliveries batch by batch until none is left.
+func (d *WebhookDispatcher) deliverDue(ctx context.Context) {
+	logger := utils.LoggerFromContext(ctx).With(
+		"component", "WebhookDispatcher",
+		"method", "deliverDue",
+	)
+
+	for ctx.Err() == nil {
+		due, err := d.webhookRepository.FindDueDeliveries(ctx, time.Now(), webhookBatchSize)
+		if err != nil {
+			logger.Error("Failed to find due deliveries", "error", err)
+			return
+		}
+		if len(due) == 0 {
+			return
+		}
+
+		var wg sync.WaitGroup
+		slots := make(chan struct{}, webhookConcurrency)
+		for _, delivery := range due {
+			// An attempt that never records its outcome is retried once the
+			// lease, longer than any attempt, runs out.
+			claimed, err := d.webhookRepository.ClaimDelivery(ctx, delivery, time.Now().Add(2*d.cfg.Timeout()+time.Minute))
+			if err != nil || !claimed {
+				continue
+			}
+			slots <- struct{}{}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-slots }()
+				d.attempt(ctx, delivery)
+			}()
+		}
+		wg.Wait()
+
+		if len(due) < webhookBatchSize {
+			return
+		}
+	}
+}
+
+// attempt sends the delivery once and records the outcome: success, another
+// attempt after the backoff, or failure once no attempt is left.
+func (d *WebhookDispatcher) attempt(ctx context.Context, delivery *models.WebhookDelivery) {
+	logger := utils.LoggerFromContext(ctx).With(
+		"component", "WebhookDispatcher",
+		"method", "attempt",
+		"delivery_id", delivery.ID,
+		"webhook_id", delivery.WebhookID,
+		"event_type", delivery.EventType,
+	)
+
+	attempts := delivery.Attempts + 1
+	startedAt := time.Now()
+	updateMap := map[string]any{
+		"attempts":        attempts,
+		"last_attempt_at": startedAt,
+		"response_status": nil,
+		"response_body":   "",
+		"error":           "",
+	}
+
+	var sendErr error
+	if delivery.Webhook == nil || !delivery.Webhook.Active {
+		sendErr = fmt.Errorf("webhook is disabled")
+		attempts = d.cfg.MaxAttempts
+	} else {
+		status, body, err := d.send(ctx, delivery.Webhook, delivery)
+		sendErr = err
+		if status != 0 {
+			updateMap["response_status"] = status
+			updateMap["response_body"] = body
+			if status < 200 || status >= 300 {
+				sendErr = fmt.Errorf("receiver answered with status %d", status)
+			}
+		}
+	}
+	updateMap["duration_ms"] = int(time.Since(startedAt).Milliseconds())
+
+	switch {
+	case sendErr == nil:
+		updateMap["status"] = models.DeliverySucceeded
+		updateMap["next_attempt_at"] = nil
+		logger.Info("Webhook delivered", "attempts", attempts)
+	case attempts >= d.cfg.MaxAttempts:
+		updateMap["status"] = models.DeliveryFailed
+		updateMap["next_attempt_at"] = nil
+		updateMap["error"] = storableText(sendErr.Error(), 500)
+		logger.Warn("Webhook delivery failed, no attempt left", "attempts", attempts, "error", sendErr)
+	default:
+		nextAttemptAt := time.Now().Add(d.cfg.Backoff(attempts))
+		updateMap["next_attempt_at"] = nextAttemptAt
+		updateMap["error"] = storableText(sendErr.Error(), 500)
+		logger.Warn("Webhook delivery failed, retrying later", "attempts", attempts, "next_attempt_at", nextAttemptAt, "error", sendErr)
+	}
+
+	if err := d.webhookRepository.UpdateDelivery(ctx, delivery.ID, updateMap); err != nil {
+		logger.Error("Failed to record webhook delivery attempt", "error", err)
+	}
+}
+
+// send posts the signed payload. It returns the response status and the
+// beginning of the body, or a zero status when no response was received.
+func (d *WebhookDispatcher) send(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) (int, string, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, delivery.EventType)
+	req.Header.Set(WebhookDeliveryHeader, strconv.Itoa(delivery.ID))
+	req.Header.Set(WebhookSignatureHeader, signWebhookPayload(webhook.Secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	responseBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxWebhookResponseBody))
+	return resp.StatusCode, storableText(string(responseBody), maxWebhookResponseBody), nil
+}
+
+// signWebhookPayload returns the value of WebhookSignatureHeader for body.
+func signWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// storableText cuts s to maxLength bytes and drops what a text column
+// rejects: invalid UTF-8, such as a rune cut in half, and NUL bytes.
+func storableText(s string, maxLength int) string {
+	if len(s) > maxLength {
+		s = s[:maxLength]
+	}
+	return strings.ReplaceAll(strings.ToValidUTF8(s, ""), "\x00", "")
+}
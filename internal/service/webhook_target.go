@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"lqkhoi-go-http-api/pkg/structs"
+)
+
+// blockedWebhookNetworks are the ranges, beside those the net package names,
+// that belong to the network of the API rather than to the receivers of
+// webhooks.
+var blockedWebhookNetworks = mustParseCIDRs(
+	"0.0.0.0/8",     // "this" network
+	"100.64.0.0/10", // carrier-grade NAT
+)
+
+// webhookTargetAllowed reports whether deliveries may be sent to ip. Loopback,
+// private, link-local, multicast and unspecified addresses are refused: a
+// webhook must not reach the API host or the services next to it.
+func webhookTargetAllowed(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, network := range blockedWebhookNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// newWebhookClient returns the HTTP client deliveries are sent with. The
+// address is checked when connecting, after DNS resolution, so a host that
+// resolves to an internal address, even after the webhook was saved, is
+// refused. Redirects are not followed: the redirect response is the outcome
+// of the delivery.
+func newWebhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !webhookTargetAllowed(ip) {
+				return fmt.Errorf("address %s: %w", address, structs.ErrWebhookURLNotAllowed)
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// validateWebhookURL refuses a URL whose host is, or resolves to, an address
+// deliveries may not be sent to.
+func validateWebhookURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return fmt.Errorf("url %q: %w", rawURL, structs.ErrWebhookURLNotAllowed)
+	}
+
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		if !webhookTargetAllowed(ip) {
+			return fmt.Errorf("address %s: %w", host, structs.ErrWebhookURLNotAllowed)
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return fmt.Errorf("host %s cannot be resolved: %w", host, structs.ErrWebhookURLNotAllowed)
+	}
+	for _, addr := range addrs {
+		if !webhookTargetAllowed(addr.IP) {
+			return fmt.Errorf("host %s resolves to %s: %w", host, addr.IP, structs.ErrWebhookURLNotAllowed)
+		}
+	}
+	return nil
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = network
+	}
+	return networks
+}
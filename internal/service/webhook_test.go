@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"lqkhoi-go-http-api/internal/config"
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/events"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubWebhookRepository struct {
+	webhooks   []*models.Webhook
+	deliveries []*models.WebhookDelivery
+	updates    map[int]map[string]any
+}
+
+func (r *stubWebhookRepository) Create(ctx context.Context, webhook *models.Webhook) (*models.Webhook, error) {
+	r.webhooks = append(r.webhooks, webhook)
+	return webhook, nil
+}
+
+func (r *stubWebhookRepository) FindByID(ctx context.Context, id int) (*models.Webhook, error) {
+	for _, webhook := range r.webhooks {
+		if webhook.ID == id {
+			return webhook, nil
+		}
+	}
+	return nil, structs.ErrWebhookNotExist
+}
+
+func (r *stubWebhookRepository) FindByProjectID(ctx context.Context, projectID int) ([]*models.Webhook, error) {
+	return r.webhooks, nil
+}
+
+func (r *stubWebhookRepository) FindActiveByProjectID(ctx context.Context, projectID int) ([]*models.Webhook, error) {
+	var active []*models.Webhook
+	for _, webhook := range r.webhooks {
+		if webhook.ProjectID == projectID && webhook.Active {
+			active = append(active, webhook)
+		}
+	}
+	return active, nil
+}
+
+func (r *stubWebhookRepository) Update(ctx context.Context, id int, updateMap map[string]any) error {
+	return nil
+}
+
+func (r *stubWebhookRepository) Delete(ctx context.Context, id int) error {
+	return nil
+}
+
+func (r *stubWebhookRepository) CreateDeliveries(ctx context.Context, deliveries []*models.WebhookDelivery) error {
+	r.deliveries = append(r.deliveries, deliveries...)
+	return nil
+}
+
+func (r *stubWebhookRepository) FindDeliveryByID(ctx context.Context, id int) (*models.WebhookDelivery, error) {
+	return nil, structs.ErrWebhookDeliveryNotExist
+}
+
+func (r *stubWebhookRepository) FindDeliveriesByWebhookID(ctx context.Context, webhookID int, page *dto.PageRequest) ([]*models.WebhookDelivery, *dto.PageInfo, error) {
+	return nil, nil, nil
+}
+
+func (r *stubWebhookRepository) FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	return nil, nil
+}
+
+func (r *stubWebhookRepository) ClaimDelivery(ctx context.Context, delivery *models.WebhookDelivery, leaseUntil time.Time) (bool, error) {
+	return true, nil
+}
+
+func (r *stubWebhookRepository) UpdateDelivery(ctx context.Context, id int, updateMap map[string]any) error {
+	if r.updates == nil {
+		r.updates = make(map[int]map[string]any)
+	}
+	r.updates[id] = updateMap
+	return nil
+}
+
+func TestWebhookService_HandleEvent(t *testing.T) {
+	repo := &stubWebhookRepository{webhooks: []*models.Webhook{
+		{ID: 1, ProjectID: 10, Active: true, Events: []string{string(events.TaskStatusChanged)}},
+		{ID: 2, ProjectID: 10, Active: true, Events: []string{string(events.TaskCreated)}},
+		{ID: 3, ProjectID: 10, Active: false, Events: []string{string(events.TaskStatusChanged)}},
+		{ID: 4, ProjectID: 11, Active: true, Events: []string{string(events.TaskStatusChanged)}},
+	}}
+	s := &webhookService{webhookRepository: repo}
+
+	s.HandleEvent(context.Background(), events.Event{ID: "evt-1", Type: events.TaskStatusChanged, ProjectID: 10})
+
+	require.Len(t, repo.deliveries, 1)
+	delivery := repo.deliveries[0]
+	assert.Equal(t, 1, delivery.WebhookID)
+	assert.Equal(t, "evt-1", delivery.EventID)
+	assert.Equal(t, models.DeliveryPending, delivery.Status)
+	assert.Contains(t, delivery.Payload, `"type":"task.status_changed"`)
+}
+
+func TestWebhookDispatcher_Attempt(t *testing.T) {
+	cfg := config.WebhookConfig{TimeoutSeconds: 5, MaxAttempts: 3, InitialBackoffSeconds: 30, PollIntervalSeconds: 15}
+	payload := `{"id":"evt-1"}`
+
+	var status int
+	var signature, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get(WebhookSignatureHeader)
+		raw, _ := io.ReadAll(r.Body)
+		body = string(raw)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	webhook := &models.Webhook{ID: 1, URL: server.URL, Secret: "s3cret", Active: true}
+	newDelivery := func(attempts int) *models.WebhookDelivery {
+		return &models.WebhookDelivery{ID: 7, WebhookID: 1, Payload: payload, Attempts: attempts, Webhook: webhook}
+	}
+
+	t.Run("success is signed and recorded", func(t *testing.T) {
+		repo := &stubWebhookRepository{}
+		d := NewWebhookDispatcher(repo, cfg)
+		d.client = server.Client()
+		status = http.StatusNoContent
+
+		d.attempt(context.Background(), newDelivery(0))
+
+		assert.Equal(t, payload, body)
+		assert.Equal(t, signWebhookPayload("s3cret", []byte(payload)), signature)
+		assert.Equal(t, models.DeliverySucceeded, repo.updates[7]["status"])
+		assert.Equal(t, http.StatusNoContent, repo.updates[7]["response_status"])
+	})
+
+	t.Run("failure is retried with backoff", func(t *testing.T) {
+		repo := &stubWebhookRepository{}
+		d := NewWebhookDispatcher(repo, cfg)
+		d.client = server.Client()
+		status = http.StatusInternalServerError
+
+		d.attempt(context.Background(), newDelivery(1))
+
+		update := repo.updates[7]
+		assert.NotContains(t, update, "status")
+		assert.Equal(t, 2, update["attempts"])
+		nextAttemptAt, ok := update["next_attempt_at"].(time.Time)
+		require.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(60*time.Second), nextAttemptAt, 5*time.Second)
+	})
+
+	t.Run("failure without attempt left is final", func(t *testing.T) {
+		repo := &stubWebhookRepository{}
+		d := NewWebhookDispatcher(repo, cfg)
+		d.client = server.Client()
+		status = http.StatusBadGateway
+
+		d.attempt(context.Background(), newDelivery(2))
+
+		update := repo.updates[7]
+		assert.Equal(t, models.DeliveryFailed, update["status"])
+		assert.Nil(t, update["next_attempt_at"])
+		assert.Contains(t, update["error"], "502")
+	})
+}
+
+func TestWebhookDispatcher_RefusesInternalTargets(t *testing.T) {
+	cfg := config.WebhookConfig{TimeoutSeconds: 5, MaxAttempts: 3, InitialBackoffSeconds: 30, PollIntervalSeconds: 15}
+	reached := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+	}))
+	defer server.Close()
+
+	repo := &stubWebhookRepository{}
+	d := NewWebhookDispatcher(repo, cfg)
+	webhook := &models.Webhook{ID: 1, URL: server.URL, Secret: "s3cret", Active: true}
+
+	d.attempt(context.Background(), &models.WebhookDelivery{ID: 7, WebhookID: 1, Payload: `{}`, Webhook: webhook})
+
+	assert.False(t, reached)
+	assert.Nil(t, repo.updates[7]["response_status"])
+	assert.Contains(t, repo.updates[7]["error"], structs.ErrWebhookURLNotAllowed.Error())
+	assert.ErrorIs(t, d.client.CheckRedirect(nil, nil), http.ErrUseLastResponse)
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	ctx := context.Background()
+	for _, rawURL := range []string{
+		"http://127.0.0.1:6379",
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.0.0.5/hook",
+		"http://192.168.1.1",
+		"http://[::1]:8080",
+		"http://0.0.0.0",
+		"http://100.64.0.1",
+		"http://localhost:8080",
+	} {
+		assert.ErrorIs(t, validateWebhookURL(ctx, rawURL), structs.ErrWebhookURLNotAllowed, rawURL)
+	}
+	assert.NoError(t, validateWebhookURL(ctx, "https://93.184.216.34/hooks"))
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	// HMAC-SHA256 of "hello" keyed with "key".
+	assert.Equal(t,
+		"sha256=9307b3b915efb5171ff14d8cb55fbcc798c6c0ef1456d66ded1a6aa723a58b7b",
+		signWebhookPayload("key", []byte("hello")))
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+//go:generate mockgen -destination=./mocks/mock_wip_limit.go -package=mocks . WipLimitService
+
+type WipLimitService interface {
+	GetWipLimits(ctx context.Context, userID, projectID int) ([]*models.WipLimit, error)
+	UpdateWipLimits(ctx context.Context, userID, projectID int, limits []*models.WipLimit) ([]*models.WipLimit, error)
+	CheckLimit(ctx context.Context, task *models.Task, status models.TaskStatus, assigneeID *int) error
+	CheckTasksEntering(ctx context.Context, projectID int, sprintID *int, tasks []*models.Task) error
+}
+
+type wipLimitService struct {
+	wipLimitRepository repository.WipLimitRepository
+	taskRepository     repository.TaskRepository
+	projectService     ProjectService
+}
+
+func NewWipLimitService(wipLimitRepository repository.WipLimitRepository, taskRepository repository.TaskRepository, projectService ProjectService) WipLimitService {
+	return &wipLimitService{
+		wipLimitRepository: wipLimitRepository,
+		taskRepository:     taskRepository,
+		projectService:     projectService,
+	}
+}
+
+// GetWipLimits returns the WIP limits of the project; any member of the
+// project may read them.
+func (s *wipLimitService) GetWipLimits(ctx context.Context, userID, projectID int) ([]*models.WipLimit, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WipLimitService",
+		"method", "GetWipLimits",
+		"project_id", projectID,
+		"requestor_id", userID,
+	)
+
+	logger.Debug("Fetching project by ID")
+	if _, err := s.projectService.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return nil, fmt.Errorf("cannot get WIP limits: %w with id %d", err, projectID)
+		}
+		return nil, err
+	}
+
+	logger.Debug("Verifying requestor is a project member")
+	if _, err := s.projectService.GetProjectMember(ctx, userID, projectID); err != nil {
+		if errors.Is(err, structs.ErrUserNotPartProject) {
+			return nil, fmt.Errorf("user %d cannot read WIP limits of project %d: %w", userID, projectID, err)
+		}
+		return nil, err
+	}
+
+	return s.wipLimitRepository.FindByProjectID(ctx, projectID)
+}
+
+// UpdateWipLimits replaces the WIP limits of the project, at most one per
+// status; an empty list lifts every limit. Only project managers may change
+// them.
+func (s *wipLimitService) UpdateWipLimits(ctx context.Context, userID, projectID int, limits []*models.WipLimit) ([]*models.WipLimit, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WipLimitService",
+		"method", "UpdateWipLimits",
+		"project_id", projectID,
+		"requestor_id", userID,
+	)
+
+	logger.Debug("Starting WIP limits update process", "count", len(limits))
+	if _, err := s.projectService.GetAndVerifyProjectManager(ctx, userID, projectID); err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return nil, fmt.Errorf("cannot update WIP limits: %w with id %d", err, projectID)
+		}
+		if errors.Is(err, structs.ErrUserNotManageProject) {
+			return nil, fmt.Errorf("user %d cannot update WIP limits of project %d: %w", userID, projectID, err)
+		}
+		logger.Error("Failed initial project retrieval or authorization", "error", err)
+		return nil, err
+	}
+
+	if err := validateWipLimits(limits); err != nil {
+		logger.Warn("Invalid WIP limits", "error", err)
+		return nil, err
+	}
+
+	if err := s.wipLimitRepository.ReplaceForProject(ctx, projectID, limits); err != nil {
+		logger.Error("Failed to replace WIP limits in repository", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Successfully updated WIP limits", "count", len(limits))
+	return s.wipLimitRepository.FindByProjectID(ctx, projectID)
+}
+
+// CheckLimit checks that the board column of status, in the sprint of the task
+// or in the backlog, can take the task, and that assigneeID, when not nil,
+// can hold one more task there. The task itself is not counted, so a task
+// already in the column always fits.
+func (s *wipLimitService) CheckLimit(ctx context.Context, task *models.Task, status models.TaskStatus, assigneeID *int) error {
+	var assigneeIDs []int
+	if assigneeID != nil {
+		assigneeIDs = []int{*assigneeID}
+	}
+	return s.checkColumn(ctx, task.ProjectID, task.SprintID, status, task.ID, 1, assigneeIDs)
+}
+
+// CheckTasksEntering checks that the board columns of the sprint, or of the
+// backlog when sprintID is nil, can take all the tasks at once, each keeping
+// its status and assignee. Tasks already in that sprint are not counted.
+func (s *wipLimitService) CheckTasksEntering(ctx context.Context, projectID int, sprintID *int, tasks []*models.Task) error {
+	var statuses []models.TaskStatus
+	entering := make(map[models.TaskStatus]int)
+	assigneeIDs := make(map[models.TaskStatus][]int)
+	for _, task := range tasks {
+		if sameSprint(task.SprintID, sprintID) {
+			continue
+		}
+		if _, ok := entering[task.Status]; !ok {
+			statuses = append(statuses, task.Status)
+		}
+		entering[task.Status]++
+		if task.AssigneeID != nil {
+			assigneeIDs[task.Status] = append(assigneeIDs[task.Status], *task.AssigneeID)
+		}
+	}
+
+	for _, status := range statuses {
+		if err := s.checkColumn(ctx, projectID, sprintID, status, 0, entering[status], assigneeIDs[status]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkColumn checks that the board column of status can take count more
+// tasks, next to those already there other than excludeID, and that every
+// user in assigneeIDs can hold as many more tasks as they appear in it.
+func (s *wipLimitService) checkColumn(ctx context.Context, projectID int, sprintID *int, status models.TaskStatus, excludeID, count int, assigneeIDs []int) error {
+	logger := utils.LoggerFromContext(ctx).With(
+		"component", "WipLimitService",
+		"method", "checkColumn",
+		"project_id", projectID,
+		"status", status,
+	)
+
+	limit, err := s.wipLimitRepository.FindByProjectIDAndStatus(ctx, projectID, status)
+	if err != nil {
+		if errors.Is(err, structs.ErrWipLimitNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	if limit.MaxTasks != nil {
+		held, err := s.taskRepository.CountInBoardColumn(ctx, projectID, sprintID, status, nil, excludeID)
+		if err != nil {
+			return err
+		}
+		if held+int64(count) > int64(*limit.MaxTasks) {
+			logger.Warn("Board column is full", "count", held, "entering", count, "max_tasks", *limit.MaxTasks)
+			return fmt.Errorf("%s column already holds %d tasks and cannot take %d more, the limit is %d: %w", status, held, count, *limit.MaxTasks, structs.ErrWipLimitReached)
+		}
+	}
+
+	if limit.MaxTasksPerAssignee == nil {
+		return nil
+	}
+
+	var order []int
+	perAssignee := make(map[int]int)
+	for _, id := range assigneeIDs {
+		if _, ok := perAssignee[id]; !ok {
+			order = append(order, id)
+		}
+		perAssignee[id]++
+	}
+	for _, assigneeID := range order {
+		held, err := s.taskRepository.CountInBoardColumn(ctx, projectID, sprintID, status, &assigneeID, excludeID)
+		if err != nil {
+			return err
+		}
+		if held+int64(perAssignee[assigneeID]) > int64(*limit.MaxTasksPerAssignee) {
+			logger.Warn("Assignee holds too many tasks in the board column", "assignee_id", assigneeID, "count", held, "entering", perAssignee[assigneeID], "max_tasks_per_assignee", *limit.MaxTasksPerAssignee)
+			return fmt.Errorf("user %d already holds %d %s tasks and cannot take %d more, the limit per assignee is %d: %w", assigneeID, held, status, perAssignee[assigneeID], *limit.MaxTasksPerAssignee, structs.ErrWipLimitReached)
+		}
+	}
+	return nil
+}
+
+func validateWipLimits(limits []*models.WipLimit) error {
+	seen := make(map[models.TaskStatus]struct{}, len(limits))
+	for _, l := range limits {
+		if !l.Status.IsValid() {
+			return fmt.Errorf("%w: unknown status %s", structs.ErrInvalidWipLimit, l.Status)
+		}
+		if _, ok := seen[l.Status]; ok {
+			return fmt.Errorf("%w: more than one limit for %s", structs.ErrInvalidWipLimit, l.Status)
+		}
+		seen[l.Status] = struct{}{}
+		if l.MaxTasks == nil && l.MaxTasksPerAssignee == nil {
+			return fmt.Errorf("%w: limit for %s caps nothing", structs.ErrInvalidWipLimit, l.Status)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubWipLimitRepository serves the WIP limits of one project.
+type stubWipLimitRepository struct {
+	repository.WipLimitRepository
+	limits []*models.WipLimit
+}
+
+func (r *stubWipLimitRepository) FindByProjectIDAndStatus(ctx context.Context, projectID int, status models.TaskStatus) (*models.WipLimit, error) {
+	for _, limit := range r.limits {
+		if limit.ProjectID == projectID && limit.Status == status {
+			return limit, nil
+		}
+	}
+	return nil, structs.ErrWipLimitNotExist
+}
+
+func TestWipLimitService_CheckLimit(t *testing.T) {
+	ctx := context.Background()
+	ptr := func(v int) *int { return &v }
+	sprintID := ptr(3)
+	tasks := []*models.Task{
+		{ID: 1, ProjectID: 1, SprintID: sprintID, Status: models.InProgressTask, AssigneeID: ptr(7)},
+		{ID: 2, ProjectID: 1, SprintID: sprintID, Status: models.InProgressTask, AssigneeID: ptr(8)},
+		{ID: 3, ProjectID: 1, SprintID: sprintID, Status: models.ToDoTask, AssigneeID: ptr(7)},
+		{ID: 4, ProjectID: 1, Status: models.InProgressTask},
+	}
+	limits := []*models.WipLimit{
+		{ProjectID: 1, Status: models.InProgressTask, MaxTasks: ptr(3), MaxTasksPerAssignee: ptr(1)},
+		{ProjectID: 1, Status: models.ReviewTask, MaxTasks: ptr(2)},
+	}
+	s := NewWipLimitService(&stubWipLimitRepository{limits: limits}, &stubTaskRepository{tasks: tasks}, nil)
+
+	t.Run("column with room takes the task", func(t *testing.T) {
+		assert.NoError(t, s.CheckLimit(ctx, tasks[2], models.InProgressTask, nil))
+	})
+
+	t.Run("assignee at the limit is rejected", func(t *testing.T) {
+		err := s.CheckLimit(ctx, tasks[2], models.InProgressTask, ptr(7))
+		assert.ErrorIs(t, err, structs.ErrWipLimitReached)
+	})
+
+	t.Run("full column is rejected", func(t *testing.T) {
+		full := append(tasks, &models.Task{ID: 5, ProjectID: 1, SprintID: sprintID, Status: models.InProgressTask})
+		s := NewWipLimitService(&stubWipLimitRepository{limits: limits}, &stubTaskRepository{tasks: full}, nil)
+
+		err := s.CheckLimit(ctx, tasks[2], models.InProgressTask, nil)
+		assert.ErrorIs(t, err, structs.ErrWipLimitReached)
+	})
+
+	t.Run("task already in the column fits", func(t *testing.T) {
+		assert.NoError(t, s.CheckLimit(ctx, tasks[0], models.InProgressTask, ptr(7)))
+	})
+
+	t.Run("unlimited status takes the task", func(t *testing.T) {
+		assert.NoError(t, s.CheckLimit(ctx, tasks[0], models.DoneTask, ptr(7)))
+	})
+}
+
+func TestWipLimitService_CheckTasksEntering(t *testing.T) {
+	ctx := context.Background()
+	ptr := func(v int) *int { return &v }
+	sprintID := ptr(3)
+	tasks := []*models.Task{
+		{ID: 1, ProjectID: 1, SprintID: sprintID, Status: models.InProgressTask, AssigneeID: ptr(7)},
+	}
+	limits := []*models.WipLimit{
+		{ProjectID: 1, Status: models.InProgressTask, MaxTasks: ptr(3), MaxTasksPerAssignee: ptr(2)},
+	}
+	s := NewWipLimitService(&stubWipLimitRepository{limits: limits}, &stubTaskRepository{tasks: tasks}, nil)
+
+	t.Run("column with room takes the subtree", func(t *testing.T) {
+		subtree := []*models.Task{
+			{ID: 10, ProjectID: 1, Status: models.InProgressTask, AssigneeID: ptr(7)},
+			{ID: 11, ProjectID: 1, Status: models.InProgressTask},
+			{ID: 12, ProjectID: 1, Status: models.ToDoTask, AssigneeID: ptr(7)},
+		}
+		assert.NoError(t, s.CheckTasksEntering(ctx, 1, sprintID, subtree))
+	})
+
+	t.Run("subtree overflowing the column is rejected", func(t *testing.T) {
+		subtree := []*models.Task{
+			{ID: 10, ProjectID: 1, Status: models.InProgressTask},
+			{ID: 11, ProjectID: 1, Status: models.InProgressTask},
+			{ID: 12, ProjectID: 1, Status: models.InProgressTask},
+		}
+		err := s.CheckTasksEntering(ctx, 1, sprintID, subtree)
+		assert.ErrorIs(t, err, structs.ErrWipLimitReached)
+	})
+
+	t.Run("subtree overflowing an assignee is rejected", func(t *testing.T) {
+		subtree := []*models.Task{
+			{ID: 10, ProjectID: 1, Status: models.InProgressTask, AssigneeID: ptr(7)},
+			{ID: 11, ProjectID: 1, Status: models.InProgressTask, AssigneeID: ptr(7)},
+		}
+		err := s.CheckTasksEntering(ctx, 1, sprintID, subtree)
+		assert.ErrorIs(t, err, structs.ErrWipLimitReached)
+	})
+
+	t.Run("tasks already in the sprint are not counted", func(t *testing.T) {
+		assert.NoError(t, s.CheckTasksEntering(ctx, 1, sprintID, append(tasks, tasks...)))
+	})
+}
+
+func TestValidateWipLimits(t *testing.T) {
+	five := 5
+
+	assert.NoError(t, validateWipLimits(nil))
+	assert.NoError(t, validateWipLimits([]*models.WipLimit{
+		{Status: models.InProgressTask, MaxTasks: &five},
+		{Status: models.ReviewTask, MaxTasksPerAssignee: &five},
+	}))
+	assert.ErrorIs(t, validateWipLimits([]*models.WipLimit{
+		{Status: models.InProgressTask, MaxTasks: &five},
+		{Status: models.InProgressTask, MaxTasksPerAssignee: &five},
+	}), structs.ErrInvalidWipLimit)
+	assert.ErrorIs(t, validateWipLimits([]*models.WipLimit{{Status: models.ReviewTask}}), structs.ErrInvalidWipLimit)
+	assert.ErrorIs(t, validateWipLimits([]*models.WipLimit{{Status: "DOING", MaxTasks: &five}}), structs.ErrInvalidWipLimit)
+}
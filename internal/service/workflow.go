@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+type WorkflowService interface {
+	GetWorkflow(ctx context.Context, userID, projectID int) ([]*models.WorkflowTransition, bool, error)
+	UpdateWorkflow(ctx context.Context, userID, projectID int, transitions []*models.WorkflowTransition) ([]*models.WorkflowTransition, bool, error)
+	ValidateTransition(ctx context.Context, projectID int, role models.ProjectMemberRole, from, to models.TaskStatus) error
+}
+
+type workflowService struct {
+	workflowRepository repository.WorkflowRepository
+	projectService     ProjectService
+}
+
+func NewWorkflowService(workflowRepository repository.WorkflowRepository, projectService ProjectService) WorkflowService {
+	return &workflowService{
+		workflowRepository: workflowRepository,
+		projectService:     projectService,
+	}
+}
+
+// GetWorkflow returns the transitions of the project and whether they are the
+// default ones; any member of the project may read them.
+func (s *workflowService) GetWorkflow(ctx context.Context, userID, projectID int) ([]*models.WorkflowTransition, bool, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorkflowService",
+		"method", "GetWorkflow",
+		"project_id", projectID,
+		"requestor_id", userID,
+	)
+
+	logger.Debug("Fetching project by ID")
+	if _, err := s.projectService.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return nil, false, fmt.Errorf("cannot get workflow: %w with id %d", err, projectID)
+		}
+		return nil, false, err
+	}
+
+	logger.Debug("Verifying requestor is a project member")
+	if _, err := s.projectService.GetProjectMember(ctx, userID, projectID); err != nil {
+		if errors.Is(err, structs.ErrUserNotPartProject) {
+			return nil, false, fmt.Errorf("user %d cannot read workflow of project %d: %w", userID, projectID, err)
+		}
+		return nil, false, err
+	}
+
+	return s.findWorkflow(ctx, projectID)
+}
+
+// UpdateWorkflow replaces the workflow of the project; an empty list restores
+// the default workflow. Only project managers may change it.
+func (s *workflowService) UpdateWorkflow(ctx context.Context, userID, projectID int, transitions []*models.WorkflowTransition) ([]*models.WorkflowTransition, bool, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorkflowService",
+		"method", "UpdateWorkflow",
+		"project_id", projectID,
+		"requestor_id", userID,
+	)
+
+	logger.Debug("Starting workflow update process", "count", len(transitions))
+	if _, err := s.projectService.GetAndVerifyProjectManager(ctx, userID, projectID); err != nil {
+		if errors.Is(err, structs.ErrProjectNotExist) {
+			return nil, false, fmt.Errorf("cannot update workflow: %w with id %d", err, projectID)
+		}
+		if errors.Is(err, structs.ErrUserNotManageProject) {
+			return nil, false, fmt.Errorf("user %d cannot update workflow of project %d: %w", userID, projectID, err)
+		}
+		logger.Error("Failed initial project retrieval or authorization", "error", err)
+		return nil, false, err
+	}
+
+	type transitionKey struct {
+		from, to models.TaskStatus
+		role     models.ProjectMemberRole
+	}
+	seen := make(map[transitionKey]struct{}, len(transitions))
+	unique := make([]*models.WorkflowTransition, 0, len(transitions))
+	for _, t := range transitions {
+		if !t.FromStatus.IsValid() || !t.ToStatus.IsValid() || !t.Role.IsValid() {
+			return nil, false, fmt.Errorf("%w: unknown status or role in transition %s -> %s for %s", structs.ErrInvalidWorkflow, t.FromStatus, t.ToStatus, t.Role)
+		}
+		if t.FromStatus == t.ToStatus {
+			return nil, false, fmt.Errorf("%w: transition from %s to itself", structs.ErrInvalidWorkflow, t.FromStatus)
+		}
+		key := transitionKey{t.FromStatus, t.ToStatus, t.Role}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		unique = append(unique, t)
+	}
+
+	if err := s.workflowRepository.ReplaceForProject(ctx, projectID, unique); err != nil {
+		logger.Error("Failed to replace workflow in repository", "error", err)
+		return nil, false, err
+	}
+
+	logger.Info("Successfully updated workflow", "count", len(unique))
+	return s.findWorkflow(ctx, projectID)
+}
+
+// ValidateTransition checks that a member with role may move a task of the
+// project from one status to another. A rejected transition is reported as a
+// *structs.StatusTransitionError listing the statuses allowed instead.
+func (s *workflowService) ValidateTransition(ctx context.Context, projectID int, role models.ProjectMemberRole, from, to models.TaskStatus) error {
+	logger := utils.LoggerFromContext(ctx).With(
+		"component", "WorkflowService",
+		"method", "ValidateTransition",
+		"project_id", projectID,
+		"role", role,
+		"from", from,
+		"to", to,
+	)
+
+	if from == to {
+		return nil
+	}
+
+	transitions, _, err := s.findWorkflow(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	allowed := make([]models.TaskStatus, 0)
+	for _, t := range transitions {
+		if t.FromStatus != from || t.Role != role {
+			continue
+		}
+		if t.ToStatus == to {
+			logger.Debug("Transition allowed")
+			return nil
+		}
+		allowed = append(allowed, t.ToStatus)
+	}
+
+	logger.Warn("Transition rejected by workflow", "allowed", allowed)
+	return &structs.StatusTransitionError{From: from, To: to, Allowed: allowed}
+}
+
+func (s *workflowService) findWorkflow(ctx context.Context, projectID int) ([]*models.WorkflowTransition, bool, error) {
+	transitions, err := s.workflowRepository.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(transitions) == 0 {
+		return models.DefaultWorkflowTransitions(projectID), true, nil
+	}
+	return transitions, false, nil
+}
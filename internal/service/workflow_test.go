@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubWorkflowRepository struct {
+	transitions []*models.WorkflowTransition
+}
+
+func (r *stubWorkflowRepository) FindByProjectID(ctx context.Context, projectID int) ([]*models.WorkflowTransition, error) {
+	return r.transitions, nil
+}
+
+func (r *stubWorkflowRepository) ReplaceForProject(ctx context.Context, projectID int, transitions []*models.WorkflowTransition) error {
+	r.transitions = transitions
+	return nil
+}
+
+func TestWorkflowService_ValidateTransition(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("default workflow rejects skipping review", func(t *testing.T) {
+		s := NewWorkflowService(&stubWorkflowRepository{}, nil)
+
+		err := s.ValidateTransition(ctx, 1, models.ProjectRoleMember, models.ToDoTask, models.DoneTask)
+
+		var transitionErr *structs.StatusTransitionError
+		require.True(t, errors.As(err, &transitionErr))
+		assert.True(t, errors.Is(err, structs.ErrStatusTransitionNotAllowed))
+		assert.Equal(t, []models.TaskStatus{models.InProgressTask, models.BlockedTask}, transitionErr.Allowed)
+		assert.Contains(t, err.Error(), "allowed next statuses: IN_PROGRESS, BLOCKED")
+	})
+
+	t.Run("default workflow allows starting work", func(t *testing.T) {
+		s := NewWorkflowService(&stubWorkflowRepository{}, nil)
+
+		assert.NoError(t, s.ValidateTransition(ctx, 1, models.ProjectRoleMember, models.ToDoTask, models.InProgressTask))
+		assert.NoError(t, s.ValidateTransition(ctx, 1, models.ProjectRoleViewer, models.DoneTask, models.DoneTask))
+	})
+
+	t.Run("configured workflow restricts by role", func(t *testing.T) {
+		repo := &stubWorkflowRepository{transitions: []*models.WorkflowTransition{
+			{ProjectID: 1, FromStatus: models.ReviewTask, ToStatus: models.DoneTask, Role: models.ProjectRoleManager},
+		}}
+		s := NewWorkflowService(repo, nil)
+
+		assert.NoError(t, s.ValidateTransition(ctx, 1, models.ProjectRoleManager, models.ReviewTask, models.DoneTask))
+
+		err := s.ValidateTransition(ctx, 1, models.ProjectRoleMember, models.ReviewTask, models.DoneTask)
+		var transitionErr *structs.StatusTransitionError
+		require.True(t, errors.As(err, &transitionErr))
+		assert.Empty(t, transitionErr.Allowed)
+	})
+}
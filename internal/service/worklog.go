@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"lqkhoi-go-http-api/internal/dto"
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+	"lqkhoi-go-http-api/pkg/utils"
+)
+
+// maxWorklogRangeDays bounds the date range of timesheet and total queries.
+const maxWorklogRangeDays = 366
+
+type WorklogService interface {
+	CreateWorklog(ctx context.Context, userID, taskID int, worklog *models.Worklog) (*models.Worklog, error)
+	ListWorklogs(ctx context.Context, userID, taskID int, page *dto.PageRequest) ([]*models.Worklog, *dto.PageInfo, error)
+	UpdateWorklog(ctx context.Context, userID, taskID, worklogID int, data *dto.UpdateWorklogRequest) (*models.Worklog, error)
+	DeleteWorklog(ctx context.Context, userID, taskID, worklogID int) error
+	GetTimesheet(ctx context.Context, userID int, from, to time.Time) ([]*models.Worklog, error)
+	GetProjectTotals(ctx context.Context, userID, projectID int, from, to time.Time) ([]models.WorklogTotal, error)
+}
+
+type worklogService struct {
+	worklogRepository repository.WorklogRepository
+	taskService       TaskService
+	projectService    ProjectService
+}
+
+func NewWorklogService(worklogRepository repository.WorklogRepository, taskService TaskService, projectService ProjectService) WorklogService {
+	return &worklogService{
+		worklogRepository: worklogRepository,
+		taskService:       taskService,
+		projectService:    projectService,
+	}
+}
+
+// CreateWorklog logs time of the user on the task. Assignees may log time on
+// their own tasks and project managers on any task of the project; other
+// members, and project viewers, may not log time.
+func (s *worklogService) CreateWorklog(ctx context.Context, userID, taskID int, worklog *models.Worklog) (*models.Worklog, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogService",
+		"method", "CreateWorklog",
+		"task_id", taskID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting worklog creation process")
+	task, err := s.taskService.GetAndVerifyContributorForTask(ctx, logger, userID, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot log time on task %d: %w", taskID, err)
+	}
+
+	if task.AssigneeID == nil || *task.AssigneeID != userID {
+		logger.Debug("Requestor is not the assignee, checking manager privileges")
+		member, err := s.projectService.GetProjectMember(ctx, userID, task.ProjectID)
+		if err != nil && !errors.Is(err, structs.ErrUserNotPartProject) {
+			return nil, err
+		}
+		if member == nil || member.Role != models.ProjectRoleManager {
+			logger.Warn("Authorization failed: User is neither task assignee nor project manager", "task_assignee_id", task.AssigneeID)
+			return nil, fmt.Errorf("user %d cannot log time on task %d: %w", userID, taskID, structs.ErrUserNotAuthorizedForTask)
+		}
+	}
+
+	worklog.TaskID = taskID
+	worklog.UserID = userID
+	worklog.ProjectID = task.ProjectID
+
+	created, err := s.worklogRepository.Create(ctx, worklog)
+	if err != nil {
+		logger.Error("Failed to create worklog in repository", "error", err)
+		return nil, fmt.Errorf("repository create failed for worklog on task %d: %w", taskID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Worklog created successfully", "worklog_id", created.ID, "duration_minutes", created.DurationMinutes)
+
+	full, err := s.worklogRepository.FindByID(ctx, created.ID)
+	if err != nil {
+		return created, nil
+	}
+	return full, nil
+}
+
+func (s *worklogService) ListWorklogs(ctx context.Context, userID, taskID int, page *dto.PageRequest) ([]*models.Worklog, *dto.PageInfo, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogService",
+		"method", "ListWorklogs",
+		"task_id", taskID,
+		"requestor_id", userID,
+	)
+
+	if _, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, false); err != nil {
+		return nil, nil, fmt.Errorf("cannot list worklogs of task %d: %w", taskID, err)
+	}
+
+	worklogs, pageInfo, err := s.worklogRepository.FindByTaskID(ctx, taskID, page)
+	if err != nil {
+		logger.Error("Failed to find worklogs of task", "error", err)
+		return nil, nil, err
+	}
+
+	logger.Info("Worklogs found", "count", len(worklogs))
+	return worklogs, pageInfo, nil
+}
+
+func (s *worklogService) UpdateWorklog(ctx context.Context, userID, taskID, worklogID int, data *dto.UpdateWorklogRequest) (*models.Worklog, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogService",
+		"method", "UpdateWorklog",
+		"task_id", taskID,
+		"worklog_id", worklogID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting worklog update process")
+	worklog, err := s.findTaskWorklog(ctx, userID, taskID, worklogID)
+	if err != nil {
+		return nil, err
+	}
+
+	if worklog.UserID != userID {
+		logger.Warn("Authorization failed: User is not the author of the worklog", "author_id", worklog.UserID)
+		return nil, fmt.Errorf("user %d cannot edit worklog %d: %w", userID, worklogID, structs.ErrUserNotWorklogAuthor)
+	}
+
+	updateMap := make(map[string]any)
+	if data.StartedAt != nil {
+		updateMap["started_at"] = *data.StartedAt
+	}
+	if data.DurationMinutes != nil {
+		updateMap["duration_minutes"] = *data.DurationMinutes
+	}
+	if data.Note != nil {
+		updateMap["note"] = *data.Note
+	}
+	if len(updateMap) == 0 {
+		logger.Info("No fields to update, returning current worklog")
+		return worklog, nil
+	}
+
+	logger.Debug("Attempting worklog update operation", "input", updateMap)
+	if err := s.worklogRepository.Update(ctx, worklogID, updateMap); err != nil {
+		logger.Error("Failed to update worklog in repository", "error", err)
+		return nil, fmt.Errorf("repository failed to update worklog %d: %w", worklogID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Worklog updated successfully")
+
+	updated, err := s.worklogRepository.FindByID(ctx, worklogID)
+	if err != nil {
+		return worklog, nil
+	}
+	return updated, nil
+}
+
+// DeleteWorklog deletes a worklog. Only its author or a manager of the
+// project may delete it.
+func (s *worklogService) DeleteWorklog(ctx context.Context, userID, taskID, worklogID int) error {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogService",
+		"method", "DeleteWorklog",
+		"task_id", taskID,
+		"worklog_id", worklogID,
+		"requestor_id", userID,
+	)
+
+	logger.Info("Starting worklog deletion process")
+	worklog, err := s.findTaskWorklog(ctx, userID, taskID, worklogID)
+	if err != nil {
+		return err
+	}
+
+	if worklog.UserID != userID {
+		logger.Debug("Requestor is not the author, checking manager privileges")
+		if _, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, true); err != nil {
+			if errors.Is(err, structs.ErrUserNotManageProject) {
+				return fmt.Errorf("user %d cannot delete worklog %d: %w", userID, worklogID, structs.ErrUserNotWorklogAuthor)
+			}
+			return err
+		}
+	}
+
+	if err := s.worklogRepository.Delete(ctx, worklogID); err != nil {
+		logger.Error("Failed to delete worklog in repository", "error", err)
+		return fmt.Errorf("repository delete failed for worklog %d: %w", worklogID, structs.ErrDatabaseFail)
+	}
+
+	logger.Info("Successfully deleted worklog")
+	return nil
+}
+
+// GetTimesheet returns the worklogs of the user started between the days
+// from and to, both included.
+func (s *worklogService) GetTimesheet(ctx context.Context, userID int, from, to time.Time) ([]*models.Worklog, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogService",
+		"method", "GetTimesheet",
+		"user_id", userID,
+	)
+
+	end, err := worklogRangeEnd(from, to)
+	if err != nil {
+		logger.Warn("Invalid timesheet range", "from", from, "to", to)
+		return nil, err
+	}
+
+	worklogs, err := s.worklogRepository.FindByUserInRange(ctx, userID, from, end)
+	if err != nil {
+		logger.Error("Failed to find worklogs of user", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Timesheet found", "count", len(worklogs))
+	return worklogs, nil
+}
+
+// GetProjectTotals returns the time logged on the project per user between
+// the days from and to, both included. Only project managers may read it.
+func (s *worklogService) GetProjectTotals(ctx context.Context, userID, projectID int, from, to time.Time) ([]models.WorklogTotal, error) {
+	baseLogger := utils.LoggerFromContext(ctx)
+	logger := baseLogger.With(
+		"component", "WorklogService",
+		"method", "GetProjectTotals",
+		"project_id", projectID,
+		"requestor_id", userID,
+	)
+
+	end, err := worklogRangeEnd(from, to)
+	if err != nil {
+		logger.Warn("Invalid totals range", "from", from, "to", to)
+		return nil, err
+	}
+
+	if _, err := s.projectService.GetAndVerifyProjectManager(ctx, userID, projectID); err != nil {
+		if errors.Is(err, structs.ErrUserNotManageProject) {
+			return nil, fmt.Errorf("authorization failure for user id %d: %w", userID, err)
+		}
+		return nil, fmt.Errorf("cannot fetch project: %w with project id: %d", err, projectID)
+	}
+
+	totals, err := s.worklogRepository.SumByProjectInRange(ctx, projectID, from, end)
+	if err != nil {
+		logger.Error("Failed to sum worklogs of project", "error", err)
+		return nil, err
+	}
+
+	logger.Info("Project worklog totals computed", "user_count", len(totals))
+	return totals, nil
+}
+
+// findTaskWorklog checks that the user can read the task and returns the
+// worklog, which must belong to that task.
+func (s *worklogService) findTaskWorklog(ctx context.Context, userID, taskID, worklogID int) (*models.Worklog, error) {
+	logger := utils.LoggerFromContext(ctx).With(
+		"component", "WorklogService",
+		"method", "findTaskWorklog",
+	)
+
+	if _, err := s.taskService.GetAndVerifyProjectManagerForTask(ctx, logger, userID, taskID, false); err != nil {
+		return nil, fmt.Errorf("cannot access worklogs of task %d: %w", taskID, err)
+	}
+
+	worklog, err := s.worklogRepository.FindByID(ctx, worklogID)
+	if err != nil {
+		return nil, err
+	}
+	if worklog.TaskID != taskID {
+		logger.Warn("Worklog belongs to another task", "worklog_task_id", worklog.TaskID)
+		return nil, fmt.Errorf("%w with id %d on task %d", structs.ErrWorklogNotExist, worklogID, taskID)
+	}
+	return worklog, nil
+}
+
+// worklogRangeEnd validates the day range [from, to] and returns the
+// exclusive end of the range, the day after to.
+func worklogRangeEnd(from, to time.Time) (time.Time, error) {
+	if to.Before(from) {
+		return time.Time{}, fmt.Errorf("%w: to %s is before from %s", structs.ErrInvalidDateRange, to.Format(time.DateOnly), from.Format(time.DateOnly))
+	}
+	end := to.AddDate(0, 0, 1)
+	if end.Sub(from) > maxWorklogRangeDays*24*time.Hour {
+		return time.Time{}, fmt.Errorf("%w: range is longer than %d days", structs.ErrInvalidDateRange, maxWorklogRangeDays)
+	}
+	return end, nil
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"lqkhoi-go-http-api/internal/models"
+	"lqkhoi-go-http-api/internal/repository"
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorklogRangeEnd(t *testing.T) {
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	end, err := worklogRangeEnd(day(2024, 3, 1), day(2024, 3, 31))
+	assert.NoError(t, err)
+	assert.Equal(t, day(2024, 4, 1), end)
+
+	end, err = worklogRangeEnd(day(2024, 3, 1), day(2024, 3, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, day(2024, 3, 2), end)
+
+	_, err = worklogRangeEnd(day(2024, 3, 2), day(2024, 3, 1))
+	assert.ErrorIs(t, err, structs.ErrInvalidDateRange)
+
+	_, err = worklogRangeEnd(day(2024, 1, 1), day(2025, 1, 1))
+	assert.ErrorIs(t, err, structs.ErrInvalidDateRange)
+}
+
+// stubWorklogRepository stores created worklogs in memory.
+type stubWorklogRepository struct {
+	repository.WorklogRepository
+	worklogs []*models.Worklog
+}
+
+func (r *stubWorklogRepository) Create(ctx context.Context, worklog *models.Worklog) (*models.Worklog, error) {
+	worklog.ID = len(r.worklogs) + 1
+	r.worklogs = append(r.worklogs, worklog)
+	return worklog, nil
+}
+
+func (r *stubWorklogRepository) FindByID(ctx context.Context, id int) (*models.Worklog, error) {
+	for _, worklog := range r.worklogs {
+		if worklog.ID == id {
+			return worklog, nil
+		}
+	}
+	return nil, structs.ErrWorklogNotExist
+}
+
+func TestWorklogService_CreateWorklog(t *testing.T) {
+	assigneeID := 7
+	projectService := &stubProjectService{projectID: 1, managerIDs: []int{6}, memberIDs: []int{7, 9}, viewerIDs: []int{8}}
+	taskService := &taskService{
+		taskRepository: &stubTaskRepository{tasks: []*models.Task{{ID: 3, ProjectID: 1, AssigneeID: &assigneeID}}},
+		projectService: projectService,
+	}
+
+	cases := []struct {
+		name   string
+		userID int
+		err    error
+	}{
+		{name: "assignee", userID: 7},
+		{name: "manager", userID: 6},
+		{name: "member on a task of someone else", userID: 9, err: structs.ErrUserNotAuthorizedForTask},
+		{name: "viewer", userID: 8, err: structs.ErrUserNotAuthorizedForTask},
+		{name: "outsider", userID: 10, err: structs.ErrUserNotAuthorizedForTask},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			worklogRepository := &stubWorklogRepository{}
+			s := NewWorklogService(worklogRepository, taskService, projectService)
+
+			worklog, err := s.CreateWorklog(context.Background(), c.userID, 3, &models.Worklog{DurationMinutes: 30})
+			if c.err != nil {
+				assert.ErrorIs(t, err, c.err)
+				assert.Empty(t, worklogRepository.worklogs)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.userID, worklog.UserID)
+			assert.Equal(t, 1, worklog.ProjectID)
+		})
+	}
+}
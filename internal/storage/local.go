@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"lqkhoi-go-http-api/pkg/structs"
+)
+
+type localStorage struct {
+	root string
+}
+
+// NewLocalStorage returns a Storage keeping files below the root directory,
+// which is created when missing.
+func NewLocalStorage(root string) (Storage, error) {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve storage root %q: %w", root, err)
+	}
+	if err := os.MkdirAll(absRoot, 0o750); err != nil {
+		return nil, fmt.Errorf("cannot create storage root %q: %w", absRoot, err)
+	}
+	return &localStorage{root: absRoot}, nil
+}
+
+func (s *localStorage) Put(ctx context.Context, key string, content io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		slog.Error("Failed to create storage directory", "key", key, "error", err)
+		return structs.ErrStorageFail
+	}
+
+	// Write to a temporary file first so readers never see partial content.
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		slog.Error("Failed to create temporary file", "key", key, "error", err)
+		return structs.ErrStorageFail
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		slog.Error("Failed to write file content", "key", key, "error", err)
+		return structs.ErrStorageFail
+	}
+	if err := tmp.Close(); err != nil {
+		slog.Error("Failed to close temporary file", "key", key, "error", err)
+		return structs.ErrStorageFail
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		slog.Error("Failed to move file into place", "key", key, "error", err)
+		return structs.ErrStorageFail
+	}
+	return nil
+}
+
+func (s *localStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, structs.ErrStorageObjectNotExist
+		}
+		slog.Error("Failed to open stored file", "key", key, "error", err)
+		return nil, structs.ErrStorageFail
+	}
+	return file, nil
+}
+
+func (s *localStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		slog.Error("Failed to delete stored file", "key", key, "error", err)
+		return structs.ErrStorageFail
+	}
+	return nil
+}
+
+// path maps key to a file below the root, rejecting keys that would escape it.
+func (s *localStorage) path(key string) (string, error) {
+	path := filepath.Join(s.root, filepath.FromSlash(key))
+	rel, err := filepath.Rel(s.root, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid storage key %q: %w", key, structs.ErrStorageFail)
+	}
+	return path, nil
+}
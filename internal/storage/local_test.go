@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"lqkhoi-go-http-api/pkg/structs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	t.Run("put then get", func(t *testing.T) {
+		require.NoError(t, s.Put(ctx, "tasks/1/a", strings.NewReader("hello")))
+		r, err := s.Get(ctx, "tasks/1/a")
+		require.NoError(t, err)
+		defer r.Close()
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(content))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, s.Delete(ctx, "tasks/1/a"))
+		_, err := s.Get(ctx, "tasks/1/a")
+		assert.ErrorIs(t, err, structs.ErrStorageObjectNotExist)
+		assert.NoError(t, s.Delete(ctx, "tasks/1/a"))
+	})
+
+	t.Run("keys cannot escape the root", func(t *testing.T) {
+		assert.ErrorIs(t, s.Put(ctx, "../outside", strings.NewReader("x")), structs.ErrStorageFail)
+		_, err := s.Get(ctx, "tasks/../../outside")
+		assert.ErrorIs(t, err, structs.ErrStorageFail)
+	})
+}
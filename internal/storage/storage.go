@@ -0,0 +1,20 @@
+package storage
+
+import (
+	"context"
+	"io"
+)
+
+// Storage keeps file contents under keys chosen by the caller. Keys are
+// slash separated paths such as "tasks/12/3f2a...". Implementations other
+// than the local filesystem, e.g. S3-compatible object stores, only need to
+// satisfy this interface.
+type Storage interface {
+	// Put stores content under key, replacing any previous content.
+	Put(ctx context.Context, key string, content io.Reader) error
+	// Get opens the content stored under key; the caller closes it.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the content stored under key. Deleting a missing key
+	// is not an error.
+	Delete(ctx context.Context, key string) error
+}
@@ -6,9 +6,21 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
 type Claims struct {
 	UserID   int `json:"user_id"`
 	Credential string `json:"credential"`
 	Role models.UserRole `json:"role"`
+	// TokenType tells access tokens apart from refresh tokens.
+	TokenType string `json:"typ,omitempty"`
+	// SessionID is shared by every token issued from the same login.
+	SessionID string `json:"sid,omitempty"`
+	// TokenVersion is the token version of the user when the token was
+	// issued; revoking the tokens of the user bumps it.
+	TokenVersion int64 `json:"ver,omitempty"`
 	jwt.RegisteredClaims
-}
\ No newline at end of file
+}
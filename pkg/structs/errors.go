@@ -25,4 +25,63 @@ var (
 	ErrUserNotAuthorizedForTask = errors.New("user is not authorized for this task")
 	ErrNoCurrentProject 		= errors.New("user does not belong to any project")
 	ErrUserNotPartProject 		= errors.New("user does not belong to this project")
+	ErrParentTaskNotExist       = errors.New("parent task does not exist")
+	ErrParentTaskDone           = errors.New("parent task is already done")
+	ErrSubtaskSprintMismatch    = errors.New("subtask must belong to the same sprint as its parent")
+	ErrTaskHierarchyCycle       = errors.New("task hierarchy would contain a cycle")
+	ErrTaskHierarchyTooDeep     = errors.New("task hierarchy exceeds the maximum depth")
+	ErrTaskHasOpenSubtasks      = errors.New("task has subtasks that are not done")
+	ErrSprintNotInProject       = errors.New("sprint does not belong to the task's project")
+	ErrSubtaskProjectMismatch   = errors.New("subtask must belong to the same project as its parent")
+	ErrProjectOwnerRemoval      = errors.New("project manager cannot be removed from the project")
+	ErrMemberCannotBeAssigned   = errors.New("project viewers cannot be assigned tasks")
+	ErrRefreshTokenInvalid      = errors.New("refresh token is invalid or expired")
+	ErrRefreshTokenReused       = errors.New("refresh token has already been used")
+	ErrAccessTokenExpired       = errors.New("access token has expired")
+	ErrAccessTokenRevoked       = errors.New("access token has been revoked")
+	ErrInvalidPageRequest       = errors.New("invalid pagination or sort parameters")
+	ErrCommentNotExist          = errors.New("comment does not exist")
+	ErrParentCommentNotExist    = errors.New("parent comment does not exist on this task")
+	ErrUserNotCommentAuthor     = errors.New("user is not the author of this comment")
+	ErrCommentThreadTooDeep     = errors.New("comment thread exceeds the maximum reply depth")
+	ErrStatusTransitionNotAllowed = errors.New("status transition is not allowed by the project workflow")
+	ErrInvalidWorkflow          = errors.New("workflow is invalid")
+	ErrSprintNotPlanned         = errors.New("sprint has already been started")
+	ErrSprintNotActive          = errors.New("sprint is not active")
+	ErrSprintClosed             = errors.New("sprint is closed")
+	ErrActiveSprintExists       = errors.New("project already has an active sprint")
+	ErrNoNextSprint             = errors.New("project has no planned sprint to carry tasks over to")
+	ErrSprintReportNotExist     = errors.New("sprint report does not exist")
+	ErrWorklogNotExist          = errors.New("worklog does not exist")
+	ErrUserNotWorklogAuthor     = errors.New("user is not the author of this worklog")
+	ErrInvalidDateRange         = errors.New("date range is invalid")
+	ErrTaskLinkNotExist         = errors.New("task link does not exist")
+	ErrTaskLinkExists           = errors.New("tasks are already linked")
+	ErrTaskLinkSelf             = errors.New("task cannot be linked to itself")
+	ErrTaskLinkProjectMismatch  = errors.New("linked tasks must belong to the same project")
+	ErrTaskLinkCycle            = errors.New("blocking links would contain a cycle")
+	ErrTaskHasOpenBlockers      = errors.New("task is blocked by tasks that are not done")
+	ErrLabelNotExist            = errors.New("label does not exist")
+	ErrLabelNameTaken           = errors.New("project already has a label with this name")
+	ErrLabelNotInProject        = errors.New("label does not belong to the task's project")
+	ErrAttachmentNotExist       = errors.New("attachment does not exist")
+	ErrUserNotAttachmentUploader = errors.New("user is not the uploader of this attachment")
+	ErrFileTooLarge             = errors.New("file exceeds the maximum upload size")
+	ErrFileTypeNotAllowed       = errors.New("file type is not allowed")
+	ErrStorageObjectNotExist    = errors.New("stored file does not exist")
+	ErrStorageFail              = errors.New("file storage operation failed")
+	ErrInvalidSearchQuery       = errors.New("search query is invalid")
+	ErrInvalidTaskFilter        = errors.New("task filter expression is invalid")
+	ErrSavedViewNotExist        = errors.New("saved view does not exist")
+	ErrUserNotViewOwner         = errors.New("user is not the owner of this saved view")
+	ErrWebhookNotExist          = errors.New("webhook does not exist")
+	ErrWebhookDeliveryNotExist  = errors.New("webhook delivery does not exist")
+	ErrWebhookURLNotAllowed     = errors.New("webhook URL must point to a public host")
+	ErrNotificationNotExist     = errors.New("notification does not exist")
+	ErrTaskNotInBoardColumn     = errors.New("neighbour task is not in the board column the task is moved to")
+	ErrInvalidBoardPosition     = errors.New("neighbour tasks are not next to each other on the board")
+	ErrWipLimitNotExist         = errors.New("status has no WIP limit")
+	ErrInvalidWipLimit          = errors.New("WIP limits are invalid")
+	ErrWipLimitReached          = errors.New("WIP limit of the board column is reached")
+	ErrWipLimitOverrideDenied   = errors.New("only project managers can override WIP limits")
 )
@@ -0,0 +1,31 @@
+package structs
+
+import (
+	"fmt"
+	"strings"
+
+	"lqkhoi-go-http-api/internal/models"
+)
+
+// StatusTransitionError reports a status change the workflow of a project
+// does not allow, together with the statuses the task may move to instead.
+type StatusTransitionError struct {
+	From    models.TaskStatus
+	To      models.TaskStatus
+	Allowed []models.TaskStatus
+}
+
+func (e *StatusTransitionError) Error() string {
+	allowed := make([]string, len(e.Allowed))
+	for i, status := range e.Allowed {
+		allowed[i] = string(status)
+	}
+	if len(allowed) == 0 {
+		return fmt.Sprintf("%s: cannot move task from %s to %s, no status is allowed next", ErrStatusTransitionNotAllowed, e.From, e.To)
+	}
+	return fmt.Sprintf("%s: cannot move task from %s to %s, allowed next statuses: %s", ErrStatusTransitionNotAllowed, e.From, e.To, strings.Join(allowed, ", "))
+}
+
+func (e *StatusTransitionError) Unwrap() error {
+	return ErrStatusTransitionNotAllowed
+}
@@ -1,6 +1,9 @@
 package utils
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
 	"time"
 
 	"lqkhoi-go-http-api/internal/models"
@@ -9,25 +12,82 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func GenerateToken(userID int, credential string, role models.UserRole) (string, error) {
+const (
+	// AccessTokenTTL is how long an access token stays valid after it is issued.
+	AccessTokenTTL = time.Hour
+	// RefreshTokenTTL is how long a refresh token stays valid after it is issued.
+	RefreshTokenTTL = 7 * 24 * time.Hour
+)
+
+// NewTokenID returns a random identifier used for token IDs and session IDs.
+func NewTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func GenerateAccessToken(userID int, credential string, role models.UserRole, sessionID string, tokenVersion int64) (string, *structs.Claims, error) {
+	return generateToken(userID, credential, role, sessionID, tokenVersion, structs.TokenTypeAccess, AccessTokenTTL)
+}
+
+func GenerateRefreshToken(userID int, credential string, role models.UserRole, sessionID string, tokenVersion int64) (string, *structs.Claims, error) {
+	return generateToken(userID, credential, role, sessionID, tokenVersion, structs.TokenTypeRefresh, RefreshTokenTTL)
+}
+
+func generateToken(userID int, credential string, role models.UserRole, sessionID string, tokenVersion int64, tokenType string, ttl time.Duration) (string, *structs.Claims, error) {
+	tokenID, err := NewTokenID()
+	if err != nil {
+		return "", nil, err
+	}
+
+	now := time.Now()
 	claims := &structs.Claims{
-		UserID:     userID,
-		Credential: credential,
-		Role:       role,
+		UserID:       userID,
+		Credential:   credential,
+		Role:         role,
+		TokenType:    tokenType,
+		SessionID:    sessionID,
+		TokenVersion: tokenVersion,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * 1)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
+			ID:        tokenID,
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "LeQuangKhoi",
 		},
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-	signedToken, err := token.SignedString([]byte(GetenvStringValue("JWT_SECRET", "randomkey")))
+	signedToken, err := token.SignedString(jwtSecret())
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
-	return signedToken, nil
+	return signedToken, claims, nil
+}
+
+// ParseToken verifies the signature and registered claims of tokenString.
+func ParseToken(tokenString string) (*structs.Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &structs.Claims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return jwtSecret(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(*structs.Claims)
+	if !ok || !token.Valid {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	return claims, nil
+}
+
+func jwtSecret() []byte {
+	return []byte(GetenvStringValue("JWT_SECRET", "randomkey"))
 }
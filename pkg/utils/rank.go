@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// rankDigits are the digits of ranks, in ascending order. A rank reads as the
+// fractional part of a base 36 number, so ranks compare like the strings
+// they are and there is always room for another rank between two of them.
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+const (
+	// rankAppendWidth is the digit a rank appended to a list is incremented
+	// at, at the least: appending stays this short for over a million ranks.
+	rankAppendWidth = 4
+
+	// MaxRankLength is the length past which a rank is better replaced by
+	// ranking its whole list again with SpreadRanks. Inserting repeatedly at
+	// the same place, between two ranks or before the first one, adds a
+	// digit every few inserts.
+	MaxRankLength = 32
+)
+
+// RankBetween returns a rank sorting after prev and before next. An empty
+// prev means the start of the list and an empty next its end. Ranks never
+// end with the smallest digit, which keeps room below every rank.
+func RankBetween(prev, next string) (string, error) {
+	if !validRank(prev) || !validRank(next) {
+		return "", fmt.Errorf("invalid rank %q or %q", prev, next)
+	}
+	if next != "" && prev >= next {
+		return "", fmt.Errorf("rank %q does not sort before %q", prev, next)
+	}
+	if next == "" && prev != "" {
+		return rankIncrement(prev), nil
+	}
+	return rankMidpoint(prev, next, next != ""), nil
+}
+
+// SpreadRanks returns n ascending ranks of equal length spread evenly over
+// the whole range, for ranking a list from scratch.
+func SpreadRanks(n int) []string {
+	width, capacity := 1, len(rankDigits)
+	for capacity <= n {
+		width++
+		capacity *= len(rankDigits)
+	}
+
+	ranks := make([]string, n)
+	step := capacity / (n + 1)
+	for i := range ranks {
+		value := (i + 1) * step
+		digits := make([]byte, width)
+		for j := width - 1; j >= 0; j-- {
+			digits[j] = rankDigits[value%len(rankDigits)]
+			value /= len(rankDigits)
+		}
+		ranks[i] = strings.TrimRight(string(digits), rankDigits[:1])
+	}
+	return ranks
+}
+
+// rankMidpoint returns a rank between a and b, or after a when b is not
+// bounded.
+func rankMidpoint(a, b string, bounded bool) string {
+	if bounded {
+		// Keep the common prefix, reading a as padded with zeros.
+		n := 0
+		for n < len(b) && rankDigitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + rankMidpoint(trimPrefix(a, n), b[n:], true)
+		}
+	}
+
+	digitA := 0
+	if a != "" {
+		digitA = strings.IndexByte(rankDigits, a[0])
+	}
+	digitB := len(rankDigits)
+	if bounded {
+		digitB = strings.IndexByte(rankDigits, b[0])
+	}
+	if digitB-digitA > 1 {
+		return string(rankDigits[(digitA+digitB+1)/2])
+	}
+	// The first digits are consecutive.
+	if bounded && len(b) > 1 {
+		return b[:1]
+	}
+	return string(rankDigits[digitA]) + rankMidpoint(trimPrefix(a, 1), "", false)
+}
+
+// rankIncrement returns the rank following rank by one unit of its last
+// digit, or of digit rankAppendWidth for shorter ranks, so that appending to
+// a list keeps ranks short rather than halving the room left each time.
+func rankIncrement(rank string) string {
+	width := max(len(rank), rankAppendWidth)
+	digits := []byte(rank + strings.Repeat(rankDigits[:1], width-len(rank)))
+	for i := width - 1; i >= 0; i-- {
+		digit := strings.IndexByte(rankDigits, digits[i])
+		if digit < len(rankDigits)-1 {
+			digits[i] = rankDigits[digit+1]
+			return strings.TrimRight(string(digits), rankDigits[:1])
+		}
+		digits[i] = rankDigits[0]
+	}
+	// Every digit is the largest one: only a longer rank sorts after it.
+	return rank + rankMidpoint("", "", false)
+}
+
+func rankDigitAt(rank string, i int) byte {
+	if i < len(rank) {
+		return rank[i]
+	}
+	return rankDigits[0]
+}
+
+func trimPrefix(rank string, n int) string {
+	if n >= len(rank) {
+		return ""
+	}
+	return rank[n:]
+}
+
+func validRank(rank string) bool {
+	for i := 0; i < len(rank); i++ {
+		if strings.IndexByte(rankDigits, rank[i]) < 0 {
+			return false
+		}
+	}
+	return !strings.HasSuffix(rank, rankDigits[:1])
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankBetween(t *testing.T) {
+	t.Run("rank sorts between its neighbours", func(t *testing.T) {
+		cases := [][2]string{
+			{"", ""}, {"", "1"}, {"i", ""}, {"a", "b"}, {"a", "a1"}, {"0i", "1"}, {"y", "z"}, {"zz", ""}, {"49", "5"},
+		}
+		for _, c := range cases {
+			rank, err := RankBetween(c[0], c[1])
+			require.NoError(t, err, "between %q and %q", c[0], c[1])
+			assert.Greater(t, rank, c[0])
+			if c[1] != "" {
+				assert.Less(t, rank, c[1])
+			}
+			assert.True(t, validRank(rank), "rank %q", rank)
+		}
+	})
+
+	t.Run("repeated inserts keep room", func(t *testing.T) {
+		prev, next := "a", "b"
+		for i := 0; i < 100; i++ {
+			rank, err := RankBetween(prev, next)
+			require.NoError(t, err)
+			require.True(t, prev < rank && rank < next)
+			next = rank
+		}
+	})
+
+	t.Run("appending keeps ranks short", func(t *testing.T) {
+		prev := "i"
+		for i := 0; i < 5000; i++ {
+			rank, err := RankBetween(prev, "")
+			require.NoError(t, err)
+			require.Greater(t, rank, prev)
+			require.True(t, validRank(rank), "rank %q", rank)
+			prev = rank
+		}
+		assert.LessOrEqual(t, len(prev), rankAppendWidth)
+	})
+
+	t.Run("appending after the largest rank goes one digit deeper", func(t *testing.T) {
+		rank, err := RankBetween("zzzz", "")
+		require.NoError(t, err)
+		assert.Greater(t, rank, "zzzz")
+		assert.Len(t, rank, 5)
+	})
+
+	t.Run("invalid ranges are rejected", func(t *testing.T) {
+		_, err := RankBetween("b", "a")
+		assert.Error(t, err)
+		_, err = RankBetween("a", "a")
+		assert.Error(t, err)
+		_, err = RankBetween("a0", "")
+		assert.Error(t, err)
+		_, err = RankBetween("A", "")
+		assert.Error(t, err)
+	})
+}
+
+func TestSpreadRanks(t *testing.T) {
+	for _, n := range []int{0, 1, 35, 36, 1000} {
+		ranks := SpreadRanks(n)
+		require.Len(t, ranks, n)
+		assert.True(t, sort.StringsAreSorted(ranks))
+		for i, rank := range ranks {
+			assert.True(t, validRank(rank) && rank != "", "rank %q", rank)
+			if i > 0 {
+				assert.NotEqual(t, ranks[i-1], rank)
+			}
+		}
+	}
+}